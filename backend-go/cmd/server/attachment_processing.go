@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/service"
+)
+
+// runAttachmentProcessingJob processes uploaded attachments on its interval
+// until ctx is done.
+func (app *App) runAttachmentProcessingJob(ctx context.Context,
+	processingService service.AttachmentProcessingService,
+) {
+	go runEvery(ctx, app.Cfg.Attachment.ProcessInterval, func(ctx context.Context) {
+		if _, err := processingService.ProcessPending(ctx); err != nil {
+			app.Log.Error("[Attachment] Failed to process pending attachments", "error", err)
+		}
+	})
+}
+
+// runLegacyAttachmentJob moves the attachment files uploaded publicly, before
+// attachment files were private, to private delivery once in the background.
+func (app *App) runLegacyAttachmentJob(ctx context.Context, legacyService service.LegacyAttachmentService) {
+	go func() {
+		moved, err := legacyService.MakePrivate(ctx)
+		if err != nil {
+			app.Log.Error("[Attachment] Failed to make legacy attachment files private", "error", err)
+		}
+		if moved > 0 {
+			app.Log.Info("[Attachment] Made legacy attachment files private", "count", moved)
+		}
+	}()
+}
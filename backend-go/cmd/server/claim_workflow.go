@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/application/workflow"
+	"ev-warranty-go/internal/infrastructure/config"
+	"time"
+)
+
+const loadWorkflowTimeout = 10 * time.Second
+
+// loadClaimWorkflow reads the claim workflow definition from the configured
+// source. The definition is validated when the workflow engine is built.
+func (app *App) loadClaimWorkflow(repo repository.ClaimWorkflowRepository) (*workflow.Definition, error) {
+	switch app.Cfg.Workflow.Source {
+	case config.WorkflowSourceFile:
+		return workflow.LoadFile(app.Cfg.Workflow.File)
+	case config.WorkflowSourceDB:
+		ctx, cancel := context.WithTimeout(context.Background(), loadWorkflowTimeout)
+		defer cancel()
+
+		claimWorkflow, err := repo.FindActive(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return workflow.Parse([]byte(claimWorkflow.Definition))
+	default:
+		return workflow.Default(), nil
+	}
+}
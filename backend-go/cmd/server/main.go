@@ -3,16 +3,24 @@ package main
 import (
 	"context"
 	"errors"
+	"ev-warranty-go/internal/application/claimstream"
+	"ev-warranty-go/internal/application/inbox"
+	"ev-warranty-go/internal/application/outbox"
 	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/application/workflow"
+	"ev-warranty-go/internal/domain/entity"
 	"ev-warranty-go/internal/infrastructure/client/dotnet"
-	"ev-warranty-go/internal/infrastructure/cloudinary"
 	"ev-warranty-go/internal/infrastructure/config"
 	"ev-warranty-go/internal/infrastructure/database"
+	"ev-warranty-go/internal/infrastructure/notification"
 	"ev-warranty-go/internal/infrastructure/oauth"
 	"ev-warranty-go/internal/infrastructure/oauth/providers"
 	"ev-warranty-go/internal/infrastructure/persistence"
+	"ev-warranty-go/internal/infrastructure/storage"
+	"ev-warranty-go/internal/infrastructure/webhook"
 	"ev-warranty-go/internal/interface/api"
 	"ev-warranty-go/internal/interface/api/handler"
+	"ev-warranty-go/internal/interface/api/middleware"
 	"ev-warranty-go/pkg/logger"
 	"ev-warranty-go/pkg/security"
 	"net/http"
@@ -20,6 +28,7 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	_ "ev-warranty-go/docs"
 
@@ -92,44 +101,192 @@ func main() {
 	claimItemRepo := persistence.NewClaimItemRepository(db.DB)
 	claimAttachmentRepo := persistence.NewClaimAttachmentRepository(db.DB)
 	claimHistoryRepo := persistence.NewClaimHistoryRepository(db.DB)
+	claimAuditLogRepo := persistence.NewClaimAuditLogRepository(db.DB)
+	outboxRepo := persistence.NewOutboxEventRepository(db.DB)
+	webhookSubscriptionRepo := persistence.NewWebhookSubscriptionRepository(db.DB)
+	webhookDeliveryRepo := persistence.NewWebhookDeliveryRepository(db.DB)
+	claimWorkflowRepo := persistence.NewClaimWorkflowRepository(db.DB)
+	partReservationRepo := persistence.NewPartReservationRepository(db.DB)
+	laborOperationRepo := persistence.NewLaborOperationRepository(db.DB)
+	settlementBatchRepo := persistence.NewSettlementBatchRepository(db.DB)
+	reportRepo := persistence.NewReportRepository(db.DB)
+	claimSLARepo := persistence.NewClaimSLARepository(db.DB)
+	notificationPreferenceRepo := persistence.NewNotificationPreferenceRepository(db.DB)
+	notificationEmailRepo := persistence.NewNotificationEmailRepository(db.DB)
+	notificationRepo := persistence.NewNotificationRepository(db.DB)
+	businessCalendarRepo := persistence.NewBusinessCalendarRepository(db.DB)
+
+	workflowDefinition, err := app.loadClaimWorkflow(claimWorkflowRepo)
+	if err != nil {
+		log.Error("Failed to load claim workflow", "source", cfg.Workflow.Source, "error", err)
+		os.Exit(1)
+	}
+	claimWorkflow, err := workflow.NewEngine(workflowDefinition,
+		service.NewClaimWorkflowGuards(claimItemRepo, claimAttachmentRepo))
+	if err != nil {
+		log.Error("Invalid claim workflow definition", "source", cfg.Workflow.Source, "error", err)
+		os.Exit(1)
+	}
 
 	googleProvider := providers.NewGoogleProvider(
 		cfg.OAuth.GoogleClientID, cfg.OAuth.GoogleClientSecret, cfg.OAuth.GoogleRedirectURL)
-	cloudinaryService, err := cloudinary.NewCloudinaryService(&cfg.Cloudinary)
+	attachmentStorage, err := app.newAttachmentStorage()
 	if err != nil {
-		log.Error("Failed to initialize cloudinary", "error", err)
+		log.Error("Failed to initialize attachment storage", "backend", cfg.Storage.Backend, "error", err)
 		os.Exit(1)
 	}
 
-	dotnetClient := dotnet.NewClient(cfg.ExternalService.DotnetBackendURL)
+	dotnetClient := dotnet.NewClient(cfg.ExternalService.DotnetBackendURL, dotnet.Config{
+		Timeout:          cfg.ExternalService.DotnetTimeout,
+		MaxRetries:       cfg.ExternalService.DotnetMaxRetries,
+		BaseBackoff:      cfg.ExternalService.DotnetRetryBackoff,
+		MaxBackoff:       cfg.ExternalService.DotnetRetryMaxBackoff,
+		BreakerThreshold: cfg.ExternalService.DotnetBreakerThreshold,
+		BreakerCooldown:  cfg.ExternalService.DotnetBreakerCooldown,
+	})
 
 	officeService := service.NewOfficeService(officeRepo)
+	laborOperationService := service.NewLaborOperationService(laborOperationRepo)
 	tokenService := service.NewTokenService(tokenRepo,
 		cfg.AccessTokenTTL, cfg.RefreshTokenTTL, security.PrivateKey(), security.PublicKey())
 	authService := service.NewAuthService(userRepo, tokenService)
 	userService := service.NewUserService(userRepo, officeRepo, claimRepo)
 	oauthService := oauth.NewOAuthService(googleProvider, userRepo)
+	warrantyService := service.NewWarrantyService(dotnetClient, service.WarrantyConfig{
+		RejectIneligible: cfg.Warranty.EligibilityMode == config.WarrantyModeReject,
+		RejectUncovered:  cfg.Warranty.CoverageMode == config.WarrantyModeReject,
+	})
+	if !entity.IsValidCurrency(cfg.Cost.Currency) {
+		log.Error("Unsupported cost currency", "currency", cfg.Cost.Currency)
+		os.Exit(1)
+	}
+	costCfg := service.CostConfig{
+		Currency: cfg.Cost.Currency,
+		TaxRate:  cfg.Cost.TaxRate,
+	}
+	slaCfg := service.SLAConfig{
+		Policies:  make(map[string]entity.SLAPolicy, len(cfg.SLA.Policies)),
+		BatchSize: cfg.SLA.BatchSize,
+	}
+	for status, policy := range cfg.SLA.Policies {
+		if !entity.IsValidClaimStatus(status) {
+			log.Error("Unknown claim status in CLAIM_SLAS", "status", status)
+			os.Exit(1)
+		}
+		slaCfg.Policies[status] = entity.SLAPolicy{Duration: policy.Duration, BusinessDays: policy.BusinessDays}
+	}
+	slaService := service.NewSLAService(log, txManager, claimSLARepo, businessCalendarRepo, claimRepo, userRepo,
+		officeRepo, outboxRepo, slaCfg)
+	claimStream := claimstream.NewBroker()
 	claimService := service.NewClaimService(log, claimRepo, userRepo, claimItemRepo, claimAttachmentRepo,
-		claimHistoryRepo, cloudinaryService)
-	claimItemService := service.NewClaimItemService(claimRepo, claimItemRepo, userRepo, dotnetClient)
-	claimAttachmentService := service.NewClaimAttachmentService(log, claimRepo, claimAttachmentRepo,
-		cloudinaryService)
+		claimHistoryRepo, claimAuditLogRepo, outboxRepo, attachmentStorage, claimWorkflow, warrantyService,
+		slaService, costCfg, claimStream)
+	partReservationService := service.NewPartReservationService(log, txManager, partReservationRepo,
+		claimItemRepo, dotnetClient, service.PartReservationConfig{
+			ServiceToken:   cfg.PartReservation.ServiceToken,
+			Grace:          cfg.PartReservation.Grace,
+			BatchSize:      partReservationBatchSize,
+			MaxAttempts:    cfg.PartReservation.MaxAttempts,
+			BaseBackoff:    cfg.PartReservation.RetryInterval,
+			MaxBackoff:     cfg.PartReservation.MaxBackoff,
+			ReleaseOrphans: cfg.PartReservation.ReleaseOrphans,
+		})
+	claimItemService := service.NewClaimItemService(claimRepo, claimItemRepo, userRepo, officeRepo,
+		laborOperationRepo, claimHistoryRepo, claimAuditLogRepo, outboxRepo, partReservationService, warrantyService,
+		costCfg, claimStream)
+	attachmentCfg := service.AttachmentConfig{
+		URLTTL:           cfg.Storage.URLTTL,
+		MaxImageSize:     cfg.Attachment.MaxImageSize,
+		MaxVideoSize:     cfg.Attachment.MaxVideoSize,
+		MaxVideoDuration: cfg.Attachment.MaxVideoDuration,
+		ThumbnailSize:    cfg.Attachment.ThumbnailSize,
+		BatchSize:        cfg.Attachment.ProcessBatchSize,
+		MaxAttempts:      cfg.Attachment.ProcessMaxAttempts,
+	}
+	claimAttachmentService := service.NewClaimAttachmentService(log, claimRepo, claimAttachmentRepo, claimItemRepo,
+		claimAuditLogRepo, outboxRepo, attachmentStorage, claimStream, attachmentCfg)
+	attachmentProcessingService := service.NewAttachmentProcessingService(log, txManager, claimAttachmentRepo,
+		attachmentStorage, attachmentCfg)
+	settlementService := service.NewSettlementService(settlementBatchRepo, claimRepo, officeRepo,
+		claimAuditLogRepo, outboxRepo, costCfg)
+	reportService := service.NewReportService(reportRepo)
+	webhookSubscriptionService := service.NewWebhookSubscriptionService(webhookSubscriptionRepo,
+		webhookDeliveryRepo, officeRepo)
+	notificationHub := inbox.NewHub()
+	notificationService := service.NewNotificationService(notificationPreferenceRepo, notificationRepo,
+		notificationHub)
+
+	emailSender, err := app.newEmailSender()
+	if err != nil {
+		log.Error("Failed to create email sender", "error", err)
+		os.Exit(1)
+	}
+	outboxSinks := []outbox.Sink{
+		outbox.NewSubscriptionSink(txManager, webhookSubscriptionRepo, webhookDeliveryRepo),
+		outbox.NewNotificationSink(txManager, claimRepo, userRepo, notificationPreferenceRepo,
+			notificationEmailRepo, notificationRepo, notification.NewTemplateRenderer(cfg.OAuth.FrontendBaseURL),
+			notificationHub),
+	}
+	if cfg.Outbox.WebhookURL != "" {
+		outboxSinks = append(outboxSinks, webhook.NewSink(cfg.Outbox.WebhookURL, cfg.Outbox.WebhookTimeout))
+	}
+	outboxConfig := outbox.Config{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		MaxAttempts:  cfg.Outbox.MaxAttempts,
+		BaseBackoff:  cfg.Outbox.BaseBackoff,
+		MaxBackoff:   cfg.Outbox.MaxBackoff,
+	}
+	outboxDispatcher := outbox.NewDispatcher(log, txManager, outboxRepo, outboxSinks, outboxConfig)
+	webhookDispatcher := outbox.NewWebhookDispatcher(log, txManager, webhookDeliveryRepo,
+		webhook.NewSender(cfg.Outbox.WebhookTimeout), outboxConfig)
+	notificationDispatcher := outbox.NewNotificationDispatcher(log, txManager, notificationEmailRepo, emailSender,
+		outboxConfig)
+
+	authMiddleware := middleware.NewAuthMiddleware(log, cfg.Auth.Mode, tokenService, userService)
 
 	officeHandler := handler.NewOfficeHandler(log, officeService)
+	laborOperationHandler := handler.NewLaborOperationHandler(log, laborOperationService)
 	authHandler := handler.NewAuthHandler(log, authService, tokenService, userService)
 	oauthHandler := handler.NewOAuthHandler(log, cfg.OAuth.FrontendBaseURL, oauthService, authService)
 	userHandler := handler.NewUserHandler(log, userService)
 	claimHandler := handler.NewClaimHandler(log, txManager, claimService)
 	claimItemHandler := handler.NewClaimItemHandler(log, txManager, claimItemService)
 	claimAttachmentHandler := handler.NewClaimAttachmentHandler(log, txManager, claimAttachmentService)
+	webhookSubscriptionHandler := handler.NewWebhookSubscriptionHandler(log, txManager, webhookSubscriptionService)
+	settlementHandler := handler.NewSettlementHandler(log, txManager, settlementService)
+	reportHandler := handler.NewReportHandler(log, reportService)
+	slaHandler := handler.NewSLAHandler(log, slaService)
+	notificationHandler := handler.NewNotificationHandler(log, notificationService)
+	fileServer, _ := attachmentStorage.(storage.FileServer)
+	fileHandler := handler.NewFileHandler(log, fileServer)
+	debugHandler := handler.NewDebugHandler(log)
 
-	r := api.NewRouter(app.DB, authHandler, oauthHandler, officeHandler,
-		userHandler, claimHandler, claimItemHandler, claimAttachmentHandler)
-	log.Info("Server starting on port " + cfg.Port)
+	r := api.NewRouter(app.DB, authMiddleware, authHandler, oauthHandler, officeHandler,
+		userHandler, claimHandler, claimItemHandler, claimAttachmentHandler, webhookSubscriptionHandler,
+		laborOperationHandler, settlementHandler, reportHandler, slaHandler, notificationHandler, fileHandler,
+		debugHandler)
+	log.Info("Server starting on port "+cfg.Port, "auth_mode", cfg.Auth.Mode)
 	srv := &http.Server{
 		Addr:    ":" + cfg.Port,
 		Handler: r,
 	}
+	// Shutdown waits for open requests, so live streams are ended first.
+	srv.RegisterOnShutdown(claimStream.Close)
+	srv.RegisterOnShutdown(notificationHub.Close)
+
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	defer stopDispatcher()
+	go outboxDispatcher.Run(dispatcherCtx)
+	go webhookDispatcher.Run(dispatcherCtx)
+	go notificationDispatcher.Run(dispatcherCtx)
+	app.runPartReservationJobs(dispatcherCtx, partReservationService)
+	app.runSLAJobs(dispatcherCtx, slaService)
+	app.runAttachmentProcessingJob(dispatcherCtx, attachmentProcessingService)
+	if mover, ok := attachmentStorage.(storage.PrivateMover); ok {
+		app.runLegacyAttachmentJob(dispatcherCtx, service.NewLegacyAttachmentService(log, txManager,
+			claimAttachmentRepo, mover, attachmentCfg))
+	}
 
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -143,6 +300,7 @@ func main() {
 	<-quit
 
 	log.Info("Shutting down server...")
+	stopDispatcher()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err = srv.Shutdown(ctx); err != nil {
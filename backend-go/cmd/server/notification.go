@@ -0,0 +1,28 @@
+package main
+
+import (
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/infrastructure/config"
+	"ev-warranty-go/internal/infrastructure/notification"
+)
+
+// newEmailSender returns the sender of notification emails selected by
+// NOTIFICATION_SENDER.
+func (app *App) newEmailSender() (outbox.EmailSender, error) {
+	cfg := app.Cfg.Notification
+	switch cfg.Sender {
+	case config.NotificationSenderSMTP:
+		return notification.NewSMTPSender(notification.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.From,
+			Timeout:  cfg.SMTPTimeout,
+		})
+	case config.NotificationSenderFile:
+		return notification.NewFileSender(cfg.FileDir, cfg.From), nil
+	default:
+		return notification.NewLogSender(app.Log), nil
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/service"
+	"time"
+)
+
+const partReservationBatchSize = 50
+
+// runPartReservationJobs retries due part releases and reconciles the .NET
+// inventory on their intervals until ctx is done. Both jobs call the
+// inventory without a user, so they only run when a service token is set.
+func (app *App) runPartReservationJobs(ctx context.Context, reservationService service.PartReservationService) {
+	if app.Cfg.PartReservation.ServiceToken == "" {
+		app.Log.Info("DOTNET_SERVICE_TOKEN is not set, part reservation retries and reconciliation are disabled")
+		return
+	}
+
+	go runEvery(ctx, app.Cfg.PartReservation.RetryInterval, func(ctx context.Context) {
+		if _, err := reservationService.ProcessPending(ctx); err != nil {
+			app.Log.Error("[PartReservation] Failed to process pending releases", "error", err)
+		}
+	})
+	go runEvery(ctx, app.Cfg.PartReservation.ReconcileInterval, func(ctx context.Context) {
+		report, err := reservationService.Reconcile(ctx)
+		if err != nil {
+			app.Log.Error("[PartReservation] Failed to reconcile reserved parts", "error", err)
+			return
+		}
+		app.Log.Info("[PartReservation] Reserved parts reconciled", "reserved_parts", report.ReservedParts,
+			"orphaned", len(report.Orphaned), "scheduled_releases", report.ScheduledReleases)
+	})
+}
+
+func runEvery(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
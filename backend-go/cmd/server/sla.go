@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/service"
+)
+
+// runSLAJobs looks for claims that ran out of their SLA on its interval until
+// ctx is done.
+func (app *App) runSLAJobs(ctx context.Context, slaService service.SLAService) {
+	if len(app.Cfg.SLA.Policies) == 0 {
+		app.Log.Info("CLAIM_SLAS is none, SLA breach detection is disabled")
+		return
+	}
+
+	go runEvery(ctx, app.Cfg.SLA.CheckInterval, func(ctx context.Context) {
+		if _, err := slaService.ProcessBreaches(ctx); err != nil {
+			app.Log.Error("[SLA] Failed to process SLA breaches", "error", err)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"ev-warranty-go/internal/infrastructure/config"
+	"ev-warranty-go/internal/infrastructure/storage"
+)
+
+// newAttachmentStorage returns the storage of claim attachment files selected
+// by STORAGE_BACKEND.
+func (app *App) newAttachmentStorage() (storage.AttachmentStorage, error) {
+	cfg := app.Cfg.Storage
+	switch cfg.Backend {
+	case config.StorageBackendLocal:
+		return storage.NewLocalStorage(cfg.LocalDir, cfg.LocalBaseURL, cfg.SigningKey)
+	case config.StorageBackendS3:
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:       cfg.S3Endpoint,
+			PublicEndpoint: cfg.S3PublicEndpoint,
+			Region:         cfg.S3Region,
+			Bucket:         cfg.S3Bucket,
+			AccessKey:      cfg.S3AccessKey,
+			SecretKey:      cfg.S3SecretKey,
+			PathStyle:      cfg.S3PathStyle,
+		})
+	default:
+		return storage.NewCloudinaryStorage(&app.Cfg.Cloudinary)
+	}
+}
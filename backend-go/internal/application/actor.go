@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type actorContextKey struct{}
+
+// Actor is the authenticated caller of a request, resolved by the auth
+// middleware and carried through the request context into the services.
+type Actor struct {
+	UserID   uuid.UUID
+	Role     string
+	OfficeID uuid.UUID
+}
+
+func NewActor(user *entity.User) *Actor {
+	return &Actor{
+		UserID:   user.ID,
+		Role:     user.Role,
+		OfficeID: user.OfficeID,
+	}
+}
+
+// IsOfficeScoped reports whether the actor may only access claims handled by
+// their own service center.
+func (a *Actor) IsOfficeScoped() bool {
+	return a.Role == entity.UserRoleScStaff || a.Role == entity.UserRoleScTechnician
+}
+
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) (*Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(*Actor)
+	return actor, ok && actor != nil
+}
@@ -0,0 +1,185 @@
+package claimstream
+
+import (
+	"ev-warranty-go/internal/domain/entity"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// replaySize is how many of the latest updates are kept for clients
+	// resuming a stream.
+	replaySize = 256
+	// subscriberBuffer is how many updates a subscriber may lag behind
+	// before it is disconnected.
+	subscriberBuffer = 64
+)
+
+// Update is a change to a claim pushed to live dashboards: a status change,
+// an item decision or a new attachment. Type is the claim event type.
+type Update struct {
+	ID             uint64                  `json:"id"`
+	Type           string                  `json:"type"`
+	ClaimID        uuid.UUID               `json:"claim_id"`
+	Status         string                  `json:"status"`
+	PreviousStatus string                  `json:"previous_status,omitempty"`
+	Item           *entity.ClaimItem       `json:"item,omitempty"`
+	Attachment     *entity.ClaimAttachment `json:"attachment,omitempty"`
+	OccurredAt     time.Time               `json:"occurred_at"`
+	StaffID        uuid.UUID               `json:"-"`
+	TechnicianID   uuid.UUID               `json:"-"`
+}
+
+// NewUpdate returns an update of eventType about claim, as it is after the
+// change. Its ID is assigned when it is published.
+func NewUpdate(eventType string, claim *entity.Claim) *Update {
+	return &Update{
+		Type:         eventType,
+		ClaimID:      claim.ID,
+		Status:       claim.Status,
+		OccurredAt:   time.Now(),
+		StaffID:      claim.StaffID,
+		TechnicianID: claim.TechnicianID,
+	}
+}
+
+// Subscription is a stream of the updates accepted by its filter.
+type Subscription struct {
+	// Replay holds the buffered updates published after the one the client
+	// resumed from.
+	Replay []*Update
+	// Missed is set when updates after the one the client resumed from are
+	// no longer buffered, so it should reload what it shows.
+	Missed bool
+	// Updates is closed when the subscriber falls too far behind, is
+	// cancelled or the broker closes.
+	Updates <-chan *Update
+	Cancel  func()
+}
+
+// Broker is the in-process pub/sub hub fanning the claim updates of this
+// instance out to its subscribers. It never blocks publishers: a subscriber
+// that falls behind is disconnected and resumes from the replay buffer when
+// it reconnects.
+type Broker interface {
+	// Publish assigns update the next ID and hands it to the subscribers
+	// whose filter accepts it.
+	Publish(update *Update)
+	// Subscribe returns the updates accepted by filter published from now
+	// on, and the buffered ones after lastID unless it is 0.
+	Subscribe(lastID uint64, filter func(*Update) bool) *Subscription
+	// Close ends every subscription, and those made afterwards right away.
+	Close()
+}
+
+type subscriber struct {
+	updates chan *Update
+	filter  func(*Update) bool
+}
+
+type broker struct {
+	mu          sync.Mutex
+	lastID      uint64
+	replay      []*Update
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+// NewBroker returns an empty broker. IDs start from the current time in
+// microseconds, so that those of a previous run are older than any buffered
+// update and resuming from them reports missed updates.
+func NewBroker() Broker {
+	return &broker{
+		lastID:      uint64(time.Now().UnixMicro()),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (b *broker) Publish(update *Update) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	update.ID = b.lastID
+	b.replay = append(b.replay, update)
+	if len(b.replay) > replaySize {
+		b.replay = b.replay[len(b.replay)-replaySize:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.filter(update) {
+			continue
+		}
+		select {
+		case sub.updates <- update:
+		default:
+			b.remove(sub)
+		}
+	}
+}
+
+func (b *broker) Subscribe(lastID uint64, filter func(*Update) bool) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &subscriber{updates: make(chan *Update, subscriberBuffer), filter: filter}
+	subscription := &Subscription{Updates: sub.updates, Cancel: func() {}}
+	if lastID != 0 {
+		subscription.Replay, subscription.Missed = b.since(lastID, filter)
+	}
+	if b.closed {
+		close(sub.updates)
+		return subscription
+	}
+
+	b.subscribers[sub] = struct{}{}
+	subscription.Cancel = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(sub)
+	}
+	return subscription
+}
+
+// since returns the buffered updates accepted by filter after lastID, and
+// whether some updates after it are no longer buffered.
+func (b *broker) since(lastID uint64, filter func(*Update) bool) ([]*Update, bool) {
+	oldest := b.lastID + 1
+	if len(b.replay) > 0 {
+		oldest = b.replay[0].ID
+	}
+	if lastID > b.lastID || lastID+1 < oldest {
+		return nil, true
+	}
+
+	var updates []*Update
+	for _, update := range b.replay {
+		if update.ID > lastID && filter(update) {
+			updates = append(updates, update)
+		}
+	}
+	return updates, false
+}
+
+func (b *broker) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.updates)
+}
+
+func (b *broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
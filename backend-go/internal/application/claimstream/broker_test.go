@@ -0,0 +1,125 @@
+package claimstream_test
+
+import (
+	"ev-warranty-go/internal/application/claimstream"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Broker", func() {
+	var (
+		broker claimstream.Broker
+		claim  *entity.Claim
+		all    func(*claimstream.Update) bool
+	)
+
+	newUpdate := func() *claimstream.Update {
+		return claimstream.NewUpdate(entity.EventClaimSubmitted, claim)
+	}
+
+	BeforeEach(func() {
+		broker = claimstream.NewBroker()
+		claim = entity.NewClaim(uuid.New(), uuid.New(), 1200, "Battery failure", uuid.New(), uuid.New(), "VND")
+		all = func(*claimstream.Update) bool { return true }
+	})
+
+	Describe("Publish", func() {
+		It("should hand updates to the subscribers whose filter accepts them, with increasing IDs", func() {
+			subscription := broker.Subscribe(0, all)
+			defer subscription.Cancel()
+			other := broker.Subscribe(0, func(u *claimstream.Update) bool { return u.ClaimID != claim.ID })
+			defer other.Cancel()
+			first, second := newUpdate(), newUpdate()
+
+			broker.Publish(first)
+			broker.Publish(second)
+
+			Expect(subscription.Updates).To(Receive(Equal(first)))
+			Expect(subscription.Updates).To(Receive(Equal(second)))
+			Expect(second.ID).To(Equal(first.ID + 1))
+			Expect(other.Updates).NotTo(Receive())
+		})
+
+		It("should disconnect a subscriber that falls behind", func() {
+			subscription := broker.Subscribe(0, all)
+			defer subscription.Cancel()
+
+			for range 100 {
+				broker.Publish(newUpdate())
+			}
+
+			Eventually(subscription.Updates).Should(BeClosed())
+		})
+	})
+
+	Describe("Subscribe", func() {
+		Context("when resuming from a buffered update", func() {
+			It("should replay the accepted updates published after it", func() {
+				first, second, third := newUpdate(), newUpdate(), newUpdate()
+				broker.Publish(first)
+				broker.Publish(second)
+				broker.Publish(third)
+
+				subscription := broker.Subscribe(first.ID, func(u *claimstream.Update) bool { return u != second })
+				defer subscription.Cancel()
+
+				Expect(subscription.Missed).To(BeFalse())
+				Expect(subscription.Replay).To(Equal([]*claimstream.Update{third}))
+			})
+		})
+
+		Context("when resuming from an update no longer buffered", func() {
+			It("should report missed updates", func() {
+				first := newUpdate()
+				broker.Publish(first)
+				for range 300 {
+					broker.Publish(newUpdate())
+				}
+
+				subscription := broker.Subscribe(first.ID, all)
+				defer subscription.Cancel()
+
+				Expect(subscription.Missed).To(BeTrue())
+				Expect(subscription.Replay).To(BeEmpty())
+			})
+		})
+
+		Context("when resuming from an update this broker never published", func() {
+			It("should report missed updates", func() {
+				other := claimstream.NewBroker()
+				update := newUpdate()
+				other.Publish(update)
+
+				subscription := broker.Subscribe(update.ID, all)
+				defer subscription.Cancel()
+
+				Expect(subscription.Missed).To(BeTrue())
+			})
+		})
+
+		Context("when the broker is closed", func() {
+			It("should return a closed stream", func() {
+				broker.Close()
+
+				subscription := broker.Subscribe(0, all)
+				subscription.Cancel()
+
+				Expect(subscription.Updates).To(BeClosed())
+			})
+		})
+	})
+
+	Describe("Close", func() {
+		It("should end every subscription", func() {
+			subscription := broker.Subscribe(0, all)
+
+			broker.Close()
+			subscription.Cancel()
+
+			Expect(subscription.Updates).To(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package inbox
+
+import (
+	"ev-warranty-go/internal/domain/entity"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// subscriberBuffer is how many notifications a subscriber may lag behind
+// before it misses some.
+const subscriberBuffer = 16
+
+// Hub hands new notifications to the subscribers of their user on this
+// instance. It never blocks publishers: a subscriber too slow to keep up
+// misses notifications, which are still in the inbox.
+type Hub interface {
+	Publish(notification *entity.Notification)
+	// Subscribe returns the notifications published for a user from now on,
+	// and a function to stop receiving them. The channel is closed when the
+	// subscription stops or the hub closes.
+	Subscribe(userID uuid.UUID) (<-chan *entity.Notification, func())
+	// Close ends every subscription, and those made afterwards right away.
+	Close()
+}
+
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan *entity.Notification]struct{}
+	closed      bool
+}
+
+func NewHub() Hub {
+	return &hub{subscribers: make(map[uuid.UUID]map[chan *entity.Notification]struct{})}
+}
+
+func (h *hub) Publish(notification *entity.Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[notification.UserID] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
+
+func (h *hub) Subscribe(userID uuid.UUID) (<-chan *entity.Notification, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan *entity.Notification, subscriberBuffer)
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan *entity.Notification]struct{})
+	}
+	h.subscribers[userID][ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() { h.unsubscribe(userID, ch) })
+	}
+}
+
+func (h *hub) unsubscribe(userID uuid.UUID, ch chan *entity.Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[userID][ch]; !ok {
+		return
+	}
+	delete(h.subscribers[userID], ch)
+	if len(h.subscribers[userID]) == 0 {
+		delete(h.subscribers, userID)
+	}
+	close(ch)
+}
+
+func (h *hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+	for _, channels := range h.subscribers {
+		for ch := range channels {
+			close(ch)
+		}
+	}
+	h.subscribers = make(map[uuid.UUID]map[chan *entity.Notification]struct{})
+}
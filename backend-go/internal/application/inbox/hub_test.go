@@ -0,0 +1,87 @@
+package inbox_test
+
+import (
+	"ev-warranty-go/internal/application/inbox"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Hub", func() {
+	var (
+		hub    inbox.Hub
+		userID uuid.UUID
+	)
+
+	newNotification := func(userID uuid.UUID) *entity.Notification {
+		return entity.NewNotification(userID, uuid.New(), entity.NotificationClaimApproved, uuid.New(), "Approved")
+	}
+
+	BeforeEach(func() {
+		hub = inbox.NewHub()
+		userID = uuid.New()
+	})
+
+	Describe("Publish", func() {
+		It("should deliver a notification to every subscriber of its user only", func() {
+			first, unsubscribeFirst := hub.Subscribe(userID)
+			defer unsubscribeFirst()
+			second, unsubscribeSecond := hub.Subscribe(userID)
+			defer unsubscribeSecond()
+			other, unsubscribeOther := hub.Subscribe(uuid.New())
+			defer unsubscribeOther()
+			notification := newNotification(userID)
+
+			hub.Publish(notification)
+
+			Expect(first).To(Receive(Equal(notification)))
+			Expect(second).To(Receive(Equal(notification)))
+			Expect(other).NotTo(Receive())
+		})
+
+		It("should drop notifications for a subscriber that does not keep up", func() {
+			notifications, unsubscribe := hub.Subscribe(userID)
+			defer unsubscribe()
+
+			for range 100 {
+				hub.Publish(newNotification(userID))
+			}
+
+			Expect(len(notifications)).To(Equal(cap(notifications)))
+		})
+	})
+
+	Describe("Subscribe", func() {
+		It("should close the channel when unsubscribed, once", func() {
+			notifications, unsubscribe := hub.Subscribe(userID)
+
+			unsubscribe()
+			unsubscribe()
+			hub.Publish(newNotification(userID))
+
+			Eventually(notifications).Should(BeClosed())
+		})
+
+		It("should return a closed channel once the hub is closed", func() {
+			hub.Close()
+
+			notifications, unsubscribe := hub.Subscribe(userID)
+			defer unsubscribe()
+
+			Expect(notifications).To(BeClosed())
+		})
+	})
+
+	Describe("Close", func() {
+		It("should end every subscription", func() {
+			notifications, unsubscribe := hub.Subscribe(userID)
+
+			hub.Close()
+			unsubscribe()
+
+			Expect(notifications).To(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,157 @@
+package outbox
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/logger"
+	"fmt"
+	"time"
+)
+
+// dispatchClaimTTL is how long a claimed batch stays hidden from other runs.
+// It outlasts a batch of sends timing out one after the other, a run taking
+// longer may see its rows claimed again and sent twice.
+const dispatchClaimTTL = 15 * time.Minute
+
+type Config struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+}
+
+// Dispatcher publishes pending outbox events to its sinks. An event is only
+// marked delivered once every sink accepted it, a failure on any sink
+// schedules a retry of the whole event with exponential backoff.
+type Dispatcher interface {
+	// Run polls for due events until ctx is done.
+	Run(ctx context.Context)
+	// DispatchPending publishes one batch of due events and returns how many
+	// were processed, delivered or not.
+	DispatchPending(ctx context.Context) (int, error)
+}
+
+type dispatcher struct {
+	log        logger.Logger
+	txManager  application.TxManager
+	outboxRepo repository.OutboxEventRepository
+	sinks      []Sink
+	cfg        Config
+}
+
+func NewDispatcher(log logger.Logger, txManager application.TxManager, outboxRepo repository.OutboxEventRepository,
+	sinks []Sink, cfg Config,
+) Dispatcher {
+	return &dispatcher{
+		log:        log,
+		txManager:  txManager,
+		outboxRepo: outboxRepo,
+		sinks:      sinks,
+		cfg:        cfg,
+	}
+}
+
+func (d *dispatcher) Run(ctx context.Context) {
+	poll(ctx, d.log, "[Outbox] Failed to dispatch events", d.cfg, d.DispatchPending)
+}
+
+func (d *dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	var events []*entity.OutboxEvent
+	err := d.txManager.Do(ctx, func(tx application.Tx) error {
+		now := time.Now()
+		var err error
+		events, err = d.outboxRepo.FindDue(tx, now, d.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			event.ClaimDispatch(now.Add(dispatchClaimTTL))
+			if err = d.outboxRepo.Update(tx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// The sinks are called once the claimed events are committed, so no row
+	// stays locked while a sink answers.
+	for _, event := range events {
+		d.deliver(ctx, event)
+	}
+	err = d.txManager.Do(ctx, func(tx application.Tx) error {
+		for _, event := range events {
+			if err := d.outboxRepo.Update(tx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+func (d *dispatcher) deliver(ctx context.Context, event *entity.OutboxEvent) {
+	for _, sink := range d.sinks {
+		if err := sink.Publish(ctx, event); err != nil {
+			err = fmt.Errorf("%s sink: %w", sink.Name(), err)
+			event.MarkFailed(err, time.Now().Add(backoff(d.cfg, event.Attempts+1)), d.cfg.MaxAttempts)
+			if event.Status == entity.OutboxStatusDead {
+				d.log.Error("[Outbox] Event moved to dead letter", "event_id", event.ID,
+					"event_type", event.EventType, "attempts", event.Attempts, "error", err)
+			} else {
+				d.log.Warn("[Outbox] Event delivery failed", "event_id", event.ID,
+					"event_type", event.EventType, "attempts", event.Attempts, "error", err)
+			}
+			return
+		}
+	}
+
+	event.MarkDelivered(time.Now())
+}
+
+// poll calls dispatchPending until ctx is done. It keeps draining while full
+// batches come back, then waits for the next tick.
+func poll(ctx context.Context, log logger.Logger, errMessage string, cfg Config,
+	dispatchPending func(ctx context.Context) (int, error),
+) {
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := dispatchPending(ctx)
+			if err != nil {
+				log.Error(errMessage, "error", err)
+				break
+			}
+			if processed < cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// backoff returns the delay before the given attempt, doubling from
+// BaseBackoff and capped at MaxBackoff.
+func backoff(cfg Config, attempt int) time.Duration {
+	delay := cfg.BaseBackoff
+	for i := 1; i < attempt && delay < cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, cfg.MaxBackoff)
+}
@@ -0,0 +1,198 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		mockLogger     *mocks.Logger
+		mockTxManager  *mocks.TxManager
+		mockTx         *mocks.Tx
+		mockOutboxRepo *mocks.OutboxEventRepository
+		mockSink       *mocks.Sink
+		events         chan *entity.OutboxEvent
+		cfg            outbox.Config
+		ctx            context.Context
+		event          *entity.OutboxEvent
+		inTx           bool
+	)
+
+	BeforeEach(func() {
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockOutboxRepo = mocks.NewOutboxEventRepository(GinkgoT())
+		mockSink = mocks.NewSink(GinkgoT())
+		events = make(chan *entity.OutboxEvent, 10)
+		cfg = outbox.Config{
+			PollInterval: time.Second,
+			BatchSize:    10,
+			MaxAttempts:  3,
+			BaseBackoff:  time.Second,
+			MaxBackoff:   3 * time.Second,
+		}
+		ctx = context.Background()
+		event = entity.NewOutboxEvent(entity.AggregateTypeClaim, uuid.New(), entity.EventClaimSubmitted,
+			[]byte(`{"actor_id":"00000000-0000-0000-0000-000000000000"}`))
+
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+		mockTxManager.EXPECT().Do(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				inTx = true
+				defer func() { inTx = false }()
+				return fn(mockTx)
+			}).Maybe()
+		mockSink.EXPECT().Name().Return("mock").Maybe()
+	})
+
+	Describe("DispatchPending", func() {
+		Context("when every sink accepts the event", func() {
+			It("should publish it and mark it delivered", func() {
+				dispatcher := outbox.NewDispatcher(mockLogger, mockTxManager, mockOutboxRepo,
+					[]outbox.Sink{outbox.NewChannelSink(events)}, cfg)
+
+				mockOutboxRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.OutboxEvent{event}, nil).Once()
+				mockOutboxRepo.EXPECT().Update(mockTx, event).Return(nil).Twice()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+				Expect(events).To(Receive(Equal(event)))
+				Expect(event.Status).To(Equal(entity.OutboxStatusDelivered))
+				Expect(event.DeliveredAt).NotTo(BeNil())
+			})
+		})
+
+		Context("when a sink fails", func() {
+			It("should schedule a retry with backoff", func() {
+				dispatcher := outbox.NewDispatcher(mockLogger, mockTxManager, mockOutboxRepo,
+					[]outbox.Sink{mockSink}, cfg)
+
+				mockOutboxRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.OutboxEvent{event}, nil).Once()
+				mockSink.EXPECT().Publish(ctx, event).Return(errors.New("connection refused")).Once()
+				mockLogger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+				mockOutboxRepo.EXPECT().Update(mockTx, event).Return(nil).Twice()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+				Expect(event.Status).To(Equal(entity.OutboxStatusPending))
+				Expect(event.Attempts).To(Equal(1))
+				Expect(*event.LastError).To(ContainSubstring("connection refused"))
+				Expect(event.NextAttemptAt).To(BeTemporally("~", time.Now().Add(cfg.BaseBackoff), 100*time.Millisecond))
+			})
+
+			It("should cap the backoff at the maximum", func() {
+				cfg.MaxAttempts = 10
+				dispatcher := outbox.NewDispatcher(mockLogger, mockTxManager, mockOutboxRepo,
+					[]outbox.Sink{mockSink}, cfg)
+				event.Attempts = 5
+
+				mockOutboxRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.OutboxEvent{event}, nil).Once()
+				mockSink.EXPECT().Publish(ctx, event).Return(errors.New("timeout")).Once()
+				mockLogger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+				mockOutboxRepo.EXPECT().Update(mockTx, event).Return(nil).Twice()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event.Status).To(Equal(entity.OutboxStatusPending))
+				Expect(event.NextAttemptAt).To(BeTemporally("~", time.Now().Add(cfg.MaxBackoff), 100*time.Millisecond))
+			})
+
+			It("should move the event to dead letter after the last attempt", func() {
+				dispatcher := outbox.NewDispatcher(mockLogger, mockTxManager, mockOutboxRepo,
+					[]outbox.Sink{outbox.NewChannelSink(events), mockSink}, cfg)
+				event.Attempts = cfg.MaxAttempts - 1
+
+				mockOutboxRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.OutboxEvent{event}, nil).Once()
+				mockSink.EXPECT().Publish(ctx, event).Return(errors.New("bad gateway")).Once()
+				mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+				mockOutboxRepo.EXPECT().Update(mockTx, event).Return(nil).Twice()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event.Status).To(Equal(entity.OutboxStatusDead))
+				Expect(event.Attempts).To(Equal(cfg.MaxAttempts))
+				Expect(event.DeliveredAt).To(BeNil())
+			})
+		})
+
+		Context("when no event is due", func() {
+			It("should process nothing", func() {
+				dispatcher := outbox.NewDispatcher(mockLogger, mockTxManager, mockOutboxRepo,
+					[]outbox.Sink{mockSink}, cfg)
+
+				mockOutboxRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return(nil, nil).Once()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(BeZero())
+			})
+		})
+
+		Context("when updating the event fails", func() {
+			It("should return the error", func() {
+				dispatcher := outbox.NewDispatcher(mockLogger, mockTxManager, mockOutboxRepo,
+					[]outbox.Sink{outbox.NewChannelSink(events)}, cfg)
+
+				mockOutboxRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.OutboxEvent{event}, nil).Once()
+				mockOutboxRepo.EXPECT().Update(mockTx, event).Return(apperror.ErrDBOperation).Once()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(processed).To(BeZero())
+				Expect(err).To(Equal(apperror.ErrDBOperation))
+				Expect(events).NotTo(Receive())
+			})
+		})
+
+		Context("when a sink is slow to answer", func() {
+			It("should publish once the claimed event is committed", func() {
+				dispatcher := outbox.NewDispatcher(mockLogger, mockTxManager, mockOutboxRepo,
+					[]outbox.Sink{mockSink}, cfg)
+
+				mockOutboxRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.OutboxEvent{event}, nil).Once()
+				mockOutboxRepo.EXPECT().Update(mockTx, event).Return(nil).Twice()
+				mockSink.EXPECT().Publish(ctx, event).
+					RunAndReturn(func(context.Context, *entity.OutboxEvent) error {
+						Expect(inTx).To(BeFalse())
+						Expect(event.NextAttemptAt).To(BeTemporally(">", time.Now().Add(time.Minute)))
+						return nil
+					}).Once()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event.Status).To(Equal(entity.OutboxStatusDelivered))
+			})
+		})
+	})
+})
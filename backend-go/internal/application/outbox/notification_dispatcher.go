@@ -0,0 +1,108 @@
+package outbox
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/logger"
+	"time"
+)
+
+// EmailSender hands a notification email over for delivery, returning an
+// error unless it was accepted.
+type EmailSender interface {
+	Send(ctx context.Context, email *entity.NotificationEmail) error
+}
+
+// NotificationDispatcher sends the pending emails queued by the notification
+// sink, retrying failed ones with exponential backoff until they are dead.
+type NotificationDispatcher interface {
+	// Run polls for due emails until ctx is done.
+	Run(ctx context.Context)
+	// DispatchPending sends one batch of due emails and returns how many were
+	// processed, sent or not.
+	DispatchPending(ctx context.Context) (int, error)
+}
+
+type notificationDispatcher struct {
+	log       logger.Logger
+	txManager application.TxManager
+	emailRepo repository.NotificationEmailRepository
+	sender    EmailSender
+	cfg       Config
+}
+
+func NewNotificationDispatcher(log logger.Logger, txManager application.TxManager,
+	emailRepo repository.NotificationEmailRepository, sender EmailSender, cfg Config,
+) NotificationDispatcher {
+	return &notificationDispatcher{
+		log:       log,
+		txManager: txManager,
+		emailRepo: emailRepo,
+		sender:    sender,
+		cfg:       cfg,
+	}
+}
+
+func (d *notificationDispatcher) Run(ctx context.Context) {
+	poll(ctx, d.log, "[Notification] Failed to dispatch emails", d.cfg, d.DispatchPending)
+}
+
+func (d *notificationDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	var emails []*entity.NotificationEmail
+	err := d.txManager.Do(ctx, func(tx application.Tx) error {
+		now := time.Now()
+		var err error
+		emails, err = d.emailRepo.FindDue(tx, now, d.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, email := range emails {
+			email.ClaimDispatch(now.Add(dispatchClaimTTL))
+			if err = d.emailRepo.Update(tx, email); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// The mail server is called once the claimed emails are committed, so no
+	// row stays locked while it answers.
+	for _, email := range emails {
+		d.send(ctx, email)
+	}
+	err = d.txManager.Do(ctx, func(tx application.Tx) error {
+		for _, email := range emails {
+			if err := d.emailRepo.Update(tx, email); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(emails), nil
+}
+
+func (d *notificationDispatcher) send(ctx context.Context, email *entity.NotificationEmail) {
+	err := d.sender.Send(ctx, email)
+	if err == nil {
+		email.MarkSent(time.Now())
+		return
+	}
+
+	email.MarkFailed(err, time.Now().Add(backoff(d.cfg, email.Attempts+1)), d.cfg.MaxAttempts)
+	if email.Status == entity.NotificationEmailStatusDead {
+		d.log.Error("[Notification] Email moved to dead letter", "email_id", email.ID, "kind", email.Kind,
+			"attempts", email.Attempts, "error", err)
+	} else {
+		d.log.Warn("[Notification] Email failed", "email_id", email.ID, "kind", email.Kind,
+			"attempts", email.Attempts, "error", err)
+	}
+}
@@ -0,0 +1,149 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("NotificationDispatcher", func() {
+	var (
+		mockLogger    *mocks.Logger
+		mockTxManager *mocks.TxManager
+		mockTx        *mocks.Tx
+		mockEmailRepo *mocks.NotificationEmailRepository
+		mockSender    *mocks.EmailSender
+		dispatcher    outbox.NotificationDispatcher
+		cfg           outbox.Config
+		ctx           context.Context
+		email         *entity.NotificationEmail
+		inTx          bool
+	)
+
+	BeforeEach(func() {
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockEmailRepo = mocks.NewNotificationEmailRepository(GinkgoT())
+		mockSender = mocks.NewEmailSender(GinkgoT())
+		cfg = outbox.Config{
+			PollInterval: time.Second,
+			BatchSize:    10,
+			MaxAttempts:  3,
+			BaseBackoff:  time.Second,
+			MaxBackoff:   3 * time.Second,
+		}
+		dispatcher = outbox.NewNotificationDispatcher(mockLogger, mockTxManager, mockEmailRepo, mockSender, cfg)
+		ctx = context.Background()
+		email = entity.NewNotificationEmail(uuid.New(), uuid.New(), entity.NotificationClaimApproved,
+			"staff@example.com", "Claim approved", "text", "<p>html</p>")
+
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+		mockTxManager.EXPECT().Do(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				inTx = true
+				defer func() { inTx = false }()
+				return fn(mockTx)
+			}).Maybe()
+	})
+
+	Describe("DispatchPending", func() {
+		Context("when the email is accepted", func() {
+			It("should mark it sent", func() {
+				mockEmailRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.NotificationEmail{email}, nil).Once()
+				mockSender.EXPECT().Send(ctx, email).Return(nil).Once()
+				mockEmailRepo.EXPECT().Update(mockTx, email).Return(nil).Twice()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+				Expect(email.Status).To(Equal(entity.NotificationEmailStatusSent))
+				Expect(email.Attempts).To(Equal(1))
+				Expect(email.SentAt).NotTo(BeNil())
+			})
+		})
+
+		Context("when the mail server refuses the email", func() {
+			It("should schedule a retry with backoff", func() {
+				email.Attempts = 1
+
+				mockEmailRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.NotificationEmail{email}, nil).Once()
+				mockSender.EXPECT().Send(ctx, email).Return(errors.New("451 try again later")).Once()
+				mockLogger.EXPECT().Warn("[Notification] Email failed", mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
+				mockEmailRepo.EXPECT().Update(mockTx, email).Return(nil).Twice()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(email.Status).To(Equal(entity.NotificationEmailStatusPending))
+				Expect(email.Attempts).To(Equal(2))
+				Expect(*email.LastError).To(Equal("451 try again later"))
+				Expect(email.NextAttemptAt).To(BeTemporally("~", time.Now().Add(2*time.Second), time.Second))
+			})
+		})
+
+		Context("when the last attempt fails", func() {
+			It("should move the email to the dead letter", func() {
+				email.Attempts = cfg.MaxAttempts - 1
+
+				mockEmailRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.NotificationEmail{email}, nil).Once()
+				mockSender.EXPECT().Send(ctx, email).Return(errors.New("connection refused")).Once()
+				mockLogger.EXPECT().Error("[Notification] Email moved to dead letter", mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return().Once()
+				mockEmailRepo.EXPECT().Update(mockTx, email).Return(nil).Twice()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(email.Status).To(Equal(entity.NotificationEmailStatusDead))
+			})
+		})
+
+		Context("when the mail server is slow to answer", func() {
+			It("should send once the claimed email is committed", func() {
+				mockEmailRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.NotificationEmail{email}, nil).Once()
+				mockEmailRepo.EXPECT().Update(mockTx, email).Return(nil).Twice()
+				mockSender.EXPECT().Send(ctx, email).
+					RunAndReturn(func(context.Context, *entity.NotificationEmail) error {
+						Expect(inTx).To(BeFalse())
+						Expect(email.NextAttemptAt).To(BeTemporally(">", time.Now().Add(time.Minute)))
+						return nil
+					}).Once()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(email.Status).To(Equal(entity.NotificationEmailStatusSent))
+			})
+		})
+
+		Context("when due emails cannot be loaded", func() {
+			It("should return the error", func() {
+				mockEmailRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return(nil, apperror.ErrDBOperation).Once()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(processed).To(BeZero())
+				Expect(err).To(MatchError(apperror.ErrDBOperation))
+			})
+		})
+	})
+})
@@ -0,0 +1,225 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/inbox"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// notificationKinds names the notification sent for each claim event, events
+// not listed here notify nobody. A claim is assigned to its technician when
+// it is created.
+var notificationKinds = map[string]string{
+	entity.EventClaimCreated:           entity.NotificationClaimAssigned,
+	entity.EventClaimSubmitted:         entity.NotificationClaimSubmitted,
+	entity.EventClaimApproved:          entity.NotificationClaimApproved,
+	entity.EventClaimPartiallyApproved: entity.NotificationClaimApproved,
+	entity.EventClaimRejected:          entity.NotificationClaimRejected,
+	entity.EventClaimCompleted:         entity.NotificationClaimCompleted,
+}
+
+// NotificationData is what the templates of a notification are rendered
+// with. ReasonCode and Note are only set for rejections.
+type NotificationData struct {
+	Kind       string
+	Recipient  *entity.User
+	Claim      *entity.Claim
+	ReasonCode string
+	Note       string
+}
+
+type EmailContent struct {
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// EmailRenderer renders the email of a notification for one recipient.
+type EmailRenderer interface {
+	Render(data *NotificationData) (*EmailContent, error)
+}
+
+// claimEventPayload is the part of a claim event payload notifications use.
+type claimEventPayload struct {
+	ActorID    uuid.UUID     `json:"actor_id"`
+	Claim      *entity.Claim `json:"claim"`
+	ReasonCode string        `json:"reason_code"`
+	Note       string        `json:"note"`
+}
+
+// recipient is a user to notify of a claim event, and whether by email too.
+type recipient struct {
+	user  *entity.User
+	email bool
+}
+
+type notificationSink struct {
+	txManager        application.TxManager
+	claimRepo        repository.ClaimRepository
+	userRepo         repository.UserRepository
+	preferenceRepo   repository.NotificationPreferenceRepository
+	emailRepo        repository.NotificationEmailRepository
+	notificationRepo repository.NotificationRepository
+	renderer         EmailRenderer
+	hub              inbox.Hub
+}
+
+// NewNotificationSink returns a sink that notifies every user concerned by a
+// claim event: the EVM reviewer pool when a claim is submitted, its
+// technician when it is assigned, and its staff and technician when it is
+// decided or completed. The user who caused the event, inactive users and
+// users who muted the notification are skipped. Each recipient gets an inbox
+// notification, handed to the hub once stored, and an email unless they
+// turned emails off. The NotificationDispatcher sends the queued emails.
+// Events of a claim deleted since notify nobody.
+func NewNotificationSink(txManager application.TxManager, claimRepo repository.ClaimRepository,
+	userRepo repository.UserRepository, preferenceRepo repository.NotificationPreferenceRepository, emailRepo repository.NotificationEmailRepository,
+	notificationRepo repository.NotificationRepository, renderer EmailRenderer, hub inbox.Hub,
+) Sink {
+	return &notificationSink{
+		txManager:        txManager,
+		claimRepo:        claimRepo,
+		userRepo:         userRepo,
+		preferenceRepo:   preferenceRepo,
+		emailRepo:        emailRepo,
+		notificationRepo: notificationRepo,
+		renderer:         renderer,
+		hub:              hub,
+	}
+}
+
+func (s *notificationSink) Name() string {
+	return "notifications"
+}
+
+func (s *notificationSink) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	kind, ok := notificationKinds[event.EventType]
+	if !ok || event.AggregateType != entity.AggregateTypeClaim {
+		return nil
+	}
+
+	var payload claimEventPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	if payload.Claim == nil {
+		return nil
+	}
+
+	recipients, err := s.findRecipients(ctx, kind, payload.Claim, payload.ActorID)
+	if err != nil || len(recipients) == 0 {
+		return err
+	}
+
+	notifications := make([]*entity.Notification, 0, len(recipients))
+	emails := make([]*entity.NotificationEmail, 0, len(recipients))
+	for _, r := range recipients {
+		content, err := s.renderer.Render(&NotificationData{
+			Kind:       kind,
+			Recipient:  r.user,
+			Claim:      payload.Claim,
+			ReasonCode: payload.ReasonCode,
+			Note:       payload.Note,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to render %s email: %w", kind, err)
+		}
+		notifications = append(notifications, entity.NewNotification(r.user.ID, event.ID, kind,
+			payload.Claim.ID, content.Subject))
+		if r.email {
+			emails = append(emails, entity.NewNotificationEmail(r.user.ID, event.ID, kind, r.user.Email,
+				content.Subject, content.TextBody, content.HTMLBody))
+		}
+	}
+
+	// Notifications reference their claim, storing them for a claim deleted
+	// since would fail on every retry and hold the event back from the sinks
+	// after this one.
+	if _, err = s.claimRepo.FindByID(ctx, payload.Claim.ID); err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) && appErr.ErrorCode == apperror.ErrNotFoundError.ErrorCode {
+			return nil
+		}
+		return err
+	}
+
+	err = s.txManager.Do(ctx, func(tx application.Tx) error {
+		for _, notification := range notifications {
+			if err := s.notificationRepo.Create(tx, notification); err != nil {
+				return err
+			}
+		}
+		for _, email := range emails {
+			if err := s.emailRepo.Create(tx, email); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, notification := range notifications {
+		s.hub.Publish(notification)
+	}
+	return nil
+}
+
+// findRecipients returns the users to notify of a claim event, the actor who
+// caused it excluded.
+func (s *notificationSink) findRecipients(ctx context.Context, kind string, claim *entity.Claim,
+	actorID uuid.UUID,
+) ([]recipient, error) {
+	var users []*entity.User
+	var err error
+	switch kind {
+	case entity.NotificationClaimSubmitted:
+		users, err = s.userRepo.FindActiveByRole(ctx, entity.UserRoleEvmStaff)
+	case entity.NotificationClaimAssigned:
+		users, err = s.userRepo.FindByIDs(ctx, []uuid.UUID{claim.TechnicianID})
+	default:
+		users, err = s.userRepo.FindByIDs(ctx, []uuid.UUID{claim.StaffID, claim.TechnicianID})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	preferences, err := s.preferenceRepo.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	byUserID := make(map[uuid.UUID]*entity.NotificationPreference, len(preferences))
+	for _, preference := range preferences {
+		byUserID[preference.UserID] = preference
+	}
+
+	recipients := make([]recipient, 0, len(users))
+	for _, user := range users {
+		if user.ID == actorID || !user.IsActive {
+			continue
+		}
+		preference, ok := byUserID[user.ID]
+		if !ok {
+			recipients = append(recipients, recipient{user: user, email: true})
+		} else if preference.Wants(kind) {
+			recipients = append(recipients, recipient{user: user, email: preference.WantsEmail(kind)})
+		}
+	}
+	return recipients, nil
+}
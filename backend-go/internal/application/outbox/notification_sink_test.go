@@ -0,0 +1,276 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("NotificationSink", func() {
+	var (
+		mockTxManager      *mocks.TxManager
+		mockTx             *mocks.Tx
+		mockClaimRepo      *mocks.ClaimRepository
+		mockUserRepo       *mocks.UserRepository
+		mockPreferenceRepo *mocks.NotificationPreferenceRepository
+		mockEmailRepo      *mocks.NotificationEmailRepository
+		mockInboxRepo      *mocks.NotificationRepository
+		mockRenderer       *mocks.EmailRenderer
+		mockHub            *mocks.Hub
+		sink               outbox.Sink
+		ctx                context.Context
+		actorID            uuid.UUID
+		claim              *entity.Claim
+		staff              *entity.User
+		technician         *entity.User
+	)
+
+	newEvent := func(eventType string, data map[string]any) *entity.OutboxEvent {
+		payload, _ := json.Marshal(data)
+		return entity.NewOutboxEvent(entity.AggregateTypeClaim, claim.ID, eventType, payload)
+	}
+
+	rendered := func(subject string) *outbox.EmailContent {
+		return &outbox.EmailContent{Subject: subject, TextBody: "text", HTMLBody: "<p>html</p>"}
+	}
+
+	BeforeEach(func() {
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockClaimRepo = mocks.NewClaimRepository(GinkgoT())
+		mockUserRepo = mocks.NewUserRepository(GinkgoT())
+		mockPreferenceRepo = mocks.NewNotificationPreferenceRepository(GinkgoT())
+		mockEmailRepo = mocks.NewNotificationEmailRepository(GinkgoT())
+		mockInboxRepo = mocks.NewNotificationRepository(GinkgoT())
+		mockRenderer = mocks.NewEmailRenderer(GinkgoT())
+		mockHub = mocks.NewHub(GinkgoT())
+		sink = outbox.NewNotificationSink(mockTxManager, mockClaimRepo, mockUserRepo, mockPreferenceRepo,
+			mockEmailRepo, mockInboxRepo, mockRenderer, mockHub)
+		ctx = context.Background()
+		actorID = uuid.New()
+		staff = entity.NewUser("Staff", "staff@example.com", entity.UserRoleScStaff, "", true, uuid.New())
+		technician = entity.NewUser("Technician", "tech@example.com", entity.UserRoleScTechnician, "", true,
+			uuid.New())
+		claim = entity.NewClaim(uuid.New(), uuid.New(), 1200, "Battery failure", staff.ID, technician.ID, "VND")
+
+		mockTxManager.EXPECT().Do(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				return fn(mockTx)
+			}).Maybe()
+	})
+
+	Describe("Publish", func() {
+		Context("when a claim is rejected", func() {
+			It("should notify every user of the claim with the rejection reason", func() {
+				claim.Status = entity.ClaimStatusRejected
+				event := newEvent(entity.EventClaimRejected, map[string]any{
+					"actor_id": actorID, "claim": claim, "reason_code": "NOT_COVERED", "note": "Out of policy",
+				})
+
+				mockUserRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{staff.ID, technician.ID}).
+					Return([]*entity.User{staff, technician}, nil).Once()
+				mockPreferenceRepo.EXPECT().FindByUserIDs(ctx, []uuid.UUID{staff.ID, technician.ID}).
+					Return(nil, nil).Once()
+				mockRenderer.EXPECT().Render(mock.MatchedBy(func(data *outbox.NotificationData) bool {
+					return data.Kind == entity.NotificationClaimRejected && data.Claim.ID == claim.ID &&
+						data.ReasonCode == "NOT_COVERED" && data.Note == "Out of policy"
+				})).RunAndReturn(func(data *outbox.NotificationData) (*outbox.EmailContent, error) {
+					return rendered("Rejected for " + data.Recipient.Name), nil
+				}).Twice()
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claim.ID).Return(claim, nil).Once()
+				var notifications []*entity.Notification
+				mockInboxRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.Notification")).
+					Run(func(_ application.Tx, notification *entity.Notification) {
+						notifications = append(notifications, notification)
+					}).Return(nil).Twice()
+				var emails []*entity.NotificationEmail
+				mockEmailRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.NotificationEmail")).
+					Run(func(_ application.Tx, email *entity.NotificationEmail) {
+						emails = append(emails, email)
+					}).Return(nil).Twice()
+				var published []*entity.Notification
+				mockHub.EXPECT().Publish(mock.AnythingOfType("*entity.Notification")).
+					Run(func(notification *entity.Notification) {
+						published = append(published, notification)
+					}).Twice()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(notifications).To(HaveLen(2))
+				Expect(notifications[0].UserID).To(Equal(staff.ID))
+				Expect(notifications[0].ClaimID).To(Equal(claim.ID))
+				Expect(notifications[0].Title).To(Equal("Rejected for Staff"))
+				Expect(notifications[0].ReadAt).To(BeNil())
+				Expect(published).To(Equal(notifications))
+				Expect(emails).To(HaveLen(2))
+				Expect(emails[0].UserID).To(Equal(staff.ID))
+				Expect(emails[0].Recipient).To(Equal("staff@example.com"))
+				Expect(emails[0].Subject).To(Equal("Rejected for Staff"))
+				Expect(emails[0].EventID).To(Equal(event.ID))
+				Expect(emails[0].Status).To(Equal(entity.NotificationEmailStatusPending))
+				Expect(emails[1].Recipient).To(Equal("tech@example.com"))
+			})
+		})
+
+		Context("when a claim is submitted", func() {
+			It("should notify the active EVM staff, except who submitted it and who muted it", func() {
+				reviewer := entity.NewUser("Reviewer", "reviewer@example.com", entity.UserRoleEvmStaff, "", true,
+					uuid.New())
+				muted := entity.NewUser("Muted", "muted@example.com", entity.UserRoleEvmStaff, "", true, uuid.New())
+				actor := entity.NewUser("Actor", "actor@example.com", entity.UserRoleEvmStaff, "", true, uuid.New())
+				preference := entity.NewNotificationPreference(muted.ID)
+				preference.MutedKinds = []string{entity.NotificationClaimSubmitted}
+				event := newEvent(entity.EventClaimSubmitted, map[string]any{"actor_id": actor.ID, "claim": claim})
+
+				mockUserRepo.EXPECT().FindActiveByRole(ctx, entity.UserRoleEvmStaff).
+					Return([]*entity.User{reviewer, muted, actor}, nil).Once()
+				mockPreferenceRepo.EXPECT().FindByUserIDs(ctx, []uuid.UUID{reviewer.ID, muted.ID, actor.ID}).
+					Return([]*entity.NotificationPreference{preference}, nil).Once()
+				mockRenderer.EXPECT().Render(mock.MatchedBy(func(data *outbox.NotificationData) bool {
+					return data.Kind == entity.NotificationClaimSubmitted && data.Recipient.ID == reviewer.ID
+				})).Return(rendered("Submitted"), nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claim.ID).Return(claim, nil).Once()
+				mockInboxRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(notification *entity.Notification) bool {
+					return notification.UserID == reviewer.ID &&
+						notification.Kind == entity.NotificationClaimSubmitted
+				})).Return(nil).Once()
+				mockEmailRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(email *entity.NotificationEmail) bool {
+					return email.UserID == reviewer.ID && email.Kind == entity.NotificationClaimSubmitted
+				})).Return(nil).Once()
+				mockHub.EXPECT().Publish(mock.AnythingOfType("*entity.Notification")).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when a user turned emails off", func() {
+			It("should only add the notification to their inbox", func() {
+				preference := entity.NewNotificationPreference(staff.ID)
+				preference.EmailEnabled = false
+				event := newEvent(entity.EventClaimApproved, map[string]any{"actor_id": actorID, "claim": claim})
+
+				mockUserRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{staff.ID, technician.ID}).
+					Return([]*entity.User{staff}, nil).Once()
+				mockPreferenceRepo.EXPECT().FindByUserIDs(ctx, []uuid.UUID{staff.ID}).
+					Return([]*entity.NotificationPreference{preference}, nil).Once()
+				mockRenderer.EXPECT().Render(mock.Anything).Return(rendered("Approved"), nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claim.ID).Return(claim, nil).Once()
+				mockInboxRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(notification *entity.Notification) bool {
+					return notification.UserID == staff.ID && notification.Title == "Approved"
+				})).Return(nil).Once()
+				mockHub.EXPECT().Publish(mock.AnythingOfType("*entity.Notification")).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+				mockEmailRepo.AssertNotCalled(GinkgoT(), "Create", mock.Anything, mock.Anything)
+			})
+		})
+
+		Context("when the notifications cannot be stored", func() {
+			It("should return the error without publishing them", func() {
+				event := newEvent(entity.EventClaimCompleted, map[string]any{"actor_id": actorID, "claim": claim})
+
+				mockUserRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{staff.ID, technician.ID}).
+					Return([]*entity.User{staff}, nil).Once()
+				mockPreferenceRepo.EXPECT().FindByUserIDs(ctx, []uuid.UUID{staff.ID}).Return(nil, nil).Once()
+				mockRenderer.EXPECT().Render(mock.Anything).Return(rendered("Completed"), nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claim.ID).Return(claim, nil).Once()
+				mockInboxRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.Notification")).
+					Return(apperror.ErrDBOperation).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).To(MatchError(apperror.ErrDBOperation))
+				mockHub.AssertNotCalled(GinkgoT(), "Publish", mock.Anything)
+			})
+		})
+
+		Context("when the claim was deleted since", func() {
+			It("should notify nobody so the later sinks still get the event", func() {
+				event := newEvent(entity.EventClaimCreated, map[string]any{"actor_id": staff.ID, "claim": claim})
+
+				mockUserRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{technician.ID}).
+					Return([]*entity.User{technician}, nil).Once()
+				mockPreferenceRepo.EXPECT().FindByUserIDs(ctx, []uuid.UUID{technician.ID}).Return(nil, nil).Once()
+				mockRenderer.EXPECT().Render(mock.Anything).Return(rendered("Assigned"), nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claim.ID).
+					Return(nil, apperror.ErrNotFoundError.WithMessage("Claim not found")).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+				mockTxManager.AssertNotCalled(GinkgoT(), "Do", mock.Anything, mock.Anything)
+				mockHub.AssertNotCalled(GinkgoT(), "Publish", mock.Anything)
+			})
+		})
+
+		Context("when a claim is created", func() {
+			It("should notify its technician of the assignment unless they are inactive", func() {
+				technician.IsActive = false
+				event := newEvent(entity.EventClaimCreated, map[string]any{"actor_id": staff.ID, "claim": claim})
+
+				mockUserRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{technician.ID}).
+					Return([]*entity.User{technician}, nil).Once()
+				mockPreferenceRepo.EXPECT().FindByUserIDs(ctx, []uuid.UUID{technician.ID}).Return(nil, nil).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+				mockTxManager.AssertNotCalled(GinkgoT(), "Do", mock.Anything, mock.Anything)
+			})
+		})
+
+		Context("when the event notifies nobody", func() {
+			It("should ignore it", func() {
+				event := newEvent(entity.EventClaimItemAdded, map[string]any{"actor_id": actorID, "claim": claim})
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when an email cannot be rendered", func() {
+			It("should return the error so the event is retried", func() {
+				event := newEvent(entity.EventClaimCompleted, map[string]any{"actor_id": actorID, "claim": claim})
+
+				mockUserRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{staff.ID, technician.ID}).
+					Return([]*entity.User{staff}, nil).Once()
+				mockPreferenceRepo.EXPECT().FindByUserIDs(ctx, []uuid.UUID{staff.ID}).Return(nil, nil).Once()
+				mockRenderer.EXPECT().Render(mock.Anything).Return(nil, errors.New("template error")).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).To(MatchError(ContainSubstring("template error")))
+			})
+		})
+
+		Context("when the recipients cannot be loaded", func() {
+			It("should return the error so the event is retried", func() {
+				event := newEvent(entity.EventClaimApproved, map[string]any{"actor_id": actorID, "claim": claim})
+
+				mockUserRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{staff.ID, technician.ID}).
+					Return(nil, apperror.ErrDBOperation).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).To(MatchError(apperror.ErrDBOperation))
+			})
+		})
+	})
+})
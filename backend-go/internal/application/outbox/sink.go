@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+)
+
+// Sink delivers outbox events outside the transaction that produced them.
+// Delivery is at-least-once, so a sink may receive the same event again after
+// a failure and consumers should deduplicate on the event ID.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, event *entity.OutboxEvent) error
+}
+
+type channelSink struct {
+	events chan<- *entity.OutboxEvent
+}
+
+// NewChannelSink returns a sink that hands events to an in-process channel,
+// blocking until the event is received or ctx is done.
+func NewChannelSink(events chan<- *entity.OutboxEvent) Sink {
+	return &channelSink{events: events}
+}
+
+func (s *channelSink) Name() string {
+	return "channel"
+}
+
+func (s *channelSink) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
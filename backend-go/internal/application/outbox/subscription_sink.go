@@ -0,0 +1,63 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"fmt"
+)
+
+type subscriptionSink struct {
+	txManager        application.TxManager
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+}
+
+// NewSubscriptionSink returns a sink that fans claim events out to the webhook
+// subscriptions of the office handling the claim. It only queues one delivery
+// per matching subscription, the WebhookDispatcher sends them. Events of a
+// claim that no longer exists match no subscription.
+func NewSubscriptionSink(txManager application.TxManager, subscriptionRepo repository.WebhookSubscriptionRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+) Sink {
+	return &subscriptionSink{
+		txManager:        txManager,
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+	}
+}
+
+func (s *subscriptionSink) Name() string {
+	return "subscriptions"
+}
+
+func (s *subscriptionSink) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	if event.AggregateType != entity.AggregateTypeClaim {
+		return nil
+	}
+
+	subscriptions, err := s.subscriptionRepo.FindMatching(ctx, event.AggregateID, event.EventType)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return s.txManager.Do(ctx, func(tx application.Tx) error {
+		for _, subscription := range subscriptions {
+			delivery := entity.NewWebhookDelivery(subscription.ID, event.ID, event.EventType, payload)
+			if err := s.deliveryRepo.Create(tx, delivery); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,94 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("SubscriptionSink", func() {
+	var (
+		mockTxManager        *mocks.TxManager
+		mockTx               *mocks.Tx
+		mockSubscriptionRepo *mocks.WebhookSubscriptionRepository
+		mockDeliveryRepo     *mocks.WebhookDeliveryRepository
+		sink                 outbox.Sink
+		ctx                  context.Context
+		event                *entity.OutboxEvent
+	)
+
+	BeforeEach(func() {
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockSubscriptionRepo = mocks.NewWebhookSubscriptionRepository(GinkgoT())
+		mockDeliveryRepo = mocks.NewWebhookDeliveryRepository(GinkgoT())
+		sink = outbox.NewSubscriptionSink(mockTxManager, mockSubscriptionRepo, mockDeliveryRepo)
+		ctx = context.Background()
+		event = entity.NewOutboxEvent(entity.AggregateTypeClaim, uuid.New(), entity.EventClaimApproved,
+			[]byte(`{"actor_id":"00000000-0000-0000-0000-000000000000"}`))
+
+		mockTxManager.EXPECT().Do(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				return fn(mockTx)
+			}).Maybe()
+	})
+
+	Describe("Publish", func() {
+		Context("when subscriptions listen to the event", func() {
+			It("should queue one delivery per subscription with the event as payload", func() {
+				first := entity.NewWebhookSubscription(uuid.New(), "https://a.example.com", nil, "a", true)
+				second := entity.NewWebhookSubscription(uuid.New(), "https://b.example.com", nil, "b", true)
+				mockSubscriptionRepo.EXPECT().FindMatching(ctx, event.AggregateID, event.EventType).
+					Return([]*entity.WebhookSubscription{first, second}, nil).Once()
+
+				var deliveries []*entity.WebhookDelivery
+				mockDeliveryRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.WebhookDelivery")).
+					Run(func(_ application.Tx, delivery *entity.WebhookDelivery) {
+						deliveries = append(deliveries, delivery)
+					}).Return(nil).Twice()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(HaveLen(2))
+				Expect(deliveries[0].SubscriptionID).To(Equal(first.ID))
+				Expect(deliveries[1].SubscriptionID).To(Equal(second.ID))
+				expected, _ := json.Marshal(event)
+				Expect(deliveries[0].EventID).To(Equal(event.ID))
+				Expect(deliveries[0].Payload).To(MatchJSON(expected))
+			})
+		})
+
+		Context("when no subscription listens to the event", func() {
+			It("should not open a transaction", func() {
+				mockSubscriptionRepo.EXPECT().FindMatching(ctx, event.AggregateID, event.EventType).
+					Return(nil, nil).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+				mockTxManager.AssertNotCalled(GinkgoT(), "Do", mock.Anything, mock.Anything)
+			})
+		})
+
+		Context("when the subscriptions cannot be loaded", func() {
+			It("should return the error so the event is retried", func() {
+				mockSubscriptionRepo.EXPECT().FindMatching(ctx, event.AggregateID, event.EventType).
+					Return(nil, apperror.ErrDBOperation).Once()
+
+				err := sink.Publish(ctx, event)
+
+				Expect(err).To(MatchError(apperror.ErrDBOperation))
+			})
+		})
+	})
+})
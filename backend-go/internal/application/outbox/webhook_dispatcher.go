@@ -0,0 +1,111 @@
+package outbox
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/logger"
+	"time"
+)
+
+// WebhookSender posts a delivery to the URL of its subscription. It returns
+// the HTTP status code of the response, zero when none was received, and an
+// error unless the subscriber acknowledged the delivery.
+type WebhookSender interface {
+	Send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error)
+}
+
+// WebhookDispatcher sends the pending deliveries queued by the subscription
+// sink, retrying failed ones with exponential backoff until they are dead.
+type WebhookDispatcher interface {
+	// Run polls for due deliveries until ctx is done.
+	Run(ctx context.Context)
+	// DispatchPending sends one batch of due deliveries and returns how many
+	// were processed, delivered or not.
+	DispatchPending(ctx context.Context) (int, error)
+}
+
+type webhookDispatcher struct {
+	log          logger.Logger
+	txManager    application.TxManager
+	deliveryRepo repository.WebhookDeliveryRepository
+	sender       WebhookSender
+	cfg          Config
+}
+
+func NewWebhookDispatcher(log logger.Logger, txManager application.TxManager,
+	deliveryRepo repository.WebhookDeliveryRepository, sender WebhookSender, cfg Config,
+) WebhookDispatcher {
+	return &webhookDispatcher{
+		log:          log,
+		txManager:    txManager,
+		deliveryRepo: deliveryRepo,
+		sender:       sender,
+		cfg:          cfg,
+	}
+}
+
+func (d *webhookDispatcher) Run(ctx context.Context) {
+	poll(ctx, d.log, "[Webhook] Failed to dispatch deliveries", d.cfg, d.DispatchPending)
+}
+
+func (d *webhookDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	var deliveries []*entity.WebhookDelivery
+	err := d.txManager.Do(ctx, func(tx application.Tx) error {
+		now := time.Now()
+		var err error
+		deliveries, err = d.deliveryRepo.FindDue(tx, now, d.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, delivery := range deliveries {
+			delivery.ClaimDispatch(now.Add(dispatchClaimTTL))
+			if err = d.deliveryRepo.Update(tx, delivery); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// The subscribers are called once the claimed deliveries are committed, so
+	// a slow endpoint holds no row lock or connection.
+	for _, delivery := range deliveries {
+		d.deliver(ctx, delivery)
+	}
+	err = d.txManager.Do(ctx, func(tx application.Tx) error {
+		for _, delivery := range deliveries {
+			if err := d.deliveryRepo.Update(tx, delivery); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(deliveries), nil
+}
+
+func (d *webhookDispatcher) deliver(ctx context.Context, delivery *entity.WebhookDelivery) {
+	statusCode, err := d.sender.Send(ctx, delivery)
+	if err == nil {
+		delivery.MarkDelivered(time.Now(), statusCode)
+		return
+	}
+
+	delivery.MarkFailed(err, statusCode, time.Now().Add(backoff(d.cfg, delivery.Attempts+1)), d.cfg.MaxAttempts)
+	if delivery.Status == entity.WebhookDeliveryStatusDead {
+		d.log.Error("[Webhook] Delivery moved to dead letter", "delivery_id", delivery.ID,
+			"subscription_id", delivery.SubscriptionID, "event_type", delivery.EventType,
+			"attempts", delivery.Attempts, "error", err)
+	} else {
+		d.log.Warn("[Webhook] Delivery failed", "delivery_id", delivery.ID,
+			"subscription_id", delivery.SubscriptionID, "event_type", delivery.EventType,
+			"attempts", delivery.Attempts, "error", err)
+	}
+}
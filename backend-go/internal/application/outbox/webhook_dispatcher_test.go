@@ -0,0 +1,160 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("WebhookDispatcher", func() {
+	var (
+		mockLogger       *mocks.Logger
+		mockTxManager    *mocks.TxManager
+		mockTx           *mocks.Tx
+		mockDeliveryRepo *mocks.WebhookDeliveryRepository
+		mockSender       *mocks.WebhookSender
+		cfg              outbox.Config
+		ctx              context.Context
+		delivery         *entity.WebhookDelivery
+		inTx             bool
+	)
+
+	BeforeEach(func() {
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockDeliveryRepo = mocks.NewWebhookDeliveryRepository(GinkgoT())
+		mockSender = mocks.NewWebhookSender(GinkgoT())
+		cfg = outbox.Config{
+			PollInterval: time.Second,
+			BatchSize:    10,
+			MaxAttempts:  3,
+			BaseBackoff:  time.Second,
+			MaxBackoff:   3 * time.Second,
+		}
+		ctx = context.Background()
+		delivery = entity.NewWebhookDelivery(uuid.New(), uuid.New(), entity.EventClaimApproved,
+			[]byte(`{"event_type":"ClaimApproved"}`))
+
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+		mockTxManager.EXPECT().Do(ctx, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				inTx = true
+				defer func() { inTx = false }()
+				return fn(mockTx)
+			}).Maybe()
+	})
+
+	Describe("DispatchPending", func() {
+		Context("when the subscriber acknowledges the delivery", func() {
+			It("should mark it delivered with the response status", func() {
+				dispatcher := outbox.NewWebhookDispatcher(mockLogger, mockTxManager, mockDeliveryRepo, mockSender, cfg)
+
+				mockDeliveryRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.WebhookDelivery{delivery}, nil).Once()
+				mockSender.EXPECT().Send(ctx, delivery).Return(204, nil).Once()
+				mockDeliveryRepo.EXPECT().Update(mockTx, delivery).Return(nil).Twice()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+				Expect(delivery.Status).To(Equal(entity.WebhookDeliveryStatusDelivered))
+				Expect(delivery.Attempts).To(Equal(1))
+				Expect(*delivery.ResponseStatus).To(Equal(204))
+				Expect(delivery.DeliveredAt).NotTo(BeNil())
+			})
+		})
+
+		Context("when the subscriber rejects the delivery", func() {
+			It("should schedule a retry with backoff", func() {
+				dispatcher := outbox.NewWebhookDispatcher(mockLogger, mockTxManager, mockDeliveryRepo, mockSender, cfg)
+				delivery.Attempts = 1
+
+				mockDeliveryRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.WebhookDelivery{delivery}, nil).Once()
+				mockSender.EXPECT().Send(ctx, delivery).
+					Return(500, errors.New("unexpected status code 500")).Once()
+				mockLogger.EXPECT().Warn("[Webhook] Delivery failed", mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything).Return().Once()
+				mockDeliveryRepo.EXPECT().Update(mockTx, delivery).Return(nil).Twice()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(delivery.Status).To(Equal(entity.WebhookDeliveryStatusPending))
+				Expect(delivery.Attempts).To(Equal(2))
+				Expect(*delivery.ResponseStatus).To(Equal(500))
+				Expect(*delivery.LastError).To(Equal("unexpected status code 500"))
+				Expect(delivery.NextAttemptAt).To(BeTemporally("~", time.Now().Add(2*time.Second), time.Second))
+			})
+		})
+
+		Context("when the subscriber cannot be reached on the last attempt", func() {
+			It("should move the delivery to the dead letter", func() {
+				dispatcher := outbox.NewWebhookDispatcher(mockLogger, mockTxManager, mockDeliveryRepo, mockSender, cfg)
+				delivery.Attempts = cfg.MaxAttempts - 1
+
+				mockDeliveryRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.WebhookDelivery{delivery}, nil).Once()
+				mockSender.EXPECT().Send(ctx, delivery).Return(0, errors.New("connection refused")).Once()
+				mockLogger.EXPECT().Error("[Webhook] Delivery moved to dead letter", mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything).Return().Once()
+				mockDeliveryRepo.EXPECT().Update(mockTx, delivery).Return(nil).Twice()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(delivery.Status).To(Equal(entity.WebhookDeliveryStatusDead))
+				Expect(delivery.ResponseStatus).To(BeNil())
+			})
+		})
+
+		Context("when the subscriber is slow to answer", func() {
+			It("should send once the claimed delivery is committed", func() {
+				dispatcher := outbox.NewWebhookDispatcher(mockLogger, mockTxManager, mockDeliveryRepo, mockSender, cfg)
+
+				mockDeliveryRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.WebhookDelivery{delivery}, nil).Once()
+				mockDeliveryRepo.EXPECT().Update(mockTx, delivery).Return(nil).Twice()
+				mockSender.EXPECT().Send(ctx, delivery).
+					RunAndReturn(func(context.Context, *entity.WebhookDelivery) (int, error) {
+						Expect(inTx).To(BeFalse())
+						Expect(delivery.NextAttemptAt).To(BeTemporally(">", time.Now().Add(time.Minute)))
+						return 200, nil
+					}).Once()
+
+				_, err := dispatcher.DispatchPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(delivery.Status).To(Equal(entity.WebhookDeliveryStatusDelivered))
+			})
+		})
+
+		Context("when due deliveries cannot be loaded", func() {
+			It("should return the error", func() {
+				dispatcher := outbox.NewWebhookDispatcher(mockLogger, mockTxManager, mockDeliveryRepo, mockSender, cfg)
+
+				mockDeliveryRepo.EXPECT().FindDue(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return(nil, apperror.ErrDBOperation).Once()
+
+				processed, err := dispatcher.DispatchPending(ctx)
+
+				Expect(processed).To(BeZero())
+				Expect(err).To(MatchError(apperror.ErrDBOperation))
+			})
+		})
+	})
+})
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type BusinessCalendarRepository interface {
+	// FindByOfficeID returns the calendar of an office, or nil when it has
+	// none.
+	FindByOfficeID(ctx context.Context, officeID uuid.UUID) (*entity.BusinessCalendar, error)
+	// Save creates the calendar of its office or replaces the existing one.
+	Save(ctx context.Context, calendar *entity.BusinessCalendar) error
+}
@@ -4,12 +4,19 @@ import (
 	"context"
 	"ev-warranty-go/internal/application"
 	"ev-warranty-go/internal/domain/entity"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type ClaimAttachmentRepository interface {
 	Create(tx application.Tx, attachment *entity.ClaimAttachment) error
+	// Update saves the processing results of attachment, it fails with
+	// ErrNotFoundError once the attachment is deleted.
+	Update(tx application.Tx, attachment *entity.ClaimAttachment) error
+	// UpdateStorageKeys saves the keys of the files of attachment, deleted or
+	// not.
+	UpdateStorageKeys(tx application.Tx, attachment *entity.ClaimAttachment) error
 	HardDelete(tx application.Tx, id uuid.UUID) error
 	SoftDeleteByClaimID(tx application.Tx, id uuid.UUID) error
 
@@ -17,4 +24,16 @@ type ClaimAttachmentRepository interface {
 	FindByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAttachment, error)
 	CountByClaimID(ctx context.Context, claimID uuid.UUID) (int64, error)
 	FindByType(ctx context.Context, claimID uuid.UUID, attachmentType string) ([]*entity.ClaimAttachment, error)
+	FindByClaimItemID(ctx context.Context, claimID, claimItemID uuid.UUID) ([]*entity.ClaimAttachment, error)
+	// ExistsByChecksum reports whether the claim already has an attachment
+	// with checksum, including those created earlier in tx.
+	ExistsByChecksum(tx application.Tx, claimID uuid.UUID, checksum string) (bool, error)
+	// FindPendingProcessing locks up to limit attachments waiting to be
+	// processed and not claimed by another run at now, oldest first, skipping
+	// those locked by another transaction.
+	FindPendingProcessing(tx application.Tx, now time.Time, limit int) ([]*entity.ClaimAttachment, error)
+	// FindPublicUploads returns up to limit attachments, deleted or not, with
+	// an ID after afterID whose file is still a public Cloudinary upload, keyed
+	// <resource type>/upload/<public ID>, ordered by ID.
+	FindPublicUploads(ctx context.Context, afterID uuid.UUID, limit int) ([]*entity.ClaimAttachment, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type ClaimAuditLogRepository interface {
+	Create(tx application.Tx, auditLog *entity.ClaimAuditLog) error
+
+	FindByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAuditLog, error)
+}
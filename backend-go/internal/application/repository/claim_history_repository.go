@@ -16,4 +16,24 @@ type ClaimHistoryRepository interface {
 	FindByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimHistory, error)
 	FindLatestByClaimID(ctx context.Context, claimID uuid.UUID) (*entity.ClaimHistory, error)
 	FindByDateRange(ctx context.Context, claimID uuid.UUID, startDate, endDate time.Time) ([]*entity.ClaimHistory, error)
+	CountRejectionReasons(ctx context.Context, filters RejectionReasonFilters) ([]*ReasonCodeCount, error)
+}
+
+const (
+	DefaultRejectionReasonLimit = 10
+	MaxRejectionReasonLimit     = 50
+)
+
+// RejectionReasonFilters narrows the rejected and cancelled history entries
+// counted by CountRejectionReasons.
+type RejectionReasonFilters struct {
+	OfficeID *uuid.UUID
+	FromDate *time.Time
+	ToDate   *time.Time
+	Limit    int
+}
+
+type ReasonCodeCount struct {
+	ReasonCode string `json:"reason_code"`
+	Count      int64  `json:"count"`
 }
@@ -14,10 +14,16 @@ type ClaimItemRepository interface {
 	HardDelete(tx application.Tx, id uuid.UUID) error
 	SoftDeleteByClaimID(tx application.Tx, claimID uuid.UUID) error
 	UpdateStatus(tx application.Tx, id uuid.UUID, status string) error
-	SumCostByClaimID(tx application.Tx, claimID uuid.UUID) (float64, error)
+	// SumCostByClaimID totals the cost of the claim's approved items and
+	// SumRequestedCostByClaimID of all its items.
+	SumCostByClaimID(tx application.Tx, claimID uuid.UUID) (int64, error)
+	SumRequestedCostByClaimID(tx application.Tx, claimID uuid.UUID) (int64, error)
 
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.ClaimItem, error)
 	FindByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimItem, error)
 	CountByClaimID(ctx context.Context, claimID uuid.UUID) (int64, error)
 	FindByStatus(ctx context.Context, claimID uuid.UUID, status string) ([]*entity.ClaimItem, error)
+	// FindReplacementPartIDs returns the replacement parts held by claim
+	// items that are neither deleted nor rejected.
+	FindReplacementPartIDs(ctx context.Context) ([]uuid.UUID, error)
 }
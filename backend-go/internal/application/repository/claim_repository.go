@@ -17,18 +17,76 @@ type ClaimRepository interface {
 	SoftDelete(tx application.Tx, id uuid.UUID) error
 
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Claim, error)
-	FindAll(ctx context.Context) ([]*entity.Claim, error)
+	FindByIDInOffice(ctx context.Context, id, officeID uuid.UUID) (*entity.Claim, error)
+	FindAll(ctx context.Context, filters ClaimFilters, pagination Pagination) ([]*entity.Claim, int64, error)
 	CountPendingByTechnician(ctx context.Context, id uuid.UUID) (int64, error)
 	FindByCustomerID(ctx context.Context, customerID uuid.UUID) ([]*entity.Claim, error)
 	FindByVehicleID(ctx context.Context, vehicleID uuid.UUID) ([]*entity.Claim, error)
+	// Export calls fn for every export row of the claims matching filters,
+	// newest claim first, reading them from the database as it goes.
+	Export(ctx context.Context, filters ClaimFilters, fn func(row *ClaimExportRow) error) error
 }
 
+const (
+	DefaultPage     = 1
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+
+	SortDirAsc  = "asc"
+	SortDirDesc = "desc"
+
+	ClaimSortByCreatedAt      = "created_at"
+	ClaimSortByUpdatedAt      = "updated_at"
+	ClaimSortByStatus         = "status"
+	ClaimSortByKilometers     = "kilometers"
+	ClaimSortByRequestedTotal = "requested_total"
+	ClaimSortByApprovedTotal  = "approved_total"
+)
+
 type ClaimFilters struct {
-	CustomerID *uuid.UUID
-	VehicleID  *uuid.UUID
-	Status     *string
-	FromDate   *time.Time
-	ToDate     *time.Time
+	CustomerID   *uuid.UUID
+	VehicleID    *uuid.UUID
+	TechnicianID *uuid.UUID
+	StaffID      *uuid.UUID
+	OfficeID     *uuid.UUID
+	Status       *string
+	FromDate     *time.Time
+	ToDate       *time.Time
+	// SLAState keeps the claims whose current SLA is in that state, see
+	// entity.ClaimSLAStateOnTrack and entity.ClaimSLAStateBreached.
+	SLAState *string
+}
+
+// ClaimExportRow is a claim item flattened with its claim. A claim without
+// items has a single row whose item fields are nil. The status timestamps are
+// the last time the claim entered each status, nil when it never did.
+type ClaimExportRow struct {
+	ClaimID          uuid.UUID
+	CustomerID       uuid.UUID
+	VehicleID        uuid.UUID
+	Kilometers       int
+	Description      string
+	ClaimStatus      string
+	Currency         string
+	RequestedTotal   int64
+	ApprovedTotal    int64
+	ReimbursedTotal  int64
+	StaffID          uuid.UUID
+	TechnicianID     uuid.UUID
+	CreatedAt        time.Time
+	AttachmentCount  int
+	SubmittedAt      *time.Time
+	ReviewingAt      *time.Time
+	ApprovedAt       *time.Time
+	RejectedAt       *time.Time
+	CancelledAt      *time.Time
+	CompletedAt      *time.Time
+	ItemID           *uuid.UUID
+	PartCategoryID   *uuid.UUID
+	FaultyPartSerial *string
+	ItemType         *string
+	ItemStatus       *string
+	ItemCost         *int64
 }
 
 type Pagination struct {
@@ -37,3 +95,33 @@ type Pagination struct {
 	SortBy   string
 	SortDir  string
 }
+
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+func (p Pagination) TotalPages(total int64) int {
+	if p.PageSize <= 0 {
+		return 0
+	}
+	return int((total + int64(p.PageSize) - 1) / int64(p.PageSize))
+}
+
+func IsValidClaimSortField(field string) bool {
+	switch field {
+	case ClaimSortByCreatedAt, ClaimSortByUpdatedAt, ClaimSortByStatus, ClaimSortByKilometers, ClaimSortByRequestedTotal,
+		ClaimSortByApprovedTotal:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsValidSortDir(dir string) bool {
+	switch dir {
+	case SortDirAsc, SortDirDesc:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ClaimSLARepository interface {
+	Create(tx application.Tx, sla *entity.ClaimSLA) error
+	Update(tx application.Tx, sla *entity.ClaimSLA) error
+	// FindOpenByClaimID returns the SLA the claim is currently held to, or
+	// nil when its status has none.
+	FindOpenByClaimID(ctx context.Context, claimID uuid.UUID) (*entity.ClaimSLA, error)
+	FindOpenByClaimIDs(ctx context.Context, claimIDs []uuid.UUID) ([]*entity.ClaimSLA, error)
+
+	// FindDueBreaches locks up to limit open SLAs past their due time that
+	// are not recorded as breached yet, most overdue first. Rows locked
+	// elsewhere and SLAs of deleted claims are skipped.
+	FindDueBreaches(tx application.Tx, now time.Time, limit int) ([]*entity.ClaimSLA, error)
+}
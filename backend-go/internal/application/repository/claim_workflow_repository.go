@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+)
+
+type ClaimWorkflowRepository interface {
+	FindActive(ctx context.Context) (*entity.ClaimWorkflow, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type LaborOperationRepository interface {
+	Create(ctx context.Context, operation *entity.LaborOperation) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.LaborOperation, error)
+	// FindAll returns every operation, or only those usable on items of the
+	// given part category, including the ones linked to no category.
+	FindAll(ctx context.Context, partCategoryID *uuid.UUID) ([]*entity.LaborOperation, error)
+	Update(ctx context.Context, operation *entity.LaborOperation) error
+	SoftDelete(ctx context.Context, id uuid.UUID) error
+}
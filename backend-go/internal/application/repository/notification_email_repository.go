@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+	"time"
+)
+
+type NotificationEmailRepository interface {
+	// Create stores an email unless one already exists for the same user and
+	// event, so republishing an event is harmless.
+	Create(tx application.Tx, email *entity.NotificationEmail) error
+	Update(tx application.Tx, email *entity.NotificationEmail) error
+
+	// FindDue locks up to limit pending emails whose next attempt is due,
+	// oldest first. Rows locked by another dispatcher are skipped.
+	FindDue(tx application.Tx, now time.Time, limit int) ([]*entity.NotificationEmail, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type NotificationPreferenceRepository interface {
+	// FindByUserID returns nil without error when the user has no preference.
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.NotificationPreference, error)
+	// FindByUserIDs returns the preferences of those users that have one.
+	FindByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*entity.NotificationPreference, error)
+	// Save creates the preference of a user or replaces it.
+	Save(ctx context.Context, preference *entity.NotificationPreference) error
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	// Create stores a notification unless the user already has one for the
+	// same event, so republishing an event is harmless.
+	Create(tx application.Tx, notification *entity.Notification) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Notification, error)
+	// FindByUserID returns a page of the notifications of a user, newest
+	// first, and how many there are in total. Only unread ones are returned
+	// when unreadOnly is set. The sorting of pagination is ignored.
+	FindByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool, pagination Pagination,
+	) ([]*entity.Notification, int64, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	MarkRead(ctx context.Context, notification *entity.Notification) error
+	// MarkAllRead marks every unread notification of a user read at now and
+	// returns how many there were.
+	MarkAllRead(ctx context.Context, userID uuid.UUID, now time.Time) (int64, error)
+}
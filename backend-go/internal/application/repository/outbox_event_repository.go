@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+	"time"
+)
+
+type OutboxEventRepository interface {
+	Create(tx application.Tx, event *entity.OutboxEvent) error
+	Update(tx application.Tx, event *entity.OutboxEvent) error
+
+	// FindDue locks up to limit pending events whose next attempt is due, oldest
+	// first. Rows locked by another dispatcher are skipped.
+	FindDue(tx application.Tx, now time.Time, limit int) ([]*entity.OutboxEvent, error)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PartReservationRepository interface {
+	Create(tx application.Tx, reservation *entity.PartReservation) error
+	Update(tx application.Tx, reservation *entity.PartReservation) error
+	// FindActiveByPartID returns the reservation currently holding a part, or
+	// nil when the part was reserved before reservations were tracked.
+	FindActiveByPartID(ctx context.Context, partID uuid.UUID) (*entity.PartReservation, error)
+	// FindUnsettledPartIDs returns the parts of every reservation that is
+	// still pending, being released or whose release failed for good.
+	FindUnsettledPartIDs(ctx context.Context) ([]uuid.UUID, error)
+
+	// FindStalePending locks up to limit reservations still pending since
+	// before createdBefore, whose claim item was never committed.
+	FindStalePending(tx application.Tx, createdBefore time.Time, limit int) ([]*entity.PartReservation, error)
+	// FindDueReleases locks up to limit reservations being released whose
+	// next attempt is due, oldest first. Rows locked elsewhere are skipped.
+	FindDueReleases(tx application.Tx, now time.Time, limit int) ([]*entity.PartReservation, error)
+}
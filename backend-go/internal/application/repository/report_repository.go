@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportRepository aggregates claims for reporting. Claims are attributed to
+// the offices of their staff member and technician, the same offices claim
+// lists are scoped by, and every figure is computed by the database.
+type ReportRepository interface {
+	ClaimVolume(ctx context.Context, filters ReportFilters) ([]*ClaimVolume, error)
+	DecisionRates(ctx context.Context, filters ReportFilters) ([]*DecisionRate, error)
+	TimeInStatus(ctx context.Context, filters ReportFilters) ([]*StatusDuration, error)
+	PartFailures(ctx context.Context, filters ReportFilters) ([]*PartFailure, error)
+	OfficeCosts(ctx context.Context, filters ReportFilters) ([]*OfficeCost, error)
+}
+
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+
+	DefaultReportDays        = 30
+	DefaultPartFailuresLimit = 10
+	MaxPartFailuresLimit     = 100
+)
+
+// ReportFilters bounds a report to [FromDate, ToDate] and buckets it by
+// Granularity. Limit only applies to PartFailures.
+type ReportFilters struct {
+	OfficeID    *uuid.UUID
+	FromDate    time.Time
+	ToDate      time.Time
+	Granularity string
+	Limit       int
+}
+
+// ClaimVolume counts the claims created in a period per office and current
+// status.
+type ClaimVolume struct {
+	Period   time.Time `json:"period"`
+	OfficeID uuid.UUID `json:"office_id"`
+	Status   string    `json:"status"`
+	Count    int64     `json:"count"`
+}
+
+// DecisionRate counts the review decisions taken in a period per office.
+// Rates are percentages of Total.
+type DecisionRate struct {
+	Period              time.Time `json:"period"`
+	OfficeID            uuid.UUID `json:"office_id"`
+	Total               int64     `json:"total"`
+	Approved            int64     `json:"approved"`
+	PartiallyApproved   int64     `json:"partially_approved"`
+	Rejected            int64     `json:"rejected"`
+	ApprovalRate        float64   `json:"approval_rate"`
+	PartialApprovalRate float64   `json:"partial_approval_rate"`
+	RejectionRate       float64   `json:"rejection_rate"`
+}
+
+// StatusDuration is the average time claims that entered Status in a period
+// spent in it before their next status change. Claims still in the status
+// are not counted.
+type StatusDuration struct {
+	Period     time.Time `json:"period"`
+	Status     string    `json:"status"`
+	Count      int64     `json:"count"`
+	AvgSeconds float64   `json:"avg_seconds"`
+}
+
+// PartFailure counts the claim items of a part category created in the
+// report range.
+type PartFailure struct {
+	PartCategoryID uuid.UUID `json:"part_category_id"`
+	Items          int64     `json:"items"`
+	Claims         int64     `json:"claims"`
+	Currency       string    `json:"currency"`
+	TotalCost      int64     `json:"total_cost"`
+}
+
+// OfficeCost sums the totals of the claims created in a period per office,
+// in minor units of Currency.
+type OfficeCost struct {
+	Period          time.Time `json:"period"`
+	OfficeID        uuid.UUID `json:"office_id"`
+	Currency        string    `json:"currency"`
+	Claims          int64     `json:"claims"`
+	RequestedTotal  int64     `json:"requested_total"`
+	ApprovedTotal   int64     `json:"approved_total"`
+	ReimbursedTotal int64     `json:"reimbursed_total"`
+}
+
+func IsValidGranularity(granularity string) bool {
+	switch granularity {
+	case GranularityDay, GranularityWeek, GranularityMonth:
+		return true
+	default:
+		return false
+	}
+}
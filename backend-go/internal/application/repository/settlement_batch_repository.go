@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SettlementBatchFilters struct {
+	OfficeID *uuid.UUID
+	Status   *string
+}
+
+type SettlementBatchRepository interface {
+	// Create inserts the batch together with its lines.
+	Create(tx application.Tx, batch *entity.SettlementBatch) error
+	// FindByID returns the batch with its lines.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.SettlementBatch, error)
+	// FindAll returns the matching batches, newest period first, without
+	// their lines.
+	FindAll(ctx context.Context, filters SettlementBatchFilters) ([]*entity.SettlementBatch, error)
+	Update(tx application.Tx, batch *entity.SettlementBatch) error
+	// HardDelete removes a batch and its lines, freeing its claim items for
+	// another batch.
+	HardDelete(tx application.Tx, id uuid.UUID) error
+
+	// FindUnsettledItems returns the approved items in currency of the claims
+	// of the office that were completed in [from, to) and that no batch
+	// includes yet.
+	FindUnsettledItems(tx application.Tx, officeID uuid.UUID, from, to time.Time, currency string,
+	) ([]*entity.ClaimItem, error)
+}
@@ -15,4 +15,11 @@ type UserRepository interface {
 	Update(ctx context.Context, user *entity.User) error
 	SoftDelete(ctx context.Context, id uuid.UUID) error
 	FindByOAuth(ctx context.Context, provider, oauthID string) (*entity.User, error)
+	// FindByIDs returns the users among ids that still exist, in no
+	// particular order.
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.User, error)
+	// FindActiveByRole returns the active users of a role.
+	FindActiveByRole(ctx context.Context, role string) ([]*entity.User, error)
+	// FindByOfficeID returns the users of an office, active or not.
+	FindByOfficeID(ctx context.Context, officeID uuid.UUID) ([]*entity.User, error)
 }
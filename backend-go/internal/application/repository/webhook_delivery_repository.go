@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryRepository interface {
+	// Create stores a delivery unless one already exists for the same
+	// subscription and event, so republishing an event is harmless.
+	Create(tx application.Tx, delivery *entity.WebhookDelivery) error
+	Update(tx application.Tx, delivery *entity.WebhookDelivery) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	// FindBySubscriptionID returns the latest deliveries of a subscription,
+	// newest first.
+	FindBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID, limit int,
+	) ([]*entity.WebhookDelivery, error)
+
+	// FindDue locks up to limit pending deliveries of active subscriptions
+	// whose next attempt is due, oldest first, with their subscription loaded.
+	// Rows locked by another dispatcher are skipped.
+	FindDue(tx application.Tx, now time.Time, limit int) ([]*entity.WebhookDelivery, error)
+}
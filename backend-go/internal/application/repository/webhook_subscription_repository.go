@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *entity.WebhookSubscription) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	// FindAll returns every subscription, or only those of the given office.
+	FindAll(ctx context.Context, officeID *uuid.UUID) ([]*entity.WebhookSubscription, error)
+	// FindMatching returns the active subscriptions of the offices handling
+	// the claim that listen to eventType.
+	FindMatching(ctx context.Context, claimID uuid.UUID, eventType string) ([]*entity.WebhookSubscription, error)
+	Update(ctx context.Context, subscription *entity.WebhookSubscription) error
+	SoftDelete(ctx context.Context, id uuid.UUID) error
+}
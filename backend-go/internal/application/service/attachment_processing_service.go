@@ -0,0 +1,303 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/storage"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"ev-warranty-go/pkg/media"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	thumbnailMimeType = "image/jpeg"
+	// processingClaimTTL is how long ProcessPending hides the attachments it
+	// processes from other runs. An attachment whose result was never
+	// recorded, such as when the server stopped, is processed again once it is
+	// over.
+	processingClaimTTL = 15 * time.Minute
+)
+
+// AttachmentProcessingService prepares uploaded attachments to be served in
+// the background. Images are copied without their location, which the
+// original keeps, and get a thumbnail. Their EXIF capture time and location
+// are recorded to help spot photos reused across claims. Videos have their
+// duration checked against the limit, MP4 and QuickTime videos are copied
+// without their location.
+type AttachmentProcessingService interface {
+	// ProcessPending processes one batch of pending attachments and returns
+	// how many were attempted. The batch is claimed in one transaction and its
+	// results recorded in another, so no row is locked while files are
+	// transferred.
+	ProcessPending(ctx context.Context) (int, error)
+}
+
+type attachmentProcessingService struct {
+	log         logger.Logger
+	txManager   application.TxManager
+	attachRepo  repository.ClaimAttachmentRepository
+	fileStorage storage.AttachmentStorage
+	cfg         AttachmentConfig
+}
+
+func NewAttachmentProcessingService(log logger.Logger, txManager application.TxManager,
+	attachRepo repository.ClaimAttachmentRepository, fileStorage storage.AttachmentStorage, cfg AttachmentConfig,
+) AttachmentProcessingService {
+	return &attachmentProcessingService{
+		log:         log,
+		txManager:   txManager,
+		attachRepo:  attachRepo,
+		fileStorage: fileStorage,
+		cfg:         cfg,
+	}
+}
+
+func (s *attachmentProcessingService) ProcessPending(ctx context.Context) (int, error) {
+	var pending []*entity.ClaimAttachment
+	err := s.txManager.Do(ctx, func(tx application.Tx) error {
+		now := time.Now()
+		var err error
+		pending, err = s.attachRepo.FindPendingProcessing(tx, now, s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, attachment := range pending {
+			attachment.ClaimProcessing(now.Add(processingClaimTTL))
+			if err = s.attachRepo.Update(tx, attachment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// The files are processed once the claimed attachments are committed, so
+	// no row stays locked while they are downloaded and uploaded.
+	for _, attachment := range pending {
+		s.process(ctx, attachment)
+	}
+	var deleted []*entity.ClaimAttachment
+	err = s.txManager.Do(ctx, func(tx application.Tx) error {
+		deleted = nil
+		for _, attachment := range pending {
+			err := s.attachRepo.Update(tx, attachment)
+			var appErr *apperror.AppError
+			if errors.As(err, &appErr) && appErr.ErrorCode == apperror.ErrNotFoundError.ErrorCode {
+				deleted = append(deleted, attachment)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// An attachment deleted while it was processed has no row left to record
+	// its derived files, which would never be deleted otherwise.
+	for _, attachment := range deleted {
+		for _, key := range []*string{attachment.PublicKey, attachment.ThumbnailKey} {
+			if key != nil {
+				s.deleteDerived(ctx, attachment, *key)
+			}
+		}
+	}
+
+	return len(pending), nil
+}
+
+// attachmentRejection is a file that can not be served, processing it again
+// would not help.
+type attachmentRejection struct {
+	reason string
+}
+
+func (r *attachmentRejection) Error() string {
+	return r.reason
+}
+
+func (s *attachmentProcessingService) process(ctx context.Context, attachment *entity.ClaimAttachment) {
+	var err error
+	switch attachment.Type {
+	case entity.AttachmentTypeImage:
+		err = s.processImage(ctx, attachment)
+	case entity.AttachmentTypeVideo:
+		err = s.processVideo(ctx, attachment)
+	default:
+		err = &attachmentRejection{reason: "Unsupported attachment type"}
+	}
+
+	var rejection *attachmentRejection
+	switch {
+	case err == nil:
+	case errors.As(err, &rejection):
+		attachment.Reject(rejection.reason, time.Now())
+	default:
+		s.log.Warn("[Attachment] Failed to process claim attachment", "attachment_id", attachment.ID,
+			"error", err)
+		attachment.MarkFailed(err, time.Now(), s.cfg.MaxAttempts)
+	}
+}
+
+// processImage uploads the image without its location, unless it has none,
+// and its thumbnail when its format can be decoded.
+func (s *attachmentProcessingService) processImage(ctx context.Context, attachment *entity.ClaimAttachment,
+) error {
+	data, err := s.download(ctx, attachment.StorageKey, s.cfg.MaxImageSize)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) > s.cfg.MaxImageSize {
+		return &attachmentRejection{reason: "Image is larger than allowed"}
+	}
+
+	exif, err := media.ReadExif(data, attachment.MimeType)
+	if errors.Is(err, media.ErrUnsupportedFormat) {
+		exif = &media.Exif{Orientation: 1}
+	} else if err != nil {
+		return &attachmentRejection{reason: "Image metadata could not be read"}
+	}
+	public, err := media.StripLocation(data, attachment.MimeType)
+	if err != nil {
+		return &attachmentRejection{reason: "Image metadata could not be read"}
+	}
+
+	var thumbnailData []byte
+	thumbnailData, err = media.Thumbnail(bytes.NewReader(public), s.cfg.ThumbnailSize, exif.Orientation)
+	if err != nil && !errors.Is(err, media.ErrUnsupportedFormat) && !errors.Is(err, media.ErrImageTooLarge) {
+		return &attachmentRejection{reason: "Image could not be decoded"}
+	}
+
+	publicKey := attachment.StorageKey
+	if !bytes.Equal(public, data) {
+		publicKey, err = s.fileStorage.UploadFile(ctx, newMemoryFile(public), entity.AttachmentTypeImage,
+			attachment.MimeType)
+		if err != nil {
+			return err
+		}
+	}
+	var thumbnailKey *string
+	if thumbnailData != nil {
+		key, err := s.fileStorage.UploadFile(ctx, newMemoryFile(thumbnailData), entity.AttachmentTypeImage,
+			thumbnailMimeType)
+		if err != nil {
+			s.deleteDerived(ctx, attachment, publicKey)
+			return err
+		}
+		thumbnailKey = &key
+	}
+
+	attachment.CapturedAt = exif.CapturedAt
+	attachment.Latitude = exif.Latitude
+	attachment.Longitude = exif.Longitude
+	attachment.MarkReady(publicKey, thumbnailKey, time.Now())
+	return nil
+}
+
+// processVideo reads the duration of the video from a temporary copy, as the
+// headers holding it may be anywhere in the file, and uploads the copy without
+// its location when it has one. WebM and AVI videos are served as uploaded.
+func (s *attachmentProcessingService) processVideo(ctx context.Context, attachment *entity.ClaimAttachment,
+) error {
+	reader, err := s.fileStorage.DownloadFile(ctx, attachment.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	file, err := os.CreateTemp("", "attachment-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	size, err := io.Copy(file, io.LimitReader(reader, s.cfg.MaxVideoSize+1))
+	if err != nil {
+		return err
+	}
+	if size > s.cfg.MaxVideoSize {
+		return &attachmentRejection{reason: "Video is larger than allowed"}
+	}
+
+	duration, err := media.VideoDuration(file, size, attachment.MimeType)
+	if err != nil {
+		return &attachmentRejection{reason: "Video duration could not be read"}
+	}
+	if duration > s.cfg.MaxVideoDuration {
+		return &attachmentRejection{reason: fmt.Sprintf("Video is longer than %s", s.cfg.MaxVideoDuration)}
+	}
+
+	stripped, err := media.StripVideoLocation(file, size, attachment.MimeType)
+	if err != nil {
+		return &attachmentRejection{reason: "Video metadata could not be read"}
+	}
+	publicKey := attachment.StorageKey
+	if stripped {
+		if _, err = file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		publicKey, err = s.fileStorage.UploadFile(ctx, file, entity.AttachmentTypeVideo, attachment.MimeType)
+		if err != nil {
+			return err
+		}
+	}
+
+	seconds := duration.Seconds()
+	attachment.DurationSeconds = &seconds
+	attachment.MarkReady(publicKey, nil, time.Now())
+	return nil
+}
+
+// download reads a stored file, up to one byte more than limit to tell
+// whether it is larger.
+func (s *attachmentProcessingService) download(ctx context.Context, key string, limit int64) ([]byte, error) {
+	reader, err := s.fileStorage.DownloadFile(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(io.LimitReader(reader, limit+1))
+}
+
+// deleteDerived removes a file derived from attachment when processing it
+// failed before it was recorded.
+func (s *attachmentProcessingService) deleteDerived(ctx context.Context, attachment *entity.ClaimAttachment,
+	key string,
+) {
+	if key == attachment.StorageKey {
+		return
+	}
+	if err := s.fileStorage.DeleteFile(ctx, key); err != nil {
+		s.log.Error("[Storage] Failed to delete file of unprocessed claim attachment", "error", err)
+	}
+}
+
+// memoryFile uploads a file built in memory.
+type memoryFile struct {
+	*bytes.Reader
+}
+
+func newMemoryFile(data []byte) memoryFile {
+	return memoryFile{Reader: bytes.NewReader(data)}
+}
+
+func (memoryFile) Close() error {
+	return nil
+}
@@ -0,0 +1,346 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/media"
+	"ev-warranty-go/pkg/mocks"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime/multipart"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("AttachmentProcessingService", func() {
+	var (
+		mockLogger     *mocks.Logger
+		mockTxManager  *mocks.TxManager
+		mockTx         *mocks.Tx
+		mockAttachRepo *mocks.ClaimAttachmentRepository
+		mockStorage    *mocks.AttachmentStorage
+		cfg            service.AttachmentConfig
+		ctx            context.Context
+	)
+
+	newService := func() service.AttachmentProcessingService {
+		return service.NewAttachmentProcessingService(mockLogger, mockTxManager, mockAttachRepo, mockStorage, cfg)
+	}
+
+	BeforeEach(func() {
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockAttachRepo = mocks.NewClaimAttachmentRepository(GinkgoT())
+		mockStorage = mocks.NewAttachmentStorage(GinkgoT())
+		cfg = service.AttachmentConfig{
+			MaxImageSize:     1 << 20,
+			MaxVideoSize:     1 << 20,
+			MaxVideoDuration: time.Minute,
+			ThumbnailSize:    32,
+			BatchSize:        10,
+			MaxAttempts:      3,
+		}
+		ctx = context.Background()
+
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+		mockTxManager.EXPECT().Do(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				return fn(mockTx)
+			}).Maybe()
+	})
+
+	newAttachment := func(attachmentType, mimeType, storageKey string) *entity.ClaimAttachment {
+		return entity.NewClaimAttachment(uuid.New(), nil, attachmentType, "file", mimeType, 0, "", nil, uuid.New(),
+			storageKey)
+	}
+
+	expectDownload := func(key string, data []byte) {
+		mockStorage.EXPECT().DownloadFile(ctx, key).Return(io.NopCloser(bytes.NewReader(data)), nil).Once()
+	}
+
+	Describe("ProcessPending", func() {
+		Context("when an image has a location", func() {
+			It("should serve a copy without it and record the EXIF metadata", func() {
+				attachment := newAttachment(entity.AttachmentTypeImage, "image/jpeg", "image/photo.jpg")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				expectDownload("image/photo.jpg", jpegWithExif(64, 48, 10.5))
+				var uploads [][]byte
+				mockStorage.EXPECT().UploadFile(ctx, mock.Anything, entity.AttachmentTypeImage, "image/jpeg").
+					RunAndReturn(func(_ context.Context, file multipart.File, _, _ string) (string, error) {
+						data, _ := io.ReadAll(file)
+						uploads = append(uploads, data)
+						return fmt.Sprintf("image/derived-%d.jpg", len(uploads)), nil
+					}).Twice()
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Twice()
+
+				processed, err := newService().ProcessPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+				Expect(attachment.ProcessingStatus).To(Equal(entity.AttachmentProcessingReady))
+				Expect(*attachment.PublicKey).To(Equal("image/derived-1.jpg"))
+				Expect(*attachment.ThumbnailKey).To(Equal("image/derived-2.jpg"))
+				Expect(*attachment.Latitude).To(Equal(10.5))
+				Expect(attachment.CapturedAt.Equal(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC))).To(BeTrue())
+
+				public, err := media.ReadExif(uploads[0], "image/jpeg")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(public.Latitude).To(BeNil())
+				Expect(public.CapturedAt).NotTo(BeNil())
+				thumbnail, err := jpeg.DecodeConfig(bytes.NewReader(uploads[1]))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(thumbnail.Width).To(Equal(32))
+				Expect(thumbnail.Height).To(Equal(24))
+			})
+		})
+
+		Context("when an image has no metadata", func() {
+			It("should serve the original and only upload a thumbnail", func() {
+				attachment := newAttachment(entity.AttachmentTypeImage, "image/jpeg", "image/photo.jpg")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				expectDownload("image/photo.jpg", encodeJPEG(16, 16))
+				mockStorage.EXPECT().UploadFile(ctx, mock.Anything, entity.AttachmentTypeImage, "image/jpeg").
+					Return("image/thumbnail.jpg", nil).Once()
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Twice()
+
+				_, err := newService().ProcessPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attachment.StorageKeys()).To(Equal([]string{"image/photo.jpg", "image/thumbnail.jpg"}))
+				Expect(attachment.CapturedAt).To(BeNil())
+			})
+		})
+
+		Context("when a video is within the limits", func() {
+			It("should serve it and record its duration", func() {
+				attachment := newAttachment(entity.AttachmentTypeVideo, "video/mp4", "video/clip.mp4")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				expectDownload("video/clip.mp4", mp4WithDuration(45*time.Second))
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Twice()
+
+				_, err := newService().ProcessPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attachment.ProcessingStatus).To(Equal(entity.AttachmentProcessingReady))
+				Expect(*attachment.PublicKey).To(Equal("video/clip.mp4"))
+				Expect(*attachment.DurationSeconds).To(Equal(45.0))
+			})
+		})
+
+		Context("when a video records its location", func() {
+			It("should serve a copy without it", func() {
+				attachment := newAttachment(entity.AttachmentTypeVideo, "video/quicktime", "video/clip.mov")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				expectDownload("video/clip.mov", mp4WithLocation(45*time.Second, "+10.7769+106.7009/"))
+				var uploaded []byte
+				mockStorage.EXPECT().UploadFile(ctx, mock.Anything, entity.AttachmentTypeVideo, "video/quicktime").
+					RunAndReturn(func(_ context.Context, file multipart.File, _, _ string) (string, error) {
+						uploaded, _ = io.ReadAll(file)
+						return "video/public.mov", nil
+					}).Once()
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Twice()
+
+				_, err := newService().ProcessPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attachment.ProcessingStatus).To(Equal(entity.AttachmentProcessingReady))
+				Expect(*attachment.PublicKey).To(Equal("video/public.mov"))
+				Expect(*attachment.DurationSeconds).To(Equal(45.0))
+				Expect(string(uploaded)).NotTo(ContainSubstring("+10.7769"))
+				Expect(string(uploaded)).To(ContainSubstring("com.apple.quicktime.make"))
+				Expect(string(uploaded)).To(ContainSubstring("Apple"))
+
+				duration, err := media.VideoDuration(bytes.NewReader(uploaded), int64(len(uploaded)), "video/quicktime")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(duration).To(Equal(45 * time.Second))
+			})
+		})
+
+		Context("when a video is longer than allowed", func() {
+			It("should fail it without retrying", func() {
+				attachment := newAttachment(entity.AttachmentTypeVideo, "video/mp4", "video/clip.mp4")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				expectDownload("video/clip.mp4", mp4WithDuration(2*time.Minute))
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Twice()
+
+				_, err := newService().ProcessPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attachment.ProcessingStatus).To(Equal(entity.AttachmentProcessingFailed))
+				Expect(*attachment.ProcessingError).To(Equal("Video is longer than 1m0s"))
+				Expect(attachment.PublicKey).To(BeNil())
+			})
+		})
+
+		Context("when the storage is unavailable", func() {
+			It("should keep the attachment pending for a retry", func() {
+				attachment := newAttachment(entity.AttachmentTypeImage, "image/jpeg", "image/photo.jpg")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				mockStorage.EXPECT().DownloadFile(ctx, "image/photo.jpg").
+					Return(nil, apperror.ErrFailedDownloadStorage).Once()
+				mockLogger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything).Once()
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Twice()
+
+				_, err := newService().ProcessPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attachment.ProcessingStatus).To(Equal(entity.AttachmentProcessingPending))
+				Expect(attachment.ProcessingAttempts).To(Equal(1))
+				Expect(attachment.ProcessingError).NotTo(BeNil())
+				Expect(attachment.ProcessingClaimedUntil).To(BeNil())
+			})
+		})
+
+		Context("when pending attachments cannot be loaded", func() {
+			It("should return the error", func() {
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return(nil, apperror.ErrDBOperation).Once()
+
+				processed, err := newService().ProcessPending(ctx)
+
+				Expect(processed).To(BeZero())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+
+		Context("when the attachment is deleted while it is processed", func() {
+			It("should delete the files derived from it", func() {
+				attachment := newAttachment(entity.AttachmentTypeImage, "image/jpeg", "image/photo.jpg")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				expectDownload("image/photo.jpg", jpegWithExif(64, 48, 10.5))
+				mockStorage.EXPECT().UploadFile(ctx, mock.Anything, entity.AttachmentTypeImage, "image/jpeg").
+					Return("image/public.jpg", nil).Once()
+				mockStorage.EXPECT().UploadFile(ctx, mock.Anything, entity.AttachmentTypeImage, "image/jpeg").
+					Return("image/thumbnail.jpg", nil).Once()
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Once()
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).
+					Return(apperror.ErrNotFoundError.WithMessage("Claim attachment not found")).Once()
+				mockStorage.EXPECT().DeleteFile(ctx, "image/public.jpg").Return(nil).Once()
+				mockStorage.EXPECT().DeleteFile(ctx, "image/thumbnail.jpg").Return(nil).Once()
+
+				processed, err := newService().ProcessPending(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+			})
+		})
+
+		Context("when saving the result fails", func() {
+			It("should return the error", func() {
+				attachment := newAttachment(entity.AttachmentTypeVideo, "video/mp4", "video/clip.mp4")
+				mockAttachRepo.EXPECT().FindPendingProcessing(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+					Return([]*entity.ClaimAttachment{attachment}, nil).Once()
+				expectDownload("video/clip.mp4", mp4WithDuration(time.Second))
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(nil).Once()
+				mockAttachRepo.EXPECT().Update(mockTx, attachment).Return(errors.New("db down")).Once()
+
+				processed, err := newService().ProcessPending(ctx)
+
+				Expect(processed).To(BeZero())
+				Expect(err).To(MatchError("db down"))
+			})
+		})
+	})
+})
+
+func encodeJPEG(width, height int) []byte {
+	var out bytes.Buffer
+	_ = jpeg.Encode(&out, image.NewRGBA(image.Rect(0, 0, width, height)), nil)
+	return out.Bytes()
+}
+
+// jpegWithExif returns a JPEG whose EXIF records a capture time and a
+// northern latitude.
+func jpegWithExif(width, height int, latitude float64) []byte {
+	order := binary.LittleEndian
+	tiff := make([]byte, 128)
+	copy(tiff, "II*\x00")
+	order.PutUint32(tiff[4:], 8)
+	entry := func(offset int, tag, kind uint16, count, value uint32) {
+		order.PutUint16(tiff[offset:], tag)
+		order.PutUint16(tiff[offset+2:], kind)
+		order.PutUint32(tiff[offset+4:], count)
+		order.PutUint32(tiff[offset+8:], value)
+	}
+	order.PutUint16(tiff[8:], 2)
+	entry(10, 0x0132, 2, 20, 80)
+	entry(22, 0x8825, 4, 1, 38)
+	order.PutUint16(tiff[38:], 2)
+	entry(40, 0x0001, 2, 2, 'N')
+	entry(52, 0x0002, 5, 3, 100)
+	copy(tiff[80:], "2024:05:06 07:08:09\x00")
+	order.PutUint32(tiff[100:], uint32(latitude*100))
+	order.PutUint32(tiff[104:], 100)
+	order.PutUint32(tiff[112:], 1)
+	order.PutUint32(tiff[120:], 1)
+
+	segment := append([]byte{0xFF, 0xE1, 0, 0}, "Exif\x00\x00"...)
+	segment = append(segment, tiff...)
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(segment)-2))
+
+	data := encodeJPEG(width, height)
+	return append(append([]byte{0xFF, 0xD8}, segment...), data[2:]...)
+}
+
+// mp4WithDuration returns the boxes of an MP4 file down to its movie header.
+func mp4WithDuration(duration time.Duration) []byte {
+	mvhd := make([]byte, 108)
+	binary.BigEndian.PutUint32(mvhd, uint32(len(mvhd)))
+	copy(mvhd[4:], "mvhd")
+	binary.BigEndian.PutUint32(mvhd[20:], 1000)
+	binary.BigEndian.PutUint32(mvhd[24:], uint32(duration.Milliseconds()))
+
+	moov := make([]byte, 8, 8+len(mvhd))
+	binary.BigEndian.PutUint32(moov, uint32(8+len(mvhd)))
+	copy(moov[4:], "moov")
+	return append(append([]byte("\x00\x00\x00\x10ftypisom\x00\x00\x00\x00"), moov...), mvhd...)
+}
+
+// mp4WithLocation returns the boxes of a QuickTime file recording location in
+// its user data and in its metadata, next to the make of the phone.
+func mp4WithLocation(duration time.Duration, location string) []byte {
+	box := func(boxType string, payloads ...[]byte) []byte {
+		content := bytes.Join(payloads, nil)
+		out := binary.BigEndian.AppendUint32(nil, uint32(8+len(content)))
+		return append(append(out, boxType...), content...)
+	}
+	key := func(name string) []byte {
+		return append(binary.BigEndian.AppendUint32(nil, uint32(8+len(name))), "mdta"+name...)
+	}
+	value := func(index uint32, text string) []byte {
+		return box(string(binary.BigEndian.AppendUint32(nil, index)),
+			box("data", []byte{0, 0, 0, 1, 0, 0, 0, 0}, []byte(text)))
+	}
+
+	movie := mp4WithDuration(duration)
+	mvhd := movie[bytes.Index(movie, []byte("mvhd"))-4:]
+	text := append([]byte{0, byte(len(location)), 0x15, 0xc7}, location...)
+	keys := append([]byte{0, 0, 0, 0, 0, 0, 0, 2}, append(key("com.apple.quicktime.make"),
+		key("com.apple.quicktime.location.ISO6709")...)...)
+	moov := box("moov", mvhd,
+		box("udta", box("\xa9xyz", text)),
+		box("meta", box("hdlr", make([]byte, 25)), box("keys", keys),
+			box("ilst", value(1, "Apple"), value(2, location))))
+	return append([]byte("\x00\x00\x00\x10ftypqt  \x00\x00\x00\x00"), moov...)
+}
@@ -2,66 +2,150 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
 	"ev-warranty-go/internal/application/repository"
 	"ev-warranty-go/internal/domain/entity"
-	"ev-warranty-go/internal/infrastructure/cloudinary"
+	"ev-warranty-go/internal/infrastructure/storage"
 	"ev-warranty-go/pkg/apperror"
 	"ev-warranty-go/pkg/logger"
+	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
+const maxAttachmentCaptionLength = 500
+
+// CreateClaimAttachmentCommand is an uploaded file with its original name.
+// ClaimItemID links it to the item of the claim it documents.
+type CreateClaimAttachmentCommand struct {
+	File        multipart.File
+	FileName    string
+	Caption     *string
+	ClaimItemID *uuid.UUID
+}
+
 type ClaimAttachmentService interface {
-	GetByID(ctx context.Context, id uuid.UUID) (*entity.ClaimAttachment, error)
-	GetByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAttachment, error)
+	GetByID(ctx context.Context, claimID, attachmentID uuid.UUID) (*entity.ClaimAttachment, error)
+	// GetByClaimID returns the attachments of the claim, only those of the
+	// item when claimItemID is set.
+	GetByClaimID(ctx context.Context, claimID uuid.UUID, claimItemID *uuid.UUID) ([]*entity.ClaimAttachment, error)
 
-	Create(tx application.Tx, technicianID, claimID uuid.UUID, file multipart.File) (*entity.ClaimAttachment, error)
+	Create(tx application.Tx, technicianID, claimID uuid.UUID, cmd *CreateClaimAttachmentCommand,
+	) (*entity.ClaimAttachment, error)
 	HardDelete(tx application.Tx, claimID, attachmentID uuid.UUID) error
 }
 
+// AttachmentConfig sets how long the download URLs of attachments are valid
+// and the largest images and videos accepted. Uploads are processed by
+// batches of BatchSize, up to MaxAttempts times, into thumbnails fitting in a
+// ThumbnailSize square.
+type AttachmentConfig struct {
+	URLTTL           time.Duration
+	MaxImageSize     int64
+	MaxVideoSize     int64
+	MaxVideoDuration time.Duration
+	ThumbnailSize    int
+	BatchSize        int
+	MaxAttempts      int
+}
+
+// maxSize returns the size limit of attachments of attachmentType.
+func (c AttachmentConfig) maxSize(attachmentType string) int64 {
+	if attachmentType == entity.AttachmentTypeVideo {
+		return c.MaxVideoSize
+	}
+	return c.MaxImageSize
+}
+
 type claimAttachmentService struct {
-	log          logger.Logger
-	claimRepo    repository.ClaimRepository
-	attachRepo   repository.ClaimAttachmentRepository
-	cloudService cloudinary.CloudinaryService
+	log         logger.Logger
+	claimRepo   repository.ClaimRepository
+	attachRepo  repository.ClaimAttachmentRepository
+	itemRepo    repository.ClaimItemRepository
+	auditRepo   repository.ClaimAuditLogRepository
+	outboxRepo  repository.OutboxEventRepository
+	fileStorage storage.AttachmentStorage
+	stream      claimstream.Broker
+	cfg         AttachmentConfig
 }
 
 func NewClaimAttachmentService(log logger.Logger, claimRepo repository.ClaimRepository,
-	attachRepo repository.ClaimAttachmentRepository, cloudService cloudinary.CloudinaryService,
+	attachRepo repository.ClaimAttachmentRepository, itemRepo repository.ClaimItemRepository,
+	auditRepo repository.ClaimAuditLogRepository, outboxRepo repository.OutboxEventRepository,
+	fileStorage storage.AttachmentStorage, stream claimstream.Broker, cfg AttachmentConfig,
 ) ClaimAttachmentService {
 	return &claimAttachmentService{
-		log:          log,
-		claimRepo:    claimRepo,
-		attachRepo:   attachRepo,
-		cloudService: cloudService,
+		log:         log,
+		claimRepo:   claimRepo,
+		attachRepo:  attachRepo,
+		itemRepo:    itemRepo,
+		auditRepo:   auditRepo,
+		outboxRepo:  outboxRepo,
+		fileStorage: fileStorage,
+		stream:      stream,
+		cfg:         cfg,
 	}
 }
 
-func (s *claimAttachmentService) GetByID(ctx context.Context, id uuid.UUID) (*entity.ClaimAttachment, error) {
-	claimAttachment, err := s.attachRepo.FindByID(ctx, id)
+func (s *claimAttachmentService) GetByID(ctx context.Context, claimID, attachmentID uuid.UUID,
+) (*entity.ClaimAttachment, error) {
+	if _, err := findClaimInScope(ctx, s.claimRepo, claimID); err != nil {
+		return nil, err
+	}
+
+	claimAttachment, err := s.attachRepo.FindByID(ctx, attachmentID)
 	if err != nil {
 		return nil, err
 	}
+	if claimAttachment.ClaimID != claimID {
+		return nil, apperror.ErrNotFoundError.WithMessage("Claim attachment not found")
+	}
+	if err = s.signURL(ctx, claimAttachment); err != nil {
+		return nil, err
+	}
 
 	return claimAttachment, nil
 }
 
-func (s *claimAttachmentService) GetByClaimID(ctx context.Context, claimID uuid.UUID,
+func (s *claimAttachmentService) GetByClaimID(ctx context.Context, claimID uuid.UUID, claimItemID *uuid.UUID,
 ) ([]*entity.ClaimAttachment, error) {
-	claimAttachments, err := s.attachRepo.FindByClaimID(ctx, claimID)
+	if _, err := findClaimInScope(ctx, s.claimRepo, claimID); err != nil {
+		return nil, err
+	}
+
+	var claimAttachments []*entity.ClaimAttachment
+	var err error
+	if claimItemID != nil {
+		claimAttachments, err = s.attachRepo.FindByClaimItemID(ctx, claimID, *claimItemID)
+	} else {
+		claimAttachments, err = s.attachRepo.FindByClaimID(ctx, claimID)
+	}
 	if err != nil {
 		return nil, err
 	}
+	for _, attachment := range claimAttachments {
+		if err = s.signURL(ctx, attachment); err != nil {
+			return nil, err
+		}
+	}
 
 	return claimAttachments, nil
 }
 
-func (s *claimAttachmentService) Create(tx application.Tx, technicianID, claimID uuid.UUID, file multipart.File,
+func (s *claimAttachmentService) Create(tx application.Tx, technicianID, claimID uuid.UUID,
+	cmd *CreateClaimAttachmentCommand,
 ) (*entity.ClaimAttachment, error) {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, claimID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,31 +154,73 @@ func (s *claimAttachmentService) Create(tx application.Tx, technicianID, claimID
 		return nil, apperror.ErrInvalidInput.WithMessage("Only assigned technician can add attachment")
 	}
 
-	mimeType, err := getMimeType(file)
+	caption, err := normalizeCaption(cmd.Caption)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.ClaimItemID != nil {
+		item, err := s.itemRepo.FindByID(tx.GetCtx(), *cmd.ClaimItemID)
+		if err != nil {
+			return nil, err
+		}
+		if item.ClaimID != claimID {
+			return nil, apperror.ErrNotFoundError.WithMessage("Claim item not found")
+		}
+	}
+
+	info, err := inspectFile(cmd.File)
 	if err != nil {
 		return nil, err
 	}
 
-	attachType := cloudinary.DetermineResourceType(mimeType)
+	attachType := storage.DetermineResourceType(info.mimeType)
 	if !entity.IsValidAttachmentType(attachType) {
 		return nil, apperror.ErrInvalidInput.WithMessage("Invalid Attachment Type")
 	}
-	attachURL, err := s.cloudService.UploadFile(tx.GetCtx(), file, attachType)
+	if maxSize := s.cfg.maxSize(attachType); info.size > maxSize {
+		return nil, apperror.ErrAttachmentTooLarge.WithMessage(fmt.Sprintf("The %s is larger than %d MB",
+			attachType, maxSize>>20))
+	}
+	duplicate, err := s.attachRepo.ExistsByChecksum(tx, claimID, info.checksum)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate {
+		return nil, apperror.ErrDuplicateAttachment
+	}
+	storageKey, err := s.fileStorage.UploadFile(tx.GetCtx(), cmd.File, attachType, info.mimeType)
 	if err != nil {
 		return nil, err
 	}
 
-	attachment := entity.NewClaimAttachment(claimID, attachType, attachURL)
+	attachment := entity.NewClaimAttachment(claimID, cmd.ClaimItemID, attachType, cmd.FileName, info.mimeType,
+		info.size, info.checksum, caption, technicianID, storageKey)
 	err = s.attachRepo.Create(tx, attachment)
 	if err != nil {
 		return nil, err
 	}
 
+	err = recordAudit(tx, s.auditRepo, claimID, entity.AuditEntityAttachment, attachment.ID,
+		entity.AuditActionCreate, nil, attachment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = publishClaimEvent(tx, s.outboxRepo, claimID, entity.EventClaimAttachmentAdded,
+		claimEventData{Attachment: attachment})
+	if err != nil {
+		return nil, err
+	}
+
+	update := claimstream.NewUpdate(entity.EventClaimAttachmentAdded, claim)
+	update.Attachment = attachment
+	streamClaimUpdate(tx, s.stream, update)
+
 	return attachment, nil
 }
 
 func (s *claimAttachmentService) HardDelete(tx application.Tx, claimID, attachmentID uuid.UUID) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, claimID)
 	if err != nil {
 		return err
 	}
@@ -106,30 +232,101 @@ func (s *claimAttachmentService) HardDelete(tx application.Tx, claimID, attachme
 	if err != nil {
 		return err
 	}
+	if attach.ClaimID != claimID {
+		return apperror.ErrNotFoundError.WithMessage("Claim attachment not found")
+	}
 
 	err = s.attachRepo.HardDelete(tx, attachmentID)
 	if err == nil {
-		if cloudErr := s.cloudService.DeleteFileByURL(tx.GetCtx(), attach.URL); cloudErr != nil {
-			s.log.Error("[Cloudinary] Failed to delete file when hard delete claim attachment", "error",
-				cloudErr)
+		err = recordAudit(tx, s.auditRepo, claimID, entity.AuditEntityAttachment, attach.ID,
+			entity.AuditActionDelete, attach, nil)
+	}
+	if err == nil {
+		err = publishClaimEvent(tx, s.outboxRepo, claimID, entity.EventClaimAttachmentRemoved,
+			claimEventData{Attachment: attach})
+	}
+	if err == nil {
+		for _, key := range attach.StorageKeys() {
+			if storageErr := s.fileStorage.DeleteFile(tx.GetCtx(), key); storageErr != nil {
+				s.log.Error("[Storage] Failed to delete file when hard delete claim attachment", "error",
+					storageErr)
+			}
 		}
 	}
 
 	return err
 }
 
-func getMimeType(file multipart.File) (string, error) {
+// signURL sets the download URLs of a processed attachment and its thumbnail,
+// valid for the configured time. The original file is only served when it
+// holds no location: images and MP4 or QuickTime videos with one are served
+// from a copy without it, while WebM and AVI videos are always served as
+// uploaded.
+func (s *claimAttachmentService) signURL(ctx context.Context, attachment *entity.ClaimAttachment) error {
+	if attachment.PublicKey == nil {
+		return nil
+	}
+
+	expiresAt := time.Now().Add(s.cfg.URLTTL)
+	signedURL, err := s.fileStorage.SignedURL(ctx, *attachment.PublicKey, expiresAt)
+	if err != nil {
+		return err
+	}
+	attachment.URL = signedURL
+	if attachment.ThumbnailKey != nil {
+		attachment.ThumbnailURL, err = s.fileStorage.SignedURL(ctx, *attachment.ThumbnailKey, expiresAt)
+		if err != nil {
+			return err
+		}
+	}
+	attachment.URLExpiresAt = &expiresAt
+	return nil
+}
+
+type fileInfo struct {
+	mimeType string
+	size     int64
+	checksum string
+}
+
+// inspectFile reads file to the end to sniff its MIME type from the first 512
+// bytes and hash it, then rewinds it for the upload.
+func inspectFile(file multipart.File) (*fileInfo, error) {
 	buffer := make([]byte, 512)
-	_, err := file.Read(buffer)
+	n, err := io.ReadFull(file, buffer)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, apperror.ErrInvalidFile.WithError(err)
+	}
+
+	hash := sha256.New()
+	hash.Write(buffer[:n])
+	rest, err := io.Copy(hash, file)
 	if err != nil {
-		return "", apperror.ErrInvalidFile.WithError(err)
+		return nil, apperror.ErrInvalidFile.WithError(err)
 	}
 
-	_, err = file.Seek(0, 0)
+	_, err = file.Seek(0, io.SeekStart)
 	if err != nil {
-		return "", apperror.ErrInvalidFile.WithError(err)
+		return nil, apperror.ErrInvalidFile.WithError(err)
 	}
 
-	mimeType := http.DetectContentType(buffer)
-	return mimeType, nil
+	return &fileInfo{
+		mimeType: http.DetectContentType(buffer[:n]),
+		size:     int64(n) + rest,
+		checksum: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+func normalizeCaption(caption *string) (*string, error) {
+	if caption == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimSpace(*caption)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(trimmed) > maxAttachmentCaptionLength {
+		return nil, apperror.ErrInvalidInput.WithMessage("Caption is too long")
+	}
+	return &trimmed, nil
 }
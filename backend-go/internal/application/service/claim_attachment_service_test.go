@@ -3,13 +3,19 @@ package service_test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
 	"ev-warranty-go/internal/application/service"
 	"ev-warranty-go/internal/domain/entity"
 	"ev-warranty-go/pkg/apperror"
 	"ev-warranty-go/pkg/mocks"
 	"io"
 	"mime/multipart"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -22,7 +28,11 @@ var _ = Describe("ClaimAttachmentService", func() {
 		mockLogger     *mocks.Logger
 		mockClaimRepo  *mocks.ClaimRepository
 		mockAttachRepo *mocks.ClaimAttachmentRepository
-		mockCloudServ  *mocks.CloudinaryService
+		mockItemRepo   *mocks.ClaimItemRepository
+		mockAuditRepo  *mocks.ClaimAuditLogRepository
+		mockOutbox     *mocks.OutboxEventRepository
+		mockStorage    *mocks.AttachmentStorage
+		mockStream     *mocks.Broker
 		mockTx         *mocks.Tx
 		attachService  service.ClaimAttachmentService
 		ctx            context.Context
@@ -32,45 +42,152 @@ var _ = Describe("ClaimAttachmentService", func() {
 		mockLogger = mocks.NewLogger(GinkgoT())
 		mockClaimRepo = mocks.NewClaimRepository(GinkgoT())
 		mockAttachRepo = mocks.NewClaimAttachmentRepository(GinkgoT())
-		mockCloudServ = mocks.NewCloudinaryService(GinkgoT())
+		mockItemRepo = mocks.NewClaimItemRepository(GinkgoT())
+		mockAuditRepo = mocks.NewClaimAuditLogRepository(GinkgoT())
+		mockOutbox = mocks.NewOutboxEventRepository(GinkgoT())
+		mockStorage = mocks.NewAttachmentStorage(GinkgoT())
+		mockStream = mocks.NewBroker(GinkgoT())
 		mockTx = mocks.NewTx(GinkgoT())
-		attachService = service.NewClaimAttachmentService(mockLogger, mockClaimRepo, mockAttachRepo, mockCloudServ)
-		ctx = context.Background()
+		attachService = service.NewClaimAttachmentService(mockLogger, mockClaimRepo, mockAttachRepo, mockItemRepo,
+			mockAuditRepo, mockOutbox, mockStorage, mockStream, service.AttachmentConfig{
+				URLTTL:       15 * time.Minute,
+				MaxImageSize: 1 << 20,
+				MaxVideoSize: 1 << 20,
+			})
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: uuid.New(),
+			Role:   entity.UserRoleAdmin,
+		})
 	})
 
 	Describe("GetByID", func() {
-		var attachmentID uuid.UUID
+		var (
+			claimID      uuid.UUID
+			attachmentID uuid.UUID
+		)
 
 		BeforeEach(func() {
+			claimID = uuid.New()
 			attachmentID = uuid.New()
 		})
 
 		Context("when attachment is found", func() {
 			It("should return the attachment", func() {
+				publicKey, thumbnailKey := "image/public.jpg", "image/thumbnail.jpg"
 				expectedAttachment := &entity.ClaimAttachment{
-					ID:      attachmentID,
-					ClaimID: uuid.New(),
-					Type:    "image",
-					URL:     "https://example.com/image.jpg",
+					ID:               attachmentID,
+					ClaimID:          claimID,
+					Type:             "image",
+					StorageKey:       "image/photo.jpg",
+					PublicKey:        &publicKey,
+					ThumbnailKey:     &thumbnailKey,
+					ProcessingStatus: entity.AttachmentProcessingReady,
 				}
 
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
 				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(expectedAttachment, nil).Once()
+				mockStorage.EXPECT().SignedURL(ctx, "image/public.jpg", mock.MatchedBy(func(t time.Time) bool {
+					return t.After(time.Now().Add(14*time.Minute)) && t.Before(time.Now().Add(16*time.Minute))
+				})).Return("https://example.com/image/public.jpg?signature=abc", nil).Once()
+				mockStorage.EXPECT().SignedURL(ctx, "image/thumbnail.jpg", mock.Anything).
+					Return("https://example.com/image/thumbnail.jpg?signature=def", nil).Once()
 
-				attachment, err := attachService.GetByID(ctx, attachmentID)
+				attachment, err := attachService.GetByID(ctx, claimID, attachmentID)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(attachment).NotTo(BeNil())
 				Expect(attachment.ID).To(Equal(expectedAttachment.ID))
-				Expect(attachment.URL).To(Equal(expectedAttachment.URL))
+				Expect(attachment.URL).To(Equal("https://example.com/image/public.jpg?signature=abc"))
+				Expect(attachment.ThumbnailURL).To(Equal("https://example.com/image/thumbnail.jpg?signature=def"))
+				Expect(attachment.URLExpiresAt).NotTo(BeNil())
+			})
+		})
+
+		Context("when attachment is not processed yet", func() {
+			It("should return it without download URL", func() {
+				pendingAttachment := &entity.ClaimAttachment{
+					ID:               attachmentID,
+					ClaimID:          claimID,
+					StorageKey:       "image/photo.jpg",
+					ProcessingStatus: entity.AttachmentProcessingPending,
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(pendingAttachment, nil).Once()
+
+				attachment, err := attachService.GetByID(ctx, claimID, attachmentID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attachment.URL).To(BeEmpty())
+				Expect(attachment.URLExpiresAt).To(BeNil())
+			})
+		})
+
+		Context("when signing the URL fails", func() {
+			It("should return the error", func() {
+				invalidKey := "invalid"
+				storedAttachment := &entity.ClaimAttachment{
+					ID:         attachmentID,
+					ClaimID:    claimID,
+					StorageKey: "invalid",
+					PublicKey:  &invalidKey,
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(storedAttachment, nil).Once()
+				mockStorage.EXPECT().SignedURL(ctx, "invalid", mock.Anything).
+					Return("", apperror.ErrInvalidStorageKey).Once()
+
+				attachment, err := attachService.GetByID(ctx, claimID, attachmentID)
+
+				Expect(attachment).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidStorageKey.ErrorCode)
+			})
+		})
+
+		Context("when attachment belongs to another claim", func() {
+			It("should return NotFound error", func() {
+				otherAttachment := &entity.ClaimAttachment{
+					ID:      attachmentID,
+					ClaimID: uuid.New(),
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(otherAttachment, nil).Once()
+
+				attachment, err := attachService.GetByID(ctx, claimID, attachmentID)
+
+				Expect(attachment).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
 			})
 		})
 
 		Context("when attachment is not found", func() {
 			It("should return ClaimAttachmentNotFound error", func() {
 				notFoundErr := apperror.ErrNotFoundError
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
 				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(nil, notFoundErr).Once()
 
-				attachment, err := attachService.GetByID(ctx, attachmentID)
+				attachment, err := attachService.GetByID(ctx, claimID, attachmentID)
+
+				Expect(attachment).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+
+		Context("when caller is scoped to another office", func() {
+			It("should return NotFound error without loading the attachment", func() {
+				officeID := uuid.New()
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScTechnician,
+					OfficeID: officeID,
+				})
+
+				mockClaimRepo.EXPECT().FindByIDInOffice(scopedCtx, claimID, officeID).
+					Return(nil, apperror.ErrNotFoundError).Once()
+
+				attachment, err := attachService.GetByID(scopedCtx, claimID, attachmentID)
 
 				Expect(attachment).To(BeNil())
 				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
@@ -87,38 +204,70 @@ var _ = Describe("ClaimAttachmentService", func() {
 
 		Context("when attachments are found", func() {
 			It("should return all attachments for the claim", func() {
+				imageKey, videoKey := "image/image1.jpg", "video/video1.mp4"
 				expectedAttachments := []*entity.ClaimAttachment{
 					{
-						ID:      uuid.New(),
-						ClaimID: claimID,
-						Type:    "image",
-						URL:     "https://example.com/image1.jpg",
+						ID:         uuid.New(),
+						ClaimID:    claimID,
+						Type:       "image",
+						StorageKey: "image/image1.jpg",
+						PublicKey:  &imageKey,
 					},
 					{
-						ID:      uuid.New(),
-						ClaimID: claimID,
-						Type:    "video",
-						URL:     "https://example.com/video1.mp4",
+						ID:         uuid.New(),
+						ClaimID:    claimID,
+						Type:       "video",
+						StorageKey: "video/video1.mp4",
+						PublicKey:  &videoKey,
 					},
 				}
 
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
 				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(expectedAttachments, nil).Once()
+				mockStorage.EXPECT().SignedURL(ctx, "image/image1.jpg", mock.Anything).
+					Return("https://example.com/image/image1.jpg?signature=abc", nil).Once()
+				mockStorage.EXPECT().SignedURL(ctx, "video/video1.mp4", mock.Anything).
+					Return("https://example.com/video/video1.mp4?signature=def", nil).Once()
 
-				attachments, err := attachService.GetByClaimID(ctx, claimID)
+				attachments, err := attachService.GetByClaimID(ctx, claimID, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(attachments).NotTo(BeNil())
 				Expect(attachments).To(HaveLen(2))
 				Expect(attachments[0].ClaimID).To(Equal(claimID))
+				Expect(attachments[0].URL).To(Equal("https://example.com/image/image1.jpg?signature=abc"))
 				Expect(attachments[1].ClaimID).To(Equal(claimID))
+				Expect(attachments[1].URL).To(Equal("https://example.com/video/video1.mp4?signature=def"))
+			})
+		})
+
+		Context("when filtered by claim item", func() {
+			It("should return the attachments of the item", func() {
+				itemID, itemKey := uuid.New(), "image/item.jpg"
+				itemAttachments := []*entity.ClaimAttachment{
+					{ID: uuid.New(), ClaimID: claimID, ClaimItemID: &itemID, StorageKey: "image/item.jpg",
+						PublicKey: &itemKey},
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockAttachRepo.EXPECT().FindByClaimItemID(ctx, claimID, itemID).Return(itemAttachments, nil).Once()
+				mockStorage.EXPECT().SignedURL(ctx, "image/item.jpg", mock.Anything).
+					Return("https://example.com/image/item.jpg?signature=abc", nil).Once()
+
+				attachments, err := attachService.GetByClaimID(ctx, claimID, &itemID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attachments).To(HaveLen(1))
+				Expect(*attachments[0].ClaimItemID).To(Equal(itemID))
 			})
 		})
 
 		Context("when no attachments are found", func() {
 			It("should return an empty slice", func() {
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
 				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return([]*entity.ClaimAttachment{}, nil).Once()
 
-				attachments, err := attachService.GetByClaimID(ctx, claimID)
+				attachments, err := attachService.GetByClaimID(ctx, claimID, nil)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(attachments).NotTo(BeNil())
@@ -126,12 +275,22 @@ var _ = Describe("ClaimAttachmentService", func() {
 			})
 		})
 
+		Context("when caller is missing", func() {
+			It("should return MissingUserID error", func() {
+				attachments, err := attachService.GetByClaimID(context.Background(), claimID, nil)
+
+				Expect(attachments).To(BeNil())
+				ExpectAppError(err, apperror.ErrMissingUserID.ErrorCode)
+			})
+		})
+
 		Context("when repository returns error", func() {
 			It("should return the error", func() {
 				dbErr := apperror.ErrDBOperation
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
 				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(nil, dbErr).Once()
 
-				attachments, err := attachService.GetByClaimID(ctx, claimID)
+				attachments, err := attachService.GetByClaimID(ctx, claimID, nil)
 
 				Expect(err).To(HaveOccurred())
 				Expect(attachments).To(BeNil())
@@ -147,6 +306,10 @@ var _ = Describe("ClaimAttachmentService", func() {
 			file         multipart.File
 		)
 
+		createCmd := func(file multipart.File) *service.CreateClaimAttachmentCommand {
+			return &service.CreateClaimAttachmentCommand{File: file, FileName: "photo.jpg"}
+		}
+
 		BeforeEach(func() {
 			claimID = uuid.New()
 			technicianID = uuid.Nil
@@ -165,19 +328,46 @@ var _ = Describe("ClaimAttachmentService", func() {
 				}
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockCloudServ.EXPECT().UploadFile(ctx, file, "image").Return("https://example.com/image.jpg", nil).Once()
+				mockAttachRepo.EXPECT().ExistsByChecksum(mockTx, claimID, mock.Anything).Return(false, nil).Once()
+				mockStorage.EXPECT().UploadFile(ctx, file, "image", "image/jpeg").Return("image/photo.jpg", nil).Once()
+				checksum := sha256.Sum256(fileContent)
 				mockAttachRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(a *entity.ClaimAttachment) bool {
 					return a.ClaimID == claimID &&
 						a.Type == "image" &&
-						a.URL == "https://example.com/image.jpg"
+						a.StorageKey == "image/photo.jpg" &&
+						a.FileName == "photo.jpg" &&
+						a.MimeType == "image/jpeg" &&
+						a.Size == int64(len(fileContent)) &&
+						a.Checksum == hex.EncodeToString(checksum[:]) &&
+						*a.UploadedBy == technicianID &&
+						a.ClaimItemID == nil && a.Caption == nil
+				})).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.ClaimID == claimID &&
+						l.EntityType == entity.AuditEntityAttachment &&
+						l.Action == entity.AuditActionCreate &&
+						l.Before == nil &&
+						bytes.Contains(l.After, []byte(`"type":"image"`))
 				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimAttachmentAdded, claimID)).
+					Return(nil).Once()
+				var onCommit func()
+				mockTx.EXPECT().OnCommit(mock.Anything).Run(func(fn func()) { onCommit = fn }).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(attachment).NotTo(BeNil())
 				Expect(attachment.ClaimID).To(Equal(claimID))
 				Expect(attachment.Type).To(Equal("image"))
+				Expect(attachment.ProcessingStatus).To(Equal(entity.AttachmentProcessingPending))
+				Expect(attachment.URL).To(BeEmpty())
+
+				mockStream.EXPECT().Publish(mock.MatchedBy(func(u *claimstream.Update) bool {
+					return u.Type == entity.EventClaimAttachmentAdded && u.ClaimID == claimID &&
+						u.Attachment.ID == attachment.ID && u.Attachment.URL == ""
+				})).Once()
+				onCommit()
 			})
 		})
 
@@ -193,23 +383,110 @@ var _ = Describe("ClaimAttachmentService", func() {
 				}
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockCloudServ.EXPECT().UploadFile(ctx, file, "image").Return("https://example.com/image.png", nil).Once()
+				mockAttachRepo.EXPECT().ExistsByChecksum(mockTx, claimID, mock.Anything).Return(false, nil).Once()
+				mockStorage.EXPECT().UploadFile(ctx, file, "image", "image/png").Return("image/photo.png", nil).Once()
 				mockAttachRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAttachment")).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimAttachmentAdded, claimID)).
+					Return(nil).Once()
+				mockTx.EXPECT().OnCommit(mock.Anything).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(attachment).NotTo(BeNil())
 			})
 		})
 
+		Context("when attachment documents a claim item", func() {
+			It("should link the item and keep the caption", func() {
+				jpegHeader := []byte{0xFF, 0xD8, 0xFF}
+				file = &mockFile{Reader: bytes.NewReader(append(jpegHeader, make([]byte, 509)...))}
+				itemID := uuid.New()
+				caption := "  Damaged connector  "
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).
+					Return(&entity.Claim{ID: claimID, Status: entity.ClaimStatusDraft}, nil).Once()
+				mockItemRepo.EXPECT().FindByID(ctx, itemID).Return(&entity.ClaimItem{ID: itemID, ClaimID: claimID}, nil).Once()
+				mockAttachRepo.EXPECT().ExistsByChecksum(mockTx, claimID, mock.Anything).Return(false, nil).Once()
+				mockStorage.EXPECT().UploadFile(ctx, file, "image", "image/jpeg").Return("image/photo.jpg", nil).Once()
+				mockAttachRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(a *entity.ClaimAttachment) bool {
+					return *a.ClaimItemID == itemID && *a.Caption == "Damaged connector"
+				})).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimAttachmentAdded, claimID)).
+					Return(nil).Once()
+				mockTx.EXPECT().OnCommit(mock.Anything).Once()
+
+				attachment, err := attachService.Create(mockTx, technicianID, claimID,
+					&service.CreateClaimAttachmentCommand{File: file, FileName: "photo.jpg", Caption: &caption,
+						ClaimItemID: &itemID})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*attachment.ClaimItemID).To(Equal(itemID))
+			})
+		})
+
+		Context("when claim item belongs to another claim", func() {
+			It("should return NotFound error without uploading", func() {
+				file = &mockFile{Reader: bytes.NewReader([]byte("test"))}
+				itemID := uuid.New()
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).
+					Return(&entity.Claim{ID: claimID, Status: entity.ClaimStatusDraft}, nil).Once()
+				mockItemRepo.EXPECT().FindByID(ctx, itemID).
+					Return(&entity.ClaimItem{ID: itemID, ClaimID: uuid.New()}, nil).Once()
+
+				attachment, err := attachService.Create(mockTx, technicianID, claimID,
+					&service.CreateClaimAttachmentCommand{File: file, ClaimItemID: &itemID})
+
+				Expect(attachment).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+
+		Context("when caption is too long", func() {
+			It("should return InvalidInput error", func() {
+				file = &mockFile{Reader: bytes.NewReader([]byte("test"))}
+				caption := strings.Repeat("a", 501)
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).
+					Return(&entity.Claim{ID: claimID, Status: entity.ClaimStatusDraft}, nil).Once()
+
+				attachment, err := attachService.Create(mockTx, technicianID, claimID,
+					&service.CreateClaimAttachmentCommand{File: file, Caption: &caption})
+
+				Expect(attachment).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when the claim already has the same file", func() {
+			It("should return DuplicateAttachment error without uploading", func() {
+				jpegHeader := []byte{0xFF, 0xD8, 0xFF}
+				fileContent := append(jpegHeader, make([]byte, 509)...)
+				file = &mockFile{Reader: bytes.NewReader(fileContent)}
+				checksum := sha256.Sum256(fileContent)
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).
+					Return(&entity.Claim{ID: claimID, Status: entity.ClaimStatusDraft}, nil).Once()
+				mockAttachRepo.EXPECT().ExistsByChecksum(mockTx, claimID, hex.EncodeToString(checksum[:])).
+					Return(true, nil).Once()
+
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
+
+				Expect(attachment).To(BeNil())
+				ExpectAppError(err, apperror.ErrDuplicateAttachment.ErrorCode)
+			})
+		})
+
 		Context("when claim is not found", func() {
 			It("should return ClaimNotFound error", func() {
 				file = &mockFile{Reader: bytes.NewReader([]byte("test"))}
 				notFoundErr := apperror.ErrNotFoundError
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(nil, notFoundErr).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(err).To(HaveOccurred())
 				Expect(attachment).To(BeNil())
@@ -227,7 +504,7 @@ var _ = Describe("ClaimAttachmentService", func() {
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(err).To(HaveOccurred())
 				Expect(attachment).To(BeNil())
@@ -244,7 +521,7 @@ var _ = Describe("ClaimAttachmentService", func() {
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(err).To(HaveOccurred())
 				Expect(attachment).To(BeNil())
@@ -264,14 +541,29 @@ var _ = Describe("ClaimAttachmentService", func() {
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(attachment).To(BeNil())
 				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
 			})
 		})
 
-		Context("when cloud upload fails", func() {
+		Context("when the file is larger than the limit", func() {
+			It("should return AttachmentTooLarge error without uploading", func() {
+				jpegHeader := []byte{0xFF, 0xD8, 0xFF}
+				file = &mockFile{Reader: bytes.NewReader(append(jpegHeader, make([]byte, 1<<20)...))}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).
+					Return(&entity.Claim{ID: claimID, Status: entity.ClaimStatusDraft}, nil).Once()
+
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
+
+				Expect(attachment).To(BeNil())
+				ExpectAppError(err, apperror.ErrAttachmentTooLarge.ErrorCode)
+			})
+		})
+
+		Context("when storage upload fails", func() {
 			It("should return error", func() {
 				jpegHeader := []byte{0xFF, 0xD8, 0xFF}
 				fileContent := append(jpegHeader, make([]byte, 509)...)
@@ -281,16 +573,17 @@ var _ = Describe("ClaimAttachmentService", func() {
 					ID:     claimID,
 					Status: entity.ClaimStatusDraft,
 				}
-				cloudErr := errors.New("cloud upload failed")
+				storageErr := errors.New("storage upload failed")
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockCloudServ.EXPECT().UploadFile(ctx, file, "image").Return("", cloudErr).Once()
+				mockAttachRepo.EXPECT().ExistsByChecksum(mockTx, claimID, mock.Anything).Return(false, nil).Once()
+				mockStorage.EXPECT().UploadFile(ctx, file, "image", "image/jpeg").Return("", storageErr).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(err).To(HaveOccurred())
 				Expect(attachment).To(BeNil())
-				Expect(err).To(Equal(cloudErr))
+				Expect(err).To(Equal(storageErr))
 			})
 		})
 
@@ -307,10 +600,11 @@ var _ = Describe("ClaimAttachmentService", func() {
 				dbErr := apperror.ErrDBOperation
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockCloudServ.EXPECT().UploadFile(ctx, file, "image").Return("https://example.com/image.jpg", nil).Once()
+				mockAttachRepo.EXPECT().ExistsByChecksum(mockTx, claimID, mock.Anything).Return(false, nil).Once()
+				mockStorage.EXPECT().UploadFile(ctx, file, "image", "image/jpeg").Return("image/photo.jpg", nil).Once()
 				mockAttachRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAttachment")).Return(dbErr).Once()
 
-				attachment, err := attachService.Create(mockTx, technicianID, claimID, file)
+				attachment, err := attachService.Create(mockTx, technicianID, claimID, createCmd(file))
 
 				Expect(err).To(HaveOccurred())
 				Expect(attachment).To(BeNil())
@@ -332,22 +626,34 @@ var _ = Describe("ClaimAttachmentService", func() {
 		})
 
 		Context("when attachment is deleted successfully", func() {
-			It("should delete from repository and cloud storage", func() {
+			It("should delete from repository and storage", func() {
 				claim := &entity.Claim{
 					ID:     claimID,
 					Status: entity.ClaimStatusDraft,
 				}
+				publicKey, thumbnailKey := "image/public.jpg", "image/thumbnail.jpg"
 				attachment := &entity.ClaimAttachment{
-					ID:      attachmentID,
-					ClaimID: claimID,
-					URL:     "https://example.com/image.jpg",
+					ID:           attachmentID,
+					ClaimID:      claimID,
+					StorageKey:   "image/photo.jpg",
+					PublicKey:    &publicKey,
+					ThumbnailKey: &thumbnailKey,
 				}
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(attachment, nil).Once()
 				mockAttachRepo.EXPECT().HardDelete(mockTx, attachmentID).Return(nil).Once()
-				mockCloudServ.EXPECT().DeleteFileByURL(ctx, attachment.URL).Return(nil).Maybe()
-				mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Maybe()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.EntityID == attachmentID &&
+						l.Action == entity.AuditActionDelete &&
+						l.Before != nil &&
+						l.After == nil
+				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimAttachmentRemoved, claimID)).
+					Return(nil).Once()
+				mockStorage.EXPECT().DeleteFile(ctx, "image/photo.jpg").Return(nil).Once()
+				mockStorage.EXPECT().DeleteFile(ctx, "image/public.jpg").Return(nil).Once()
+				mockStorage.EXPECT().DeleteFile(ctx, "image/thumbnail.jpg").Return(nil).Once()
 
 				err := attachService.HardDelete(mockTx, claimID, attachmentID)
 
@@ -388,6 +694,27 @@ var _ = Describe("ClaimAttachmentService", func() {
 			})
 		})
 
+		Context("when attachment belongs to another claim", func() {
+			It("should return NotFound error without deleting it", func() {
+				claim := &entity.Claim{
+					ID:     claimID,
+					Status: entity.ClaimStatusDraft,
+				}
+				attachment := &entity.ClaimAttachment{
+					ID:         attachmentID,
+					ClaimID:    uuid.New(),
+					StorageKey: "image/photo.jpg",
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
+				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(attachment, nil).Once()
+
+				err := attachService.HardDelete(mockTx, claimID, attachmentID)
+
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+
 		Context("when repository delete fails", func() {
 			It("should return the error", func() {
 				claim := &entity.Claim{
@@ -395,9 +722,9 @@ var _ = Describe("ClaimAttachmentService", func() {
 					Status: entity.ClaimStatusDraft,
 				}
 				attachment := &entity.ClaimAttachment{
-					ID:      attachmentID,
-					ClaimID: claimID,
-					URL:     "https://example.com/image.jpg",
+					ID:         attachmentID,
+					ClaimID:    claimID,
+					StorageKey: "image/photo.jpg",
 				}
 				dbErr := apperror.ErrDBOperation
 
@@ -412,25 +739,28 @@ var _ = Describe("ClaimAttachmentService", func() {
 			})
 		})
 
-		Context("when cloud delete fails", func() {
+		Context("when storage delete fails", func() {
 			It("should log error but still return nil", func() {
 				claim := &entity.Claim{
 					ID:     claimID,
 					Status: entity.ClaimStatusDraft,
 				}
 				attachment := &entity.ClaimAttachment{
-					ID:      attachmentID,
-					ClaimID: claimID,
-					URL:     "https://example.com/image.jpg",
+					ID:         attachmentID,
+					ClaimID:    claimID,
+					StorageKey: "image/photo.jpg",
 				}
-				cloudErr := errors.New("cloud storage error")
+				storageErr := errors.New("storage delete failed")
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 				mockAttachRepo.EXPECT().FindByID(ctx, attachmentID).Return(attachment, nil).Once()
 				mockAttachRepo.EXPECT().HardDelete(mockTx, attachmentID).Return(nil).Once()
-				mockCloudServ.EXPECT().DeleteFileByURL(ctx, attachment.URL).Return(cloudErr).Once()
-				mockLogger.EXPECT().Error("[Cloudinary] Failed to delete file when hard delete claim attachment",
-					"error", cloudErr).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimAttachmentRemoved, claimID)).
+					Return(nil).Once()
+				mockStorage.EXPECT().DeleteFile(ctx, attachment.StorageKey).Return(storageErr).Once()
+				mockLogger.EXPECT().Error("[Storage] Failed to delete file when hard delete claim attachment",
+					"error", storageErr).Once()
 
 				err := attachService.HardDelete(mockTx, claimID, attachmentID)
 
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"encoding/json"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+// auditIgnoredFields are bookkeeping columns that change on every write and
+// carry nothing an auditor needs.
+var auditIgnoredFields = []string{"created_at", "updated_at"}
+
+// recordAudit appends an audit entry for a change to a claim, claim item or
+// attachment within tx, attributed to the actor carried by its context.
+// before and after are snapshots of the entity, either may be nil for a
+// creation or a deletion. Updates only keep the fields that differ and are
+// skipped entirely when nothing changed.
+func recordAudit(tx application.Tx, auditRepo repository.ClaimAuditLogRepository, claimID uuid.UUID,
+	entityType string, entityID uuid.UUID, action string, before, after any,
+) error {
+	actor, ok := application.ActorFromContext(tx.GetCtx())
+	if !ok {
+		return apperror.ErrMissingUserID
+	}
+
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return err
+	}
+
+	if action == entity.AuditActionUpdate {
+		for key, value := range beforeFields {
+			if reflect.DeepEqual(value, afterFields[key]) {
+				delete(beforeFields, key)
+				delete(afterFields, key)
+			}
+		}
+		if len(beforeFields) == 0 && len(afterFields) == 0 {
+			return nil
+		}
+	}
+
+	beforeJSON, err := marshalAuditFields(beforeFields)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := marshalAuditFields(afterFields)
+	if err != nil {
+		return err
+	}
+
+	auditLog := entity.NewClaimAuditLog(claimID, entityType, entityID, action, beforeJSON, afterJSON, actor.UserID)
+	return auditRepo.Create(tx, auditLog)
+}
+
+func auditFields(snapshot any) (map[string]any, error) {
+	if snapshot == nil || reflect.ValueOf(snapshot).IsZero() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, apperror.ErrInternalServerError.WithError(err)
+	}
+
+	var fields map[string]any
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, apperror.ErrInternalServerError.WithError(err)
+	}
+	for _, key := range auditIgnoredFields {
+		delete(fields, key)
+	}
+
+	return fields, nil
+}
+
+func marshalAuditFields(fields map[string]any) (json.RawMessage, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, apperror.ErrInternalServerError.WithError(err)
+	}
+	return data, nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"encoding/json"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+)
+
+// claimStatusEvents names the event emitted when a claim enters a status.
+// Statuses reached through a custom workflow that are not listed here emit
+// EventClaimStatusChanged.
+var claimStatusEvents = map[string]string{
+	entity.ClaimStatusSubmitted:         entity.EventClaimSubmitted,
+	entity.ClaimStatusReviewing:         entity.EventClaimReviewStarted,
+	entity.ClaimStatusCancelled:         entity.EventClaimCancelled,
+	entity.ClaimStatusApproved:          entity.EventClaimApproved,
+	entity.ClaimStatusPartiallyApproved: entity.EventClaimPartiallyApproved,
+	entity.ClaimStatusRejected:          entity.EventClaimRejected,
+	entity.ClaimStatusCompleted:         entity.EventClaimCompleted,
+}
+
+// claimEventData is the payload of every claim outbox event, only the parts
+// relevant to the event are set.
+type claimEventData struct {
+	ActorID        uuid.UUID               `json:"actor_id"`
+	Claim          *entity.Claim           `json:"claim,omitempty"`
+	Item           *entity.ClaimItem       `json:"item,omitempty"`
+	Attachment     *entity.ClaimAttachment `json:"attachment,omitempty"`
+	PreviousStatus string                  `json:"previous_status,omitempty"`
+	ReasonCode     string                  `json:"reason_code,omitempty"`
+	Note           string                  `json:"note,omitempty"`
+	Review         *ClaimReviewStats       `json:"review,omitempty"`
+}
+
+// publishClaimEvent writes a claim event to the outbox within tx, so it is
+// only delivered if the change it describes commits.
+func publishClaimEvent(tx application.Tx, outboxRepo repository.OutboxEventRepository, claimID uuid.UUID,
+	eventType string, data claimEventData,
+) error {
+	actor, ok := application.ActorFromContext(tx.GetCtx())
+	if !ok {
+		return apperror.ErrMissingUserID
+	}
+	data.ActorID = actor.UserID
+
+	return publishSystemClaimEvent(tx, outboxRepo, claimID, eventType, data)
+}
+
+// publishSystemClaimEvent is publishClaimEvent for events raised by the
+// background jobs, which have no actor. Their actor_id is the nil UUID.
+func publishSystemClaimEvent(tx application.Tx, outboxRepo repository.OutboxEventRepository, claimID uuid.UUID,
+	eventType string, data claimEventData,
+) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return apperror.ErrInternalServerError.WithError(err)
+	}
+
+	event := entity.NewOutboxEvent(entity.AggregateTypeClaim, claimID, eventType, payload)
+	return outboxRepo.Create(tx, event)
+}
+
+// streamClaimUpdate hands update to the live claim stream once tx commits, so
+// dashboards never see a change that is rolled back.
+func streamClaimUpdate(tx application.Tx, stream claimstream.Broker, update *claimstream.Update) {
+	tx.OnCommit(func() {
+		stream.Publish(update)
+	})
+}
+
+func claimStatusEvent(status string) string {
+	if eventType, ok := claimStatusEvents[status]; ok {
+		return eventType
+	}
+	return entity.EventClaimStatusChanged
+}
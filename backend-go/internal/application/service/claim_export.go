@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/xlsx"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// formulaPrefixes are the first characters that make spreadsheets evaluate a
+// cell as a formula.
+const formulaPrefixes = "=+-@\t\r"
+
+var claimExportHeader = []string{
+	"claim_id", "customer_id", "vehicle_id", "kilometers", "description", "claim_status", "currency",
+	"requested_total", "approved_total", "reimbursed_total", "staff_id", "technician_id", "created_at",
+	"attachment_count", "submitted_at", "reviewing_at", "approved_at", "rejected_at", "cancelled_at",
+	"completed_at", "item_id", "part_category_id", "faulty_part_serial", "item_type", "item_status", "item_cost",
+}
+
+// ClaimExport is a claim export ready to be streamed. Rows are only read from
+// the database while Stream writes them.
+type ClaimExport struct {
+	Filename    string
+	ContentType string
+	stream      func(w io.Writer) error
+}
+
+// Stream writes the export to w. It can only be called once.
+func (e *ClaimExport) Stream(w io.Writer) error {
+	return e.stream(w)
+}
+
+func (s *claimService) Export(ctx context.Context, filters repository.ClaimFilters, format string,
+) (*ClaimExport, error) {
+	if filters.Status != nil && !entity.IsValidClaimStatus(*filters.Status) {
+		return nil, apperror.ErrInvalidInput.WithMessage("Invalid claim status")
+	}
+	if filters.SLAState != nil && !entity.IsValidClaimSLAState(*filters.SLAState) {
+		return nil, apperror.ErrInvalidInput.WithMessage("SLA state must be ON_TRACK or BREACHED")
+	}
+	if filters.FromDate != nil && filters.ToDate != nil && filters.FromDate.After(*filters.ToDate) {
+		return nil, apperror.ErrInvalidInput.WithMessage("From date must be before to date")
+	}
+
+	filters, err := scopeClaimFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &ClaimExport{
+		Filename: "claims-" + time.Now().Format("20060102-150405") + "." + format,
+	}
+	switch format {
+	case ExportFormatCSV:
+		export.ContentType = "text/csv"
+		export.stream = func(w io.Writer) error {
+			return s.exportCSV(ctx, filters, w)
+		}
+	case ExportFormatXLSX:
+		export.ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		export.stream = func(w io.Writer) error {
+			return s.exportXLSX(ctx, filters, w)
+		}
+	default:
+		return nil, apperror.ErrInvalidParams.WithMessage("Export format must be csv or xlsx")
+	}
+
+	return export, nil
+}
+
+func (s *claimService) exportCSV(ctx context.Context, filters repository.ClaimFilters, w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(claimExportHeader); err != nil {
+		return err
+	}
+
+	err := s.claimRepo.Export(ctx, filters, func(row *repository.ClaimExportRow) error {
+		record := make([]string, 0, len(claimExportHeader))
+		for _, cell := range claimExportCells(row) {
+			value, err := csvCell(cell)
+			if err != nil {
+				return err
+			}
+			record = append(record, value)
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func (s *claimService) exportXLSX(ctx context.Context, filters repository.ClaimFilters, w io.Writer) error {
+	xw, err := xlsx.NewWriter(w, "Claims")
+	if err != nil {
+		return err
+	}
+
+	header := make([]any, len(claimExportHeader))
+	for i, name := range claimExportHeader {
+		header[i] = name
+	}
+	if err = xw.WriteRow(header...); err != nil {
+		return err
+	}
+
+	err = s.claimRepo.Export(ctx, filters, func(row *repository.ClaimExportRow) error {
+		return xw.WriteRow(claimExportCells(row)...)
+	})
+	if err != nil {
+		return err
+	}
+
+	return xw.Close()
+}
+
+// claimExportCells lists the values of a row in claimExportHeader order,
+// with nil for the fields it does not have.
+func claimExportCells(row *repository.ClaimExportRow) []any {
+	return []any{
+		row.ClaimID.String(), row.CustomerID.String(), row.VehicleID.String(), row.Kilometers, row.Description,
+		row.ClaimStatus, row.Currency, row.RequestedTotal, row.ApprovedTotal, row.ReimbursedTotal,
+		row.StaffID.String(), row.TechnicianID.String(), row.CreatedAt, row.AttachmentCount,
+		optional(row.SubmittedAt), optional(row.ReviewingAt), optional(row.ApprovedAt), optional(row.RejectedAt),
+		optional(row.CancelledAt), optional(row.CompletedAt),
+		optionalString(row.ItemID), optionalString(row.PartCategoryID), optional(row.FaultyPartSerial),
+		optional(row.ItemType), optional(row.ItemStatus), optional(row.ItemCost),
+	}
+}
+
+func optional[T any](value *T) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+func optionalString[T interface{ String() string }](value *T) any {
+	if value == nil {
+		return nil
+	}
+	return (*value).String()
+}
+
+// spreadsheetText prefixes text starting like a formula with a quote, which
+// spreadsheets read as the start of a text value.
+func spreadsheetText(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// csvCell formats a cell for a CSV export. Text is escaped so spreadsheets
+// opening the file do not evaluate it as a formula, the XLSX export needs no
+// escaping as its inline strings are never evaluated.
+func csvCell(cell any) (string, error) {
+	switch v := cell.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return spreadsheetText(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case time.Time:
+		return v.Format(time.RFC3339), nil
+	default:
+		return "", fmt.Errorf("unsupported export cell type %T", cell)
+	}
+}
@@ -3,68 +3,118 @@ package service
 import (
 	"context"
 	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
 	"ev-warranty-go/internal/application/repository"
 	"ev-warranty-go/internal/domain/entity"
-	"ev-warranty-go/internal/infrastructure/client/dotnet"
 	"ev-warranty-go/pkg/apperror"
+	"strings"
 
 	"github.com/google/uuid"
 )
 
+// CostConfig sets how claim items are priced. TaxRate is in basis points.
+type CostConfig struct {
+	Currency string
+	TaxRate  int
+}
+
 type CreateClaimItemCommand struct {
 	PartCategoryID   uuid.UUID
 	FaultyPartSerial string
 	IssueDescription string
 	Status           string
 	Type             string
+	LaborOperationID *uuid.UUID
+	DiagnosticFee    int64
 }
 
 type UpdateClaimItemCommand struct {
 	IssueDescription string
 	Type             string
+	LaborOperationID *uuid.UUID
+	DiagnosticFee    int64
+}
+
+// ApproveClaimItemCommand approves an item, optionally charging LaborMinutes
+// instead of the flat-rate time of its labor operation.
+type ApproveClaimItemCommand struct {
+	Note         string
+	LaborMinutes *int
 }
 
 type UpdateClaimItemStatusCommand struct {
 }
 
 type ClaimItemService interface {
-	GetByID(ctx context.Context, id uuid.UUID) (*entity.ClaimItem, error)
+	GetByID(ctx context.Context, claimID, itemID uuid.UUID) (*entity.ClaimItem, error)
 	GetByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimItem, error)
 
 	Create(tx application.Tx, claimID uuid.UUID, cmd *CreateClaimItemCommand, authToken string) (*entity.ClaimItem, error)
 	Update(tx application.Tx, claimID, itemID uuid.UUID, cmd *UpdateClaimItemCommand, authToken string) error
 	HardDelete(tx application.Tx, claimID, itemID uuid.UUID, authToken string) error
 
-	Approve(tx application.Tx, claimID, itemID uuid.UUID) error
-	Reject(tx application.Tx, claimID, itemID uuid.UUID, authToken string) error
+	Approve(tx application.Tx, claimID, itemID, changedBy uuid.UUID, cmd *ApproveClaimItemCommand) error
+	Reject(tx application.Tx, claimID, itemID, changedBy uuid.UUID, reason *ReasonCommand, authToken string) error
 }
 
 type claimItemService struct {
 	claimRepo    repository.ClaimRepository
 	itemRepo     repository.ClaimItemRepository
 	userRepo     repository.UserRepository
-	dotnetClient dotnet.Client
+	officeRepo   repository.OfficeRepository
+	laborOpRepo  repository.LaborOperationRepository
+	historyRepo  repository.ClaimHistoryRepository
+	auditRepo    repository.ClaimAuditLogRepository
+	outboxRepo   repository.OutboxEventRepository
+	reservations PartReservationService
+	warranty     WarrantyService
+	costCfg      CostConfig
+	stream       claimstream.Broker
 }
 
-func NewClaimItemService(claimRepo repository.ClaimRepository, itemRepo repository.ClaimItemRepository, userRepo repository.UserRepository, dotnetClient dotnet.Client) ClaimItemService {
+func NewClaimItemService(claimRepo repository.ClaimRepository, itemRepo repository.ClaimItemRepository,
+	userRepo repository.UserRepository, officeRepo repository.OfficeRepository,
+	laborOpRepo repository.LaborOperationRepository, historyRepo repository.ClaimHistoryRepository,
+	auditRepo repository.ClaimAuditLogRepository, outboxRepo repository.OutboxEventRepository,
+	reservations PartReservationService, warranty WarrantyService, costCfg CostConfig, stream claimstream.Broker,
+) ClaimItemService {
 	return &claimItemService{
 		claimRepo:    claimRepo,
 		itemRepo:     itemRepo,
 		userRepo:     userRepo,
-		dotnetClient: dotnetClient,
+		officeRepo:   officeRepo,
+		laborOpRepo:  laborOpRepo,
+		historyRepo:  historyRepo,
+		auditRepo:    auditRepo,
+		outboxRepo:   outboxRepo,
+		reservations: reservations,
+		warranty:     warranty,
+		costCfg:      costCfg,
+		stream:       stream,
 	}
 }
 
-func (s *claimItemService) GetByID(ctx context.Context, id uuid.UUID) (*entity.ClaimItem, error) {
-	item, err := s.itemRepo.FindByID(ctx, id)
+func (s *claimItemService) GetByID(ctx context.Context, claimID, itemID uuid.UUID) (*entity.ClaimItem, error) {
+	if _, err := findClaimInScope(ctx, s.claimRepo, claimID); err != nil {
+		return nil, err
+	}
+
+	item, err := s.itemRepo.FindByID(ctx, itemID)
 	if err != nil {
 		return nil, err
 	}
+	if item.ClaimID != claimID {
+		return nil, apperror.ErrNotFoundError.WithMessage("Claim item not found")
+	}
 
 	return item, nil
 }
 
 func (s *claimItemService) GetByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimItem, error) {
+	if _, err := findClaimInScope(ctx, s.claimRepo, claimID); err != nil {
+		return nil, err
+	}
+
 	items, err := s.itemRepo.FindByClaimID(ctx, claimID)
 	if err != nil {
 		return nil, err
@@ -75,7 +125,7 @@ func (s *claimItemService) GetByClaimID(ctx context.Context, claimID uuid.UUID)
 
 func (s *claimItemService) Create(tx application.Tx, claimID uuid.UUID,
 	cmd *CreateClaimItemCommand, authToken string) (*entity.ClaimItem, error) {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, claimID)
 	if err != nil {
 		return nil, err
 	}
@@ -86,40 +136,63 @@ func (s *claimItemService) Create(tx application.Tx, claimID uuid.UUID,
 	if !entity.IsValidClaimItemType(cmd.Type) {
 		return nil, apperror.ErrInvalidInput.WithMessage("Invalid claim item type")
 	}
+	if cmd.DiagnosticFee < 0 {
+		return nil, apperror.ErrInvalidInput.WithMessage("Diagnostic fee cannot be negative")
+	}
 
-	var cost float64
-	var replacementPartID *uuid.UUID
+	operation, err := s.findLaborOperation(tx, cmd.Type, cmd.LaborOperationID, cmd.PartCategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	coverage, err := s.warranty.CheckCoverage(tx.GetCtx(), claim.Warranty, cmd.PartCategoryID, authToken)
+	if err != nil {
+		return nil, err
+	}
+
+	office, err := s.findRepairOffice(tx, claim)
+	if err != nil {
+		return nil, err
+	}
+
+	item := entity.NewClaimItem(claimID, cmd.PartCategoryID, cmd.FaultyPartSerial, nil,
+		cmd.IssueDescription, cmd.Status, cmd.Type, claim.Currency)
+	item.Coverage = coverage
+	item.SetLaborOperation(operation)
+	item.DiagnosticFee = cmd.DiagnosticFee
 
 	if cmd.Type == entity.ClaimItemTypeReplacement {
-		technician, err := s.userRepo.FindByID(tx.GetCtx(), claim.TechnicianID)
-		if err != nil {
-			return nil, apperror.ErrNotFoundError.WithMessage("Technician not found")
+		if err = s.reservePart(tx, claim, item, office.ID, authToken); err != nil {
+			return nil, err
 		}
+	}
+	item.Price(office.LaborRate, s.costCfg.TaxRate)
 
-		reservedPart, err := s.dotnetClient.ReservePart(tx.GetCtx(), technician.OfficeID, cmd.PartCategoryID, authToken)
-		if err != nil {
-			return nil, apperror.ErrExternalServiceError.WithMessage("Failed to reserve part: " + err.Error())
-		}
+	err = s.itemRepo.Create(tx, item)
+	if err != nil {
+		return nil, err
+	}
 
-		replacementPartID = &reservedPart.ID
-		cost = reservedPart.UnitPrice
-	} else {
-		replacementPartID = nil
-		cost = 0
+	err = recordAudit(tx, s.auditRepo, claimID, entity.AuditEntityClaimItem, item.ID, entity.AuditActionCreate,
+		nil, item)
+	if err != nil {
+		return nil, err
 	}
 
-	item := entity.NewClaimItem(claimID, cmd.PartCategoryID, cmd.FaultyPartSerial, replacementPartID,
-		cmd.IssueDescription, cmd.Status, cmd.Type, cost)
-	err = s.itemRepo.Create(tx, item)
+	err = publishClaimEvent(tx, s.outboxRepo, claimID, entity.EventClaimItemAdded, claimEventData{Item: item})
 	if err != nil {
 		return nil, err
 	}
 
+	if err = s.updateTotalCost(tx, claim); err != nil {
+		return nil, err
+	}
+
 	return item, nil
 }
 
 func (s *claimItemService) Update(tx application.Tx, claimID, itemID uuid.UUID, cmd *UpdateClaimItemCommand, authToken string) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, claimID)
 	if err != nil {
 		return err
 	}
@@ -130,7 +203,7 @@ func (s *claimItemService) Update(tx application.Tx, claimID, itemID uuid.UUID,
 		return apperror.ErrInvalidClaimAction.WithMessage("Can only update when claim status if draft")
 	}
 
-	item, err := s.itemRepo.FindByID(tx.GetCtx(), itemID)
+	item, err := s.findItemOfClaim(tx, claimID, itemID)
 	if err != nil {
 		return err
 	}
@@ -144,67 +217,70 @@ func (s *claimItemService) Update(tx application.Tx, claimID, itemID uuid.UUID,
 	if !entity.IsValidClaimItemType(cmd.Type) {
 		return apperror.ErrInvalidClaimAction.WithMessage("Invalid claim item type")
 	}
+	if cmd.DiagnosticFee < 0 {
+		return apperror.ErrInvalidInput.WithMessage("Diagnostic fee cannot be negative")
+	}
 
-	var cost float64
-	var replacementPartID *uuid.UUID
+	operation, err := s.findLaborOperation(tx, cmd.Type, cmd.LaborOperationID, item.PartCategoryID)
+	if err != nil {
+		return err
+	}
+
+	office, err := s.findRepairOffice(tx, claim)
+	if err != nil {
+		return err
+	}
 
+	before := *item
 	oldType := item.Type
 	newType := cmd.Type
 
 	if oldType == entity.ClaimItemTypeReplacement && newType == entity.ClaimItemTypeRepair {
 		if item.ReplacementPartID != nil {
-			err := s.dotnetClient.UnreservePart(tx.GetCtx(), *item.ReplacementPartID, authToken)
+			err = s.reservations.Release(tx, claimID, item.ID, *item.ReplacementPartID,
+				entity.ReleaseReasonItemRepair, authToken)
 			if err != nil {
-				return apperror.ErrExternalServiceError.WithMessage("Failed to unreserve part: " + err.Error())
+				return err
 			}
 		}
-		replacementPartID = nil
-		cost = 0
+		item.ReplacementPartID = nil
+		item.PartsCost = 0
 	} else if oldType == entity.ClaimItemTypeRepair && newType == entity.ClaimItemTypeReplacement {
-		technician, err := s.userRepo.FindByID(tx.GetCtx(), claim.TechnicianID)
-		if err != nil {
-			return apperror.ErrNotFoundError.WithMessage("Technician not found")
+		if err = s.reservePart(tx, claim, item, office.ID, authToken); err != nil {
+			return err
 		}
-
-		reservedPart, err := s.dotnetClient.ReservePart(tx.GetCtx(), technician.OfficeID, item.PartCategoryID, authToken)
-		if err != nil {
-			return apperror.ErrExternalServiceError.WithMessage("Failed to reserve part: " + err.Error())
-		}
-
-		replacementPartID = &reservedPart.ID
-		cost = reservedPart.UnitPrice
-	} else if newType == entity.ClaimItemTypeReplacement {
-		replacementPartID = item.ReplacementPartID
-		cost = item.Cost
-	} else {
-		replacementPartID = nil
-		cost = 0
+	} else if newType != entity.ClaimItemTypeReplacement {
+		item.ReplacementPartID = nil
+		item.PartsCost = 0
 	}
+
 	item.IssueDescription = cmd.IssueDescription
 	item.Type = cmd.Type
-	item.ReplacementPartID = replacementPartID
-	item.Cost = cost
+	item.SetLaborOperation(operation)
+	item.DiagnosticFee = cmd.DiagnosticFee
+	item.Price(office.LaborRate, s.costCfg.TaxRate)
 
 	err = s.itemRepo.Update(tx, item)
 	if err != nil {
 		return err
 	}
 
-	claim.TotalCost, err = s.itemRepo.SumCostByClaimID(tx, claimID)
+	err = recordAudit(tx, s.auditRepo, claimID, entity.AuditEntityClaimItem, item.ID, entity.AuditActionUpdate,
+		before, item)
 	if err != nil {
 		return err
 	}
 
-	err = s.claimRepo.Update(tx, claim)
+	err = publishClaimEvent(tx, s.outboxRepo, claimID, entity.EventClaimItemUpdated, claimEventData{Item: item})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.updateTotalCost(tx, claim)
 }
 
 func (s *claimItemService) HardDelete(tx application.Tx, claimID, itemID uuid.UUID, authToken string) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, claimID)
 	if err != nil {
 		return err
 	}
@@ -213,15 +289,16 @@ func (s *claimItemService) HardDelete(tx application.Tx, claimID, itemID uuid.UU
 		return apperror.ErrInvalidClaimAction.WithMessage("Can only hard delete when claim status is draft")
 	}
 
-	item, err := s.itemRepo.FindByID(tx.GetCtx(), itemID)
+	item, err := s.findItemOfClaim(tx, claimID, itemID)
 	if err != nil {
 		return err
 	}
 
 	if item.Type == entity.ClaimItemTypeReplacement && item.ReplacementPartID != nil {
-		err := s.dotnetClient.UnreservePart(tx.GetCtx(), *item.ReplacementPartID, authToken)
+		err = s.reservations.Release(tx, claimID, item.ID, *item.ReplacementPartID,
+			entity.ReleaseReasonItemRemoved, authToken)
 		if err != nil {
-			return apperror.ErrExternalServiceError.WithMessage("Failed to unreserve part: " + err.Error())
+			return err
 		}
 	}
 
@@ -230,21 +307,29 @@ func (s *claimItemService) HardDelete(tx application.Tx, claimID, itemID uuid.UU
 		return err
 	}
 
-	claim.TotalCost, err = s.itemRepo.SumCostByClaimID(tx, claimID)
+	err = recordAudit(tx, s.auditRepo, claimID, entity.AuditEntityClaimItem, item.ID, entity.AuditActionDelete,
+		item, nil)
 	if err != nil {
 		return err
 	}
 
-	err = s.claimRepo.Update(tx, claim)
+	err = publishClaimEvent(tx, s.outboxRepo, claimID, entity.EventClaimItemRemoved, claimEventData{Item: item})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.updateTotalCost(tx, claim)
 }
 
-func (s *claimItemService) Approve(tx application.Tx, claimID, itemID uuid.UUID) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+func (s *claimItemService) Approve(tx application.Tx, claimID, itemID, changedBy uuid.UUID,
+	cmd *ApproveClaimItemCommand,
+) error {
+	note := strings.TrimSpace(cmd.Note)
+	if cmd.LaborMinutes != nil && *cmd.LaborMinutes < 0 {
+		return apperror.ErrInvalidInput.WithMessage("Labor time cannot be negative")
+	}
+
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, claimID)
 	if err != nil {
 		return err
 	}
@@ -253,26 +338,50 @@ func (s *claimItemService) Approve(tx application.Tx, claimID, itemID uuid.UUID)
 		return apperror.ErrInvalidClaimAction.WithMessage("Can only approve if claim status is reviewing")
 	}
 
-	err = s.itemRepo.UpdateStatus(tx, itemID, entity.ClaimItemStatusApproved)
+	item, err := s.findItemOfClaim(tx, claimID, itemID)
 	if err != nil {
 		return err
 	}
 
-	claim.TotalCost, err = s.itemRepo.SumCostByClaimID(tx, claimID)
+	if cmd.LaborMinutes != nil && *cmd.LaborMinutes != item.LaborMinutes {
+		if err = s.adjustLabor(tx, item, *cmd.LaborMinutes, note); err != nil {
+			return err
+		}
+	}
+
+	err = s.itemRepo.UpdateStatus(tx, itemID, entity.ClaimItemStatusApproved)
 	if err != nil {
 		return err
 	}
 
-	err = s.claimRepo.Update(tx, claim)
+	if err = s.auditItemStatus(tx, item, entity.ClaimItemStatusApproved); err != nil {
+		return err
+	}
+
+	history := entity.NewClaimItemHistory(claimID, itemID, entity.ClaimItemStatusApproved, changedBy)
+	history.SetReason("", note)
+	if err = s.historyRepo.Create(tx, history); err != nil {
+		return err
+	}
+
+	err = publishClaimEvent(tx, s.outboxRepo, claimID, entity.EventClaimItemApproved,
+		claimEventData{Item: item, Note: note})
 	if err != nil {
 		return err
 	}
+	s.streamItemDecision(tx, claim, item, entity.EventClaimItemApproved)
 
-	return nil
+	return s.updateTotalCost(tx, claim)
 }
 
-func (s *claimItemService) Reject(tx application.Tx, claimID, itemID uuid.UUID, authtoken string) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+func (s *claimItemService) Reject(tx application.Tx, claimID, itemID, changedBy uuid.UUID, reason *ReasonCommand,
+	authToken string,
+) error {
+	if err := validateReason(reason, true); err != nil {
+		return err
+	}
+
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, claimID)
 	if err != nil {
 		return err
 	}
@@ -281,15 +390,16 @@ func (s *claimItemService) Reject(tx application.Tx, claimID, itemID uuid.UUID,
 		return apperror.ErrInvalidClaimAction.WithMessage("Can only reject when claim status is reviewing")
 	}
 
-	item, err := s.itemRepo.FindByID(tx.GetCtx(), itemID)
+	item, err := s.findItemOfClaim(tx, claimID, itemID)
 	if err != nil {
 		return err
 	}
 
 	if item.Type == entity.ClaimItemTypeReplacement && item.ReplacementPartID != nil {
-		err := s.dotnetClient.UnreservePart(tx.GetCtx(), *item.ReplacementPartID, authtoken)
+		err = s.reservations.Release(tx, claimID, item.ID, *item.ReplacementPartID,
+			entity.ReleaseReasonItemRejected, authToken)
 		if err != nil {
-			return apperror.ErrExternalServiceError.WithMessage("Failed to unreserve part: " + err.Error())
+			return err
 		}
 	}
 
@@ -298,15 +408,149 @@ func (s *claimItemService) Reject(tx application.Tx, claimID, itemID uuid.UUID,
 		return err
 	}
 
-	claim.TotalCost, err = s.itemRepo.SumCostByClaimID(tx, claimID)
+	if err = s.auditItemStatus(tx, item, entity.ClaimItemStatusRejected); err != nil {
+		return err
+	}
+
+	history := entity.NewClaimItemHistory(claimID, itemID, entity.ClaimItemStatusRejected, changedBy)
+	history.SetReason(reason.ReasonCode, strings.TrimSpace(reason.Note))
+	if err = s.historyRepo.Create(tx, history); err != nil {
+		return err
+	}
+
+	err = publishClaimEvent(tx, s.outboxRepo, claimID, entity.EventClaimItemRejected,
+		claimEventData{Item: item, ReasonCode: reason.ReasonCode, Note: strings.TrimSpace(reason.Note)})
 	if err != nil {
 		return err
 	}
+	s.streamItemDecision(tx, claim, item, entity.EventClaimItemRejected)
+
+	return s.updateTotalCost(tx, claim)
+}
 
-	err = s.claimRepo.Update(tx, claim)
+// findLaborOperation returns the operation an item of itemType and part
+// category is charged for. Repairs must name one, replacements may.
+func (s *claimItemService) findLaborOperation(tx application.Tx, itemType string, operationID *uuid.UUID,
+	partCategoryID uuid.UUID,
+) (*entity.LaborOperation, error) {
+	if operationID == nil {
+		if itemType == entity.ClaimItemTypeRepair {
+			return nil, apperror.ErrInvalidInput.WithMessage("Repair items require a labor operation")
+		}
+		return nil, nil
+	}
+
+	operation, err := s.laborOpRepo.FindByID(tx.GetCtx(), *operationID)
 	if err != nil {
+		return nil, err
+	}
+	if !operation.IsActive {
+		return nil, apperror.ErrInvalidInput.WithMessage("Labor operation is not active")
+	}
+	if !operation.AppliesTo(partCategoryID) {
+		return nil, apperror.ErrInvalidInput.WithMessage("Labor operation does not apply to the part category")
+	}
+	return operation, nil
+}
+
+// adjustLabor re-prices item for the labor time approved by the reviewer, at
+// the rates it was priced with. The reason for the adjustment is required.
+func (s *claimItemService) adjustLabor(tx application.Tx, item *entity.ClaimItem, laborMinutes int,
+	note string,
+) error {
+	if note == "" {
+		return apperror.ErrInvalidInput.WithMessage("A note is required when adjusting labor time")
+	}
+
+	before := *item
+	item.LaborMinutes = laborMinutes
+	item.Price(item.LaborRate, item.TaxRate)
+	if err := s.itemRepo.Update(tx, item); err != nil {
 		return err
 	}
 
+	return recordAudit(tx, s.auditRepo, item.ClaimID, entity.AuditEntityClaimItem, item.ID,
+		entity.AuditActionUpdate, before, item)
+}
+
+// findRepairOffice returns the office of the claim's technician, where the
+// repair is done and whose labor rate and stock apply.
+func (s *claimItemService) findRepairOffice(tx application.Tx, claim *entity.Claim) (*entity.Office, error) {
+	technician, err := s.userRepo.FindByID(tx.GetCtx(), claim.TechnicianID)
+	if err != nil {
+		return nil, apperror.ErrNotFoundError.WithMessage("Technician not found")
+	}
+
+	return s.officeRepo.FindByID(tx.GetCtx(), technician.OfficeID)
+}
+
+// reservePart reserves a replacement part for item at the office, which is
+// released again if tx rolls back, and prices the item's parts with it.
+func (s *claimItemService) reservePart(tx application.Tx, claim *entity.Claim, item *entity.ClaimItem,
+	officeID uuid.UUID, authToken string,
+) error {
+	part, err := s.reservations.Reserve(tx, claim.ID, item.ID, officeID, item.PartCategoryID, authToken)
+	if err != nil {
+		return err
+	}
+
+	partsCost, err := entity.ToMinorUnits(part.UnitPrice.String(), item.Currency)
+	if err != nil {
+		return apperror.ErrExternalServiceError.WithMessage("Invalid part unit price").WithError(err)
+	}
+
+	item.ReplacementPartID = &part.ID
+	item.PartsCost = partsCost
 	return nil
 }
+
+func (s *claimItemService) findItemOfClaim(tx application.Tx, claimID, itemID uuid.UUID) (*entity.ClaimItem, error) {
+	item, err := s.itemRepo.FindByID(tx.GetCtx(), itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.ClaimID != claimID {
+		return nil, apperror.ErrNotFoundError.WithMessage("Claim item not found")
+	}
+	return item, nil
+}
+
+func (s *claimItemService) auditItemStatus(tx application.Tx, item *entity.ClaimItem, status string) error {
+	before := *item
+	item.Status = status
+	return recordAudit(tx, s.auditRepo, item.ClaimID, entity.AuditEntityClaimItem, item.ID, entity.AuditActionUpdate,
+		before, item)
+}
+
+// updateTotalCost recomputes the claim's requested and approved totals from
+// its items and audits the change when they moved.
+func (s *claimItemService) updateTotalCost(tx application.Tx, claim *entity.Claim) error {
+	requestedTotal, err := s.itemRepo.SumRequestedCostByClaimID(tx, claim.ID)
+	if err != nil {
+		return err
+	}
+	approvedTotal, err := s.itemRepo.SumCostByClaimID(tx, claim.ID)
+	if err != nil {
+		return err
+	}
+
+	before := *claim
+	claim.RequestedTotal = requestedTotal
+	claim.ApprovedTotal = approvedTotal
+	if err = s.claimRepo.Update(tx, claim); err != nil {
+		return err
+	}
+
+	return recordAudit(tx, s.auditRepo, claim.ID, entity.AuditEntityClaim, claim.ID, entity.AuditActionUpdate,
+		before, claim)
+}
+
+// streamItemDecision streams the decision on item, which auditItemStatus
+// already moved to its decided status.
+func (s *claimItemService) streamItemDecision(tx application.Tx, claim *entity.Claim, item *entity.ClaimItem,
+	eventType string,
+) {
+	update := claimstream.NewUpdate(eventType, claim)
+	update.Item = item
+	streamClaimUpdate(tx, s.stream, update)
+}
@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("ClaimItemService office scope", func() {
+	var (
+		mockClaimRepo    *mocks.ClaimRepository
+		mockItemRepo     *mocks.ClaimItemRepository
+		mockReservations *mocks.PartReservationService
+		mockTx           *mocks.Tx
+		itemService      service.ClaimItemService
+		ctx              context.Context
+		officeID         uuid.UUID
+		claimID          uuid.UUID
+		itemID           uuid.UUID
+		partID           uuid.UUID
+		otherItem        *entity.ClaimItem
+	)
+
+	BeforeEach(func() {
+		mockClaimRepo = mocks.NewClaimRepository(GinkgoT())
+		mockItemRepo = mocks.NewClaimItemRepository(GinkgoT())
+		mockReservations = mocks.NewPartReservationService(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		itemService = service.NewClaimItemService(mockClaimRepo, mockItemRepo, mocks.NewUserRepository(GinkgoT()),
+			mocks.NewOfficeRepository(GinkgoT()), mocks.NewLaborOperationRepository(GinkgoT()),
+			mocks.NewClaimHistoryRepository(GinkgoT()), mocks.NewClaimAuditLogRepository(GinkgoT()),
+			mocks.NewOutboxEventRepository(GinkgoT()), mockReservations, mocks.NewWarrantyService(GinkgoT()),
+			service.CostConfig{}, mocks.NewBroker(GinkgoT()))
+
+		officeID = uuid.New()
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID:   uuid.New(),
+			Role:     entity.UserRoleScStaff,
+			OfficeID: officeID,
+		})
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+
+		claimID = uuid.New()
+		itemID = uuid.New()
+		partID = uuid.New()
+		otherItem = &entity.ClaimItem{
+			ID:                itemID,
+			ClaimID:           uuid.New(),
+			Type:              entity.ClaimItemTypeReplacement,
+			Status:            entity.ClaimItemStatusPending,
+			ReplacementPartID: &partID,
+		}
+		mockClaimRepo.EXPECT().FindByIDInOffice(ctx, claimID, officeID).
+			Return(&entity.Claim{ID: claimID, Status: entity.ClaimStatusDraft}, nil).Once()
+		mockItemRepo.EXPECT().FindByID(ctx, itemID).Return(otherItem, nil).Once()
+	})
+
+	Describe("Update", func() {
+		Context("when item belongs to another claim", func() {
+			It("should return NotFound error without changing it", func() {
+				cmd := &service.UpdateClaimItemCommand{
+					IssueDescription: "Battery swelling",
+					Type:             entity.ClaimItemTypeRepair,
+				}
+
+				err := itemService.Update(mockTx, claimID, itemID, cmd, "token")
+
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("HardDelete", func() {
+		Context("when item belongs to another claim", func() {
+			It("should return NotFound error without deleting it", func() {
+				err := itemService.HardDelete(mockTx, claimID, itemID, "token")
+
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+)
+
+// findClaimInScope loads a claim on behalf of the actor carried by ctx. Office
+// scoped actors only resolve claims handled by their own service center, any
+// other claim is reported as not found so its existence is not leaked.
+func findClaimInScope(ctx context.Context, claimRepo repository.ClaimRepository, id uuid.UUID,
+) (*entity.Claim, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	if actor.IsOfficeScoped() {
+		return claimRepo.FindByIDInOffice(ctx, id, actor.OfficeID)
+	}
+	return claimRepo.FindByID(ctx, id)
+}
+
+// findEditableClaimInScope is findClaimInScope for changes to the claim, its
+// items or attachments, which are refused once the claim is locked by an
+// issued settlement batch.
+func findEditableClaimInScope(ctx context.Context, claimRepo repository.ClaimRepository, id uuid.UUID,
+) (*entity.Claim, error) {
+	claim, err := findClaimInScope(ctx, claimRepo, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim.IsLocked() {
+		return nil, apperror.ErrClaimLocked
+	}
+	return claim, nil
+}
+
+// scopeClaimFilters restricts filters to the office of the actor carried by
+// ctx, overriding any office requested by an office scoped caller.
+func scopeClaimFilters(ctx context.Context, filters repository.ClaimFilters) (repository.ClaimFilters, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return filters, apperror.ErrMissingUserID
+	}
+
+	if actor.IsOfficeScoped() {
+		officeID := actor.OfficeID
+		filters.OfficeID = &officeID
+	}
+	return filters, nil
+}
@@ -3,12 +3,15 @@ package service
 import (
 	"context"
 	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
 	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/application/workflow"
 	"ev-warranty-go/internal/domain/entity"
-	"ev-warranty-go/internal/infrastructure/cloudinary"
+	"ev-warranty-go/internal/infrastructure/storage"
 	"ev-warranty-go/pkg/apperror"
 	"ev-warranty-go/pkg/logger"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -27,21 +30,51 @@ type UpdateClaimCommand struct {
 	Description string
 }
 
+// ReasonCommand carries the reason given for a claim or claim item decision.
+type ReasonCommand struct {
+	ReasonCode string
+	Note       string
+}
+
+// ClaimReviewStats summarizes the item decisions a review was completed with.
+type ClaimReviewStats struct {
+	TotalItems    int `json:"total_items"`
+	ApprovedItems int `json:"approved_items"`
+	RejectedItems int `json:"rejected_items"`
+	// UncoveredItems are items whose part category the warranty policy does
+	// not cover, of which UncoveredApproved were approved regardless.
+	UncoveredItems    int `json:"uncovered_items"`
+	UncoveredApproved int `json:"uncovered_approved"`
+}
+
 type ClaimService interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Claim, error)
-	GetAll(ctx context.Context) ([]*entity.Claim, error)
-
-	Create(tx application.Tx, cmd *CreateClaimCommand) (*entity.Claim, error)
+	GetAll(ctx context.Context, filters repository.ClaimFilters, pagination repository.Pagination,
+	) ([]*entity.Claim, int64, error)
+	// Export prepares a csv or xlsx export of the claims matching filters,
+	// one row per claim item.
+	Export(ctx context.Context, filters repository.ClaimFilters, format string) (*ClaimExport, error)
+	// Stream subscribes the current user to the live updates of the claims
+	// they can see, resuming after lastEventID unless it is 0.
+	Stream(ctx context.Context, lastEventID uint64) (*claimstream.Subscription, error)
+
+	Create(tx application.Tx, cmd *CreateClaimCommand, authToken string) (*entity.Claim, error)
 	Update(tx application.Tx, id uuid.UUID, cmd *UpdateClaimCommand) error
 	HardDelete(tx application.Tx, id uuid.UUID) error
 	SoftDelete(tx application.Tx, id uuid.UUID) error
 
-	UpdateStatus(tx application.Tx, id uuid.UUID, status string, changedBy uuid.UUID) error
 	Submit(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error
-	DoneReview(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error
+	Review(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error
+	Cancel(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *ReasonCommand) error
+	DoneReview(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *ReasonCommand,
+	) (*ClaimReviewStats, error)
 	Complete(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error
+	GetAvailableActions(ctx context.Context, id uuid.UUID) ([]string, error)
 
 	GetHistory(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimHistory, error)
+	GetAuditLog(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAuditLog, error)
+	GetRejectionReasons(ctx context.Context, filters repository.RejectionReasonFilters,
+	) ([]*repository.ReasonCodeCount, error)
 }
 
 type claimService struct {
@@ -51,7 +84,14 @@ type claimService struct {
 	itemRepo       repository.ClaimItemRepository
 	attachmentRepo repository.ClaimAttachmentRepository
 	historyRepo    repository.ClaimHistoryRepository
-	cloudService   cloudinary.CloudinaryService
+	auditRepo      repository.ClaimAuditLogRepository
+	outboxRepo     repository.OutboxEventRepository
+	fileStorage    storage.AttachmentStorage
+	workflow       workflow.Engine
+	warranty       WarrantyService
+	sla            SLAService
+	costCfg        CostConfig
+	stream         claimstream.Broker
 }
 
 func NewClaimService(
@@ -61,7 +101,14 @@ func NewClaimService(
 	itemRepo repository.ClaimItemRepository,
 	attachmentRepo repository.ClaimAttachmentRepository,
 	historyRepo repository.ClaimHistoryRepository,
-	cloudService cloudinary.CloudinaryService,
+	auditRepo repository.ClaimAuditLogRepository,
+	outboxRepo repository.OutboxEventRepository,
+	fileStorage storage.AttachmentStorage,
+	claimWorkflow workflow.Engine,
+	warranty WarrantyService,
+	sla SLAService,
+	costCfg CostConfig,
+	stream claimstream.Broker,
 ) ClaimService {
 	return &claimService{
 		log:            log,
@@ -70,24 +117,74 @@ func NewClaimService(
 		itemRepo:       itemRepo,
 		attachmentRepo: attachmentRepo,
 		historyRepo:    historyRepo,
-		cloudService:   cloudService,
+		auditRepo:      auditRepo,
+		outboxRepo:     outboxRepo,
+		fileStorage:    fileStorage,
+		workflow:       claimWorkflow,
+		warranty:       warranty,
+		sla:            sla,
+		costCfg:        costCfg,
+		stream:         stream,
 	}
 }
 
 func (s *claimService) GetByID(ctx context.Context, id uuid.UUID) (*entity.Claim, error) {
-	return s.claimRepo.FindByID(ctx, id)
+	claim, err := findClaimInScope(ctx, s.claimRepo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.sla.Attach(ctx, claim); err != nil {
+		return nil, err
+	}
+	return claim, nil
 }
 
-func (s *claimService) GetAll(ctx context.Context) ([]*entity.Claim, error) {
-	claims, err := s.claimRepo.FindAll(ctx)
+func (s *claimService) GetAll(ctx context.Context, filters repository.ClaimFilters,
+	pagination repository.Pagination,
+) ([]*entity.Claim, int64, error) {
+	if filters.Status != nil && !entity.IsValidClaimStatus(*filters.Status) {
+		return nil, 0, apperror.ErrInvalidInput.WithMessage("Invalid claim status")
+	}
+	if filters.SLAState != nil && !entity.IsValidClaimSLAState(*filters.SLAState) {
+		return nil, 0, apperror.ErrInvalidInput.WithMessage("SLA state must be ON_TRACK or BREACHED")
+	}
+	if filters.FromDate != nil && filters.ToDate != nil && filters.FromDate.After(*filters.ToDate) {
+		return nil, 0, apperror.ErrInvalidInput.WithMessage("From date must be before to date")
+	}
+	if pagination.Page < 1 {
+		return nil, 0, apperror.ErrInvalidInput.WithMessage("Page must be at least 1")
+	}
+	if pagination.PageSize < 1 || pagination.PageSize > repository.MaxPageSize {
+		return nil, 0, apperror.ErrInvalidInput.
+			WithMessage(fmt.Sprintf("Page size must be between 1 and %d", repository.MaxPageSize))
+	}
+	if !repository.IsValidClaimSortField(pagination.SortBy) {
+		return nil, 0, apperror.ErrInvalidInput.WithMessage("Invalid sort field")
+	}
+	if !repository.IsValidSortDir(pagination.SortDir) {
+		return nil, 0, apperror.ErrInvalidInput.WithMessage("Invalid sort direction")
+	}
+
+	filters, err := scopeClaimFilters(ctx, filters)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return claims, err
+	claims, total, err := s.claimRepo.FindAll(ctx, filters, pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err = s.sla.Attach(ctx, claims...); err != nil {
+		return nil, 0, err
+	}
+
+	return claims, total, nil
 }
 
-func (s *claimService) Create(tx application.Tx, cmd *CreateClaimCommand) (*entity.Claim, error) {
+func (s *claimService) Create(tx application.Tx, cmd *CreateClaimCommand, authToken string,
+) (*entity.Claim, error) {
 	staff, err := s.userRepo.FindByID(tx.GetCtx(), cmd.StaffID)
 	if err != nil {
 		return nil, err
@@ -116,8 +213,14 @@ func (s *claimService) Create(tx application.Tx, cmd *CreateClaimCommand) (*enti
 		return nil, apperror.ErrTechnicianWorkloadExceed
 	}
 
+	snapshot, err := s.warranty.Check(tx.GetCtx(), cmd.VehicleID, cmd.CustomerID, cmd.Kilometers, authToken)
+	if err != nil {
+		return nil, err
+	}
+
 	claim := entity.NewClaim(cmd.VehicleID, cmd.CustomerID, cmd.Kilometers, cmd.Description,
-		cmd.StaffID, cmd.TechnicianID)
+		cmd.StaffID, cmd.TechnicianID, s.costCfg.Currency)
+	claim.Warranty = snapshot
 
 	if err := s.claimRepo.Create(tx, claim); err != nil {
 		return nil, err
@@ -129,11 +232,23 @@ func (s *claimService) Create(tx application.Tx, cmd *CreateClaimCommand) (*enti
 		return nil, err
 	}
 
+	err = recordAudit(tx, s.auditRepo, claim.ID, entity.AuditEntityClaim, claim.ID, entity.AuditActionCreate,
+		nil, claim)
+	if err != nil {
+		return nil, err
+	}
+
+	err = publishClaimEvent(tx, s.outboxRepo, claim.ID, entity.EventClaimCreated, claimEventData{Claim: claim})
+	if err != nil {
+		return nil, err
+	}
+	streamClaimUpdate(tx, s.stream, claimstream.NewUpdate(entity.EventClaimCreated, claim))
+
 	return claim, nil
 }
 
 func (s *claimService) Update(tx application.Tx, id uuid.UUID, cmd *UpdateClaimCommand) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), id)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, id)
 	if err != nil {
 		return err
 	}
@@ -142,17 +257,24 @@ func (s *claimService) Update(tx application.Tx, id uuid.UUID, cmd *UpdateClaimC
 		return apperror.ErrInvalidClaimAction.WithMessage("Can only update when status if draft")
 	}
 
+	before := *claim
 	claim.Description = cmd.Description
 
 	if err = s.claimRepo.Update(tx, claim); err != nil {
 		return err
 	}
 
-	return nil
+	err = recordAudit(tx, s.auditRepo, claim.ID, entity.AuditEntityClaim, claim.ID, entity.AuditActionUpdate,
+		before, claim)
+	if err != nil {
+		return err
+	}
+
+	return publishClaimEvent(tx, s.outboxRepo, claim.ID, entity.EventClaimUpdated, claimEventData{Claim: claim})
 }
 
 func (s *claimService) HardDelete(tx application.Tx, id uuid.UUID) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), id)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, id)
 	if err != nil {
 		return err
 	}
@@ -167,11 +289,20 @@ func (s *claimService) HardDelete(tx application.Tx, id uuid.UUID) error {
 	}
 
 	err = s.claimRepo.HardDelete(tx, id)
+	if err == nil {
+		err = recordAudit(tx, s.auditRepo, claim.ID, entity.AuditEntityClaim, claim.ID, entity.AuditActionDelete,
+			claim, nil)
+	}
+	if err == nil {
+		err = publishClaimEvent(tx, s.outboxRepo, claim.ID, entity.EventClaimDeleted, claimEventData{Claim: claim})
+	}
 	if err == nil {
 		for _, attach := range attachments {
-			err := s.cloudService.DeleteFileByURL(context.Background(), attach.URL)
-			if err != nil {
-				s.log.Error("[Cloudinary] Failed to delete file in delete claim use case", "error", err)
+			for _, key := range attach.StorageKeys() {
+				err := s.fileStorage.DeleteFile(context.Background(), key)
+				if err != nil {
+					s.log.Error("[Storage] Failed to delete file in delete claim use case", "error", err)
+				}
 			}
 		}
 	}
@@ -179,7 +310,7 @@ func (s *claimService) HardDelete(tx application.Tx, id uuid.UUID) error {
 }
 
 func (s *claimService) SoftDelete(tx application.Tx, id uuid.UUID) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), id)
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, id)
 	if err != nil {
 		return err
 	}
@@ -201,152 +332,193 @@ func (s *claimService) SoftDelete(tx application.Tx, id uuid.UUID) error {
 		}
 	}
 
-	return nil
+	err = recordAudit(tx, s.auditRepo, claim.ID, entity.AuditEntityClaim, claim.ID, entity.AuditActionDelete,
+		claim, nil)
+	if err != nil {
+		return err
+	}
+
+	return publishClaimEvent(tx, s.outboxRepo, claim.ID, entity.EventClaimDeleted, claimEventData{Claim: claim})
 }
 
-func (s *claimService) UpdateStatus(tx application.Tx, id uuid.UUID, status string, changedBy uuid.UUID,
-) error {
-	if !entity.IsValidClaimStatus(status) {
-		return apperror.ErrInvalidInput.WithMessage("Invalid claim status")
-	}
+func (s *claimService) Submit(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
+	return s.applyAction(tx, id, workflow.ActionSubmit, changedBy, nil, claimEventData{})
+}
 
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), id)
+func (s *claimService) Review(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
+	return s.applyAction(tx, id, workflow.ActionReview, changedBy, nil, claimEventData{})
+}
+
+func (s *claimService) Cancel(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *ReasonCommand) error {
+	return s.applyAction(tx, id, workflow.ActionCancel, changedBy, reason, claimEventData{})
+}
+
+func (s *claimService) DoneReview(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *ReasonCommand,
+) (*ClaimReviewStats, error) {
+	items, err := s.itemRepo.FindByClaimID(tx.GetCtx(), id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !entity.IsValidClaimStatusTransition(claim.Status, status) {
-		return apperror.ErrInvalidClaimAction.WithMessage("This action are not allowed")
+	stats := &ClaimReviewStats{TotalItems: len(items)}
+	for _, item := range items {
+		approved := item.Status == entity.ClaimItemStatusApproved
+		switch item.Status {
+		case entity.ClaimItemStatusApproved:
+			stats.ApprovedItems++
+		case entity.ClaimItemStatusRejected:
+			stats.RejectedItems++
+		}
+		if item.Coverage.IsUncovered() {
+			stats.UncoveredItems++
+			if approved {
+				stats.UncoveredApproved++
+			}
+		}
 	}
 
-	err = s.claimRepo.UpdateStatus(tx, id, status)
+	err = s.applyAction(tx, id, workflow.ActionDoneReview, changedBy, reason, claimEventData{Review: stats})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	history := entity.NewClaimHistory(claim.ID, status, changedBy)
-	if err = s.historyRepo.Create(tx, history); err != nil {
-		return err
+	return stats, nil
+}
+
+func (s *claimService) Complete(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
+	return s.applyAction(tx, id, workflow.ActionComplete, changedBy, nil, claimEventData{})
+}
+
+func (s *claimService) GetAvailableActions(ctx context.Context, id uuid.UUID) ([]string, error) {
+	claim, err := findClaimInScope(ctx, s.claimRepo, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	actor, _ := application.ActorFromContext(ctx)
+	return s.workflow.AvailableActions(ctx, claim, actor.Role)
 }
 
-func (s *claimService) Submit(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), id)
+// applyAction moves the claim to the status the workflow resolves for action
+// and records the change, with its reason, in the claim history. The claim's
+// SLA follows the new status. data carries what the action adds to the
+// published event.
+func (s *claimService) applyAction(tx application.Tx, id uuid.UUID, action string, changedBy uuid.UUID,
+	reason *ReasonCommand, data claimEventData,
+) error {
+	claim, err := findEditableClaimInScope(tx.GetCtx(), s.claimRepo, id)
 	if err != nil {
 		return err
 	}
 
-	if !entity.IsValidClaimStatusTransition(claim.Status, entity.ClaimStatusSubmitted) {
-		return apperror.ErrInvalidClaimAction.WithMessage("Invalid claim action")
+	actor, _ := application.ActorFromContext(tx.GetCtx())
+	transition, err := s.workflow.Fire(tx.GetCtx(), claim, actor.Role, action)
+	if err != nil {
+		return err
+	}
+	if err = validateReason(reason, transition.RequireReason); err != nil {
+		return err
 	}
 
-	items, err := s.itemRepo.FindByClaimID(tx.GetCtx(), id)
+	err = s.claimRepo.UpdateStatus(tx, id, transition.To)
 	if err != nil {
 		return err
 	}
-	attachments, err := s.attachmentRepo.FindByClaimID(tx.GetCtx(), id)
+
+	before := *claim
+	claim.Status = transition.To
+	err = recordAudit(tx, s.auditRepo, claim.ID, entity.AuditEntityClaim, claim.ID, entity.AuditActionUpdate,
+		before, claim)
 	if err != nil {
 		return err
 	}
 
-	if len(items) < entity.MinItemPerClaim {
-		return apperror.ErrMissingInformationClaim.
-			WithMessage(fmt.Sprintf("Claim item must be atleast %d", entity.MinItemPerClaim))
+	history := entity.NewClaimHistory(claim.ID, transition.To, changedBy)
+	data.Claim, data.PreviousStatus = claim, before.Status
+	if reason != nil {
+		history.SetReason(reason.ReasonCode, strings.TrimSpace(reason.Note))
+		data.ReasonCode, data.Note = reason.ReasonCode, strings.TrimSpace(reason.Note)
 	}
-	if len(attachments) < entity.MinAttachmentPerClaim {
-		return apperror.ErrMissingInformationClaim.
-			WithMessage(fmt.Sprintf("Claim attachment must be atleast %d", entity.MinAttachmentPerClaim))
+	if err = s.historyRepo.Create(tx, history); err != nil {
+		return err
 	}
-
-	err = s.claimRepo.UpdateStatus(tx, id, entity.ClaimStatusSubmitted)
-	if err != nil {
+	if err = s.sla.Track(tx, claim); err != nil {
 		return err
 	}
 
-	history := entity.NewClaimHistory(claim.ID, entity.ClaimStatusSubmitted, changedBy)
-	if err = s.historyRepo.Create(tx, history); err != nil {
+	eventType := claimStatusEvent(transition.To)
+	if err = publishClaimEvent(tx, s.outboxRepo, claim.ID, eventType, data); err != nil {
 		return err
 	}
 
+	update := claimstream.NewUpdate(eventType, claim)
+	update.PreviousStatus = before.Status
+	streamClaimUpdate(tx, s.stream, update)
 	return nil
 }
 
-func (s *claimService) DoneReview(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), id)
-	if err != nil {
-		return err
+func (s *claimService) GetHistory(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimHistory, error) {
+	if _, err := findClaimInScope(ctx, s.claimRepo, claimID); err != nil {
+		return nil, err
 	}
 
-	items, err := s.itemRepo.FindByClaimID(tx.GetCtx(), id)
+	histories, err := s.historyRepo.FindByClaimID(ctx, claimID)
 	if err != nil {
-		return err
-	}
-
-	approvedCount := 0
-	for _, item := range items {
-		switch item.Status {
-		case entity.ClaimItemStatusApproved:
-			approvedCount++
-		case entity.ClaimItemStatusRejected:
-		default:
-			return apperror.ErrInvalidClaimAction.WithMessage("Can only complete when all item are approved or rejected")
-		}
+		return nil, err
 	}
 
-	newStatus := entity.ClaimStatusPartiallyApproved
-	if approvedCount == len(items) {
-		newStatus = entity.ClaimStatusApproved
-	} else if approvedCount == 0 {
-		newStatus = entity.ClaimStatusRejected
-	}
+	return histories, nil
+}
 
-	if !entity.IsValidClaimStatusTransition(claim.Status, newStatus) {
-		return apperror.ErrInvalidClaimAction.WithMessage("This action are not allowed")
+func (s *claimService) GetAuditLog(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAuditLog, error) {
+	if _, err := findClaimInScope(ctx, s.claimRepo, claimID); err != nil {
+		return nil, err
 	}
 
-	err = s.claimRepo.UpdateStatus(tx, id, newStatus)
+	auditLogs, err := s.auditRepo.FindByClaimID(ctx, claimID)
 	if err != nil {
-		return err
-	}
-
-	history := entity.NewClaimHistory(claim.ID, newStatus, changedBy)
-	if err = s.historyRepo.Create(tx, history); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return auditLogs, nil
 }
 
-func (s *claimService) Complete(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
-	claim, err := s.claimRepo.FindByID(tx.GetCtx(), id)
-	if err != nil {
-		return err
+func (s *claimService) GetRejectionReasons(ctx context.Context, filters repository.RejectionReasonFilters,
+) ([]*repository.ReasonCodeCount, error) {
+	if filters.FromDate != nil && filters.ToDate != nil && filters.FromDate.After(*filters.ToDate) {
+		return nil, apperror.ErrInvalidInput.WithMessage("From date must be before to date")
 	}
-
-	if !entity.IsValidClaimStatusTransition(claim.Status, entity.ClaimStatusCompleted) {
-		return apperror.ErrInvalidInput.WithMessage("This action are not allowed")
+	if filters.Limit < 1 || filters.Limit > repository.MaxRejectionReasonLimit {
+		return nil, apperror.ErrInvalidInput.
+			WithMessage(fmt.Sprintf("Limit must be between 1 and %d", repository.MaxRejectionReasonLimit))
 	}
 
-	err = s.claimRepo.UpdateStatus(tx, id, entity.ClaimStatusCompleted)
+	claimFilters, err := scopeClaimFilters(ctx, repository.ClaimFilters{OfficeID: filters.OfficeID})
 	if err != nil {
-		return err
-	}
-
-	history := entity.NewClaimHistory(claim.ID, entity.ClaimStatusCompleted, changedBy)
-	if err = s.historyRepo.Create(tx, history); err != nil {
-		return err
+		return nil, err
 	}
+	filters.OfficeID = claimFilters.OfficeID
 
-	return nil
+	return s.historyRepo.CountRejectionReasons(ctx, filters)
 }
 
-func (s *claimService) GetHistory(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimHistory, error) {
-	histories, err := s.historyRepo.FindByClaimID(ctx, claimID)
-	if err != nil {
-		return nil, err
+// validateReason checks the reason given for a decision. A required reason
+// needs a known reason code and a note, an optional one may be omitted but
+// must still use a known reason code when it names one.
+func validateReason(reason *ReasonCommand, required bool) error {
+	if reason == nil || (reason.ReasonCode == "" && strings.TrimSpace(reason.Note) == "") {
+		if required {
+			return apperror.ErrInvalidInput.WithMessage("Reason code and note are required")
+		}
+		return nil
 	}
 
-	return histories, nil
+	if reason.ReasonCode != "" && !entity.IsValidReasonCode(reason.ReasonCode) {
+		return apperror.ErrInvalidInput.WithMessage("Invalid reason code")
+	}
+	if required && (reason.ReasonCode == "" || strings.TrimSpace(reason.Note) == "") {
+		return apperror.ErrInvalidInput.WithMessage("Reason code and note are required")
+	}
+
+	return nil
 }
@@ -1,9 +1,18 @@
 package service_test
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/csv"
 	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
+	"ev-warranty-go/internal/application/repository"
 	"ev-warranty-go/pkg/apperror"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -11,6 +20,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/application/workflow"
 	"ev-warranty-go/internal/domain/entity"
 	"ev-warranty-go/pkg/mocks"
 )
@@ -23,7 +33,13 @@ var _ = Describe("ClaimService", func() {
 		mockItemRepo   *mocks.ClaimItemRepository
 		mockAttachRepo *mocks.ClaimAttachmentRepository
 		mockHistRepo   *mocks.ClaimHistoryRepository
-		mockCloudServ  *mocks.CloudinaryService
+		mockAuditRepo  *mocks.ClaimAuditLogRepository
+		mockOutbox     *mocks.OutboxEventRepository
+		mockStorage    *mocks.AttachmentStorage
+		mockWorkflow   *mocks.Engine
+		mockWarranty   *mocks.WarrantyService
+		mockSLA        *mocks.SLAService
+		mockStream     *mocks.Broker
 		mockTx         *mocks.Tx
 		claimService   service.ClaimService
 		ctx            context.Context
@@ -36,11 +52,21 @@ var _ = Describe("ClaimService", func() {
 		mockItemRepo = mocks.NewClaimItemRepository(GinkgoT())
 		mockAttachRepo = mocks.NewClaimAttachmentRepository(GinkgoT())
 		mockHistRepo = mocks.NewClaimHistoryRepository(GinkgoT())
-		mockCloudServ = mocks.NewCloudinaryService(GinkgoT())
+		mockAuditRepo = mocks.NewClaimAuditLogRepository(GinkgoT())
+		mockOutbox = mocks.NewOutboxEventRepository(GinkgoT())
+		mockStorage = mocks.NewAttachmentStorage(GinkgoT())
+		mockWorkflow = mocks.NewEngine(GinkgoT())
+		mockWarranty = mocks.NewWarrantyService(GinkgoT())
+		mockSLA = mocks.NewSLAService(GinkgoT())
+		mockStream = mocks.NewBroker(GinkgoT())
 		mockTx = mocks.NewTx(GinkgoT())
 		claimService = service.NewClaimService(mockLogger, mockClaimRepo, mockUserRepo, mockItemRepo, mockAttachRepo,
-			mockHistRepo, mockCloudServ)
-		ctx = context.Background()
+			mockHistRepo, mockAuditRepo, mockOutbox, mockStorage, mockWorkflow, mockWarranty, mockSLA,
+			service.CostConfig{Currency: entity.DefaultCurrency}, mockStream)
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: uuid.New(),
+			Role:   entity.UserRoleAdmin,
+		})
 	})
 
 	Describe("GetByID", func() {
@@ -61,6 +87,7 @@ var _ = Describe("ClaimService", func() {
 				}
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(expectedClaim, nil).Once()
+				mockSLA.EXPECT().Attach(ctx, expectedClaim).Return(nil).Once()
 
 				claim, err := claimService.GetByID(ctx, claimID)
 
@@ -71,6 +98,30 @@ var _ = Describe("ClaimService", func() {
 			})
 		})
 
+		Context("when the claim is held to an SLA", func() {
+			It("should return the claim with its SLA state", func() {
+				expectedClaim := &entity.Claim{ID: claimID, Status: entity.ClaimStatusSubmitted}
+				state := &entity.ClaimSLAState{
+					Status:           entity.ClaimStatusSubmitted,
+					DueAt:            time.Now().Add(-time.Hour),
+					Breached:         true,
+					RemainingSeconds: -3600,
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(expectedClaim, nil).Once()
+				mockSLA.EXPECT().Attach(ctx, expectedClaim).
+					Run(func(_ context.Context, claims ...*entity.Claim) {
+						claims[0].SLA = state
+					}).
+					Return(nil).Once()
+
+				claim, err := claimService.GetByID(ctx, claimID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claim.SLA).To(Equal(state))
+			})
+		})
+
 		Context("when claim is not found", func() {
 			It("should return ClaimNotFound error", func() {
 				notFoundErr := apperror.ErrNotFoundError
@@ -82,34 +133,172 @@ var _ = Describe("ClaimService", func() {
 				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
 			})
 		})
+
+		Context("when caller is office scoped", func() {
+			It("should look up the claim within the caller's office", func() {
+				officeID := uuid.New()
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScTechnician,
+					OfficeID: officeID,
+				})
+				expectedClaim := &entity.Claim{ID: claimID}
+
+				mockClaimRepo.EXPECT().FindByIDInOffice(scopedCtx, claimID, officeID).Return(expectedClaim, nil).Once()
+				mockSLA.EXPECT().Attach(scopedCtx, expectedClaim).Return(nil).Once()
+
+				claim, err := claimService.GetByID(scopedCtx, claimID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claim).To(Equal(expectedClaim))
+			})
+		})
+
+		Context("when caller is missing", func() {
+			It("should return MissingUserID error", func() {
+				claim, err := claimService.GetByID(context.Background(), claimID)
+
+				Expect(claim).To(BeNil())
+				ExpectAppError(err, apperror.ErrMissingUserID.ErrorCode)
+			})
+		})
 	})
 
 	Describe("GetAll", func() {
+		var (
+			filters    repository.ClaimFilters
+			pagination repository.Pagination
+		)
+
+		BeforeEach(func() {
+			filters = repository.ClaimFilters{}
+			pagination = repository.Pagination{
+				Page:     1,
+				PageSize: 20,
+				SortBy:   repository.ClaimSortByCreatedAt,
+				SortDir:  repository.SortDirDesc,
+			}
+		})
+
 		Context("when claims are found", func() {
-			It("should return all claims", func() {
+			It("should return claims and total", func() {
+				status := entity.ClaimStatusSubmitted
+				filters.Status = &status
 				expectedClaims := []*entity.Claim{
-					{ID: uuid.New(), Status: entity.ClaimStatusDraft},
+					{ID: uuid.New(), Status: entity.ClaimStatusSubmitted},
 					{ID: uuid.New(), Status: entity.ClaimStatusSubmitted},
 				}
 
-				mockClaimRepo.EXPECT().FindAll(ctx).Return(expectedClaims, nil).Once()
+				mockClaimRepo.EXPECT().FindAll(ctx, filters, pagination).Return(expectedClaims, int64(42), nil).Once()
+				mockSLA.EXPECT().Attach(ctx, expectedClaims[0], expectedClaims[1]).Return(nil).Once()
 
-				claims, err := claimService.GetAll(ctx)
+				claims, total, err := claimService.GetAll(ctx, filters, pagination)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(claims).NotTo(BeNil())
 				Expect(claims).To(HaveLen(2))
+				Expect(total).To(Equal(int64(42)))
+			})
+		})
+
+		Context("when caller is office scoped", func() {
+			It("should restrict the filters to the caller's office", func() {
+				officeID := uuid.New()
+				requestedOfficeID := uuid.New()
+				filters.OfficeID = &requestedOfficeID
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+				scopedFilters := repository.ClaimFilters{OfficeID: &officeID}
+
+				mockClaimRepo.EXPECT().FindAll(scopedCtx, scopedFilters, pagination).
+					Return([]*entity.Claim{}, int64(0), nil).Once()
+				mockSLA.EXPECT().Attach(scopedCtx).Return(nil).Once()
+
+				_, _, err := claimService.GetAll(scopedCtx, filters, pagination)
+
+				Expect(err).NotTo(HaveOccurred())
 			})
 		})
 
 		Context("when no claims are found", func() {
 			It("should return empty slice", func() {
-				mockClaimRepo.EXPECT().FindAll(ctx).Return([]*entity.Claim{}, nil).Once()
+				mockClaimRepo.EXPECT().FindAll(ctx, filters, pagination).Return([]*entity.Claim{}, int64(0), nil).Once()
+				mockSLA.EXPECT().Attach(ctx).Return(nil).Once()
 
-				claims, err := claimService.GetAll(ctx)
+				claims, total, err := claimService.GetAll(ctx, filters, pagination)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(claims).To(BeEmpty())
+				Expect(total).To(BeZero())
+			})
+		})
+
+		Context("when SLA state filter is invalid", func() {
+			It("should return InvalidInput error", func() {
+				slaState := "LATE"
+				filters.SLAState = &slaState
+
+				claims, _, err := claimService.GetAll(ctx, filters, pagination)
+
+				Expect(claims).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when status filter is invalid", func() {
+			It("should return InvalidInput error", func() {
+				status := "UNKNOWN"
+				filters.Status = &status
+
+				claims, _, err := claimService.GetAll(ctx, filters, pagination)
+
+				Expect(claims).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when date range is inverted", func() {
+			It("should return InvalidInput error", func() {
+				from := time.Now()
+				to := from.Add(-time.Hour)
+				filters.FromDate = &from
+				filters.ToDate = &to
+
+				_, _, err := claimService.GetAll(ctx, filters, pagination)
+
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when page size exceeds the maximum", func() {
+			It("should return InvalidInput error", func() {
+				pagination.PageSize = repository.MaxPageSize + 1
+
+				_, _, err := claimService.GetAll(ctx, filters, pagination)
+
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when sort field is not allowed", func() {
+			It("should return InvalidInput error", func() {
+				pagination.SortBy = "description; DROP TABLE claims"
+
+				_, _, err := claimService.GetAll(ctx, filters, pagination)
+
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when sort direction is not allowed", func() {
+			It("should return InvalidInput error", func() {
+				pagination.SortDir = "sideways"
+
+				_, _, err := claimService.GetAll(ctx, filters, pagination)
+
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
 			})
 		})
 
@@ -117,9 +306,9 @@ var _ = Describe("ClaimService", func() {
 			It("should return the error", func() {
 				dbErr := apperror.ErrDBOperation
 
-				mockClaimRepo.EXPECT().FindAll(ctx).Return(nil, dbErr).Once()
+				mockClaimRepo.EXPECT().FindAll(ctx, filters, pagination).Return(nil, int64(0), dbErr).Once()
 
-				claims, err := claimService.GetAll(ctx)
+				claims, _, err := claimService.GetAll(ctx, filters, pagination)
 
 				Expect(err).To(HaveOccurred())
 				Expect(claims).To(BeNil())
@@ -128,8 +317,238 @@ var _ = Describe("ClaimService", func() {
 		})
 	})
 
+	Describe("Export", func() {
+		var (
+			row    *repository.ClaimExportRow
+			stream func(ctx context.Context, filters repository.ClaimFilters,
+				fn func(*repository.ClaimExportRow) error)
+		)
+
+		BeforeEach(func() {
+			submittedAt := time.Date(2026, 9, 2, 8, 30, 0, 0, time.UTC)
+			itemID := uuid.New()
+			serial := "SN-001"
+			cost := int64(1500000)
+			row = &repository.ClaimExportRow{
+				ClaimID:          uuid.New(),
+				Description:      "Battery, overheating",
+				ClaimStatus:      entity.ClaimStatusSubmitted,
+				Currency:         entity.DefaultCurrency,
+				RequestedTotal:   cost,
+				CreatedAt:        time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC),
+				AttachmentCount:  2,
+				SubmittedAt:      &submittedAt,
+				ItemID:           &itemID,
+				FaultyPartSerial: &serial,
+				ItemCost:         &cost,
+			}
+			stream = func(_ context.Context, _ repository.ClaimFilters, fn func(*repository.ClaimExportRow) error) {
+				Expect(fn(row)).To(Succeed())
+			}
+		})
+
+		Context("when a CSV export is streamed", func() {
+			It("should write a header and a row per claim item", func() {
+				mockClaimRepo.EXPECT().Export(ctx, repository.ClaimFilters{}, mock.Anything).
+					Run(stream).Return(nil).Once()
+
+				export, err := claimService.Export(ctx, repository.ClaimFilters{}, service.ExportFormatCSV)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(export.ContentType).To(Equal("text/csv"))
+				Expect(export.Filename).To(HaveSuffix(".csv"))
+
+				var out strings.Builder
+				Expect(export.Stream(&out)).To(Succeed())
+
+				lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+				Expect(lines).To(HaveLen(2))
+				Expect(lines[0]).To(HavePrefix("claim_id,customer_id,vehicle_id,kilometers,description"))
+				Expect(lines[1]).To(ContainSubstring(`,"Battery, overheating",SUBMITTED,VND,1500000,`))
+				Expect(lines[1]).To(ContainSubstring(",2026-09-01T10:00:00Z,2,2026-09-02T08:30:00Z,,,,,,"))
+				Expect(lines[1]).To(HaveSuffix(",SN-001,,,1500000"))
+			})
+		})
+
+		Context("when an XLSX export is streamed", func() {
+			It("should write a zipped workbook", func() {
+				mockClaimRepo.EXPECT().Export(ctx, repository.ClaimFilters{}, mock.Anything).
+					Run(stream).Return(nil).Once()
+
+				export, err := claimService.Export(ctx, repository.ClaimFilters{}, service.ExportFormatXLSX)
+				Expect(err).NotTo(HaveOccurred())
+
+				var out strings.Builder
+				Expect(export.Stream(&out)).To(Succeed())
+				Expect(out.String()).To(HavePrefix("PK"))
+			})
+		})
+
+		Context("when every field of a row is set", func() {
+			It("should write a value in every CSV column", func() {
+				partCategoryID := uuid.New()
+				itemType, itemStatus := entity.ClaimItemTypeReplacement, entity.ClaimItemStatusPending
+				at := time.Date(2026, 9, 3, 9, 0, 0, 0, time.UTC)
+				row.CustomerID, row.VehicleID, row.StaffID, row.TechnicianID = uuid.New(), uuid.New(), uuid.New(),
+					uuid.New()
+				row.Kilometers, row.ApprovedTotal, row.ReimbursedTotal = 12000, 1000, 1000
+				row.ReviewingAt, row.ApprovedAt, row.RejectedAt, row.CancelledAt, row.CompletedAt = &at, &at, &at,
+					&at, &at
+				row.PartCategoryID, row.ItemType, row.ItemStatus = &partCategoryID, &itemType, &itemStatus
+				mockClaimRepo.EXPECT().Export(ctx, repository.ClaimFilters{}, mock.Anything).
+					Run(stream).Return(nil).Once()
+
+				export, err := claimService.Export(ctx, repository.ClaimFilters{}, service.ExportFormatCSV)
+				Expect(err).NotTo(HaveOccurred())
+
+				var out strings.Builder
+				Expect(export.Stream(&out)).To(Succeed())
+
+				records, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(HaveLen(2))
+				Expect(records[1]).To(HaveLen(len(records[0])))
+				Expect(records[1]).NotTo(ContainElement(""))
+			})
+		})
+
+		Context("when user text starts like a formula", func() {
+			BeforeEach(func() {
+				serial := "@SUM(A1:A9)"
+				row.Description = "=HYPERLINK(\"https://example.com\")"
+				row.FaultyPartSerial = &serial
+			})
+
+			It("should escape it in a CSV export", func() {
+				mockClaimRepo.EXPECT().Export(ctx, repository.ClaimFilters{}, mock.Anything).
+					Run(stream).Return(nil).Once()
+
+				export, err := claimService.Export(ctx, repository.ClaimFilters{}, service.ExportFormatCSV)
+				Expect(err).NotTo(HaveOccurred())
+
+				var out strings.Builder
+				Expect(export.Stream(&out)).To(Succeed())
+
+				records, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records[1]).To(ContainElement("'=HYPERLINK(\"https://example.com\")"))
+				Expect(records[1]).To(ContainElement("'@SUM(A1:A9)"))
+			})
+
+			It("should keep it unchanged in an XLSX export", func() {
+				mockClaimRepo.EXPECT().Export(ctx, repository.ClaimFilters{}, mock.Anything).
+					Run(stream).Return(nil).Once()
+
+				export, err := claimService.Export(ctx, repository.ClaimFilters{}, service.ExportFormatXLSX)
+				Expect(err).NotTo(HaveOccurred())
+
+				var out bytes.Buffer
+				Expect(export.Stream(&out)).To(Succeed())
+
+				workbook, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+				Expect(err).NotTo(HaveOccurred())
+				sheet, err := workbook.Open("xl/worksheets/sheet1.xml")
+				Expect(err).NotTo(HaveOccurred())
+				data, err := io.ReadAll(sheet)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(data)).To(ContainSubstring(`">=HYPERLINK(&#34;https://example.com&#34;)</t>`))
+				Expect(string(data)).To(ContainSubstring(`">@SUM(A1:A9)</t>`))
+				Expect(string(data)).NotTo(ContainSubstring("&#39;"))
+			})
+		})
+
+		Context("when an office scoped actor exports claims", func() {
+			It("should only export the claims of their office", func() {
+				officeID := uuid.New()
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+				mockClaimRepo.EXPECT().Export(scopedCtx, mock.MatchedBy(func(f repository.ClaimFilters) bool {
+					return f.OfficeID != nil && *f.OfficeID == officeID
+				}), mock.Anything).Return(nil).Once()
+
+				export, err := claimService.Export(scopedCtx, repository.ClaimFilters{}, service.ExportFormatCSV)
+				Expect(err).NotTo(HaveOccurred())
+
+				var out strings.Builder
+				Expect(export.Stream(&out)).To(Succeed())
+			})
+		})
+
+		Context("when the format is not supported", func() {
+			It("should return InvalidParams error", func() {
+				export, err := claimService.Export(ctx, repository.ClaimFilters{}, "pdf")
+
+				Expect(export).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidParams.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Stream", func() {
+		Context("when an admin subscribes", func() {
+			It("should stream every claim", func() {
+				subscription := &claimstream.Subscription{}
+				mockStream.EXPECT().Subscribe(uint64(42), mock.MatchedBy(func(f func(*claimstream.Update) bool) bool {
+					return f(&claimstream.Update{StaffID: uuid.New(), TechnicianID: uuid.New()})
+				})).Return(subscription).Once()
+
+				result, err := claimService.Stream(ctx, 42)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(subscription))
+			})
+		})
+
+		Context("when an office scoped actor subscribes", func() {
+			It("should only stream the claims of their office", func() {
+				officeID := uuid.New()
+				technician := &entity.User{ID: uuid.New(), OfficeID: officeID}
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+				mockUserRepo.EXPECT().FindByOfficeID(scopedCtx, officeID).
+					Return([]*entity.User{technician}, nil).Once()
+
+				var filter func(*claimstream.Update) bool
+				mockStream.EXPECT().Subscribe(uint64(0), mock.Anything).
+					Run(func(_ uint64, f func(*claimstream.Update) bool) { filter = f }).
+					Return(&claimstream.Subscription{}).Once()
+
+				_, err := claimService.Stream(scopedCtx, 0)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(filter(&claimstream.Update{StaffID: uuid.New(), TechnicianID: technician.ID})).To(BeTrue())
+				Expect(filter(&claimstream.Update{StaffID: uuid.New(), TechnicianID: uuid.New()})).To(BeFalse())
+			})
+		})
+
+		Context("when the office users cannot be loaded", func() {
+			It("should return the error", func() {
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScTechnician,
+					OfficeID: uuid.New(),
+				})
+				mockUserRepo.EXPECT().FindByOfficeID(scopedCtx, mock.Anything).
+					Return(nil, apperror.ErrDBOperation).Once()
+
+				subscription, err := claimService.Stream(scopedCtx, 0)
+
+				Expect(subscription).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
 	Describe("Create", func() {
-		var cmd *service.CreateClaimCommand
+		var (
+			cmd      *service.CreateClaimCommand
+			snapshot *entity.WarrantySnapshot
+		)
 
 		BeforeEach(func() {
 			cmd = &service.CreateClaimCommand{
@@ -138,8 +557,16 @@ var _ = Describe("ClaimService", func() {
 				StaffID:      uuid.New(),
 				TechnicianID: uuid.New(),
 				OfficeID:     uuid.New(),
+				Kilometers:   12000,
 				Description:  "Test claim",
 			}
+			snapshot = &entity.WarrantySnapshot{
+				Eligible:   true,
+				Reasons:    []string{},
+				VehicleID:  cmd.VehicleID,
+				CustomerID: cmd.CustomerID,
+				Kilometers: cmd.Kilometers,
+			}
 			mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
 		})
 
@@ -159,23 +586,70 @@ var _ = Describe("ClaimService", func() {
 				mockUserRepo.EXPECT().FindByID(ctx, cmd.StaffID).Return(staff, nil).Once()
 				mockUserRepo.EXPECT().FindByID(ctx, cmd.TechnicianID).Return(technician, nil).Once()
 				mockClaimRepo.EXPECT().CountPendingByTechnician(ctx, cmd.TechnicianID).Return(int64(0), nil).Once()
+				mockWarranty.EXPECT().Check(ctx, cmd.VehicleID, cmd.CustomerID, cmd.Kilometers, "token").
+					Return(snapshot, nil).Once()
 				mockClaimRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(c *entity.Claim) bool {
 					return c.VehicleID == cmd.VehicleID &&
 						c.CustomerID == cmd.CustomerID &&
-						c.Description == cmd.Description
+						c.Description == cmd.Description &&
+						c.Warranty == snapshot
 				})).Return(nil).Once()
 
 				mockHistRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(h *entity.ClaimHistory) bool {
 					return h.Status == entity.ClaimStatusDraft &&
 						h.ChangedBy == cmd.StaffID
 				})).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.EntityType == entity.AuditEntityClaim &&
+						l.Action == entity.AuditActionCreate &&
+						l.Before == nil && l.After != nil
+				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, mock.MatchedBy(func(e *entity.OutboxEvent) bool {
+					return e.EventType == entity.EventClaimCreated && e.AggregateType == entity.AggregateTypeClaim
+				})).Return(nil).Once()
+				var onCommit func()
+				mockTx.EXPECT().OnCommit(mock.Anything).Run(func(fn func()) { onCommit = fn }).Once()
 
-				claim, err := claimService.Create(mockTx, cmd)
+				claim, err := claimService.Create(mockTx, cmd, "token")
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(claim).NotTo(BeNil())
 				Expect(claim.VehicleID).To(Equal(cmd.VehicleID))
 				Expect(claim.CustomerID).To(Equal(cmd.CustomerID))
+				Expect(claim.Warranty).To(Equal(snapshot))
+				Expect(claim.Currency).To(Equal(entity.DefaultCurrency))
+
+				mockStream.EXPECT().Publish(mock.MatchedBy(func(u *claimstream.Update) bool {
+					return u.Type == entity.EventClaimCreated && u.ClaimID == claim.ID &&
+						u.StaffID == cmd.StaffID && u.TechnicianID == cmd.TechnicianID
+				})).Once()
+				onCommit()
+			})
+		})
+
+		Context("when the vehicle is not eligible for warranty", func() {
+			It("should not create the claim", func() {
+				staff := &entity.User{
+					ID:       cmd.StaffID,
+					Role:     entity.UserRoleScStaff,
+					OfficeID: cmd.OfficeID,
+				}
+				technician := &entity.User{
+					ID:       cmd.TechnicianID,
+					Role:     entity.UserRoleScTechnician,
+					OfficeID: cmd.OfficeID,
+				}
+
+				mockUserRepo.EXPECT().FindByID(ctx, cmd.StaffID).Return(staff, nil).Once()
+				mockUserRepo.EXPECT().FindByID(ctx, cmd.TechnicianID).Return(technician, nil).Once()
+				mockClaimRepo.EXPECT().CountPendingByTechnician(ctx, cmd.TechnicianID).Return(int64(0), nil).Once()
+				mockWarranty.EXPECT().Check(ctx, cmd.VehicleID, cmd.CustomerID, cmd.Kilometers, "token").
+					Return(nil, apperror.ErrClaimNotEligible).Once()
+
+				claim, err := claimService.Create(mockTx, cmd, "token")
+
+				Expect(claim).To(BeNil())
+				ExpectAppError(err, apperror.ErrClaimNotEligible.ErrorCode)
 			})
 		})
 
@@ -196,9 +670,11 @@ var _ = Describe("ClaimService", func() {
 				mockUserRepo.EXPECT().FindByID(ctx, cmd.StaffID).Return(staff, nil).Once()
 				mockUserRepo.EXPECT().FindByID(ctx, cmd.TechnicianID).Return(technician, nil).Once()
 				mockClaimRepo.EXPECT().CountPendingByTechnician(ctx, cmd.TechnicianID).Return(int64(0), nil).Once()
+				mockWarranty.EXPECT().Check(ctx, cmd.VehicleID, cmd.CustomerID, cmd.Kilometers, "token").
+					Return(snapshot, nil).Once()
 				mockClaimRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.Claim")).Return(dbErr).Once()
 
-				claim, err := claimService.Create(mockTx, cmd)
+				claim, err := claimService.Create(mockTx, cmd, "token")
 
 				Expect(err).To(HaveOccurred())
 				Expect(claim).To(BeNil())
@@ -223,10 +699,12 @@ var _ = Describe("ClaimService", func() {
 				mockUserRepo.EXPECT().FindByID(ctx, cmd.StaffID).Return(staff, nil).Once()
 				mockUserRepo.EXPECT().FindByID(ctx, cmd.TechnicianID).Return(technician, nil).Once()
 				mockClaimRepo.EXPECT().CountPendingByTechnician(ctx, cmd.TechnicianID).Return(int64(0), nil).Once()
+				mockWarranty.EXPECT().Check(ctx, cmd.VehicleID, cmd.CustomerID, cmd.Kilometers, "token").
+					Return(snapshot, nil).Once()
 				mockClaimRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.Claim")).Return(nil).Once()
 				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(dbErr).Once()
 
-				claim, err := claimService.Create(mockTx, cmd)
+				claim, err := claimService.Create(mockTx, cmd, "token")
 
 				Expect(err).To(HaveOccurred())
 				Expect(claim).To(BeNil())
@@ -250,16 +728,59 @@ var _ = Describe("ClaimService", func() {
 		})
 
 		Context("when claim is updated successfully", func() {
-			It("should update claim description", func() {
+			It("should update claim description and audit the change", func() {
 				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
+					ID:          claimID,
+					Status:      entity.ClaimStatusDraft,
+					Description: "Original description",
 				}
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 				mockClaimRepo.EXPECT().Update(mockTx, mock.MatchedBy(func(c *entity.Claim) bool {
 					return c.ID == claimID && c.Description == cmd.Description
 				})).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.EntityID == claimID &&
+						l.Action == entity.AuditActionUpdate &&
+						string(l.Before) == `{"description":"Original description"}` &&
+						string(l.After) == `{"description":"Updated description"}`
+				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimUpdated, claimID)).Return(nil).Once()
+
+				err := claimService.Update(mockTx, claimID, cmd)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when claim is in an issued settlement batch", func() {
+			It("should return ClaimLocked error", func() {
+				batchID := uuid.New()
+				claim := &entity.Claim{
+					ID:                claimID,
+					Status:            entity.ClaimStatusCompleted,
+					SettlementBatchID: &batchID,
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
+
+				err := claimService.Update(mockTx, claimID, cmd)
+
+				ExpectAppError(err, apperror.ErrClaimLocked.ErrorCode)
+			})
+		})
+
+		Context("when description is unchanged", func() {
+			It("should not write an audit entry", func() {
+				claim := &entity.Claim{
+					ID:          claimID,
+					Status:      entity.ClaimStatusDraft,
+					Description: cmd.Description,
+				}
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
+				mockClaimRepo.EXPECT().Update(mockTx, claim).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimUpdated, claimID)).Return(nil).Once()
 
 				err := claimService.Update(mockTx, claimID, cmd)
 
@@ -267,6 +788,24 @@ var _ = Describe("ClaimService", func() {
 			})
 		})
 
+		Context("when audit log creation fails", func() {
+			It("should return the error", func() {
+				claim := &entity.Claim{
+					ID:     claimID,
+					Status: entity.ClaimStatusDraft,
+				}
+				dbErr := apperror.ErrDBOperation
+
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
+				mockClaimRepo.EXPECT().Update(mockTx, claim).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(dbErr).Once()
+
+				err := claimService.Update(mockTx, claimID, cmd)
+
+				Expect(err).To(Equal(dbErr))
+			})
+		})
+
 		Context("when claim status is not draft or request_info", func() {
 			It("should return NotAllowUpdateClaim error", func() {
 				claim := &entity.Claim{
@@ -322,20 +861,24 @@ var _ = Describe("ClaimService", func() {
 		})
 
 		Context("when claim is deleted successfully", func() {
-			It("should delete claim and cloud files", func() {
+			It("should delete claim and stored files", func() {
 				claim := &entity.Claim{
 					ID:     claimID,
 					Status: entity.ClaimStatusDraft,
 				}
 				attachments := []*entity.ClaimAttachment{
-					{ID: uuid.New(), URL: "https://example.com/file1.jpg"},
-					{ID: uuid.New(), URL: "https://example.com/file2.jpg"},
+					{ID: uuid.New(), StorageKey: "image/file1.jpg"},
+					{ID: uuid.New(), StorageKey: "image/file2.jpg"},
 				}
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(attachments, nil).Once()
 				mockClaimRepo.EXPECT().HardDelete(mockTx, claimID).Return(nil).Once()
-				mockCloudServ.EXPECT().DeleteFileByURL(mock.Anything, mock.Anything).Return(nil).Maybe()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.Action == entity.AuditActionDelete && l.Before != nil && l.After == nil
+				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimDeleted, claimID)).Return(nil).Once()
+				mockStorage.EXPECT().DeleteFile(mock.Anything, mock.Anything).Return(nil).Maybe()
 				mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Maybe()
 
 				err := claimService.HardDelete(mockTx, claimID)
@@ -408,22 +951,26 @@ var _ = Describe("ClaimService", func() {
 			})
 		})
 
-		Context("when cloud claimService delete fails", func() {
+		Context("when storage delete fails", func() {
 			It("should log the error", func() {
 				claim := &entity.Claim{
 					ID:     claimID,
 					Status: entity.ClaimStatusDraft,
 				}
 				attachments := []*entity.ClaimAttachment{
-					{ID: uuid.New(), URL: "https://example.com/file1.jpg"},
-					{ID: uuid.New(), URL: "https://example.com/file2.jpg"},
+					{ID: uuid.New(), StorageKey: "image/file1.jpg"},
+					{ID: uuid.New(), StorageKey: "image/file2.jpg"},
 				}
 
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(attachments, nil).Once()
 				mockClaimRepo.EXPECT().HardDelete(mockTx, claimID).Return(nil).Once()
-				mockCloudServ.EXPECT().DeleteFileByURL(ctx, attachments[0].URL).Return(errors.New("cloud error")).Once()
-				mockCloudServ.EXPECT().DeleteFileByURL(ctx, attachments[1].URL).Return(errors.New("cloud error")).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.Action == entity.AuditActionDelete && l.Before != nil && l.After == nil
+				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimDeleted, claimID)).Return(nil).Once()
+				mockStorage.EXPECT().DeleteFile(context.Background(), attachments[0].StorageKey).Return(errors.New("storage error")).Once()
+				mockStorage.EXPECT().DeleteFile(context.Background(), attachments[1].StorageKey).Return(errors.New("storage error")).Once()
 				mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Return().Times(2)
 
 				err := claimService.HardDelete(mockTx, claimID)
@@ -453,6 +1000,10 @@ var _ = Describe("ClaimService", func() {
 				mockItemRepo.EXPECT().SoftDeleteByClaimID(mockTx, claimID).Return(nil).Once()
 				mockAttachRepo.EXPECT().SoftDeleteByClaimID(mockTx, claimID).Return(nil).Once()
 				mockHistRepo.EXPECT().SoftDeleteByClaimID(mockTx, claimID).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.EntityID == claimID && l.Action == entity.AuditActionDelete
+				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimDeleted, claimID)).Return(nil).Once()
 
 				err := claimService.SoftDelete(mockTx, claimID)
 
@@ -566,184 +1117,175 @@ var _ = Describe("ClaimService", func() {
 		})
 	})
 
-	Describe("UpdateStatus", func() {
+	Describe("workflow actions", func() {
 		var (
 			claimID   uuid.UUID
 			changedBy uuid.UUID
+			claim     *entity.Claim
+			onCommit  func()
 		)
 
 		BeforeEach(func() {
 			claimID = uuid.New()
 			changedBy = uuid.New()
+			claim = &entity.Claim{ID: claimID, Status: entity.ClaimStatusDraft}
+			onCommit = nil
 			mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+			mockTx.EXPECT().OnCommit(mock.Anything).Run(func(fn func()) { onCommit = fn }).Maybe()
 		})
 
-		Context("when status is updated successfully", func() {
-			It("should update status and create history", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-
+		DescribeTable("when the workflow allows the action",
+			func(action, to, eventType string, apply func(id, changedBy uuid.UUID) error) {
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted).Return(nil).Once()
+				mockWorkflow.EXPECT().Fire(ctx, claim, entity.UserRoleAdmin, action).
+					Return(&workflow.Transition{Action: action, To: to}, nil).Once()
+				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, to).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(l *entity.ClaimAuditLog) bool {
+					return l.Action == entity.AuditActionUpdate &&
+						string(l.Before) == `{"status":"`+entity.ClaimStatusDraft+`"}` &&
+						string(l.After) == `{"status":"`+to+`"}`
+				})).Return(nil).Once()
 				mockHistRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(h *entity.ClaimHistory) bool {
-					return h.ClaimID == claimID &&
-						h.Status == entity.ClaimStatusSubmitted &&
-						h.ChangedBy == changedBy
+					return h.ClaimID == claimID && h.Status == to && h.ChangedBy == changedBy
 				})).Return(nil).Once()
+				mockSLA.EXPECT().Track(mockTx, mock.MatchedBy(func(c *entity.Claim) bool {
+					return c.ID == claimID && c.Status == to
+				})).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(eventType, claimID)).Return(nil).Once()
 
-				err := claimService.UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted, changedBy)
+				err := apply(claimID, changedBy)
 
 				Expect(err).NotTo(HaveOccurred())
-			})
-		})
-
-		Context("when status is invalid", func() {
-			It("should return InvalidClaimStatus error", func() {
-				err := claimService.UpdateStatus(mockTx, claimID, "INVALID_STATUS", changedBy)
 
-				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
-			})
-		})
+				mockStream.EXPECT().Publish(mock.MatchedBy(func(u *claimstream.Update) bool {
+					return u.Type == eventType && u.ClaimID == claimID && u.Status == to &&
+						u.PreviousStatus == entity.ClaimStatusDraft
+				})).Once()
+				onCommit()
+			},
+			Entry("Submit", workflow.ActionSubmit, entity.ClaimStatusSubmitted, entity.EventClaimSubmitted,
+				func(id, by uuid.UUID) error { return claimService.Submit(mockTx, id, by) }),
+			Entry("Review", workflow.ActionReview, entity.ClaimStatusReviewing, entity.EventClaimReviewStarted,
+				func(id, by uuid.UUID) error { return claimService.Review(mockTx, id, by) }),
+			Entry("Cancel", workflow.ActionCancel, entity.ClaimStatusCancelled, entity.EventClaimCancelled,
+				func(id, by uuid.UUID) error { return claimService.Cancel(mockTx, id, by, nil) }),
+			Entry("DoneReview", workflow.ActionDoneReview, entity.ClaimStatusPartiallyApproved, entity.EventClaimPartiallyApproved,
+				func(id, by uuid.UUID) error {
+					mockItemRepo.EXPECT().FindByClaimID(ctx, id).Return([]*entity.ClaimItem{}, nil).Once()
+					_, err := claimService.DoneReview(mockTx, id, by, nil)
+					return err
+				}),
+			Entry("Complete", workflow.ActionComplete, entity.ClaimStatusCompleted, entity.EventClaimCompleted,
+				func(id, by uuid.UUID) error { return claimService.Complete(mockTx, id, by) }),
+		)
 
-		Context("when status transition is invalid", func() {
-			It("should return InvalidClaimAction error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusApproved,
-				}
+		Context("when the transition requires a reason", func() {
+			var transition *workflow.Transition
 
+			BeforeEach(func() {
+				transition = &workflow.Transition{To: entity.ClaimStatusCancelled, RequireReason: true}
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
+				mockWorkflow.EXPECT().Fire(ctx, claim, entity.UserRoleAdmin, workflow.ActionCancel).
+					Return(transition, nil).Once()
+			})
+
+			It("should record the reason in the history", func() {
+				reason := &service.ReasonCommand{ReasonCode: entity.ReasonCodeCustomerRequest, Note: " Customer sold the car "}
+				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusCancelled).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(nil).Once()
+				mockHistRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(h *entity.ClaimHistory) bool {
+					return h.Status == entity.ClaimStatusCancelled &&
+						h.ReasonCode != nil && *h.ReasonCode == entity.ReasonCodeCustomerRequest &&
+						h.Note != nil && *h.Note == "Customer sold the car" &&
+						h.ClaimItemID == nil
+				})).Return(nil).Once()
+				mockSLA.EXPECT().Track(mockTx, claim).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, mock.MatchedBy(func(e *entity.OutboxEvent) bool {
+					return e.EventType == entity.EventClaimCancelled &&
+						strings.Contains(string(e.Payload), `"reason_code":"CUSTOMER_REQUEST"`) &&
+						strings.Contains(string(e.Payload), `"previous_status":"DRAFT"`)
+				})).Return(nil).Once()
 
-				err := claimService.UpdateStatus(mockTx, claimID, entity.ClaimStatusDraft, changedBy)
+				err := claimService.Cancel(mockTx, claimID, changedBy, reason)
 
-				ExpectAppError(err, apperror.ErrInvalidClaimAction.ErrorCode)
+				Expect(err).NotTo(HaveOccurred())
 			})
+
+			DescribeTable("should reject a missing or invalid reason",
+				func(reason *service.ReasonCommand) {
+					err := claimService.Cancel(mockTx, claimID, changedBy, reason)
+
+					ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+				},
+				Entry("no reason", nil),
+				Entry("no reason code", &service.ReasonCommand{Note: "Customer request"}),
+				Entry("no note", &service.ReasonCommand{ReasonCode: entity.ReasonCodeCustomerRequest, Note: "  "}),
+				Entry("unknown reason code", &service.ReasonCommand{ReasonCode: "BAD_LUCK", Note: "Customer request"}),
+			)
 		})
 
-		Context("when claim is not found", func() {
-			It("should return ClaimNotFound error", func() {
-				notFoundErr := apperror.ErrNotFoundError
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(nil, notFoundErr).Once()
-
-				err := claimService.UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted, changedBy)
-
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(notFoundErr))
-			})
-		})
-
-		Context("when updating status fails", func() {
-			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				dbErr := apperror.ErrDBOperation
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted).Return(dbErr).Once()
-
-				err := claimService.UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted, changedBy)
-
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(dbErr))
-			})
-		})
-
-		Context("when creating history fails", func() {
-			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				dbErr := apperror.ErrDBOperation
-
+		Context("when an optional reason is given", func() {
+			It("should record the note in the history", func() {
+				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return([]*entity.ClaimItem{}, nil).Once()
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted).Return(nil).Once()
-				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(dbErr).Once()
+				mockWorkflow.EXPECT().Fire(ctx, claim, entity.UserRoleAdmin, workflow.ActionDoneReview).
+					Return(&workflow.Transition{To: entity.ClaimStatusApproved}, nil).Once()
+				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusApproved).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(nil).Once()
+				mockHistRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(h *entity.ClaimHistory) bool {
+					return h.ReasonCode == nil && h.Note != nil && *h.Note == "All parts covered"
+				})).Return(nil).Once()
+				mockSLA.EXPECT().Track(mockTx, claim).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimApproved, claimID)).Return(nil).Once()
 
-				err := claimService.UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted, changedBy)
+				_, err := claimService.DoneReview(mockTx, claimID, changedBy, &service.ReasonCommand{Note: "All parts covered"})
 
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(dbErr))
+				Expect(err).NotTo(HaveOccurred())
 			})
 		})
-	})
-
-	Describe("Submit", func() {
-		var (
-			claimID   uuid.UUID
-			changedBy uuid.UUID
-		)
-
-		BeforeEach(func() {
-			claimID = uuid.New()
-			changedBy = uuid.New()
-			mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
-		})
 
-		Context("when claim is submitted successfully", func() {
-			It("should update status to submitted", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
+		Context("when the review is done", func() {
+			It("should count the item decisions and uncovered items", func() {
+				notCovered := &entity.ItemCoverage{Status: entity.CoverageStatusNotCovered}
 				items := []*entity.ClaimItem{
-					{ID: uuid.New(), ClaimID: claimID},
-				}
-				attachments := []*entity.ClaimAttachment{
-					{ID: uuid.New(), ClaimID: claimID},
-					{ID: uuid.New(), ClaimID: claimID},
-					{ID: uuid.New(), ClaimID: claimID},
+					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved,
+						Coverage: &entity.ItemCoverage{Status: entity.CoverageStatusCovered}},
+					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved, Coverage: notCovered},
+					{ID: uuid.New(), Status: entity.ClaimItemStatusRejected, Coverage: notCovered},
+					{ID: uuid.New(), Status: entity.ClaimItemStatusRejected},
 				}
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
 				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(attachments, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted).Return(nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
+				mockWorkflow.EXPECT().Fire(ctx, claim, entity.UserRoleAdmin, workflow.ActionDoneReview).
+					Return(&workflow.Transition{To: entity.ClaimStatusPartiallyApproved}, nil).Once()
+				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusPartiallyApproved).
+					Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(nil).Once()
 				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(nil).Once()
+				mockSLA.EXPECT().Track(mockTx, claim).Return(nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, mock.MatchedBy(func(e *entity.OutboxEvent) bool {
+					return e.EventType == entity.EventClaimPartiallyApproved &&
+						strings.Contains(string(e.Payload), `"uncovered_items":2`)
+				})).Return(nil).Once()
 
-				err := claimService.Submit(mockTx, claimID, changedBy)
+				stats, err := claimService.DoneReview(mockTx, claimID, changedBy, nil)
 
 				Expect(err).NotTo(HaveOccurred())
+				Expect(stats).To(Equal(&service.ClaimReviewStats{
+					TotalItems:        4,
+					ApprovedItems:     2,
+					RejectedItems:     2,
+					UncoveredItems:    2,
+					UncoveredApproved: 1,
+				}))
 			})
 		})
 
-		Context("when claim has insufficient items", func() {
-			It("should return MissingInformationClaim error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				attachments := []*entity.ClaimAttachment{
-					{ID: uuid.New(), ClaimID: claimID},
-				}
-
+		Context("when the workflow rejects the action", func() {
+			It("should return the workflow error without updating the claim", func() {
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return([]*entity.ClaimItem{}, nil).Once()
-				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(attachments, nil).Once()
-
-				err := claimService.Submit(mockTx, claimID, changedBy)
-
-				ExpectAppError(err, apperror.ErrMissingInformationClaim.ErrorCode)
-			})
-		})
-
-		Context("when claim has insufficient attachments", func() {
-			It("should return MissingInformationClaim error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), ClaimID: claimID},
-				}
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return([]*entity.ClaimAttachment{}, nil).Once()
+				mockWorkflow.EXPECT().Fire(ctx, claim, entity.UserRoleAdmin, workflow.ActionSubmit).
+					Return(nil, apperror.ErrMissingInformationClaim).Once()
 
 				err := claimService.Submit(mockTx, claimID, changedBy)
 
@@ -753,368 +1295,296 @@ var _ = Describe("ClaimService", func() {
 
 		Context("when claim is not found", func() {
 			It("should return ClaimNotFound error", func() {
-				notFoundErr := apperror.ErrNotFoundError
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(nil, notFoundErr).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(nil, apperror.ErrNotFoundError).Once()
 
-				err := claimService.Submit(mockTx, claimID, changedBy)
+				err := claimService.Review(mockTx, claimID, changedBy)
 
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(notFoundErr))
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
 			})
 		})
 
-		Context("when status transition is invalid", func() {
-			It("should return InvalidClaimAction error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusApproved,
-				}
+		Context("when caller is office scoped", func() {
+			It("should fire the action with the caller's role", func() {
+				officeID := uuid.New()
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   changedBy,
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+				scopedTx := mocks.NewTx(GinkgoT())
+				scopedTx.EXPECT().GetCtx().Return(scopedCtx)
 
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
+				mockClaimRepo.EXPECT().FindByIDInOffice(scopedCtx, claimID, officeID).Return(claim, nil).Once()
+				mockWorkflow.EXPECT().Fire(scopedCtx, claim, entity.UserRoleScStaff, workflow.ActionCancel).
+					Return(nil, apperror.ErrUnauthorizedRole).Once()
 
-				err := claimService.Submit(mockTx, claimID, changedBy)
+				err := claimService.Cancel(scopedTx, claimID, changedBy, nil)
 
-				ExpectAppError(err, apperror.ErrInvalidClaimAction.ErrorCode)
+				ExpectAppError(err, apperror.ErrUnauthorizedRole.ErrorCode)
 			})
 		})
 
-		Context("when finding items fails", func() {
+		Context("when update status fails", func() {
 			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
 				dbErr := apperror.ErrDBOperation
-
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(nil, dbErr).Once()
+				mockWorkflow.EXPECT().Fire(ctx, claim, entity.UserRoleAdmin, workflow.ActionComplete).
+					Return(&workflow.Transition{To: entity.ClaimStatusCompleted}, nil).Once()
+				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusCompleted).Return(dbErr).Once()
 
-				err := claimService.Submit(mockTx, claimID, changedBy)
+				err := claimService.Complete(mockTx, claimID, changedBy)
 
-				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(dbErr))
 			})
 		})
 
-		Context("when finding attachments fails", func() {
+		Context("when history creation fails", func() {
 			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				items := []*entity.ClaimItem{{ID: uuid.New()}}
 				dbErr := apperror.ErrDBOperation
-
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(nil, dbErr).Once()
+				mockWorkflow.EXPECT().Fire(ctx, claim, entity.UserRoleAdmin, workflow.ActionReview).
+					Return(&workflow.Transition{To: entity.ClaimStatusReviewing}, nil).Once()
+				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusReviewing).Return(nil).Once()
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).Return(nil).Once()
+				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(dbErr).Once()
 
-				err := claimService.Submit(mockTx, claimID, changedBy)
+				err := claimService.Review(mockTx, claimID, changedBy)
 
-				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(dbErr))
 			})
 		})
+	})
 
-		Context("when updating status fails", func() {
-			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				items := []*entity.ClaimItem{{ID: uuid.New()}}
-				attachments := []*entity.ClaimAttachment{
-					{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()},
-				}
-				dbErr := apperror.ErrDBOperation
+	Describe("GetAvailableActions", func() {
+		var claimID uuid.UUID
 
+		BeforeEach(func() {
+			claimID = uuid.New()
+		})
+
+		Context("when claim is found", func() {
+			It("should return the actions available to the caller", func() {
+				claim := &entity.Claim{ID: claimID, Status: entity.ClaimStatusSubmitted}
 				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(attachments, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted).Return(dbErr).Once()
+				mockWorkflow.EXPECT().AvailableActions(ctx, claim, entity.UserRoleAdmin).
+					Return([]string{workflow.ActionReview}, nil).Once()
 
-				err := claimService.Submit(mockTx, claimID, changedBy)
+				actions, err := claimService.GetAvailableActions(ctx, claimID)
 
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(dbErr))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actions).To(Equal([]string{workflow.ActionReview}))
 			})
 		})
 
-		Context("when creating history fails", func() {
-			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				items := []*entity.ClaimItem{{ID: uuid.New()}}
-				attachments := []*entity.ClaimAttachment{
-					{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()},
-				}
-				dbErr := apperror.ErrDBOperation
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockAttachRepo.EXPECT().FindByClaimID(ctx, claimID).Return(attachments, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusSubmitted).Return(nil).Once()
-				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(dbErr).Once()
+		Context("when claim is not found", func() {
+			It("should return ClaimNotFound error", func() {
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(nil, apperror.ErrNotFoundError).Once()
 
-				err := claimService.Submit(mockTx, claimID, changedBy)
+				actions, err := claimService.GetAvailableActions(ctx, claimID)
 
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(dbErr))
+				Expect(actions).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
 			})
 		})
 	})
 
-	Describe("DoneReview", func() {
-		var (
-			claimID   uuid.UUID
-			changedBy uuid.UUID
-		)
+	Describe("GetHistory", func() {
+		var claimID uuid.UUID
 
 		BeforeEach(func() {
 			claimID = uuid.New()
-			changedBy = uuid.New()
-			mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
 		})
 
-		Context("when all items are approved", func() {
-			It("should set status to approved", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusReviewing,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved},
-					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved},
+		Context("when history is found", func() {
+			It("should return claim histories", func() {
+				expectedHistories := []*entity.ClaimHistory{
+					{
+						ID:        uuid.New(),
+						ClaimID:   claimID,
+						Status:    entity.ClaimStatusDraft,
+						ChangedBy: uuid.New(),
+					},
+					{
+						ID:        uuid.New(),
+						ClaimID:   claimID,
+						Status:    entity.ClaimStatusSubmitted,
+						ChangedBy: uuid.New(),
+					},
 				}
 
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusApproved).Return(nil).Once()
-				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockHistRepo.EXPECT().FindByClaimID(ctx, claimID).Return(expectedHistories, nil).Once()
 
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+				histories, err := claimService.GetHistory(ctx, claimID)
 
 				Expect(err).NotTo(HaveOccurred())
+				Expect(histories).NotTo(BeNil())
+				Expect(histories).To(HaveLen(2))
 			})
 		})
 
-		Context("when all items are rejected", func() {
-			It("should set status to rejected", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusReviewing,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), Status: entity.ClaimItemStatusRejected},
-					{ID: uuid.New(), Status: entity.ClaimItemStatusRejected},
-				}
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusRejected).Return(nil).Once()
-				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(nil).Once()
+		Context("when no history is found", func() {
+			It("should return an empty slice", func() {
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockHistRepo.EXPECT().FindByClaimID(ctx, claimID).Return([]*entity.ClaimHistory{}, nil).Once()
 
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+				histories, err := claimService.GetHistory(ctx, claimID)
 
 				Expect(err).NotTo(HaveOccurred())
+				Expect(histories).NotTo(BeNil())
+				Expect(histories).To(BeEmpty())
 			})
 		})
 
-		Context("when some items are rejected", func() {
-			It("should set status to partially approved", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusReviewing,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved},
-					{ID: uuid.New(), Status: entity.ClaimItemStatusRejected},
-				}
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID,
-					entity.ClaimStatusPartiallyApproved).Return(nil).Once()
-				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(nil).Once()
+		Context("when repository returns error", func() {
+			It("should return the error", func() {
+				dbErr := apperror.ErrDBOperation
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockHistRepo.EXPECT().FindByClaimID(ctx, claimID).Return(nil, dbErr).Once()
 
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+				histories, err := claimService.GetHistory(ctx, claimID)
 
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(HaveOccurred())
+				Expect(histories).To(BeNil())
+				Expect(err).To(Equal(dbErr))
 			})
 		})
 
-		Context("when items have pending status", func() {
-			It("should return InvalidClaimAction error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusReviewing,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), Status: entity.ClaimItemStatusPending},
-				}
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+		Context("when claim is outside the caller's office", func() {
+			It("should return NotFound error without loading history", func() {
+				officeID := uuid.New()
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
 
-				ExpectAppError(err, apperror.ErrInvalidClaimAction.ErrorCode)
-			})
-		})
+				mockClaimRepo.EXPECT().FindByIDInOffice(scopedCtx, claimID, officeID).
+					Return(nil, apperror.ErrNotFoundError).Once()
 
-		Context("when claim is not found", func() {
-			It("should return ClaimNotFound error", func() {
-				notFoundErr := apperror.ErrNotFoundError
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(nil, notFoundErr).Once()
-
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+				histories, err := claimService.GetHistory(scopedCtx, claimID)
 
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(notFoundErr))
+				Expect(histories).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
 			})
 		})
+	})
 
-		Context("when finding items fails", func() {
-			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusReviewing,
-				}
-				dbErr := apperror.ErrDBOperation
-
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(nil, dbErr).Once()
-
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+	Describe("GetAuditLog", func() {
+		var claimID uuid.UUID
 
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(dbErr))
-			})
+		BeforeEach(func() {
+			claimID = uuid.New()
 		})
 
-		Context("when status transition is invalid", func() {
-			It("should return InvalidClaimAction error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusDraft,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved},
+		Context("when audit entries are found", func() {
+			It("should return the audit log", func() {
+				expectedLogs := []*entity.ClaimAuditLog{
+					entity.NewClaimAuditLog(claimID, entity.AuditEntityClaim, claimID, entity.AuditActionCreate,
+						nil, []byte(`{"status":"DRAFT"}`), uuid.New()),
 				}
 
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockAuditRepo.EXPECT().FindByClaimID(ctx, claimID).Return(expectedLogs, nil).Once()
 
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+				auditLogs, err := claimService.GetAuditLog(ctx, claimID)
 
-				ExpectAppError(err, apperror.ErrInvalidClaimAction.ErrorCode)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(auditLogs).To(Equal(expectedLogs))
 			})
 		})
 
-		Context("when updating status fails", func() {
+		Context("when repository returns error", func() {
 			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusReviewing,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved},
-				}
 				dbErr := apperror.ErrDBOperation
+				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(&entity.Claim{ID: claimID}, nil).Once()
+				mockAuditRepo.EXPECT().FindByClaimID(ctx, claimID).Return(nil, dbErr).Once()
 
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusApproved).Return(dbErr).Once()
-
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+				auditLogs, err := claimService.GetAuditLog(ctx, claimID)
 
-				Expect(err).To(HaveOccurred())
+				Expect(auditLogs).To(BeNil())
 				Expect(err).To(Equal(dbErr))
 			})
 		})
 
-		Context("when creating history fails", func() {
-			It("should return the error", func() {
-				claim := &entity.Claim{
-					ID:     claimID,
-					Status: entity.ClaimStatusReviewing,
-				}
-				items := []*entity.ClaimItem{
-					{ID: uuid.New(), Status: entity.ClaimItemStatusApproved},
-				}
-				dbErr := apperror.ErrDBOperation
+		Context("when claim is outside the caller's office", func() {
+			It("should return NotFound error without loading the audit log", func() {
+				officeID := uuid.New()
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
 
-				mockClaimRepo.EXPECT().FindByID(ctx, claimID).Return(claim, nil).Once()
-				mockItemRepo.EXPECT().FindByClaimID(ctx, claimID).Return(items, nil).Once()
-				mockClaimRepo.EXPECT().UpdateStatus(mockTx, claimID, entity.ClaimStatusApproved).Return(nil).Once()
-				mockHistRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimHistory")).Return(dbErr).Once()
+				mockClaimRepo.EXPECT().FindByIDInOffice(scopedCtx, claimID, officeID).
+					Return(nil, apperror.ErrNotFoundError).Once()
 
-				err := claimService.DoneReview(mockTx, claimID, changedBy)
+				auditLogs, err := claimService.GetAuditLog(scopedCtx, claimID)
 
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(dbErr))
+				Expect(auditLogs).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
 			})
 		})
 	})
 
-	Describe("GetHistory", func() {
-		var claimID uuid.UUID
+	Describe("GetRejectionReasons", func() {
+		var filters repository.RejectionReasonFilters
 
 		BeforeEach(func() {
-			claimID = uuid.New()
+			filters = repository.RejectionReasonFilters{Limit: repository.DefaultRejectionReasonLimit}
 		})
 
-		Context("when history is found", func() {
-			It("should return claim histories", func() {
-				expectedHistories := []*entity.ClaimHistory{
-					{
-						ID:        uuid.New(),
-						ClaimID:   claimID,
-						Status:    entity.ClaimStatusDraft,
-						ChangedBy: uuid.New(),
-					},
-					{
-						ID:        uuid.New(),
-						ClaimID:   claimID,
-						Status:    entity.ClaimStatusSubmitted,
-						ChangedBy: uuid.New(),
-					},
-				}
+		Context("when filters are valid", func() {
+			It("should return the reason counts", func() {
+				expected := []*repository.ReasonCodeCount{{ReasonCode: entity.ReasonCodeNotCovered, Count: 4}}
+				mockHistRepo.EXPECT().CountRejectionReasons(ctx, filters).Return(expected, nil).Once()
 
-				mockHistRepo.EXPECT().FindByClaimID(ctx, claimID).Return(expectedHistories, nil).Once()
-
-				histories, err := claimService.GetHistory(ctx, claimID)
+				reasons, err := claimService.GetRejectionReasons(ctx, filters)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(histories).NotTo(BeNil())
-				Expect(histories).To(HaveLen(2))
+				Expect(reasons).To(Equal(expected))
 			})
 		})
 
-		Context("when no history is found", func() {
-			It("should return an empty slice", func() {
-				mockHistRepo.EXPECT().FindByClaimID(ctx, claimID).Return([]*entity.ClaimHistory{}, nil).Once()
+		Context("when caller is office scoped", func() {
+			It("should restrict the counts to the caller's office", func() {
+				officeID := uuid.New()
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+				scopedFilters := filters
+				scopedFilters.OfficeID = &officeID
+				mockHistRepo.EXPECT().CountRejectionReasons(scopedCtx, scopedFilters).
+					Return([]*repository.ReasonCodeCount{}, nil).Once()
 
-				histories, err := claimService.GetHistory(ctx, claimID)
+				_, err := claimService.GetRejectionReasons(scopedCtx, filters)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(histories).NotTo(BeNil())
-				Expect(histories).To(BeEmpty())
 			})
 		})
 
-		Context("when repository returns error", func() {
-			It("should return the error", func() {
-				dbErr := apperror.ErrDBOperation
-				mockHistRepo.EXPECT().FindByClaimID(ctx, claimID).Return(nil, dbErr).Once()
+		Context("when limit is out of range", func() {
+			It("should return InvalidInput error", func() {
+				filters.Limit = repository.MaxRejectionReasonLimit + 1
 
-				histories, err := claimService.GetHistory(ctx, claimID)
+				_, err := claimService.GetRejectionReasons(ctx, filters)
 
-				Expect(err).To(HaveOccurred())
-				Expect(histories).To(BeNil())
-				Expect(err).To(Equal(dbErr))
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when from date is after to date", func() {
+			It("should return InvalidInput error", func() {
+				from := time.Now()
+				to := from.Add(-time.Hour)
+				filters.FromDate = &from
+				filters.ToDate = &to
+
+				_, err := claimService.GetRejectionReasons(ctx, filters)
+
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
 			})
 		})
 	})
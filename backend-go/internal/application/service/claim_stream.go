@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+)
+
+// Stream scopes office scoped actors to the claims whose staff or technician
+// belongs to their office, like the claim list. The users of the office are
+// loaded when subscribing, clients pick up later changes by reconnecting.
+func (s *claimService) Stream(ctx context.Context, lastEventID uint64) (*claimstream.Subscription, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	filter := func(*claimstream.Update) bool { return true }
+	if actor.IsOfficeScoped() {
+		users, err := s.userRepo.FindByOfficeID(ctx, actor.OfficeID)
+		if err != nil {
+			return nil, err
+		}
+		officeUsers := make(map[uuid.UUID]bool, len(users))
+		for _, user := range users {
+			officeUsers[user.ID] = true
+		}
+		filter = func(update *claimstream.Update) bool {
+			return officeUsers[update.StaffID] || officeUsers[update.TechnicianID]
+		}
+	}
+
+	return s.stream.Subscribe(lastEventID, filter), nil
+}
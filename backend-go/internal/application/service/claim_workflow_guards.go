@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/application/workflow"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+)
+
+const (
+	GuardMinItems          = "min_items"
+	GuardMinAttachments    = "min_attachments"
+	GuardItemsReviewed     = "items_reviewed"
+	GuardAllItemsApproved  = "all_items_approved"
+	GuardSomeItemsApproved = "some_items_approved"
+	GuardNoItemsApproved   = "no_items_approved"
+)
+
+// NewClaimWorkflowGuards returns the guards claim workflow definitions may
+// reference by name.
+func NewClaimWorkflowGuards(itemRepo repository.ClaimItemRepository,
+	attachmentRepo repository.ClaimAttachmentRepository,
+) map[string]workflow.Guard {
+	countApproved := func(ctx context.Context, claim *entity.Claim) (int, int, error) {
+		items, err := itemRepo.FindByClaimID(ctx, claim.ID)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		approved := 0
+		for _, item := range items {
+			if item.Status == entity.ClaimItemStatusApproved {
+				approved++
+			}
+		}
+		return approved, len(items), nil
+	}
+
+	outcomeErr := apperror.ErrInvalidClaimAction.WithMessage("Claim items do not match this review outcome")
+
+	return map[string]workflow.Guard{
+		GuardMinItems: {
+			Check: func(ctx context.Context, claim *entity.Claim) (bool, error) {
+				items, err := itemRepo.FindByClaimID(ctx, claim.ID)
+				if err != nil {
+					return false, err
+				}
+				return len(items) >= entity.MinItemPerClaim, nil
+			},
+			Err: apperror.ErrMissingInformationClaim.
+				WithMessage(fmt.Sprintf("Claim item must be atleast %d", entity.MinItemPerClaim)),
+		},
+		GuardMinAttachments: {
+			Check: func(ctx context.Context, claim *entity.Claim) (bool, error) {
+				attachments, err := attachmentRepo.FindByClaimID(ctx, claim.ID)
+				if err != nil {
+					return false, err
+				}
+				return len(attachments) >= entity.MinAttachmentPerClaim, nil
+			},
+			Err: apperror.ErrMissingInformationClaim.
+				WithMessage(fmt.Sprintf("Claim attachment must be atleast %d", entity.MinAttachmentPerClaim)),
+		},
+		GuardItemsReviewed: {
+			Check: func(ctx context.Context, claim *entity.Claim) (bool, error) {
+				items, err := itemRepo.FindByClaimID(ctx, claim.ID)
+				if err != nil {
+					return false, err
+				}
+				for _, item := range items {
+					if item.Status != entity.ClaimItemStatusApproved && item.Status != entity.ClaimItemStatusRejected {
+						return false, nil
+					}
+				}
+				return true, nil
+			},
+			Err: apperror.ErrInvalidClaimAction.WithMessage("Can only complete when all item are approved or rejected"),
+		},
+		GuardAllItemsApproved: {
+			Check: func(ctx context.Context, claim *entity.Claim) (bool, error) {
+				approved, total, err := countApproved(ctx, claim)
+				return err == nil && approved == total, err
+			},
+			Err: outcomeErr,
+		},
+		GuardSomeItemsApproved: {
+			Check: func(ctx context.Context, claim *entity.Claim) (bool, error) {
+				approved, total, err := countApproved(ctx, claim)
+				return err == nil && approved > 0 && approved < total, err
+			},
+			Err: outcomeErr,
+		},
+		GuardNoItemsApproved: {
+			Check: func(ctx context.Context, claim *entity.Claim) (bool, error) {
+				approved, total, err := countApproved(ctx, claim)
+				return err == nil && total > 0 && approved == 0, err
+			},
+			Err: outcomeErr,
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/application/workflow"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClaimWorkflowGuards", func() {
+	var (
+		mockItemRepo   *mocks.ClaimItemRepository
+		mockAttachRepo *mocks.ClaimAttachmentRepository
+		engine         workflow.Engine
+		ctx            context.Context
+		claim          *entity.Claim
+	)
+
+	items := func(statuses ...string) []*entity.ClaimItem {
+		result := make([]*entity.ClaimItem, 0, len(statuses))
+		for _, status := range statuses {
+			result = append(result, &entity.ClaimItem{ID: uuid.New(), Status: status})
+		}
+		return result
+	}
+
+	attachments := func(count int) []*entity.ClaimAttachment {
+		result := make([]*entity.ClaimAttachment, 0, count)
+		for i := 0; i < count; i++ {
+			result = append(result, &entity.ClaimAttachment{ID: uuid.New()})
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		mockItemRepo = mocks.NewClaimItemRepository(GinkgoT())
+		mockAttachRepo = mocks.NewClaimAttachmentRepository(GinkgoT())
+		ctx = context.Background()
+		claim = &entity.Claim{ID: uuid.New()}
+
+		var err error
+		engine, err = workflow.NewEngine(workflow.Default(),
+			service.NewClaimWorkflowGuards(mockItemRepo, mockAttachRepo))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("submit", func() {
+		BeforeEach(func() {
+			claim.Status = entity.ClaimStatusDraft
+		})
+
+		Context("when the claim has enough items and attachments", func() {
+			It("should move the claim to SUBMITTED", func() {
+				mockItemRepo.EXPECT().FindByClaimID(ctx, claim.ID).
+					Return(items(entity.ClaimItemStatusPending), nil).Once()
+				mockAttachRepo.EXPECT().FindByClaimID(ctx, claim.ID).Return(attachments(2), nil).Once()
+
+				transition, err := engine.Fire(ctx, claim, entity.UserRoleScStaff, workflow.ActionSubmit)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(transition.To).To(Equal(entity.ClaimStatusSubmitted))
+			})
+		})
+
+		Context("when the claim has no item", func() {
+			It("should return MissingInformationClaim error", func() {
+				mockItemRepo.EXPECT().FindByClaimID(ctx, claim.ID).Return(items(), nil).Once()
+
+				_, err := engine.Fire(ctx, claim, entity.UserRoleScStaff, workflow.ActionSubmit)
+
+				ExpectAppError(err, apperror.ErrMissingInformationClaim.ErrorCode)
+			})
+		})
+
+		Context("when the claim has too few attachments", func() {
+			It("should return MissingInformationClaim error", func() {
+				mockItemRepo.EXPECT().FindByClaimID(ctx, claim.ID).
+					Return(items(entity.ClaimItemStatusPending), nil).Once()
+				mockAttachRepo.EXPECT().FindByClaimID(ctx, claim.ID).Return(attachments(1), nil).Once()
+
+				_, err := engine.Fire(ctx, claim, entity.UserRoleScStaff, workflow.ActionSubmit)
+
+				ExpectAppError(err, apperror.ErrMissingInformationClaim.ErrorCode)
+			})
+		})
+
+		Context("when loading items fails", func() {
+			It("should return the error", func() {
+				mockItemRepo.EXPECT().FindByClaimID(ctx, claim.ID).Return(nil, apperror.ErrDBOperation).Once()
+
+				_, err := engine.Fire(ctx, claim, entity.UserRoleScStaff, workflow.ActionSubmit)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("done_review", func() {
+		BeforeEach(func() {
+			claim.Status = entity.ClaimStatusReviewing
+		})
+
+		DescribeTable("when every item is reviewed",
+			func(statuses []string, expected string) {
+				mockItemRepo.EXPECT().FindByClaimID(ctx, claim.ID).Return(items(statuses...), nil)
+
+				transition, err := engine.Fire(ctx, claim, entity.UserRoleEvmStaff, workflow.ActionDoneReview)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(transition.To).To(Equal(expected))
+			},
+			Entry("all approved", []string{entity.ClaimItemStatusApproved, entity.ClaimItemStatusApproved},
+				entity.ClaimStatusApproved),
+			Entry("some approved", []string{entity.ClaimItemStatusApproved, entity.ClaimItemStatusRejected},
+				entity.ClaimStatusPartiallyApproved),
+			Entry("none approved", []string{entity.ClaimItemStatusRejected, entity.ClaimItemStatusRejected},
+				entity.ClaimStatusRejected),
+		)
+
+		Context("when an item is still pending", func() {
+			It("should return InvalidClaimAction error", func() {
+				mockItemRepo.EXPECT().FindByClaimID(ctx, claim.ID).
+					Return(items(entity.ClaimItemStatusApproved, entity.ClaimItemStatusPending), nil)
+
+				_, err := engine.Fire(ctx, claim, entity.UserRoleEvmStaff, workflow.ActionDoneReview)
+
+				ExpectAppError(err, apperror.ErrInvalidClaimAction.ErrorCode)
+			})
+		})
+	})
+
+	Describe("AvailableActions", func() {
+		It("should list the actions allowed for the role in the claim status", func() {
+			claim.Status = entity.ClaimStatusSubmitted
+
+			evmActions, err := engine.AvailableActions(ctx, claim, entity.UserRoleEvmStaff)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(evmActions).To(Equal([]string{workflow.ActionReview}))
+
+			scActions, err := engine.AvailableActions(ctx, claim, entity.UserRoleScStaff)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scActions).To(Equal([]string{workflow.ActionCancel}))
+		})
+
+		It("should hide actions whose guards fail", func() {
+			claim.Status = entity.ClaimStatusDraft
+			mockItemRepo.EXPECT().FindByClaimID(ctx, claim.ID).Return(items(), nil).Once()
+
+			actions, err := engine.AvailableActions(ctx, claim, entity.UserRoleScStaff)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actions).To(BeEmpty())
+		})
+	})
+})
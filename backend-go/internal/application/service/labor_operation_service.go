@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type CreateLaborOperationCommand struct {
+	Code            string
+	Description     string
+	FlatRateMinutes int
+	PartCategoryID  *uuid.UUID
+	IsActive        bool
+}
+
+type UpdateLaborOperationCommand struct {
+	Code            string
+	Description     string
+	FlatRateMinutes int
+	PartCategoryID  *uuid.UUID
+	IsActive        bool
+}
+
+type LaborOperationService interface {
+	Create(ctx context.Context, cmd *CreateLaborOperationCommand) (*entity.LaborOperation, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.LaborOperation, error)
+	GetAll(ctx context.Context, partCategoryID *uuid.UUID) ([]*entity.LaborOperation, error)
+	Update(ctx context.Context, id uuid.UUID, cmd *UpdateLaborOperationCommand) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type laborOperationService struct {
+	repo repository.LaborOperationRepository
+}
+
+func NewLaborOperationService(repo repository.LaborOperationRepository) LaborOperationService {
+	return &laborOperationService{repo}
+}
+
+func (s *laborOperationService) Create(ctx context.Context, cmd *CreateLaborOperationCommand,
+) (*entity.LaborOperation, error) {
+	code := strings.ToUpper(strings.TrimSpace(cmd.Code))
+	if err := validateLaborOperation(code, cmd.FlatRateMinutes); err != nil {
+		return nil, err
+	}
+
+	operation := entity.NewLaborOperation(code, strings.TrimSpace(cmd.Description), cmd.FlatRateMinutes,
+		cmd.PartCategoryID, cmd.IsActive)
+	if err := s.repo.Create(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return operation, nil
+}
+
+func (s *laborOperationService) GetByID(ctx context.Context, id uuid.UUID) (*entity.LaborOperation, error) {
+	return s.repo.FindByID(ctx, id)
+}
+
+func (s *laborOperationService) GetAll(ctx context.Context, partCategoryID *uuid.UUID,
+) ([]*entity.LaborOperation, error) {
+	return s.repo.FindAll(ctx, partCategoryID)
+}
+
+// Update changes the catalog entry only. Items already priced with the
+// operation keep the flat-rate time they were created with.
+func (s *laborOperationService) Update(ctx context.Context, id uuid.UUID, cmd *UpdateLaborOperationCommand) error {
+	operation, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(cmd.Code))
+	if err = validateLaborOperation(code, cmd.FlatRateMinutes); err != nil {
+		return err
+	}
+
+	operation.Code = code
+	operation.Description = strings.TrimSpace(cmd.Description)
+	operation.FlatRateMinutes = cmd.FlatRateMinutes
+	operation.PartCategoryID = cmd.PartCategoryID
+	operation.IsActive = cmd.IsActive
+
+	return s.repo.Update(ctx, operation)
+}
+
+func (s *laborOperationService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.FindByID(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.SoftDelete(ctx, id)
+}
+
+func validateLaborOperation(code string, flatRateMinutes int) error {
+	if code == "" {
+		return apperror.ErrInvalidInput.WithMessage("Labor operation code is required")
+	}
+	if flatRateMinutes <= 0 {
+		return apperror.ErrInvalidInput.WithMessage("Flat-rate time must be positive")
+	}
+	return nil
+}
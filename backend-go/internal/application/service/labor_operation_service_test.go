@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("LaborOperationService", func() {
+	var (
+		mockRepo              *mocks.LaborOperationRepository
+		laborOperationService service.LaborOperationService
+		ctx                   context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mocks.NewLaborOperationRepository(GinkgoT())
+		laborOperationService = service.NewLaborOperationService(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("Create", func() {
+		var cmd *service.CreateLaborOperationCommand
+
+		BeforeEach(func() {
+			categoryID := uuid.New()
+			cmd = &service.CreateLaborOperationCommand{
+				Code:            " bat-replace ",
+				Description:     "Replace battery module",
+				FlatRateMinutes: 90,
+				PartCategoryID:  &categoryID,
+				IsActive:        true,
+			}
+		})
+
+		Context("when the operation is valid", func() {
+			It("should create it with a normalized code", func() {
+				mockRepo.EXPECT().Create(ctx, mock.MatchedBy(func(o *entity.LaborOperation) bool {
+					return o.Code == "BAT-REPLACE" && o.FlatRateMinutes == 90 &&
+						o.PartCategoryID == cmd.PartCategoryID && o.IsActive
+				})).Return(nil).Once()
+
+				operation, err := laborOperationService.Create(ctx, cmd)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(operation.Code).To(Equal("BAT-REPLACE"))
+				Expect(operation.ID).NotTo(Equal(uuid.Nil))
+			})
+		})
+
+		DescribeTable("when the operation is invalid",
+			func(code string, minutes int) {
+				cmd.Code = code
+				cmd.FlatRateMinutes = minutes
+
+				operation, err := laborOperationService.Create(ctx, cmd)
+
+				Expect(operation).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			},
+			Entry("blank code", "  ", 60),
+			Entry("zero flat-rate time", "DIAG", 0),
+			Entry("negative flat-rate time", "DIAG", -30),
+		)
+
+		Context("when the code already exists", func() {
+			It("should return the repository error", func() {
+				mockRepo.EXPECT().Create(ctx, mock.Anything).Return(apperror.ErrDuplicateKey).Once()
+
+				operation, err := laborOperationService.Create(ctx, cmd)
+
+				Expect(operation).To(BeNil())
+				ExpectAppError(err, apperror.ErrDuplicateKey.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			existing *entity.LaborOperation
+			cmd      *service.UpdateLaborOperationCommand
+		)
+
+		BeforeEach(func() {
+			existing = entity.NewLaborOperation("DIAG", "Run diagnostics", 30, nil, true)
+			cmd = &service.UpdateLaborOperationCommand{
+				Code:            "diag-full",
+				Description:     "Run full diagnostics",
+				FlatRateMinutes: 45,
+				IsActive:        false,
+			}
+		})
+
+		Context("when the operation is updated successfully", func() {
+			It("should save the new values", func() {
+				mockRepo.EXPECT().FindByID(ctx, existing.ID).Return(existing, nil).Once()
+				mockRepo.EXPECT().Update(ctx, mock.MatchedBy(func(o *entity.LaborOperation) bool {
+					return o.ID == existing.ID && o.Code == "DIAG-FULL" && o.FlatRateMinutes == 45 && !o.IsActive
+				})).Return(nil).Once()
+
+				err := laborOperationService.Update(ctx, existing.ID, cmd)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the flat-rate time is not positive", func() {
+			It("should return InvalidInput error", func() {
+				cmd.FlatRateMinutes = 0
+				mockRepo.EXPECT().FindByID(ctx, existing.ID).Return(existing, nil).Once()
+
+				err := laborOperationService.Update(ctx, existing.ID, cmd)
+
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when the operation is not found", func() {
+			It("should return NotFound error", func() {
+				mockRepo.EXPECT().FindByID(ctx, existing.ID).Return(nil, apperror.ErrNotFoundError).Once()
+
+				err := laborOperationService.Update(ctx, existing.ID, cmd)
+
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should soft delete an existing operation", func() {
+			operation := entity.NewLaborOperation("DIAG", "Run diagnostics", 30, nil, true)
+			mockRepo.EXPECT().FindByID(ctx, operation.ID).Return(operation, nil).Once()
+			mockRepo.EXPECT().SoftDelete(ctx, operation.ID).Return(nil).Once()
+
+			err := laborOperationService.Delete(ctx, operation.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return NotFound error for a missing operation", func() {
+			id := uuid.New()
+			mockRepo.EXPECT().FindByID(ctx, id).Return(nil, apperror.ErrNotFoundError).Once()
+
+			err := laborOperationService.Delete(ctx, id)
+
+			ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+		})
+	})
+})
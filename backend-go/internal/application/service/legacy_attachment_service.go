@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/infrastructure/storage"
+	"ev-warranty-go/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// LegacyAttachmentService makes private the files uploaded to Cloudinary as
+// public assets before attachment files were private, so their permanent
+// public URLs stop working.
+type LegacyAttachmentService interface {
+	// MakePrivate moves every public file and returns how many were moved.
+	// A file that fails to move is logged and left for the next run.
+	MakePrivate(ctx context.Context) (int, error)
+}
+
+type legacyAttachmentService struct {
+	log        logger.Logger
+	txManager  application.TxManager
+	attachRepo repository.ClaimAttachmentRepository
+	mover      storage.PrivateMover
+	cfg        AttachmentConfig
+}
+
+func NewLegacyAttachmentService(log logger.Logger, txManager application.TxManager,
+	attachRepo repository.ClaimAttachmentRepository, mover storage.PrivateMover, cfg AttachmentConfig,
+) LegacyAttachmentService {
+	return &legacyAttachmentService{
+		log:        log,
+		txManager:  txManager,
+		attachRepo: attachRepo,
+		mover:      mover,
+		cfg:        cfg,
+	}
+}
+
+func (s *legacyAttachmentService) MakePrivate(ctx context.Context) (int, error) {
+	var moved int
+	afterID := uuid.Nil
+	for {
+		attachments, err := s.attachRepo.FindPublicUploads(ctx, afterID, s.cfg.BatchSize)
+		if err != nil {
+			return moved, err
+		}
+		if len(attachments) == 0 {
+			return moved, nil
+		}
+
+		for _, attachment := range attachments {
+			afterID = attachment.ID
+
+			key, err := s.mover.MakePrivate(ctx, attachment.StorageKey)
+			if err != nil {
+				s.log.Warn("[Attachment] Failed to make claim attachment file private", "attachment_id",
+					attachment.ID, "error", err)
+				continue
+			}
+
+			// Attachments processed before they were moved serve the
+			// original file.
+			if attachment.PublicKey != nil && *attachment.PublicKey == attachment.StorageKey {
+				attachment.PublicKey = &key
+			}
+			attachment.StorageKey = key
+			err = s.txManager.Do(ctx, func(tx application.Tx) error {
+				return s.attachRepo.UpdateStorageKeys(tx, attachment)
+			})
+			if err != nil {
+				return moved, err
+			}
+			moved++
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("LegacyAttachmentService", func() {
+	var (
+		mockLogger     *mocks.Logger
+		mockTxManager  *mocks.TxManager
+		mockTx         *mocks.Tx
+		mockAttachRepo *mocks.ClaimAttachmentRepository
+		mockMover      *mocks.PrivateMover
+		legacyService  service.LegacyAttachmentService
+		ctx            context.Context
+	)
+
+	BeforeEach(func() {
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockAttachRepo = mocks.NewClaimAttachmentRepository(GinkgoT())
+		mockMover = mocks.NewPrivateMover(GinkgoT())
+		legacyService = service.NewLegacyAttachmentService(mockLogger, mockTxManager, mockAttachRepo, mockMover,
+			service.AttachmentConfig{BatchSize: 2})
+		ctx = context.Background()
+
+		mockTxManager.EXPECT().Do(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				return fn(mockTx)
+			}).Maybe()
+	})
+
+	newLegacyAttachment := func(storageKey string) *entity.ClaimAttachment {
+		return &entity.ClaimAttachment{ID: uuid.New(), ClaimID: uuid.New(), StorageKey: storageKey}
+	}
+
+	Describe("MakePrivate", func() {
+		Context("when public files are left", func() {
+			It("should move them batch after batch and save their new keys", func() {
+				first := newLegacyAttachment("image/upload/first.jpg")
+				served := "image/upload/second.jpg"
+				second := newLegacyAttachment(served)
+				second.PublicKey = &served
+				third := newLegacyAttachment("video/upload/third.mp4")
+
+				mockAttachRepo.EXPECT().FindPublicUploads(ctx, uuid.Nil, 2).
+					Return([]*entity.ClaimAttachment{first, second}, nil).Once()
+				mockAttachRepo.EXPECT().FindPublicUploads(ctx, second.ID, 2).
+					Return([]*entity.ClaimAttachment{third}, nil).Once()
+				mockAttachRepo.EXPECT().FindPublicUploads(ctx, third.ID, 2).
+					Return([]*entity.ClaimAttachment{}, nil).Once()
+				mockMover.EXPECT().MakePrivate(ctx, "image/upload/first.jpg").
+					Return("image/authenticated/first.jpg", nil).Once()
+				mockMover.EXPECT().MakePrivate(ctx, "image/upload/second.jpg").
+					Return("image/authenticated/second.jpg", nil).Once()
+				mockMover.EXPECT().MakePrivate(ctx, "video/upload/third.mp4").
+					Return("video/authenticated/third.mp4", nil).Once()
+				mockAttachRepo.EXPECT().UpdateStorageKeys(mockTx, mock.Anything).Return(nil).Times(3)
+
+				moved, err := legacyService.MakePrivate(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(moved).To(Equal(3))
+				Expect(first.StorageKey).To(Equal("image/authenticated/first.jpg"))
+				Expect(first.PublicKey).To(BeNil())
+				Expect(second.StorageKey).To(Equal("image/authenticated/second.jpg"))
+				Expect(*second.PublicKey).To(Equal("image/authenticated/second.jpg"))
+			})
+		})
+
+		Context("when a file fails to move", func() {
+			It("should keep its key and go on with the next files", func() {
+				failing := newLegacyAttachment("image/upload/failing.jpg")
+				next := newLegacyAttachment("image/upload/next.jpg")
+
+				mockAttachRepo.EXPECT().FindPublicUploads(ctx, uuid.Nil, 2).
+					Return([]*entity.ClaimAttachment{failing, next}, nil).Once()
+				mockAttachRepo.EXPECT().FindPublicUploads(ctx, next.ID, 2).
+					Return([]*entity.ClaimAttachment{}, nil).Once()
+				mockMover.EXPECT().MakePrivate(ctx, "image/upload/failing.jpg").
+					Return("", apperror.ErrFailedUploadCloudinary).Once()
+				mockLogger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything).Once()
+				mockMover.EXPECT().MakePrivate(ctx, "image/upload/next.jpg").
+					Return("image/authenticated/next.jpg", nil).Once()
+				mockAttachRepo.EXPECT().UpdateStorageKeys(mockTx, next).Return(nil).Once()
+
+				moved, err := legacyService.MakePrivate(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(moved).To(Equal(1))
+				Expect(failing.StorageKey).To(Equal("image/upload/failing.jpg"))
+			})
+		})
+
+		Context("when the attachments can not be listed", func() {
+			It("should return the error", func() {
+				dbErr := apperror.ErrDBOperation.WithError(errors.New("connection refused"))
+				mockAttachRepo.EXPECT().FindPublicUploads(ctx, uuid.Nil, 2).Return(nil, dbErr).Once()
+
+				moved, err := legacyService.MakePrivate(ctx)
+
+				Expect(moved).To(BeZero())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/inbox"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UpdateNotificationPreferenceCommand struct {
+	EmailEnabled bool
+	MutedKinds   []string
+}
+
+// NotificationService manages the inbox of the current user and how they are
+// notified of claim changes. The notifications themselves are created from
+// the outbox.
+type NotificationService interface {
+	// GetPreference returns the preference of the current user, the default
+	// one when they never set it.
+	GetPreference(ctx context.Context) (*entity.NotificationPreference, error)
+	UpdatePreference(ctx context.Context, cmd *UpdateNotificationPreferenceCommand,
+	) (*entity.NotificationPreference, error)
+
+	GetNotifications(ctx context.Context, unreadOnly bool, pagination repository.Pagination,
+	) ([]*entity.Notification, int64, error)
+	CountUnread(ctx context.Context) (int64, error)
+	MarkRead(ctx context.Context, id uuid.UUID) (*entity.Notification, error)
+	// MarkAllRead marks every notification of the current user read and
+	// returns how many were unread.
+	MarkAllRead(ctx context.Context) (int64, error)
+	// Subscribe returns the notifications of the current user created from
+	// now on, and a function to call once done with them.
+	Subscribe(ctx context.Context) (<-chan *entity.Notification, func(), error)
+}
+
+type notificationService struct {
+	preferenceRepo   repository.NotificationPreferenceRepository
+	notificationRepo repository.NotificationRepository
+	hub              inbox.Hub
+}
+
+func NewNotificationService(preferenceRepo repository.NotificationPreferenceRepository,
+	notificationRepo repository.NotificationRepository, hub inbox.Hub,
+) NotificationService {
+	return &notificationService{
+		preferenceRepo:   preferenceRepo,
+		notificationRepo: notificationRepo,
+		hub:              hub,
+	}
+}
+
+func (s *notificationService) GetPreference(ctx context.Context) (*entity.NotificationPreference, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	preference, err := s.preferenceRepo.FindByUserID(ctx, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if preference == nil {
+		preference = entity.NewNotificationPreference(actor.UserID)
+	}
+	return preference, nil
+}
+
+func (s *notificationService) UpdatePreference(ctx context.Context, cmd *UpdateNotificationPreferenceCommand,
+) (*entity.NotificationPreference, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	mutedKinds := make([]string, 0, len(cmd.MutedKinds))
+	for _, kind := range cmd.MutedKinds {
+		if !entity.IsValidNotificationKind(kind) {
+			return nil, apperror.ErrInvalidInput.WithMessage("Unknown notification kind: " + kind)
+		}
+		mutedKinds = append(mutedKinds, kind)
+	}
+	slices.Sort(mutedKinds)
+
+	preference := entity.NewNotificationPreference(actor.UserID)
+	preference.EmailEnabled = cmd.EmailEnabled
+	preference.MutedKinds = slices.Compact(mutedKinds)
+	if err := s.preferenceRepo.Save(ctx, preference); err != nil {
+		return nil, err
+	}
+	return preference, nil
+}
+
+func (s *notificationService) GetNotifications(ctx context.Context, unreadOnly bool,
+	pagination repository.Pagination,
+) ([]*entity.Notification, int64, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, 0, apperror.ErrMissingUserID
+	}
+	if pagination.Page < 1 {
+		return nil, 0, apperror.ErrInvalidInput.WithMessage("Page must be at least 1")
+	}
+	if pagination.PageSize < 1 || pagination.PageSize > repository.MaxPageSize {
+		return nil, 0, apperror.ErrInvalidInput.
+			WithMessage(fmt.Sprintf("Page size must be between 1 and %d", repository.MaxPageSize))
+	}
+
+	return s.notificationRepo.FindByUserID(ctx, actor.UserID, unreadOnly, pagination)
+}
+
+func (s *notificationService) CountUnread(ctx context.Context) (int64, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return 0, apperror.ErrMissingUserID
+	}
+
+	return s.notificationRepo.CountUnread(ctx, actor.UserID)
+}
+
+func (s *notificationService) MarkRead(ctx context.Context, id uuid.UUID) (*entity.Notification, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	notification, err := s.notificationRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if notification.UserID != actor.UserID {
+		return nil, apperror.ErrNotFoundError.WithMessage("Notification not found")
+	}
+	if notification.ReadAt != nil {
+		return notification, nil
+	}
+
+	notification.MarkRead(time.Now())
+	if err = s.notificationRepo.MarkRead(ctx, notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (s *notificationService) MarkAllRead(ctx context.Context) (int64, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return 0, apperror.ErrMissingUserID
+	}
+
+	return s.notificationRepo.MarkAllRead(ctx, actor.UserID, time.Now())
+}
+
+func (s *notificationService) Subscribe(ctx context.Context) (<-chan *entity.Notification, func(), error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, nil, apperror.ErrMissingUserID
+	}
+
+	notifications, unsubscribe := s.hub.Subscribe(actor.UserID)
+	return notifications, unsubscribe, nil
+}
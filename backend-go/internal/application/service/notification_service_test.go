@@ -0,0 +1,230 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("NotificationService", func() {
+	var (
+		mockPreferenceRepo  *mocks.NotificationPreferenceRepository
+		mockInboxRepo       *mocks.NotificationRepository
+		mockHub             *mocks.Hub
+		notificationService service.NotificationService
+		ctx                 context.Context
+		userID              uuid.UUID
+	)
+
+	BeforeEach(func() {
+		mockPreferenceRepo = mocks.NewNotificationPreferenceRepository(GinkgoT())
+		mockInboxRepo = mocks.NewNotificationRepository(GinkgoT())
+		mockHub = mocks.NewHub(GinkgoT())
+		notificationService = service.NewNotificationService(mockPreferenceRepo, mockInboxRepo, mockHub)
+		userID = uuid.New()
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: userID,
+			Role:   entity.UserRoleScTechnician,
+		})
+	})
+
+	Describe("GetPreference", func() {
+		Context("when the user never set a preference", func() {
+			It("should return the default one", func() {
+				mockPreferenceRepo.EXPECT().FindByUserID(ctx, userID).Return(nil, nil).Once()
+
+				preference, err := notificationService.GetPreference(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(preference.UserID).To(Equal(userID))
+				Expect(preference.EmailEnabled).To(BeTrue())
+				Expect(preference.MutedKinds).To(BeEmpty())
+			})
+		})
+
+		Context("when the user set a preference", func() {
+			It("should return it", func() {
+				stored := entity.NewNotificationPreference(userID)
+				stored.EmailEnabled = false
+				mockPreferenceRepo.EXPECT().FindByUserID(ctx, userID).Return(stored, nil).Once()
+
+				preference, err := notificationService.GetPreference(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(preference).To(Equal(stored))
+			})
+		})
+
+		Context("when there is no actor", func() {
+			It("should return MissingUserID error", func() {
+				preference, err := notificationService.GetPreference(context.Background())
+
+				Expect(preference).To(BeNil())
+				ExpectAppError(err, apperror.ErrMissingUserID.ErrorCode)
+			})
+		})
+	})
+
+	Describe("UpdatePreference", func() {
+		Context("when the muted kinds are valid", func() {
+			It("should save them sorted without duplicates", func() {
+				mockPreferenceRepo.EXPECT().Save(ctx, mock.MatchedBy(func(p *entity.NotificationPreference) bool {
+					return p.UserID == userID && p.EmailEnabled
+				})).Return(nil).Once()
+
+				preference, err := notificationService.UpdatePreference(ctx, &service.UpdateNotificationPreferenceCommand{
+					EmailEnabled: true,
+					MutedKinds: []string{entity.NotificationClaimSubmitted, entity.NotificationClaimAssigned,
+						entity.NotificationClaimSubmitted},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(preference.MutedKinds).To(Equal([]string{
+					entity.NotificationClaimAssigned, entity.NotificationClaimSubmitted,
+				}))
+			})
+		})
+
+		Context("when a muted kind is unknown", func() {
+			It("should return InvalidInput error", func() {
+				preference, err := notificationService.UpdatePreference(ctx, &service.UpdateNotificationPreferenceCommand{
+					MutedKinds: []string{"CLAIM_DELETED"},
+				})
+
+				Expect(preference).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when the preference cannot be saved", func() {
+			It("should return the error", func() {
+				mockPreferenceRepo.EXPECT().Save(ctx, mock.Anything).Return(apperror.ErrDBOperation).Once()
+
+				preference, err := notificationService.UpdatePreference(ctx,
+					&service.UpdateNotificationPreferenceCommand{})
+
+				Expect(preference).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("GetNotifications", func() {
+		Context("when the pagination is valid", func() {
+			It("should return the notifications of the current user", func() {
+				pagination := repository.Pagination{Page: 2, PageSize: 10}
+				notifications := []*entity.Notification{
+					entity.NewNotification(userID, uuid.New(), entity.NotificationClaimAssigned, uuid.New(), "Assigned"),
+				}
+				mockInboxRepo.EXPECT().FindByUserID(ctx, userID, true, pagination).
+					Return(notifications, int64(11), nil).Once()
+
+				result, total, err := notificationService.GetNotifications(ctx, true, pagination)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(notifications))
+				Expect(total).To(Equal(int64(11)))
+			})
+		})
+
+		Context("when the page size is too large", func() {
+			It("should return InvalidInput error", func() {
+				result, _, err := notificationService.GetNotifications(ctx, false,
+					repository.Pagination{Page: 1, PageSize: repository.MaxPageSize + 1})
+
+				Expect(result).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+	})
+
+	Describe("MarkRead", func() {
+		var notification *entity.Notification
+
+		BeforeEach(func() {
+			notification = entity.NewNotification(userID, uuid.New(), entity.NotificationClaimApproved, uuid.New(),
+				"Approved")
+		})
+
+		Context("when the notification is unread", func() {
+			It("should mark it read", func() {
+				mockInboxRepo.EXPECT().FindByID(ctx, notification.ID).Return(notification, nil).Once()
+				mockInboxRepo.EXPECT().MarkRead(ctx, notification).Return(nil).Once()
+
+				result, err := notificationService.MarkRead(ctx, notification.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ReadAt).NotTo(BeNil())
+			})
+		})
+
+		Context("when the notification is already read", func() {
+			It("should keep its read time", func() {
+				readAt := time.Now().Add(-time.Hour)
+				notification.ReadAt = &readAt
+				mockInboxRepo.EXPECT().FindByID(ctx, notification.ID).Return(notification, nil).Once()
+
+				result, err := notificationService.MarkRead(ctx, notification.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*result.ReadAt).To(Equal(readAt))
+			})
+		})
+
+		Context("when the notification belongs to another user", func() {
+			It("should return NotFound error", func() {
+				notification.UserID = uuid.New()
+				mockInboxRepo.EXPECT().FindByID(ctx, notification.ID).Return(notification, nil).Once()
+
+				result, err := notificationService.MarkRead(ctx, notification.ID)
+
+				Expect(result).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("MarkAllRead", func() {
+		It("should mark the notifications of the current user read", func() {
+			mockInboxRepo.EXPECT().MarkAllRead(ctx, userID, mock.AnythingOfType("time.Time")).
+				Return(int64(3), nil).Once()
+
+			updated, err := notificationService.MarkAllRead(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(3)))
+		})
+	})
+
+	Describe("Subscribe", func() {
+		It("should subscribe to the notifications of the current user", func() {
+			var notifications <-chan *entity.Notification = make(chan *entity.Notification)
+			mockHub.EXPECT().Subscribe(userID).Return(notifications, func() {}).Once()
+
+			result, unsubscribe, err := notificationService.Subscribe(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(notifications))
+			Expect(unsubscribe).NotTo(BeNil())
+		})
+
+		Context("when there is no actor", func() {
+			It("should return MissingUserID error", func() {
+				_, _, err := notificationService.Subscribe(context.Background())
+
+				ExpectAppError(err, apperror.ErrMissingUserID.ErrorCode)
+			})
+		})
+	})
+})
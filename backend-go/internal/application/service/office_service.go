@@ -14,6 +14,7 @@ type CreateOfficeCommand struct {
 	OfficeType string
 	Address    string
 	IsActive   bool
+	LaborRate  int64
 }
 
 type UpdateOfficeCommand struct {
@@ -21,6 +22,7 @@ type UpdateOfficeCommand struct {
 	OfficeType string
 	Address    string
 	IsActive   bool
+	LaborRate  int64
 }
 
 type OfficeService interface {
@@ -44,7 +46,12 @@ func (s *officeService) Create(ctx context.Context, cmd *CreateOfficeCommand) (*
 		return nil, apperror.ErrInvalidInput.WithMessage("Invalid office type")
 	}
 
+	if cmd.LaborRate < 0 {
+		return nil, apperror.ErrInvalidInput.WithMessage("Labor rate cannot be negative")
+	}
+
 	office := entity.NewOffice(cmd.OfficeName, cmd.OfficeType, cmd.Address, cmd.IsActive)
+	office.LaborRate = cmd.LaborRate
 	if err := s.repo.Create(ctx, office); err != nil {
 		return nil, err
 	}
@@ -69,10 +76,14 @@ func (s *officeService) Update(ctx context.Context, id uuid.UUID, cmd *UpdateOff
 	if !entity.IsValidOfficeType(cmd.OfficeType) {
 		return apperror.ErrInvalidInput.WithMessage("Invalid office type")
 	}
+	if cmd.LaborRate < 0 {
+		return apperror.ErrInvalidInput.WithMessage("Labor rate cannot be negative")
+	}
 	office.OfficeName = cmd.OfficeName
 	office.OfficeType = cmd.OfficeType
 	office.Address = cmd.Address
 	office.IsActive = cmd.IsActive
+	office.LaborRate = cmd.LaborRate
 
 	if err = s.repo.Update(ctx, office); err != nil {
 		return err
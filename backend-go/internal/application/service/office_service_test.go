@@ -37,6 +37,7 @@ var _ = Describe("OfficeService", func() {
 					OfficeType: entity.OfficeTypeEVM,
 					Address:    "123 Test St",
 					IsActive:   true,
+					LaborRate:  250000,
 				}
 			})
 
@@ -51,6 +52,7 @@ var _ = Describe("OfficeService", func() {
 				Expect(office.OfficeType).To(Equal(cmd.OfficeType))
 				Expect(office.Address).To(Equal(cmd.Address))
 				Expect(office.IsActive).To(Equal(cmd.IsActive))
+				Expect(office.LaborRate).To(Equal(cmd.LaborRate))
 				Expect(office.ID).NotTo(Equal(uuid.Nil))
 			})
 		})
@@ -97,6 +99,25 @@ var _ = Describe("OfficeService", func() {
 			})
 		})
 
+		Context("when labor rate is negative", func() {
+			BeforeEach(func() {
+				cmd = &service.CreateOfficeCommand{
+					OfficeName: "Test Office",
+					OfficeType: entity.OfficeTypeSC,
+					Address:    "123 Test St",
+					IsActive:   true,
+					LaborRate:  -1,
+				}
+			})
+
+			It("should return InvalidInput error", func() {
+				office, err := officeService.Create(ctx, cmd)
+
+				Expect(office).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
 		Context("when repository create fails", func() {
 			BeforeEach(func() {
 				cmd = &service.CreateOfficeCommand{
@@ -262,6 +283,7 @@ var _ = Describe("OfficeService", func() {
 					OfficeType: entity.OfficeTypeSC,
 					Address:    "Updated Address",
 					IsActive:   false,
+					LaborRate:  300000,
 				}
 			})
 
@@ -314,6 +336,26 @@ var _ = Describe("OfficeService", func() {
 			})
 		})
 
+		Context("when labor rate is negative", func() {
+			BeforeEach(func() {
+				cmd = &service.UpdateOfficeCommand{
+					OfficeName: "Updated Office Name",
+					OfficeType: entity.OfficeTypeSC,
+					Address:    "Updated Address",
+					IsActive:   true,
+					LaborRate:  -500,
+				}
+			})
+
+			It("should return InvalidInput error", func() {
+				mockRepo.EXPECT().FindByID(ctx, officeID).Return(existingOffice, nil).Once()
+
+				err := officeService.Update(ctx, officeID, cmd)
+
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
 		Context("when repository update fails", func() {
 			BeforeEach(func() {
 				cmd = &service.UpdateOfficeCommand{
@@ -384,7 +426,8 @@ func MatchOffice(cmd *service.CreateOfficeCommand) interface{} {
 		return o.OfficeName == cmd.OfficeName &&
 			o.OfficeType == cmd.OfficeType &&
 			o.Address == cmd.Address &&
-			o.IsActive == cmd.IsActive
+			o.IsActive == cmd.IsActive &&
+			o.LaborRate == cmd.LaborRate
 	})
 }
 
@@ -394,6 +437,7 @@ func MatchUpdatedOffice(id uuid.UUID, cmd *service.UpdateOfficeCommand) interfac
 			o.OfficeName == cmd.OfficeName &&
 			o.OfficeType == cmd.OfficeType &&
 			o.Address == cmd.Address &&
-			o.IsActive == cmd.IsActive
+			o.IsActive == cmd.IsActive &&
+			o.LaborRate == cmd.LaborRate
 	})
 }
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/client/dotnet"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// unreserveTimeout bounds the compensating unreserve call made once a
+	// request's transaction has finished, when the request context may be
+	// gone.
+	unreserveTimeout = 10 * time.Second
+	// releaseClaimTTL is how long ProcessPending hides the releases it
+	// attempts from other runs. A release whose outcome was never recorded,
+	// such as when the server stopped, is retried once it is over.
+	releaseClaimTTL = 5 * time.Minute
+)
+
+type PartReservationConfig struct {
+	// ServiceToken is the Authorization header used by the background jobs,
+	// which have no user token to act with.
+	ServiceToken string
+	// Grace is how long a reservation may stay pending before it is treated
+	// as belonging to a transaction that never finished.
+	Grace          time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	BaseBackoff    time.Duration
+	MaxBackoff     time.Duration
+	ReleaseOrphans bool
+}
+
+type PartReconcileReport struct {
+	ReservedParts     int         `json:"reserved_parts"`
+	Orphaned          []uuid.UUID `json:"orphaned"`
+	ScheduledReleases int         `json:"scheduled_releases"`
+}
+
+// PartReservationService keeps reservations made in the .NET inventory in
+// step with the claim items holding them. A part is reserved before the
+// claim item is written, so every reservation is recorded as pending in its
+// own transaction first and compensated with an unreserve call when the
+// claim item's transaction rolls back.
+type PartReservationService interface {
+	// Reserve reserves a part of the category at the office for the claim
+	// item being written in tx, released again if tx rolls back.
+	Reserve(tx application.Tx, claimID, itemID, officeID, categoryID uuid.UUID, authToken string,
+	) (*dotnet.PartResponse, error)
+	// Release schedules the part held by a claim item to be unreserved once
+	// tx commits.
+	Release(tx application.Tx, claimID, itemID, partID uuid.UUID, reason, authToken string) error
+
+	// ProcessPending releases reservations left pending past the grace
+	// period, then retries one batch of due releases. The batch is claimed
+	// in one transaction and its outcome recorded in another, so no row is
+	// locked during the unreserve calls. It returns how many releases were
+	// attempted.
+	ProcessPending(ctx context.Context) (int, error)
+	// Reconcile compares the parts reserved in the inventory with the parts
+	// held by claim items and reports the ones nothing accounts for.
+	Reconcile(ctx context.Context) (*PartReconcileReport, error)
+}
+
+type partReservationService struct {
+	log             logger.Logger
+	txManager       application.TxManager
+	reservationRepo repository.PartReservationRepository
+	itemRepo        repository.ClaimItemRepository
+	dotnetClient    dotnet.Client
+	cfg             PartReservationConfig
+}
+
+func NewPartReservationService(log logger.Logger, txManager application.TxManager,
+	reservationRepo repository.PartReservationRepository, itemRepo repository.ClaimItemRepository,
+	dotnetClient dotnet.Client, cfg PartReservationConfig,
+) PartReservationService {
+	return &partReservationService{
+		log:             log,
+		txManager:       txManager,
+		reservationRepo: reservationRepo,
+		itemRepo:        itemRepo,
+		dotnetClient:    dotnetClient,
+		cfg:             cfg,
+	}
+}
+
+func (s *partReservationService) Reserve(tx application.Tx, claimID, itemID, officeID, categoryID uuid.UUID,
+	authToken string,
+) (*dotnet.PartResponse, error) {
+	part, err := s.dotnetClient.ReservePart(tx.GetCtx(), officeID, categoryID, authToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pending row is committed on its own so it outlives tx. Should the
+	// process die before tx ends, ProcessPending still finds the part.
+	reservation := entity.NewPartReservation(part.ID, &claimID, &itemID)
+	err = s.txManager.Do(tx.GetCtx(), func(pendingTx application.Tx) error {
+		return s.reservationRepo.Create(pendingTx, reservation)
+	})
+	if err != nil {
+		s.unreserve(reservation.PartID, authToken)
+		return nil, err
+	}
+
+	tx.OnRollback(func() {
+		reservation.ScheduleRelease(entity.ReleaseReasonRollback, time.Now())
+		s.releaseNow(reservation, authToken)
+	})
+
+	reservation.Activate()
+	if err = s.reservationRepo.Update(tx, reservation); err != nil {
+		return nil, err
+	}
+
+	return part, nil
+}
+
+func (s *partReservationService) Release(tx application.Tx, claimID, itemID, partID uuid.UUID,
+	reason, authToken string,
+) error {
+	reservation, err := s.reservationRepo.FindActiveByPartID(tx.GetCtx(), partID)
+	if err != nil {
+		return err
+	}
+
+	// The retry is held back by the grace period so the job does not race
+	// the release attempted right after commit.
+	notBefore := time.Now().Add(s.cfg.Grace)
+	if reservation == nil {
+		reservation = entity.NewPartReservation(partID, &claimID, &itemID)
+		reservation.ScheduleRelease(reason, notBefore)
+		err = s.reservationRepo.Create(tx, reservation)
+	} else {
+		reservation.ScheduleRelease(reason, notBefore)
+		err = s.reservationRepo.Update(tx, reservation)
+	}
+	if err != nil {
+		return err
+	}
+
+	tx.OnCommit(func() {
+		s.releaseNow(reservation, authToken)
+	})
+
+	return nil
+}
+
+func (s *partReservationService) ProcessPending(ctx context.Context) (int, error) {
+	var due []*entity.PartReservation
+	err := s.txManager.Do(ctx, func(tx application.Tx) error {
+		now := time.Now()
+		stale, err := s.reservationRepo.FindStalePending(tx, now.Add(-s.cfg.Grace), s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range stale {
+			reservation.ScheduleRelease(entity.ReleaseReasonRollback, now)
+			if err = s.reservationRepo.Update(tx, reservation); err != nil {
+				return err
+			}
+		}
+
+		due, err = s.reservationRepo.FindDueReleases(tx, now, s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range due {
+			reservation.ClaimRelease(now.Add(releaseClaimTTL))
+			if err = s.reservationRepo.Update(tx, reservation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// The unreserve calls are made once the claimed releases are committed,
+	// so no row stays locked while the inventory answers.
+	for _, reservation := range due {
+		s.attemptRelease(ctx, reservation, s.cfg.ServiceToken)
+	}
+	err = s.txManager.Do(ctx, func(tx application.Tx) error {
+		for _, reservation := range due {
+			if err := s.reservationRepo.Update(tx, reservation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(due), nil
+}
+
+func (s *partReservationService) Reconcile(ctx context.Context) (*PartReconcileReport, error) {
+	reservedParts, err := s.dotnetClient.ListReservedParts(ctx, s.cfg.ServiceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	heldPartIDs, err := s.itemRepo.FindReplacementPartIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	unsettledPartIDs, err := s.reservationRepo.FindUnsettledPartIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounted := make(map[uuid.UUID]struct{}, len(heldPartIDs)+len(unsettledPartIDs))
+	for _, partID := range heldPartIDs {
+		accounted[partID] = struct{}{}
+	}
+	for _, partID := range unsettledPartIDs {
+		accounted[partID] = struct{}{}
+	}
+
+	report := &PartReconcileReport{
+		ReservedParts: len(reservedParts),
+		Orphaned:      []uuid.UUID{},
+	}
+	for _, part := range reservedParts {
+		if _, ok := accounted[part.ID]; !ok {
+			report.Orphaned = append(report.Orphaned, part.ID)
+		}
+	}
+
+	if len(report.Orphaned) == 0 {
+		return report, nil
+	}
+	s.log.Warn("[PartReservation] Orphaned part reservations found", "count", len(report.Orphaned),
+		"part_ids", report.Orphaned, "release", s.cfg.ReleaseOrphans)
+
+	if !s.cfg.ReleaseOrphans {
+		return report, nil
+	}
+
+	// Orphans are released by ProcessPending after the grace period, which
+	// leaves a reservation made while this ran time to be recorded.
+	err = s.txManager.Do(ctx, func(tx application.Tx) error {
+		for _, partID := range report.Orphaned {
+			reservation := entity.NewPartReservation(partID, nil, nil)
+			reservation.ScheduleRelease(entity.ReleaseReasonOrphaned, time.Now().Add(s.cfg.Grace))
+			if err := s.reservationRepo.Create(tx, reservation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.ScheduledReleases = len(report.Orphaned)
+
+	return report, nil
+}
+
+// releaseNow unreserves the part once the request's transaction is over and
+// records the outcome. A failed release is left for ProcessPending to retry.
+func (s *partReservationService) releaseNow(reservation *entity.PartReservation, authToken string) {
+	ctx, cancel := context.WithTimeout(context.Background(), unreserveTimeout)
+	defer cancel()
+
+	s.attemptRelease(ctx, reservation, authToken)
+	err := s.txManager.Do(ctx, func(tx application.Tx) error {
+		return s.reservationRepo.Update(tx, reservation)
+	})
+	if err != nil {
+		s.log.Error("[PartReservation] Failed to record release", "reservation_id", reservation.ID,
+			"part_id", reservation.PartID, "status", reservation.Status, "error", err)
+	}
+}
+
+// unreserve is a best effort release of a part no reservation was recorded
+// for, which leaves it to Reconcile when it fails.
+func (s *partReservationService) unreserve(partID uuid.UUID, authToken string) {
+	ctx, cancel := context.WithTimeout(context.Background(), unreserveTimeout)
+	defer cancel()
+
+	if err := s.dotnetClient.UnreservePart(ctx, partID, authToken); err != nil {
+		s.log.Error("[PartReservation] Failed to release unrecorded part", "part_id", partID, "error", err)
+	}
+}
+
+func (s *partReservationService) attemptRelease(ctx context.Context, reservation *entity.PartReservation,
+	authToken string,
+) {
+	err := s.dotnetClient.UnreservePart(ctx, reservation.PartID, authToken)
+	var appErr *apperror.AppError
+	if errors.As(err, &appErr) && appErr.ErrorCode == apperror.ErrPartNotFound.ErrorCode {
+		// A part gone from the inventory is not reserved by anyone anymore.
+		s.log.Warn("[PartReservation] Released part not found in inventory", "reservation_id", reservation.ID,
+			"part_id", reservation.PartID)
+		err = nil
+	}
+	if err != nil {
+		reservation.MarkReleaseFailed(err, time.Now().Add(s.releaseBackoff(reservation.Attempts+1)),
+			s.cfg.MaxAttempts)
+		if reservation.Status == entity.PartReservationStatusFailed {
+			s.log.Error("[PartReservation] Part release failed for good", "reservation_id", reservation.ID,
+				"part_id", reservation.PartID, "attempts", reservation.Attempts, "error", err)
+		} else {
+			s.log.Warn("[PartReservation] Part release failed", "reservation_id", reservation.ID,
+				"part_id", reservation.PartID, "attempts", reservation.Attempts, "error", err)
+		}
+		return
+	}
+
+	reservation.MarkReleased(time.Now())
+}
+
+// releaseBackoff returns the delay before the given attempt, doubling from
+// BaseBackoff and capped at MaxBackoff.
+func (s *partReservationService) releaseBackoff(attempt int) time.Duration {
+	delay := s.cfg.BaseBackoff
+	for i := 1; i < attempt && delay < s.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, s.cfg.MaxBackoff)
+}
@@ -0,0 +1,300 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/infrastructure/client/dotnet"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("PartReservationService", func() {
+	var (
+		mockLogger          *mocks.Logger
+		mockTxManager       *mocks.TxManager
+		mockTx              *mocks.Tx
+		mockReservationRepo *mocks.PartReservationRepository
+		mockItemRepo        *mocks.ClaimItemRepository
+		mockClient          *mocks.Client
+		cfg                 service.PartReservationConfig
+		ctx                 context.Context
+		claimID             uuid.UUID
+		itemID              uuid.UUID
+		partID              uuid.UUID
+	)
+
+	newService := func() service.PartReservationService {
+		return service.NewPartReservationService(mockLogger, mockTxManager, mockReservationRepo, mockItemRepo,
+			mockClient, cfg)
+	}
+
+	BeforeEach(func() {
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		mockReservationRepo = mocks.NewPartReservationRepository(GinkgoT())
+		mockItemRepo = mocks.NewClaimItemRepository(GinkgoT())
+		mockClient = mocks.NewClient(GinkgoT())
+		cfg = service.PartReservationConfig{
+			ServiceToken: "Bearer service",
+			Grace:        time.Minute,
+			BatchSize:    10,
+			MaxAttempts:  3,
+			BaseBackoff:  time.Second,
+			MaxBackoff:   time.Minute,
+		}
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: uuid.New(),
+			Role:   entity.UserRoleScStaff,
+		})
+		claimID = uuid.New()
+		itemID = uuid.New()
+		partID = uuid.New()
+
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+		mockTxManager.EXPECT().Do(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+				return fn(mockTx)
+			}).Maybe()
+	})
+
+	Describe("Reserve", func() {
+		var officeID, categoryID uuid.UUID
+
+		BeforeEach(func() {
+			officeID = uuid.New()
+			categoryID = uuid.New()
+		})
+
+		Context("when the part is reserved", func() {
+			It("should record the reservation and release it if the transaction rolls back", func() {
+				var reservation *entity.PartReservation
+				var onRollback func()
+
+				mockClient.EXPECT().ReservePart(ctx, officeID, categoryID, "Bearer user").
+					Return(&dotnet.PartResponse{ID: partID, UnitPrice: json.Number("120")}, nil).Once()
+				mockReservationRepo.EXPECT().Create(mockTx, mock.Anything).
+					RunAndReturn(func(_ application.Tx, r *entity.PartReservation) error {
+						Expect(r.Status).To(Equal(entity.PartReservationStatusPending))
+						reservation = r
+						return nil
+					}).Once()
+				mockTx.EXPECT().OnRollback(mock.Anything).Run(func(fn func()) { onRollback = fn }).Once()
+				mockReservationRepo.EXPECT().Update(mockTx, mock.Anything).Return(nil).Once()
+
+				part, err := newService().Reserve(mockTx, claimID, itemID, officeID, categoryID, "Bearer user")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(part.ID).To(Equal(partID))
+				Expect(reservation.PartID).To(Equal(partID))
+				Expect(*reservation.ClaimItemID).To(Equal(itemID))
+				Expect(reservation.Status).To(Equal(entity.PartReservationStatusActive))
+
+				mockClient.EXPECT().UnreservePart(mock.Anything, partID, "Bearer user").Return(nil).Once()
+				mockReservationRepo.EXPECT().Update(mockTx, reservation).Return(nil).Once()
+
+				onRollback()
+
+				Expect(reservation.Status).To(Equal(entity.PartReservationStatusReleased))
+				Expect(*reservation.ReleaseReason).To(Equal(entity.ReleaseReasonRollback))
+			})
+		})
+
+		Context("when the inventory has no part left", func() {
+			It("should return the inventory error", func() {
+				mockClient.EXPECT().ReservePart(ctx, officeID, categoryID, "Bearer user").
+					Return(nil, apperror.ErrPartOutOfStock).Once()
+
+				part, err := newService().Reserve(mockTx, claimID, itemID, officeID, categoryID, "Bearer user")
+
+				Expect(part).To(BeNil())
+				ExpectAppError(err, apperror.ErrPartOutOfStock.ErrorCode)
+			})
+		})
+
+		Context("when the reservation cannot be recorded", func() {
+			It("should unreserve the part and return the error", func() {
+				mockClient.EXPECT().ReservePart(ctx, officeID, categoryID, "Bearer user").
+					Return(&dotnet.PartResponse{ID: partID}, nil).Once()
+				mockReservationRepo.EXPECT().Create(mockTx, mock.Anything).
+					Return(apperror.ErrDBOperation).Once()
+				mockClient.EXPECT().UnreservePart(mock.Anything, partID, "Bearer user").Return(nil).Once()
+
+				part, err := newService().Reserve(mockTx, claimID, itemID, officeID, categoryID, "Bearer user")
+
+				Expect(part).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Release", func() {
+		Context("when the part has an active reservation", func() {
+			It("should schedule the release and unreserve once the transaction commits", func() {
+				reservation := entity.NewPartReservation(partID, &claimID, &itemID)
+				reservation.Activate()
+				var onCommit func()
+
+				mockReservationRepo.EXPECT().FindActiveByPartID(ctx, partID).Return(reservation, nil).Once()
+				mockReservationRepo.EXPECT().Update(mockTx, reservation).Return(nil).Once()
+				mockTx.EXPECT().OnCommit(mock.Anything).Run(func(fn func()) { onCommit = fn }).Once()
+
+				err := newService().Release(mockTx, claimID, itemID, partID, entity.ReleaseReasonItemRemoved,
+					"Bearer user")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reservation.Status).To(Equal(entity.PartReservationStatusReleasing))
+				Expect(*reservation.ReleaseReason).To(Equal(entity.ReleaseReasonItemRemoved))
+
+				mockClient.EXPECT().UnreservePart(mock.Anything, partID, "Bearer user").
+					Return(errors.New("inventory unavailable")).Once()
+				mockLogger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+				mockReservationRepo.EXPECT().Update(mockTx, reservation).Return(nil).Once()
+
+				onCommit()
+
+				Expect(reservation.Status).To(Equal(entity.PartReservationStatusReleasing))
+				Expect(reservation.Attempts).To(Equal(1))
+				Expect(*reservation.LastError).To(Equal("inventory unavailable"))
+			})
+		})
+
+		Context("when the part is gone from the inventory", func() {
+			It("should consider the part released", func() {
+				reservation := entity.NewPartReservation(partID, &claimID, &itemID)
+				reservation.Activate()
+				var onCommit func()
+
+				mockReservationRepo.EXPECT().FindActiveByPartID(ctx, partID).Return(reservation, nil).Once()
+				mockReservationRepo.EXPECT().Update(mockTx, reservation).Return(nil).Twice()
+				mockTx.EXPECT().OnCommit(mock.Anything).Run(func(fn func()) { onCommit = fn }).Once()
+				mockClient.EXPECT().UnreservePart(mock.Anything, partID, "Bearer user").
+					Return(apperror.ErrPartNotFound).Once()
+				mockLogger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+					mock.Anything).Once()
+
+				err := newService().Release(mockTx, claimID, itemID, partID, entity.ReleaseReasonItemRemoved,
+					"Bearer user")
+				onCommit()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reservation.Status).To(Equal(entity.PartReservationStatusReleased))
+			})
+		})
+
+		Context("when the part was reserved before reservations were tracked", func() {
+			It("should record a new reservation being released", func() {
+				mockReservationRepo.EXPECT().FindActiveByPartID(ctx, partID).Return(nil, nil).Once()
+				mockReservationRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(r *entity.PartReservation) bool {
+					return r.PartID == partID && r.Status == entity.PartReservationStatusReleasing &&
+						*r.ReleaseReason == entity.ReleaseReasonItemRejected
+				})).Return(nil).Once()
+				mockTx.EXPECT().OnCommit(mock.Anything).Once()
+
+				err := newService().Release(mockTx, claimID, itemID, partID, entity.ReleaseReasonItemRejected,
+					"Bearer user")
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ProcessPending", func() {
+		It("should release stale pending reservations and retry due releases", func() {
+			stale := entity.NewPartReservation(partID, &claimID, &itemID)
+			due := entity.NewPartReservation(uuid.New(), &claimID, &itemID)
+			due.ScheduleRelease(entity.ReleaseReasonItemRemoved, time.Now())
+			due.Attempts = 2
+
+			mockReservationRepo.EXPECT().FindStalePending(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+				Return([]*entity.PartReservation{stale}, nil).Once()
+			mockReservationRepo.EXPECT().Update(mockTx, stale).Return(nil).Times(3)
+			mockReservationRepo.EXPECT().FindDueReleases(mockTx, mock.AnythingOfType("time.Time"), cfg.BatchSize).
+				Return([]*entity.PartReservation{stale, due}, nil).Once()
+			mockClient.EXPECT().UnreservePart(ctx, stale.PartID, cfg.ServiceToken).Return(nil).Once()
+			mockClient.EXPECT().UnreservePart(ctx, due.PartID, cfg.ServiceToken).
+				Return(errors.New("inventory unavailable")).Once()
+			mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once()
+			mockReservationRepo.EXPECT().Update(mockTx, due).Return(nil).Twice()
+
+			processed, err := newService().ProcessPending(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(processed).To(Equal(2))
+			Expect(stale.Status).To(Equal(entity.PartReservationStatusReleased))
+			Expect(*stale.ReleaseReason).To(Equal(entity.ReleaseReasonRollback))
+			Expect(due.Status).To(Equal(entity.PartReservationStatusFailed))
+		})
+
+		It("should return the error when due releases cannot be loaded", func() {
+			mockReservationRepo.EXPECT().FindStalePending(mockTx, mock.Anything, cfg.BatchSize).
+				Return(nil, nil).Once()
+			mockReservationRepo.EXPECT().FindDueReleases(mockTx, mock.Anything, cfg.BatchSize).
+				Return(nil, apperror.ErrDBOperation).Once()
+
+			processed, err := newService().ProcessPending(ctx)
+
+			Expect(processed).To(BeZero())
+			ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+		})
+	})
+
+	Describe("Reconcile", func() {
+		var heldPartID, unsettledPartID, orphanPartID uuid.UUID
+
+		BeforeEach(func() {
+			heldPartID = uuid.New()
+			unsettledPartID = uuid.New()
+			orphanPartID = uuid.New()
+
+			mockClient.EXPECT().ListReservedParts(ctx, cfg.ServiceToken).Return([]dotnet.PartResponse{
+				{ID: heldPartID}, {ID: unsettledPartID}, {ID: orphanPartID},
+			}, nil).Once()
+			mockItemRepo.EXPECT().FindReplacementPartIDs(ctx).Return([]uuid.UUID{heldPartID}, nil).Once()
+			mockReservationRepo.EXPECT().FindUnsettledPartIDs(ctx).Return([]uuid.UUID{unsettledPartID}, nil).Once()
+			mockLogger.EXPECT().Warn(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything).Once()
+		})
+
+		Context("when orphans are only reported", func() {
+			It("should report the parts no claim item accounts for", func() {
+				report, err := newService().Reconcile(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(report.ReservedParts).To(Equal(3))
+				Expect(report.Orphaned).To(Equal([]uuid.UUID{orphanPartID}))
+				Expect(report.ScheduledReleases).To(BeZero())
+			})
+		})
+
+		Context("when orphans are released", func() {
+			It("should schedule a release for each orphan", func() {
+				cfg.ReleaseOrphans = true
+				mockReservationRepo.EXPECT().Create(mockTx, mock.MatchedBy(func(r *entity.PartReservation) bool {
+					return r.PartID == orphanPartID && r.ClaimItemID == nil &&
+						r.Status == entity.PartReservationStatusReleasing &&
+						*r.ReleaseReason == entity.ReleaseReasonOrphaned
+				})).Return(nil).Once()
+
+				report, err := newService().Reconcile(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(report.Orphaned).To(Equal([]uuid.UUID{orphanPartID}))
+				Expect(report.ScheduledReleases).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+)
+
+// ReportService serves claim analytics. Office scoped actors only get the
+// figures of their own office.
+type ReportService interface {
+	ClaimVolume(ctx context.Context, filters repository.ReportFilters) ([]*repository.ClaimVolume, error)
+	DecisionRates(ctx context.Context, filters repository.ReportFilters) ([]*repository.DecisionRate, error)
+	TimeInStatus(ctx context.Context, filters repository.ReportFilters) ([]*repository.StatusDuration, error)
+	PartFailures(ctx context.Context, filters repository.ReportFilters) ([]*repository.PartFailure, error)
+	OfficeCosts(ctx context.Context, filters repository.ReportFilters) ([]*repository.OfficeCost, error)
+}
+
+type reportService struct {
+	reportRepo repository.ReportRepository
+}
+
+func NewReportService(reportRepo repository.ReportRepository) ReportService {
+	return &reportService{
+		reportRepo: reportRepo,
+	}
+}
+
+func (s *reportService) ClaimVolume(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.ClaimVolume, error) {
+	filters, err := scopeReportFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return s.reportRepo.ClaimVolume(ctx, filters)
+}
+
+func (s *reportService) DecisionRates(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.DecisionRate, error) {
+	filters, err := scopeReportFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return s.reportRepo.DecisionRates(ctx, filters)
+}
+
+func (s *reportService) TimeInStatus(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.StatusDuration, error) {
+	filters, err := scopeReportFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return s.reportRepo.TimeInStatus(ctx, filters)
+}
+
+func (s *reportService) PartFailures(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.PartFailure, error) {
+	if filters.Limit < 1 || filters.Limit > repository.MaxPartFailuresLimit {
+		return nil, apperror.ErrInvalidInput.
+			WithMessage(fmt.Sprintf("Limit must be between 1 and %d", repository.MaxPartFailuresLimit))
+	}
+
+	filters, err := scopeReportFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return s.reportRepo.PartFailures(ctx, filters)
+}
+
+func (s *reportService) OfficeCosts(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.OfficeCost, error) {
+	filters, err := scopeReportFilters(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return s.reportRepo.OfficeCosts(ctx, filters)
+}
+
+// scopeReportFilters validates the range and granularity of a report and
+// restricts office scoped actors to their office.
+func scopeReportFilters(ctx context.Context, filters repository.ReportFilters) (repository.ReportFilters, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return filters, apperror.ErrMissingUserID
+	}
+
+	if filters.FromDate.After(filters.ToDate) {
+		return filters, apperror.ErrInvalidInput.WithMessage("From date must be before to date")
+	}
+	if !repository.IsValidGranularity(filters.Granularity) {
+		return filters, apperror.ErrInvalidInput.WithMessage("Granularity must be day, week or month")
+	}
+
+	if actor.IsOfficeScoped() {
+		officeID := actor.OfficeID
+		filters.OfficeID = &officeID
+	}
+	return filters, nil
+}
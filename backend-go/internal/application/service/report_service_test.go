@@ -0,0 +1,198 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("ReportService", func() {
+	var (
+		mockReportRepo *mocks.ReportRepository
+		reportService  service.ReportService
+		ctx            context.Context
+		filters        repository.ReportFilters
+	)
+
+	BeforeEach(func() {
+		mockReportRepo = mocks.NewReportRepository(GinkgoT())
+		reportService = service.NewReportService(mockReportRepo)
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: uuid.New(),
+			Role:   entity.UserRoleEvmStaff,
+		})
+
+		toDate := time.Date(2026, 9, 30, 23, 59, 59, 0, time.UTC)
+		filters = repository.ReportFilters{
+			FromDate:    toDate.AddDate(0, 0, -repository.DefaultReportDays),
+			ToDate:      toDate,
+			Granularity: repository.GranularityWeek,
+			Limit:       repository.DefaultPartFailuresLimit,
+		}
+	})
+
+	Describe("ClaimVolume", func() {
+		Context("when the actor is EVM staff", func() {
+			It("should report every office", func() {
+				volumes := []*repository.ClaimVolume{
+					{Period: filters.FromDate, OfficeID: uuid.New(), Status: entity.ClaimStatusSubmitted, Count: 4},
+				}
+				mockReportRepo.EXPECT().ClaimVolume(ctx, filters).Return(volumes, nil).Once()
+
+				result, err := reportService.ClaimVolume(ctx, filters)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(volumes))
+			})
+		})
+
+		Context("when the actor is SC staff", func() {
+			It("should only report their office", func() {
+				officeID := uuid.New()
+				ctx = application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+				otherOffice := uuid.New()
+				filters.OfficeID = &otherOffice
+
+				scoped := filters
+				scoped.OfficeID = &officeID
+				mockReportRepo.EXPECT().ClaimVolume(ctx, scoped).Return(nil, nil).Once()
+
+				_, err := reportService.ClaimVolume(ctx, filters)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the range is inverted", func() {
+			It("should return InvalidInput error", func() {
+				filters.FromDate, filters.ToDate = filters.ToDate, filters.FromDate
+
+				result, err := reportService.ClaimVolume(ctx, filters)
+
+				Expect(result).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when the granularity is unknown", func() {
+			It("should return InvalidInput error", func() {
+				filters.Granularity = "quarter"
+
+				result, err := reportService.ClaimVolume(ctx, filters)
+
+				Expect(result).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when there is no actor", func() {
+			It("should return MissingUserID error", func() {
+				result, err := reportService.ClaimVolume(context.Background(), filters)
+
+				Expect(result).To(BeNil())
+				ExpectAppError(err, apperror.ErrMissingUserID.ErrorCode)
+			})
+		})
+	})
+
+	Describe("DecisionRates", func() {
+		It("should return the rates of the repository", func() {
+			rates := []*repository.DecisionRate{
+				{Period: filters.FromDate, OfficeID: uuid.New(), Total: 4, Approved: 3, Rejected: 1,
+					ApprovalRate: 75, RejectionRate: 25},
+			}
+			mockReportRepo.EXPECT().DecisionRates(ctx, filters).Return(rates, nil).Once()
+
+			result, err := reportService.DecisionRates(ctx, filters)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(rates))
+		})
+	})
+
+	Describe("TimeInStatus", func() {
+		Context("when the repository fails", func() {
+			It("should return the error", func() {
+				dbErr := apperror.ErrDBOperation.WithError(errors.New("database error"))
+				mockReportRepo.EXPECT().TimeInStatus(ctx, filters).Return(nil, dbErr).Once()
+
+				result, err := reportService.TimeInStatus(ctx, filters)
+
+				Expect(result).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("PartFailures", func() {
+		Context("when the limit is in bounds", func() {
+			It("should return the top part categories", func() {
+				failures := []*repository.PartFailure{
+					{PartCategoryID: uuid.New(), Items: 7, Claims: 5, Currency: entity.DefaultCurrency,
+						TotalCost: 3500000},
+				}
+				mockReportRepo.EXPECT().PartFailures(ctx, filters).Return(failures, nil).Once()
+
+				result, err := reportService.PartFailures(ctx, filters)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(failures))
+			})
+		})
+
+		DescribeTable("when the limit is out of bounds",
+			func(limit int) {
+				filters.Limit = limit
+
+				result, err := reportService.PartFailures(ctx, filters)
+
+				Expect(result).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			},
+			Entry("zero", 0),
+			Entry("negative", -1),
+			Entry("above the maximum", repository.MaxPartFailuresLimit+1),
+		)
+	})
+
+	Describe("OfficeCosts", func() {
+		Context("when the actor is SC staff", func() {
+			It("should only report their office", func() {
+				officeID := uuid.New()
+				ctx = application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+
+				scoped := filters
+				scoped.OfficeID = &officeID
+				costs := []*repository.OfficeCost{
+					{Period: filters.FromDate, OfficeID: officeID, Currency: entity.DefaultCurrency, Claims: 2,
+						RequestedTotal: 900000, ApprovedTotal: 700000},
+				}
+				mockReportRepo.EXPECT().OfficeCosts(ctx, scoped).Return(costs, nil).Once()
+
+				result, err := reportService.OfficeCosts(ctx, filters)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(costs))
+			})
+		})
+	})
+})
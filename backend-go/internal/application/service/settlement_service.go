@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateSettlementBatchCommand settles the claims an office completed from
+// PeriodStart up to, but excluding, PeriodEnd.
+type CreateSettlementBatchCommand struct {
+	OfficeID    uuid.UUID
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+type SettlementService interface {
+	// Create drafts a batch with a line for every approved item of the
+	// office's claims completed in the period that is not settled yet.
+	Create(tx application.Tx, cmd *CreateSettlementBatchCommand) (*entity.SettlementBatch, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.SettlementBatch, error)
+	GetAll(ctx context.Context, filters repository.SettlementBatchFilters) ([]*entity.SettlementBatch, error)
+	// Issue sends the batch to the office and locks its claims against
+	// further changes.
+	Issue(tx application.Tx, id uuid.UUID) (*entity.SettlementBatch, error)
+	// MarkPaid records the payment of the batch on its claims' reimbursed
+	// totals.
+	MarkPaid(tx application.Tx, id uuid.UUID) (*entity.SettlementBatch, error)
+	Dispute(tx application.Tx, id uuid.UUID, reason string) (*entity.SettlementBatch, error)
+	// Delete discards a draft batch.
+	Delete(tx application.Tx, id uuid.UUID) error
+	Statement(ctx context.Context, id uuid.UUID, format string) (*SettlementStatement, error)
+}
+
+type settlementService struct {
+	batchRepo  repository.SettlementBatchRepository
+	claimRepo  repository.ClaimRepository
+	officeRepo repository.OfficeRepository
+	auditRepo  repository.ClaimAuditLogRepository
+	outboxRepo repository.OutboxEventRepository
+	costCfg    CostConfig
+}
+
+func NewSettlementService(batchRepo repository.SettlementBatchRepository, claimRepo repository.ClaimRepository,
+	officeRepo repository.OfficeRepository, auditRepo repository.ClaimAuditLogRepository,
+	outboxRepo repository.OutboxEventRepository, costCfg CostConfig,
+) SettlementService {
+	return &settlementService{
+		batchRepo:  batchRepo,
+		claimRepo:  claimRepo,
+		officeRepo: officeRepo,
+		auditRepo:  auditRepo,
+		outboxRepo: outboxRepo,
+		costCfg:    costCfg,
+	}
+}
+
+func (s *settlementService) Create(tx application.Tx, cmd *CreateSettlementBatchCommand,
+) (*entity.SettlementBatch, error) {
+	actor, ok := application.ActorFromContext(tx.GetCtx())
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	if !cmd.PeriodEnd.After(cmd.PeriodStart) {
+		return nil, apperror.ErrInvalidInput.WithMessage("Settlement period must end after it starts")
+	}
+	if _, err := s.officeRepo.FindByID(tx.GetCtx(), cmd.OfficeID); err != nil {
+		return nil, err
+	}
+
+	items, err := s.batchRepo.FindUnsettledItems(tx, cmd.OfficeID, cmd.PeriodStart, cmd.PeriodEnd,
+		s.costCfg.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, apperror.ErrNothingToSettle
+	}
+
+	batch := entity.NewSettlementBatch(cmd.OfficeID, cmd.PeriodStart, cmd.PeriodEnd, s.costCfg.Currency,
+		actor.UserID)
+	for _, item := range items {
+		batch.AddItem(item)
+	}
+
+	if err = s.batchRepo.Create(tx, batch); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (s *settlementService) GetByID(ctx context.Context, id uuid.UUID) (*entity.SettlementBatch, error) {
+	return s.findBatchInScope(ctx, id)
+}
+
+func (s *settlementService) GetAll(ctx context.Context, filters repository.SettlementBatchFilters,
+) ([]*entity.SettlementBatch, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	if actor.IsOfficeScoped() {
+		filters.OfficeID = &actor.OfficeID
+	}
+	if filters.Status != nil && !entity.IsValidSettlementStatus(*filters.Status) {
+		return nil, apperror.ErrInvalidParams.WithMessage("Invalid settlement status")
+	}
+	return s.batchRepo.FindAll(ctx, filters)
+}
+
+func (s *settlementService) Issue(tx application.Tx, id uuid.UUID) (*entity.SettlementBatch, error) {
+	batch, err := s.transition(tx, id, entity.SettlementStatusIssued)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	batch.IssuedAt = &now
+	batch.DisputeReason = nil
+	if err = s.batchRepo.Update(tx, batch); err != nil {
+		return nil, err
+	}
+
+	err = s.updateClaims(tx, batch, entity.EventClaimSettled, func(claim *entity.Claim) {
+		claim.SettlementBatchID = &batch.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (s *settlementService) MarkPaid(tx application.Tx, id uuid.UUID) (*entity.SettlementBatch, error) {
+	batch, err := s.transition(tx, id, entity.SettlementStatusPaid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	batch.PaidAt = &now
+	if err = s.batchRepo.Update(tx, batch); err != nil {
+		return nil, err
+	}
+
+	err = s.updateClaims(tx, batch, entity.EventClaimReimbursed, func(claim *entity.Claim) {
+		claim.ReimbursedTotal += batch.ClaimAmount(claim.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (s *settlementService) Dispute(tx application.Tx, id uuid.UUID, reason string,
+) (*entity.SettlementBatch, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, apperror.ErrInvalidInput.WithMessage("Dispute reason is required")
+	}
+
+	batch, err := s.transition(tx, id, entity.SettlementStatusDisputed)
+	if err != nil {
+		return nil, err
+	}
+
+	batch.DisputeReason = &reason
+	if err = s.batchRepo.Update(tx, batch); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (s *settlementService) Delete(tx application.Tx, id uuid.UUID) error {
+	batch, err := s.findBatchInScope(tx.GetCtx(), id)
+	if err != nil {
+		return err
+	}
+	if batch.Status != entity.SettlementStatusDraft {
+		return apperror.ErrInvalidSettlementAction.WithMessage("Can only delete a draft settlement batch")
+	}
+
+	return s.batchRepo.HardDelete(tx, id)
+}
+
+// transition loads the batch and moves it to status, when its lifecycle
+// allows it.
+func (s *settlementService) transition(tx application.Tx, id uuid.UUID, status string,
+) (*entity.SettlementBatch, error) {
+	batch, err := s.findBatchInScope(tx.GetCtx(), id)
+	if err != nil {
+		return nil, err
+	}
+	if !batch.CanTransitionTo(status) {
+		return nil, apperror.ErrInvalidSettlementAction.WithMessage(
+			"Cannot move a " + batch.Status + " settlement batch to " + status)
+	}
+
+	batch.Status = status
+	return batch, nil
+}
+
+// updateClaims applies change to every claim of the batch, then audits and
+// publishes eventType for each of them.
+func (s *settlementService) updateClaims(tx application.Tx, batch *entity.SettlementBatch, eventType string,
+	change func(claim *entity.Claim),
+) error {
+	for _, claimID := range batch.ClaimIDs() {
+		claim, err := s.claimRepo.FindByID(tx.GetCtx(), claimID)
+		if err != nil {
+			return err
+		}
+
+		before := *claim
+		change(claim)
+		if err = s.claimRepo.Update(tx, claim); err != nil {
+			return err
+		}
+
+		err = recordAudit(tx, s.auditRepo, claim.ID, entity.AuditEntityClaim, claim.ID, entity.AuditActionUpdate,
+			before, claim)
+		if err != nil {
+			return err
+		}
+
+		if err = publishClaimEvent(tx, s.outboxRepo, claim.ID, eventType, claimEventData{Claim: claim}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findBatchInScope loads a batch on behalf of the actor carried by ctx. Office
+// scoped actors only resolve the batches of their own office, any other is
+// reported as not found.
+func (s *settlementService) findBatchInScope(ctx context.Context, id uuid.UUID) (*entity.SettlementBatch, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	batch, err := s.batchRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if actor.IsOfficeScoped() && batch.OfficeID != actor.OfficeID {
+		return nil, apperror.ErrNotFoundError.WithMessage("Settlement batch not found")
+	}
+	return batch, nil
+}
@@ -0,0 +1,344 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/pkg/apperror"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("SettlementService", func() {
+	var (
+		mockBatchRepo     *mocks.SettlementBatchRepository
+		mockClaimRepo     *mocks.ClaimRepository
+		mockOfficeRepo    *mocks.OfficeRepository
+		mockAuditRepo     *mocks.ClaimAuditLogRepository
+		mockOutbox        *mocks.OutboxEventRepository
+		mockTx            *mocks.Tx
+		settlementService service.SettlementService
+		ctx               context.Context
+		officeID          uuid.UUID
+		periodStart       time.Time
+		periodEnd         time.Time
+		items             []*entity.ClaimItem
+	)
+
+	newBatch := func(status string) *entity.SettlementBatch {
+		batch := entity.NewSettlementBatch(officeID, periodStart, periodEnd, entity.DefaultCurrency, uuid.New())
+		for _, item := range items {
+			batch.AddItem(item)
+		}
+		batch.Status = status
+		return batch
+	}
+
+	BeforeEach(func() {
+		mockBatchRepo = mocks.NewSettlementBatchRepository(GinkgoT())
+		mockClaimRepo = mocks.NewClaimRepository(GinkgoT())
+		mockOfficeRepo = mocks.NewOfficeRepository(GinkgoT())
+		mockAuditRepo = mocks.NewClaimAuditLogRepository(GinkgoT())
+		mockOutbox = mocks.NewOutboxEventRepository(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		settlementService = service.NewSettlementService(mockBatchRepo, mockClaimRepo, mockOfficeRepo,
+			mockAuditRepo, mockOutbox, service.CostConfig{Currency: entity.DefaultCurrency})
+
+		officeID = uuid.New()
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: uuid.New(),
+			Role:   entity.UserRoleEvmStaff,
+		})
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+
+		periodStart = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+		periodEnd = periodStart.AddDate(0, 1, 0)
+		claimID := uuid.New()
+		items = []*entity.ClaimItem{
+			{ID: uuid.New(), ClaimID: claimID, IssueDescription: "Battery module", TotalCost: 1500000},
+			{ID: uuid.New(), ClaimID: claimID, IssueDescription: "Coolant pump", TotalCost: 250000},
+			{ID: uuid.New(), ClaimID: uuid.New(), IssueDescription: "Charging port", TotalCost: 400000},
+		}
+	})
+
+	Describe("Create", func() {
+		var cmd *service.CreateSettlementBatchCommand
+
+		BeforeEach(func() {
+			cmd = &service.CreateSettlementBatchCommand{
+				OfficeID:    officeID,
+				PeriodStart: periodStart,
+				PeriodEnd:   periodEnd,
+			}
+		})
+
+		Context("when the office has unsettled items", func() {
+			It("should draft a batch with a line per item", func() {
+				mockOfficeRepo.EXPECT().FindByID(ctx, officeID).Return(&entity.Office{ID: officeID}, nil).Once()
+				mockBatchRepo.EXPECT().FindUnsettledItems(mockTx, officeID, periodStart, periodEnd,
+					entity.DefaultCurrency).Return(items, nil).Once()
+				mockBatchRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.SettlementBatch")).
+					Return(nil).Once()
+
+				batch, err := settlementService.Create(mockTx, cmd)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(batch.Status).To(Equal(entity.SettlementStatusDraft))
+				Expect(batch.Lines).To(HaveLen(3))
+				Expect(batch.TotalAmount).To(Equal(int64(2150000)))
+				Expect(batch.ClaimIDs()).To(HaveLen(2))
+			})
+		})
+
+		Context("when there is nothing to settle", func() {
+			It("should return NothingToSettle error", func() {
+				mockOfficeRepo.EXPECT().FindByID(ctx, officeID).Return(&entity.Office{ID: officeID}, nil).Once()
+				mockBatchRepo.EXPECT().FindUnsettledItems(mockTx, officeID, periodStart, periodEnd,
+					entity.DefaultCurrency).Return(nil, nil).Once()
+
+				batch, err := settlementService.Create(mockTx, cmd)
+
+				Expect(batch).To(BeNil())
+				ExpectAppError(err, apperror.ErrNothingToSettle.ErrorCode)
+			})
+		})
+
+		Context("when the period ends before it starts", func() {
+			It("should return InvalidInput error", func() {
+				cmd.PeriodEnd = periodStart.AddDate(0, 0, -1)
+
+				batch, err := settlementService.Create(mockTx, cmd)
+
+				Expect(batch).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Issue", func() {
+		Context("when the batch is a draft", func() {
+			It("should issue it and lock its claims", func() {
+				batch := newBatch(entity.SettlementStatusDraft)
+				mockBatchRepo.EXPECT().FindByID(ctx, batch.ID).Return(batch, nil).Once()
+				mockBatchRepo.EXPECT().Update(mockTx, batch).Return(nil).Once()
+				for _, claimID := range batch.ClaimIDs() {
+					mockClaimRepo.EXPECT().FindByID(ctx, claimID).
+						Return(&entity.Claim{ID: claimID, Status: entity.ClaimStatusCompleted}, nil).Once()
+					mockClaimRepo.EXPECT().Update(mockTx, mock.MatchedBy(func(c *entity.Claim) bool {
+						return c.ID == claimID && c.SettlementBatchID != nil && *c.SettlementBatchID == batch.ID
+					})).Return(nil).Once()
+					mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimSettled, claimID)).
+						Return(nil).Once()
+				}
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).
+					Return(nil).Times(2)
+
+				issued, err := settlementService.Issue(mockTx, batch.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(issued.Status).To(Equal(entity.SettlementStatusIssued))
+				Expect(issued.IssuedAt).NotTo(BeNil())
+			})
+		})
+
+		Context("when the batch is already paid", func() {
+			It("should return InvalidSettlementAction error", func() {
+				batch := newBatch(entity.SettlementStatusPaid)
+				mockBatchRepo.EXPECT().FindByID(ctx, batch.ID).Return(batch, nil).Once()
+
+				issued, err := settlementService.Issue(mockTx, batch.ID)
+
+				Expect(issued).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidSettlementAction.ErrorCode)
+			})
+		})
+
+		Context("when an office scoped actor issues another office's batch", func() {
+			It("should return NotFound error", func() {
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: uuid.New(),
+				})
+				scopedTx := mocks.NewTx(GinkgoT())
+				scopedTx.EXPECT().GetCtx().Return(scopedCtx).Maybe()
+				batch := newBatch(entity.SettlementStatusDraft)
+				mockBatchRepo.EXPECT().FindByID(scopedCtx, batch.ID).Return(batch, nil).Once()
+
+				issued, err := settlementService.Issue(scopedTx, batch.ID)
+
+				Expect(issued).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("MarkPaid", func() {
+		Context("when the batch is issued", func() {
+			It("should add the settled amounts to the reimbursed totals", func() {
+				batch := newBatch(entity.SettlementStatusIssued)
+				mockBatchRepo.EXPECT().FindByID(ctx, batch.ID).Return(batch, nil).Once()
+				mockBatchRepo.EXPECT().Update(mockTx, batch).Return(nil).Once()
+				for _, claimID := range batch.ClaimIDs() {
+					expected := batch.ClaimAmount(claimID) + 1000
+					mockClaimRepo.EXPECT().FindByID(ctx, claimID).
+						Return(&entity.Claim{ID: claimID, ReimbursedTotal: 1000, SettlementBatchID: &batch.ID}, nil).
+						Once()
+					mockClaimRepo.EXPECT().Update(mockTx, mock.MatchedBy(func(c *entity.Claim) bool {
+						return c.ID == claimID && c.ReimbursedTotal == expected
+					})).Return(nil).Once()
+					mockOutbox.EXPECT().Create(mockTx, OutboxEvent(entity.EventClaimReimbursed, claimID)).
+						Return(nil).Once()
+				}
+				mockAuditRepo.EXPECT().Create(mockTx, mock.AnythingOfType("*entity.ClaimAuditLog")).
+					Return(nil).Times(2)
+
+				paid, err := settlementService.MarkPaid(mockTx, batch.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(paid.Status).To(Equal(entity.SettlementStatusPaid))
+				Expect(paid.PaidAt).NotTo(BeNil())
+			})
+		})
+	})
+
+	Describe("Dispute", func() {
+		Context("when the batch is issued", func() {
+			It("should record the reason", func() {
+				batch := newBatch(entity.SettlementStatusIssued)
+				mockBatchRepo.EXPECT().FindByID(ctx, batch.ID).Return(batch, nil).Once()
+				mockBatchRepo.EXPECT().Update(mockTx, batch).Return(nil).Once()
+
+				disputed, err := settlementService.Dispute(mockTx, batch.ID, "  Coolant pump was not replaced ")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(disputed.Status).To(Equal(entity.SettlementStatusDisputed))
+				Expect(*disputed.DisputeReason).To(Equal("Coolant pump was not replaced"))
+			})
+		})
+
+		Context("when no reason is given", func() {
+			It("should return InvalidInput error", func() {
+				disputed, err := settlementService.Dispute(mockTx, uuid.New(), " ")
+
+				Expect(disputed).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		Context("when the batch is a draft", func() {
+			It("should delete it", func() {
+				batch := newBatch(entity.SettlementStatusDraft)
+				mockBatchRepo.EXPECT().FindByID(ctx, batch.ID).Return(batch, nil).Once()
+				mockBatchRepo.EXPECT().HardDelete(mockTx, batch.ID).Return(nil).Once()
+
+				err := settlementService.Delete(mockTx, batch.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the batch is issued", func() {
+			It("should return InvalidSettlementAction error", func() {
+				batch := newBatch(entity.SettlementStatusIssued)
+				mockBatchRepo.EXPECT().FindByID(ctx, batch.ID).Return(batch, nil).Once()
+
+				err := settlementService.Delete(mockTx, batch.ID)
+
+				ExpectAppError(err, apperror.ErrInvalidSettlementAction.ErrorCode)
+			})
+		})
+	})
+
+	Describe("GetAll", func() {
+		Context("when an office scoped actor lists batches", func() {
+			It("should only list the batches of their office", func() {
+				scopedCtx := application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: officeID,
+				})
+				otherOffice := uuid.New()
+				mockBatchRepo.EXPECT().FindAll(scopedCtx, mock.MatchedBy(func(f repository.SettlementBatchFilters) bool {
+					return f.OfficeID != nil && *f.OfficeID == officeID
+				})).Return([]*entity.SettlementBatch{}, nil).Once()
+
+				_, err := settlementService.GetAll(scopedCtx, repository.SettlementBatchFilters{OfficeID: &otherOffice})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Statement", func() {
+		var batch *entity.SettlementBatch
+
+		BeforeEach(func() {
+			batch = newBatch(entity.SettlementStatusIssued)
+			mockBatchRepo.EXPECT().FindByID(ctx, batch.ID).Return(batch, nil).Once()
+			mockOfficeRepo.EXPECT().FindByID(ctx, officeID).
+				Return(&entity.Office{ID: officeID, OfficeName: "Service Center HN"}, nil).Once()
+		})
+
+		Context("when a CSV statement is requested", func() {
+			It("should write a row per line with the inclusive period", func() {
+				statement, err := settlementService.Statement(ctx, batch.ID, service.StatementFormatCSV)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(statement.ContentType).To(Equal("text/csv"))
+				Expect(statement.Filename).To(HaveSuffix(".csv"))
+				rows := strings.Split(strings.TrimSpace(string(statement.Content)), "\n")
+				Expect(rows).To(HaveLen(4))
+				Expect(rows[1]).To(ContainSubstring("Service Center HN,2026-09-01,2026-09-30,ISSUED"))
+				Expect(rows[1]).To(HaveSuffix(",Battery module,1500000,VND"))
+			})
+		})
+
+		Context("when a CSV statement has user-typed formulas in a 2-decimal currency", func() {
+			It("should escape the text and write amounts in the major unit", func() {
+				batch.Currency = "USD"
+				batch.Lines[0].Description = "=HYPERLINK(\"http://evil\")"
+				batch.Lines[0].Amount = 125050
+
+				statement, err := settlementService.Statement(ctx, batch.ID, service.StatementFormatCSV)
+
+				Expect(err).NotTo(HaveOccurred())
+				rows := strings.Split(strings.TrimSpace(string(statement.Content)), "\n")
+				Expect(rows[1]).To(HaveSuffix(`,"'=HYPERLINK(""http://evil"")",1250.50,USD`))
+			})
+		})
+
+		Context("when a PDF statement is requested", func() {
+			It("should render a PDF document", func() {
+				statement, err := settlementService.Statement(ctx, batch.ID, service.StatementFormatPDF)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(statement.ContentType).To(Equal("application/pdf"))
+				Expect(string(statement.Content)).To(HavePrefix("%PDF-"))
+				// The text is written as glyph IDs, pkg/pdf checks it decodes back.
+				Expect(string(statement.Content)).To(ContainSubstring("/FontFile2"))
+				Expect(string(statement.Content)).To(ContainSubstring("> Tj T*"))
+			})
+		})
+
+		Context("when the format is not supported", func() {
+			It("should return InvalidParams error", func() {
+				statement, err := settlementService.Statement(ctx, batch.ID, "xlsx")
+
+				Expect(statement).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidParams.ErrorCode)
+			})
+		})
+	})
+})
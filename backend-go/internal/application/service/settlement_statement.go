@@ -0,0 +1,127 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/pdf"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	StatementFormatCSV = "csv"
+	StatementFormatPDF = "pdf"
+)
+
+// SettlementStatement is a rendered statement of a settlement batch, ready to
+// be served as a download.
+type SettlementStatement struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+func (s *settlementService) Statement(ctx context.Context, id uuid.UUID, format string,
+) (*SettlementStatement, error) {
+	batch, err := s.findBatchInScope(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	office, err := s.officeRepo.FindByID(ctx, batch.OfficeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	statement := &SettlementStatement{
+		Filename: fmt.Sprintf("settlement-%s-%s.%s", batch.PeriodStart.Format(time.DateOnly),
+			batch.ID.String()[:8], format),
+	}
+
+	switch format {
+	case StatementFormatCSV:
+		statement.ContentType = "text/csv"
+		err = writeStatementCSV(&buf, batch, office.OfficeName)
+	case StatementFormatPDF:
+		statement.ContentType = "application/pdf"
+		_, err = statementPDF(batch, office.OfficeName).WriteTo(&buf)
+	default:
+		return nil, apperror.ErrInvalidParams.WithMessage("Statement format must be csv or pdf")
+	}
+	if err != nil {
+		return nil, apperror.ErrInternalServerError.WithError(err)
+	}
+
+	statement.Content = buf.Bytes()
+	return statement, nil
+}
+
+// statementPeriod formats the batch period with its inclusive last day, the
+// way it was requested.
+func statementPeriod(batch *entity.SettlementBatch) (string, string) {
+	return batch.PeriodStart.Format(time.DateOnly), batch.PeriodEnd.AddDate(0, 0, -1).Format(time.DateOnly)
+}
+
+// writeStatementCSV writes a row per line, amounts in the major unit like the
+// PDF. The office name and descriptions are typed by users, so they are
+// escaped against formula injection like claim exports.
+func writeStatementCSV(buf *bytes.Buffer, batch *entity.SettlementBatch, officeName string) error {
+	from, to := statementPeriod(batch)
+	w := csv.NewWriter(buf)
+
+	rows := [][]string{{
+		"batch_id", "office", "period_start", "period_end", "status",
+		"claim_id", "claim_item_id", "description", "amount", "currency",
+	}}
+	for _, line := range batch.Lines {
+		rows = append(rows, []string{
+			batch.ID.String(), spreadsheetText(officeName), from, to, batch.Status,
+			line.ClaimID.String(), line.ClaimItemID.String(), spreadsheetText(line.Description),
+			entity.FormatMinorUnits(line.Amount, batch.Currency), batch.Currency,
+		})
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+	return w.Error()
+}
+
+func statementPDF(batch *entity.SettlementBatch, officeName string) *pdf.Document {
+	from, to := statementPeriod(batch)
+	doc := pdf.New()
+
+	doc.Line("WARRANTY REIMBURSEMENT STATEMENT")
+	doc.Line("")
+	doc.Linef("Batch:    %s", batch.ID)
+	doc.Linef("Office:   %s", officeName)
+	doc.Linef("Period:   %s to %s", from, to)
+	doc.Linef("Status:   %s", batch.Status)
+	doc.Linef("Currency: %s", batch.Currency)
+	doc.Line("")
+	doc.Linef("%-36s %-35s %16s", "Claim", "Item", "Amount")
+	doc.Line(strings.Repeat("-", 89))
+	for _, line := range batch.Lines {
+		doc.Linef("%-36s %-35s %16s", line.ClaimID, truncate(line.Description, 35),
+			entity.FormatMinorUnits(line.Amount, batch.Currency))
+	}
+	doc.Line(strings.Repeat("-", 89))
+	doc.Linef("%-72s %16s", "Total", entity.FormatMinorUnits(batch.TotalAmount, batch.Currency))
+
+	return doc
+}
+
+func truncate(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	return string(runes[:n-3]) + "..."
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SLAConfig holds the SLA policy of each claim status. Statuses without one
+// are not tracked.
+type SLAConfig struct {
+	Policies  map[string]entity.SLAPolicy
+	BatchSize int
+}
+
+type UpdateBusinessCalendarCommand struct {
+	Timezone    string
+	WorkingDays []int
+	Holidays    []string
+}
+
+// SLAService tracks how long claims stay in the statuses that have an SLA
+// policy. Policies in business days follow the calendar of the office of the
+// claim's staff.
+type SLAService interface {
+	// Track closes the SLA the claim was held to and starts the one of the
+	// status it entered in tx, if any.
+	Track(tx application.Tx, claim *entity.Claim) error
+	// Attach sets the state of the SLA each claim is currently held to.
+	Attach(ctx context.Context, claims ...*entity.Claim) error
+	// ProcessBreaches records one batch of SLAs that ran out and publishes an
+	// escalation event for each. It returns how many were recorded.
+	ProcessBreaches(ctx context.Context) (int, error)
+
+	GetCalendar(ctx context.Context, officeID uuid.UUID) (*entity.BusinessCalendar, error)
+	// UpdateCalendar replaces the calendar of an office. SLAs already running
+	// keep the due time they started with.
+	UpdateCalendar(ctx context.Context, officeID uuid.UUID, cmd *UpdateBusinessCalendarCommand,
+	) (*entity.BusinessCalendar, error)
+}
+
+type slaService struct {
+	log          logger.Logger
+	txManager    application.TxManager
+	slaRepo      repository.ClaimSLARepository
+	calendarRepo repository.BusinessCalendarRepository
+	claimRepo    repository.ClaimRepository
+	userRepo     repository.UserRepository
+	officeRepo   repository.OfficeRepository
+	outboxRepo   repository.OutboxEventRepository
+	cfg          SLAConfig
+}
+
+func NewSLAService(log logger.Logger, txManager application.TxManager, slaRepo repository.ClaimSLARepository,
+	calendarRepo repository.BusinessCalendarRepository, claimRepo repository.ClaimRepository,
+	userRepo repository.UserRepository, officeRepo repository.OfficeRepository,
+	outboxRepo repository.OutboxEventRepository, cfg SLAConfig,
+) SLAService {
+	return &slaService{
+		log:          log,
+		txManager:    txManager,
+		slaRepo:      slaRepo,
+		calendarRepo: calendarRepo,
+		claimRepo:    claimRepo,
+		userRepo:     userRepo,
+		officeRepo:   officeRepo,
+		outboxRepo:   outboxRepo,
+		cfg:          cfg,
+	}
+}
+
+func (s *slaService) Track(tx application.Tx, claim *entity.Claim) error {
+	now := time.Now()
+	current, err := s.slaRepo.FindOpenByClaimID(tx.GetCtx(), claim.ID)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		current.Close(now)
+		if err = s.slaRepo.Update(tx, current); err != nil {
+			return err
+		}
+	}
+
+	policy, ok := s.cfg.Policies[claim.Status]
+	if !ok {
+		return nil
+	}
+
+	var calendar *entity.BusinessCalendar
+	if policy.BusinessDays > 0 {
+		staff, err := s.userRepo.FindByID(tx.GetCtx(), claim.StaffID)
+		if err != nil {
+			return err
+		}
+		if calendar, err = s.findCalendar(tx.GetCtx(), staff.OfficeID); err != nil {
+			return err
+		}
+	}
+
+	sla := entity.NewClaimSLA(claim.ID, claim.Status, now, policy.DueAt(now, calendar))
+	return s.slaRepo.Create(tx, sla)
+}
+
+func (s *slaService) Attach(ctx context.Context, claims ...*entity.Claim) error {
+	if len(claims) == 0 {
+		return nil
+	}
+
+	claimIDs := make([]uuid.UUID, len(claims))
+	for i, claim := range claims {
+		claimIDs[i] = claim.ID
+	}
+	slas, err := s.slaRepo.FindOpenByClaimIDs(ctx, claimIDs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	states := make(map[uuid.UUID]*entity.ClaimSLAState, len(slas))
+	for _, sla := range slas {
+		states[sla.ClaimID] = sla.StateAt(now)
+	}
+	for _, claim := range claims {
+		claim.SLA = states[claim.ID]
+	}
+	return nil
+}
+
+func (s *slaService) ProcessBreaches(ctx context.Context) (int, error) {
+	var breached int
+	err := s.txManager.Do(ctx, func(tx application.Tx) error {
+		now := time.Now()
+		due, err := s.slaRepo.FindDueBreaches(tx, now, s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, sla := range due {
+			sla.MarkBreached(now)
+			if err = s.slaRepo.Update(tx, sla); err != nil {
+				return err
+			}
+
+			claim, err := s.claimRepo.FindByID(tx.GetCtx(), sla.ClaimID)
+			if err != nil {
+				return err
+			}
+			claim.SLA = sla.StateAt(now)
+			err = publishSystemClaimEvent(tx, s.outboxRepo, claim.ID, entity.EventClaimSLABreached,
+				claimEventData{Claim: claim})
+			if err != nil {
+				return err
+			}
+			breached++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if breached > 0 {
+		s.log.Warn("[SLA] Claims breached their SLA", "count", breached)
+	}
+	return breached, nil
+}
+
+func (s *slaService) GetCalendar(ctx context.Context, officeID uuid.UUID) (*entity.BusinessCalendar, error) {
+	if err := s.checkOfficeInScope(ctx, officeID); err != nil {
+		return nil, err
+	}
+	return s.findCalendar(ctx, officeID)
+}
+
+func (s *slaService) UpdateCalendar(ctx context.Context, officeID uuid.UUID, cmd *UpdateBusinessCalendarCommand,
+) (*entity.BusinessCalendar, error) {
+	if _, err := time.LoadLocation(cmd.Timezone); err != nil || cmd.Timezone == "" {
+		return nil, apperror.ErrInvalidInput.WithMessage("Invalid time zone")
+	}
+	if len(cmd.WorkingDays) == 0 {
+		return nil, apperror.ErrInvalidInput.WithMessage("At least one working day is required")
+	}
+
+	workingDays := make([]time.Weekday, 0, len(cmd.WorkingDays))
+	for _, day := range cmd.WorkingDays {
+		if day < int(time.Sunday) || day > int(time.Saturday) {
+			return nil, apperror.ErrInvalidInput.WithMessage("Working days must be between 0 (Sunday) and 6")
+		}
+		workingDays = append(workingDays, time.Weekday(day))
+	}
+	holidays := make([]string, 0, len(cmd.Holidays))
+	for _, holiday := range cmd.Holidays {
+		if _, err := time.Parse(entity.BusinessDateLayout, holiday); err != nil {
+			return nil, apperror.ErrInvalidInput.WithMessage("Holidays must be dates formatted as YYYY-MM-DD")
+		}
+		holidays = append(holidays, holiday)
+	}
+	slices.Sort(workingDays)
+	slices.Sort(holidays)
+
+	if err := s.checkOfficeInScope(ctx, officeID); err != nil {
+		return nil, err
+	}
+
+	calendar := entity.NewBusinessCalendar(officeID)
+	calendar.Timezone = cmd.Timezone
+	calendar.WorkingDays = slices.Compact(workingDays)
+	calendar.Holidays = slices.Compact(holidays)
+	if err := s.calendarRepo.Save(ctx, calendar); err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
+
+// findCalendar returns the calendar of an office, the default one when it has
+// none.
+func (s *slaService) findCalendar(ctx context.Context, officeID uuid.UUID) (*entity.BusinessCalendar, error) {
+	calendar, err := s.calendarRepo.FindByOfficeID(ctx, officeID)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		calendar = entity.NewBusinessCalendar(officeID)
+	}
+	return calendar, nil
+}
+
+// checkOfficeInScope makes sure the office exists and, for office scoped
+// actors, is their own.
+func (s *slaService) checkOfficeInScope(ctx context.Context, officeID uuid.UUID) error {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return apperror.ErrMissingUserID
+	}
+	if actor.IsOfficeScoped() && actor.OfficeID != officeID {
+		return apperror.ErrNotFoundError.WithMessage("Office not found")
+	}
+
+	_, err := s.officeRepo.FindByID(ctx, officeID)
+	return err
+}
@@ -0,0 +1,302 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/pkg/apperror"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("SLAService", func() {
+	var (
+		mockLogger       *mocks.Logger
+		mockTxManager    *mocks.TxManager
+		mockSLARepo      *mocks.ClaimSLARepository
+		mockCalendarRepo *mocks.BusinessCalendarRepository
+		mockClaimRepo    *mocks.ClaimRepository
+		mockUserRepo     *mocks.UserRepository
+		mockOfficeRepo   *mocks.OfficeRepository
+		mockOutbox       *mocks.OutboxEventRepository
+		mockTx           *mocks.Tx
+		slaService       service.SLAService
+		ctx              context.Context
+		officeID         uuid.UUID
+		claim            *entity.Claim
+	)
+
+	BeforeEach(func() {
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockTxManager = mocks.NewTxManager(GinkgoT())
+		mockSLARepo = mocks.NewClaimSLARepository(GinkgoT())
+		mockCalendarRepo = mocks.NewBusinessCalendarRepository(GinkgoT())
+		mockClaimRepo = mocks.NewClaimRepository(GinkgoT())
+		mockUserRepo = mocks.NewUserRepository(GinkgoT())
+		mockOfficeRepo = mocks.NewOfficeRepository(GinkgoT())
+		mockOutbox = mocks.NewOutboxEventRepository(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		slaService = service.NewSLAService(mockLogger, mockTxManager, mockSLARepo, mockCalendarRepo, mockClaimRepo,
+			mockUserRepo, mockOfficeRepo, mockOutbox, service.SLAConfig{
+				Policies: map[string]entity.SLAPolicy{
+					entity.ClaimStatusSubmitted: {Duration: 48 * time.Hour},
+					entity.ClaimStatusReviewing: {BusinessDays: 5},
+				},
+				BatchSize: 10,
+			})
+
+		officeID = uuid.New()
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: uuid.New(),
+			Role:   entity.UserRoleEvmStaff,
+		})
+		mockTx.EXPECT().GetCtx().Return(ctx).Maybe()
+		claim = &entity.Claim{ID: uuid.New(), Status: entity.ClaimStatusSubmitted, StaffID: uuid.New()}
+	})
+
+	Describe("Track", func() {
+		Context("when the claim enters a status with a duration policy", func() {
+			It("should start an SLA due after the duration", func() {
+				mockSLARepo.EXPECT().FindOpenByClaimID(ctx, claim.ID).Return(nil, nil).Once()
+				mockSLARepo.EXPECT().Create(mockTx, mock.MatchedBy(func(s *entity.ClaimSLA) bool {
+					return s.ClaimID == claim.ID && s.Status == entity.ClaimStatusSubmitted &&
+						s.DueAt.Equal(s.StartedAt.Add(48*time.Hour)) && s.ClosedAt == nil
+				})).Return(nil).Once()
+
+				err := slaService.Track(mockTx, claim)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the claim enters a status with a business days policy", func() {
+			It("should close the previous SLA and count the days of the staff's office", func() {
+				claim.Status = entity.ClaimStatusReviewing
+				current := entity.NewClaimSLA(claim.ID, entity.ClaimStatusSubmitted, time.Now().Add(-time.Hour),
+					time.Now().Add(47*time.Hour))
+				calendar := entity.NewBusinessCalendar(officeID)
+				calendar.Timezone = "Asia/Ho_Chi_Minh"
+
+				mockSLARepo.EXPECT().FindOpenByClaimID(ctx, claim.ID).Return(current, nil).Once()
+				mockSLARepo.EXPECT().Update(mockTx, mock.MatchedBy(func(s *entity.ClaimSLA) bool {
+					return s.ID == current.ID && s.ClosedAt != nil && s.BreachedAt == nil
+				})).Return(nil).Once()
+				mockUserRepo.EXPECT().FindByID(ctx, claim.StaffID).
+					Return(&entity.User{ID: claim.StaffID, OfficeID: officeID}, nil).Once()
+				mockCalendarRepo.EXPECT().FindByOfficeID(ctx, officeID).Return(calendar, nil).Once()
+				mockSLARepo.EXPECT().Create(mockTx, mock.MatchedBy(func(s *entity.ClaimSLA) bool {
+					return s.Status == entity.ClaimStatusReviewing &&
+						s.DueAt.Equal(calendar.AddBusinessDays(s.StartedAt, 5)) &&
+						calendar.IsBusinessDay(s.DueAt) &&
+						!s.DueAt.Before(s.StartedAt.AddDate(0, 0, 5))
+				})).Return(nil).Once()
+
+				err := slaService.Track(mockTx, claim)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the claim leaves its status after the due time", func() {
+			It("should record the breach and start no SLA for a status without policy", func() {
+				claim.Status = entity.ClaimStatusApproved
+				current := entity.NewClaimSLA(claim.ID, entity.ClaimStatusReviewing, time.Now().Add(-8*24*time.Hour),
+					time.Now().Add(-time.Hour))
+
+				mockSLARepo.EXPECT().FindOpenByClaimID(ctx, claim.ID).Return(current, nil).Once()
+				mockSLARepo.EXPECT().Update(mockTx, mock.MatchedBy(func(s *entity.ClaimSLA) bool {
+					return s.ClosedAt != nil && s.BreachedAt != nil
+				})).Return(nil).Once()
+
+				err := slaService.Track(mockTx, claim)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the repository fails", func() {
+			It("should return the error", func() {
+				mockSLARepo.EXPECT().FindOpenByClaimID(ctx, claim.ID).Return(nil, apperror.ErrDBOperation).Once()
+
+				err := slaService.Track(mockTx, claim)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Attach", func() {
+		It("should set the state of the open SLA of each claim", func() {
+			other := &entity.Claim{ID: uuid.New(), Status: entity.ClaimStatusDraft}
+			sla := entity.NewClaimSLA(claim.ID, entity.ClaimStatusSubmitted, time.Now().Add(-50*time.Hour),
+				time.Now().Add(-2*time.Hour))
+
+			mockSLARepo.EXPECT().FindOpenByClaimIDs(ctx, []uuid.UUID{claim.ID, other.ID}).
+				Return([]*entity.ClaimSLA{sla}, nil).Once()
+
+			err := slaService.Attach(ctx, claim, other)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claim.SLA).NotTo(BeNil())
+			Expect(claim.SLA.Status).To(Equal(entity.ClaimStatusSubmitted))
+			Expect(claim.SLA.DueAt).To(Equal(sla.DueAt))
+			Expect(claim.SLA.Breached).To(BeTrue())
+			Expect(claim.SLA.RemainingSeconds).To(BeNumerically("<=", -7200))
+			Expect(other.SLA).To(BeNil())
+		})
+
+		It("should not query without claims", func() {
+			err := slaService.Attach(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ProcessBreaches", func() {
+		BeforeEach(func() {
+			mockTxManager.EXPECT().Do(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, fn func(application.Tx) error) error {
+					return fn(mockTx)
+				}).Once()
+		})
+
+		Context("when SLAs ran out", func() {
+			It("should record the breach and publish an escalation event", func() {
+				sla := entity.NewClaimSLA(claim.ID, entity.ClaimStatusSubmitted, time.Now().Add(-49*time.Hour),
+					time.Now().Add(-time.Hour))
+
+				mockSLARepo.EXPECT().FindDueBreaches(mockTx, mock.AnythingOfType("time.Time"), 10).
+					Return([]*entity.ClaimSLA{sla}, nil).Once()
+				mockSLARepo.EXPECT().Update(mockTx, mock.MatchedBy(func(s *entity.ClaimSLA) bool {
+					return s.ID == sla.ID && s.BreachedAt != nil && s.ClosedAt == nil
+				})).Return(nil).Once()
+				mockClaimRepo.EXPECT().FindByID(ctx, claim.ID).Return(claim, nil).Once()
+				mockOutbox.EXPECT().Create(mockTx, mock.MatchedBy(func(e *entity.OutboxEvent) bool {
+					return e.EventType == entity.EventClaimSLABreached && e.AggregateID == claim.ID &&
+						strings.Contains(string(e.Payload), `"breached":true`) &&
+						strings.Contains(string(e.Payload), `"actor_id":"`+uuid.Nil.String()+`"`)
+				})).Return(nil).Once()
+				mockLogger.EXPECT().Warn(mock.Anything, "count", 1).Once()
+
+				breached, err := slaService.ProcessBreaches(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(breached).To(Equal(1))
+			})
+		})
+
+		Context("when no SLA ran out", func() {
+			It("should record nothing", func() {
+				mockSLARepo.EXPECT().FindDueBreaches(mockTx, mock.AnythingOfType("time.Time"), 10).
+					Return([]*entity.ClaimSLA{}, nil).Once()
+
+				breached, err := slaService.ProcessBreaches(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(breached).To(BeZero())
+			})
+		})
+	})
+
+	Describe("GetCalendar", func() {
+		Context("when the office has no calendar", func() {
+			It("should return the default calendar", func() {
+				mockOfficeRepo.EXPECT().FindByID(ctx, officeID).Return(&entity.Office{ID: officeID}, nil).Once()
+				mockCalendarRepo.EXPECT().FindByOfficeID(ctx, officeID).Return(nil, nil).Once()
+
+				calendar, err := slaService.GetCalendar(ctx, officeID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(calendar.OfficeID).To(Equal(officeID))
+				Expect(calendar.Timezone).To(Equal("UTC"))
+				Expect(calendar.WorkingDays).To(Equal(entity.DefaultWorkingDays))
+			})
+		})
+
+		Context("when an office scoped actor asks for another office", func() {
+			It("should return NotFound error", func() {
+				ctx = application.WithActor(context.Background(), &application.Actor{
+					UserID:   uuid.New(),
+					Role:     entity.UserRoleScStaff,
+					OfficeID: uuid.New(),
+				})
+
+				calendar, err := slaService.GetCalendar(ctx, officeID)
+
+				Expect(calendar).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("UpdateCalendar", func() {
+		var cmd *service.UpdateBusinessCalendarCommand
+
+		BeforeEach(func() {
+			cmd = &service.UpdateBusinessCalendarCommand{
+				Timezone:    "Asia/Ho_Chi_Minh",
+				WorkingDays: []int{6, 1, 2, 3, 4, 5, 1},
+				Holidays:    []string{"2026-09-02", "2026-01-01"},
+			}
+		})
+
+		Context("when the calendar is valid", func() {
+			It("should save it with sorted days", func() {
+				mockOfficeRepo.EXPECT().FindByID(ctx, officeID).Return(&entity.Office{ID: officeID}, nil).Once()
+				mockCalendarRepo.EXPECT().Save(ctx, mock.AnythingOfType("*entity.BusinessCalendar")).
+					Return(nil).Once()
+
+				calendar, err := slaService.UpdateCalendar(ctx, officeID, cmd)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(calendar.Timezone).To(Equal("Asia/Ho_Chi_Minh"))
+				Expect(calendar.WorkingDays).To(Equal([]time.Weekday{
+					time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+				}))
+				Expect(calendar.Holidays).To(Equal([]string{"2026-01-01", "2026-09-02"}))
+			})
+		})
+
+		Context("when the office does not exist", func() {
+			It("should return NotFound error", func() {
+				mockOfficeRepo.EXPECT().FindByID(ctx, officeID).Return(nil, apperror.ErrNotFoundError).Once()
+
+				calendar, err := slaService.UpdateCalendar(ctx, officeID, cmd)
+
+				Expect(calendar).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+
+		DescribeTable("when the calendar is invalid",
+			func(update func(cmd *service.UpdateBusinessCalendarCommand)) {
+				update(cmd)
+
+				calendar, err := slaService.UpdateCalendar(ctx, officeID, cmd)
+
+				Expect(calendar).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			},
+			Entry("unknown time zone", func(cmd *service.UpdateBusinessCalendarCommand) {
+				cmd.Timezone = "Mars/Olympus_Mons"
+			}),
+			Entry("no working day", func(cmd *service.UpdateBusinessCalendarCommand) {
+				cmd.WorkingDays = nil
+			}),
+			Entry("working day out of range", func(cmd *service.UpdateBusinessCalendarCommand) {
+				cmd.WorkingDays = []int{1, 7}
+			}),
+			Entry("malformed holiday", func(cmd *service.UpdateBusinessCalendarCommand) {
+				cmd.Holidays = []string{"02/09/2026"}
+			}),
+		)
+	})
+})
@@ -2,10 +2,13 @@ package service_test
 
 import (
 	"errors"
+	"ev-warranty-go/internal/domain/entity"
 	"ev-warranty-go/pkg/apperror"
 
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 )
 
 func ExpectAppError(err error, expectedCode string) {
@@ -15,3 +18,13 @@ func ExpectAppError(err error, expectedCode string) {
 	Expect(errors.As(err, &appErr)).To(BeTrue(), "error should be an AppError")
 	Expect(appErr.ErrorCode).To(Equal(expectedCode), "error code should match")
 }
+
+// OutboxEvent matches an outbox event of eventType raised for claimID.
+func OutboxEvent(eventType string, claimID uuid.UUID) any {
+	return mock.MatchedBy(func(e *entity.OutboxEvent) bool {
+		return e.EventType == eventType &&
+			e.AggregateType == entity.AggregateTypeClaim &&
+			e.AggregateID == claimID &&
+			e.Status == entity.OutboxStatusPending
+	})
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/client/dotnet"
+	"ev-warranty-go/pkg/apperror"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WarrantyConfig struct {
+	// RejectIneligible refuses claims for vehicles out of warranty. When it
+	// is off such claims are created with the failed check on record.
+	RejectIneligible bool
+	// RejectUncovered refuses claim items whose part category the policy
+	// does not cover. When it is off they are added marked as not covered.
+	RejectUncovered bool
+}
+
+// WarrantyService decides whether a vehicle is still covered by the warranty
+// policy of its model, using the vehicle data held by the .NET backend.
+type WarrantyService interface {
+	Check(ctx context.Context, vehicleID, customerID uuid.UUID, kilometers int, authToken string,
+	) (*entity.WarrantySnapshot, error)
+	// CheckCoverage decides whether the policy in the claim's warranty
+	// snapshot covers the part category.
+	CheckCoverage(ctx context.Context, warranty *entity.WarrantySnapshot, categoryID uuid.UUID, authToken string,
+	) (*entity.ItemCoverage, error)
+}
+
+type warrantyService struct {
+	dotnetClient dotnet.Client
+	cfg          WarrantyConfig
+}
+
+func NewWarrantyService(dotnetClient dotnet.Client, cfg WarrantyConfig) WarrantyService {
+	return &warrantyService{
+		dotnetClient: dotnetClient,
+		cfg:          cfg,
+	}
+}
+
+func (s *warrantyService) Check(ctx context.Context, vehicleID, customerID uuid.UUID, kilometers int,
+	authToken string,
+) (*entity.WarrantySnapshot, error) {
+	vehicle, err := s.dotnetClient.GetVehicle(ctx, vehicleID, authToken)
+	if err != nil {
+		return nil, err
+	}
+	if vehicle.CustomerID != customerID {
+		return nil, apperror.ErrVehicleCustomerMismatch
+	}
+
+	model, err := s.dotnetClient.GetVehicleModel(ctx, vehicle.ModelID, authToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy *dotnet.WarrantyPolicyResponse
+	if model.PolicyID != nil {
+		if policy, err = s.dotnetClient.GetWarrantyPolicy(ctx, *model.PolicyID, authToken); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := evaluateWarranty(vehicle, model, policy, kilometers, time.Now())
+	if !snapshot.Eligible && s.cfg.RejectIneligible {
+		return nil, apperror.ErrClaimNotEligible.
+			WithMessage("Vehicle is not eligible for warranty: " + strings.Join(snapshot.Reasons, ", "))
+	}
+
+	return snapshot, nil
+}
+
+func (s *warrantyService) CheckCoverage(ctx context.Context, warranty *entity.WarrantySnapshot,
+	categoryID uuid.UUID, authToken string,
+) (*entity.ItemCoverage, error) {
+	coverage := &entity.ItemCoverage{
+		Status:    entity.CoverageStatusUnknown,
+		CheckedAt: time.Now(),
+	}
+	if warranty == nil {
+		return coverage, nil
+	}
+
+	coverage.Status = entity.CoverageStatusNotCovered
+	coverage.PolicyID = warranty.PolicyID
+	if warranty.PolicyID != nil {
+		covered, err := s.dotnetClient.GetCoverage(ctx, *warranty.PolicyID, categoryID, authToken)
+		if err != nil {
+			return nil, err
+		}
+		if covered != nil {
+			coverage.Status = entity.CoverageStatusCovered
+			coverage.CoveredCategoryID = &covered.PartCategoryID
+			coverage.Inherited = covered.IsInherited
+			coverage.Conditions = covered.CoverageConditions
+		}
+	}
+
+	if coverage.IsUncovered() && s.cfg.RejectUncovered {
+		return nil, apperror.ErrClaimItemNotCovered
+	}
+
+	return coverage, nil
+}
+
+// evaluateWarranty checks the vehicle against its policy as of now. A policy
+// that has expired or been superseded still covers the vehicles sold under
+// it, while a draft or archived one covers none.
+func evaluateWarranty(vehicle *dotnet.VehicleResponse, model *dotnet.VehicleModelResponse,
+	policy *dotnet.WarrantyPolicyResponse, kilometers int, now time.Time,
+) *entity.WarrantySnapshot {
+	snapshot := &entity.WarrantySnapshot{
+		Eligible:     true,
+		Reasons:      []string{},
+		CheckedAt:    now,
+		VehicleID:    vehicle.ID,
+		VIN:          vehicle.VIN,
+		CustomerID:   vehicle.CustomerID,
+		PurchaseDate: vehicle.PurchaseDate,
+		Kilometers:   kilometers,
+		ModelID:      model.ID,
+		ModelName:    model.ModelName,
+	}
+
+	if policy == nil {
+		snapshot.Ineligible(entity.IneligibleNoPolicy)
+		return snapshot
+	}
+
+	snapshot.PolicyID = &policy.ID
+	snapshot.PolicyName = policy.PolicyName
+	snapshot.PolicyStatus = policy.Status
+	snapshot.WarrantyDurationMonths = policy.WarrantyDurationMonths
+	snapshot.KilometerLimit = policy.KilometerLimit
+
+	if policy.Status == dotnet.PolicyStatusDraft || policy.Status == dotnet.PolicyStatusArchived {
+		snapshot.Ineligible(entity.IneligiblePolicyNotInEffect)
+	}
+
+	if vehicle.PurchaseDate == nil {
+		snapshot.Ineligible(entity.IneligibleNoPurchaseDate)
+	} else {
+		expiresAt := vehicle.PurchaseDate.AddDate(0, policy.WarrantyDurationMonths, 0)
+		snapshot.WarrantyExpiresAt = &expiresAt
+		if !now.Before(expiresAt) {
+			snapshot.Ineligible(entity.IneligibleWarrantyExpired)
+		}
+	}
+
+	if policy.KilometerLimit != nil && kilometers > *policy.KilometerLimit {
+		snapshot.Ineligible(entity.IneligibleKilometersExceed)
+	}
+
+	return snapshot
+}
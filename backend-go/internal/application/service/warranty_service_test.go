@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/infrastructure/client/dotnet"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("WarrantyService", func() {
+	var (
+		mockClient *mocks.Client
+		cfg        service.WarrantyConfig
+		ctx        context.Context
+		vehicle    *dotnet.VehicleResponse
+		model      *dotnet.VehicleModelResponse
+		policy     *dotnet.WarrantyPolicyResponse
+		kmLimit    int
+	)
+
+	newService := func() service.WarrantyService {
+		return service.NewWarrantyService(mockClient, cfg)
+	}
+
+	expectLookups := func() {
+		mockClient.EXPECT().GetVehicle(ctx, vehicle.ID, "token").Return(vehicle, nil).Once()
+		mockClient.EXPECT().GetVehicleModel(ctx, model.ID, "token").Return(model, nil).Once()
+		mockClient.EXPECT().GetWarrantyPolicy(ctx, policy.ID, "token").Return(policy, nil).Once()
+	}
+
+	BeforeEach(func() {
+		mockClient = mocks.NewClient(GinkgoT())
+		cfg = service.WarrantyConfig{RejectIneligible: true}
+		ctx = context.Background()
+
+		kmLimit = 100000
+		purchaseDate := time.Now().AddDate(-1, 0, 0)
+		policy = &dotnet.WarrantyPolicyResponse{
+			ID:                     uuid.New(),
+			PolicyName:             "Standard",
+			WarrantyDurationMonths: 36,
+			KilometerLimit:         &kmLimit,
+			Status:                 dotnet.PolicyStatusActive,
+		}
+		model = &dotnet.VehicleModelResponse{
+			ID:        uuid.New(),
+			ModelName: "VF8",
+			PolicyID:  &policy.ID,
+		}
+		vehicle = &dotnet.VehicleResponse{
+			ID:           uuid.New(),
+			VIN:          "VIN0001",
+			CustomerID:   uuid.New(),
+			ModelID:      model.ID,
+			PurchaseDate: &purchaseDate,
+		}
+	})
+
+	Describe("Check", func() {
+		Context("when the vehicle is within its warranty", func() {
+			It("should return an eligible snapshot of the policy", func() {
+				expectLookups()
+
+				snapshot, err := newService().Check(ctx, vehicle.ID, vehicle.CustomerID, 20000, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot.Eligible).To(BeTrue())
+				Expect(snapshot.Reasons).To(BeEmpty())
+				Expect(snapshot.VIN).To(Equal(vehicle.VIN))
+				Expect(snapshot.PolicyID).To(Equal(&policy.ID))
+				Expect(snapshot.KilometerLimit).To(Equal(&kmLimit))
+				Expect(*snapshot.WarrantyExpiresAt).To(BeTemporally("~", vehicle.PurchaseDate.AddDate(3, 0, 0)))
+			})
+		})
+
+		Context("when the vehicle belongs to another customer", func() {
+			It("should return a mismatch error", func() {
+				mockClient.EXPECT().GetVehicle(ctx, vehicle.ID, "token").Return(vehicle, nil).Once()
+
+				snapshot, err := newService().Check(ctx, vehicle.ID, uuid.New(), 20000, "token")
+
+				Expect(snapshot).To(BeNil())
+				ExpectAppError(err, apperror.ErrVehicleCustomerMismatch.ErrorCode)
+			})
+		})
+
+		Context("when the vehicle is not found", func() {
+			It("should return the client error", func() {
+				mockClient.EXPECT().GetVehicle(ctx, vehicle.ID, "token").Return(nil, apperror.ErrVehicleNotFound).Once()
+
+				snapshot, err := newService().Check(ctx, vehicle.ID, vehicle.CustomerID, 20000, "token")
+
+				Expect(snapshot).To(BeNil())
+				ExpectAppError(err, apperror.ErrVehicleNotFound.ErrorCode)
+			})
+		})
+
+		Context("when the warranty has expired and the kilometer limit is exceeded", func() {
+			It("should reject the claim with both reasons", func() {
+				purchaseDate := time.Now().AddDate(-4, 0, 0)
+				vehicle.PurchaseDate = &purchaseDate
+				expectLookups()
+
+				snapshot, err := newService().Check(ctx, vehicle.ID, vehicle.CustomerID, 150000, "token")
+
+				Expect(snapshot).To(BeNil())
+				ExpectAppError(err, apperror.ErrClaimNotEligible.ErrorCode)
+				Expect(err.Error()).To(ContainSubstring(entity.IneligibleWarrantyExpired))
+				Expect(err.Error()).To(ContainSubstring(entity.IneligibleKilometersExceed))
+			})
+		})
+
+		Context("when ineligible claims are flagged", func() {
+			BeforeEach(func() {
+				cfg.RejectIneligible = false
+			})
+
+			It("should return the ineligible snapshot", func() {
+				policy.Status = dotnet.PolicyStatusArchived
+				expectLookups()
+
+				snapshot, err := newService().Check(ctx, vehicle.ID, vehicle.CustomerID, 20000, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot.Eligible).To(BeFalse())
+				Expect(snapshot.Reasons).To(Equal([]string{entity.IneligiblePolicyNotInEffect}))
+			})
+
+			It("should flag a model without a policy", func() {
+				model.PolicyID = nil
+				mockClient.EXPECT().GetVehicle(ctx, vehicle.ID, "token").Return(vehicle, nil).Once()
+				mockClient.EXPECT().GetVehicleModel(ctx, model.ID, "token").Return(model, nil).Once()
+
+				snapshot, err := newService().Check(ctx, vehicle.ID, vehicle.CustomerID, 20000, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot.Eligible).To(BeFalse())
+				Expect(snapshot.Reasons).To(Equal([]string{entity.IneligibleNoPolicy}))
+				Expect(snapshot.PolicyID).To(BeNil())
+			})
+
+			It("should flag a vehicle without a purchase date", func() {
+				vehicle.PurchaseDate = nil
+				expectLookups()
+
+				snapshot, err := newService().Check(ctx, vehicle.ID, vehicle.CustomerID, 20000, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot.Reasons).To(Equal([]string{entity.IneligibleNoPurchaseDate}))
+				Expect(snapshot.WarrantyExpiresAt).To(BeNil())
+			})
+		})
+	})
+	Describe("CheckCoverage", func() {
+		var (
+			categoryID uuid.UUID
+			warranty   *entity.WarrantySnapshot
+		)
+
+		BeforeEach(func() {
+			categoryID = uuid.New()
+			warranty = &entity.WarrantySnapshot{Eligible: true, PolicyID: &policy.ID}
+		})
+
+		Context("when the policy covers a parent category", func() {
+			It("should record the inherited coverage", func() {
+				parentID := uuid.New()
+				mockClient.EXPECT().GetCoverage(ctx, policy.ID, categoryID, "token").Return(&dotnet.CoverageResponse{
+					PolicyID:           policy.ID,
+					PartCategoryID:     parentID,
+					CoverageConditions: "Battery capacity below 70%",
+					IsInherited:        true,
+				}, nil).Once()
+
+				coverage, err := newService().CheckCoverage(ctx, warranty, categoryID, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(coverage.Status).To(Equal(entity.CoverageStatusCovered))
+				Expect(coverage.CoveredCategoryID).To(Equal(&parentID))
+				Expect(coverage.Inherited).To(BeTrue())
+				Expect(coverage.Conditions).To(Equal("Battery capacity below 70%"))
+			})
+		})
+
+		Context("when the category is not covered", func() {
+			BeforeEach(func() {
+				mockClient.EXPECT().GetCoverage(ctx, policy.ID, categoryID, "token").Return(nil, nil).Once()
+			})
+
+			It("should mark the item as not covered", func() {
+				coverage, err := newService().CheckCoverage(ctx, warranty, categoryID, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(coverage.Status).To(Equal(entity.CoverageStatusNotCovered))
+				Expect(coverage.PolicyID).To(Equal(&policy.ID))
+			})
+
+			It("should reject the item when uncovered items are rejected", func() {
+				cfg.RejectUncovered = true
+
+				coverage, err := newService().CheckCoverage(ctx, warranty, categoryID, "token")
+
+				Expect(coverage).To(BeNil())
+				ExpectAppError(err, apperror.ErrClaimItemNotCovered.ErrorCode)
+			})
+		})
+
+		Context("when the claim was flagged without a policy", func() {
+			It("should mark the item as not covered without a lookup", func() {
+				warranty.PolicyID = nil
+
+				coverage, err := newService().CheckCoverage(ctx, warranty, categoryID, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(coverage.Status).To(Equal(entity.CoverageStatusNotCovered))
+			})
+		})
+
+		Context("when the claim has no warranty snapshot", func() {
+			It("should leave the coverage unknown", func() {
+				cfg.RejectUncovered = true
+
+				coverage, err := newService().CheckCoverage(ctx, nil, categoryID, "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(coverage.Status).To(Equal(entity.CoverageStatusUnknown))
+			})
+		})
+	})
+})
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	webhookSecretPrefix = "whsec_"
+	webhookSecretBytes  = 32
+
+	webhookDeliveryListLimit = 100
+)
+
+type CreateWebhookSubscriptionCommand struct {
+	OfficeID   uuid.UUID
+	URL        string
+	EventTypes []string
+	IsActive   bool
+}
+
+type UpdateWebhookSubscriptionCommand struct {
+	URL        string
+	EventTypes []string
+	IsActive   bool
+}
+
+type WebhookSubscriptionService interface {
+	// Create registers a subscription with a freshly generated secret. The
+	// returned subscription is the only place the secret is ever exposed.
+	Create(ctx context.Context, cmd *CreateWebhookSubscriptionCommand) (*entity.WebhookSubscription, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	GetAll(ctx context.Context, officeID *uuid.UUID) ([]*entity.WebhookSubscription, error)
+	Update(ctx context.Context, id uuid.UUID, cmd *UpdateWebhookSubscriptionCommand) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	GetDeliveries(ctx context.Context, subscriptionID uuid.UUID) ([]*entity.WebhookDelivery, error)
+	Redeliver(tx application.Tx, subscriptionID, deliveryID uuid.UUID) (*entity.WebhookDelivery, error)
+}
+
+type webhookSubscriptionService struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+	officeRepo       repository.OfficeRepository
+}
+
+func NewWebhookSubscriptionService(subscriptionRepo repository.WebhookSubscriptionRepository,
+	deliveryRepo repository.WebhookDeliveryRepository, officeRepo repository.OfficeRepository,
+) WebhookSubscriptionService {
+	return &webhookSubscriptionService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		officeRepo:       officeRepo,
+	}
+}
+
+func (s *webhookSubscriptionService) Create(ctx context.Context, cmd *CreateWebhookSubscriptionCommand,
+) (*entity.WebhookSubscription, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	officeID := cmd.OfficeID
+	if actor.IsOfficeScoped() {
+		if officeID != uuid.Nil && officeID != actor.OfficeID {
+			return nil, apperror.ErrUnauthorizedRole.WithMessage(
+				"Cannot manage webhook subscriptions of another office")
+		}
+		officeID = actor.OfficeID
+	}
+	if officeID == uuid.Nil {
+		return nil, apperror.ErrInvalidInput.WithMessage("Office is required")
+	}
+
+	eventTypes, err := validateWebhookSubscription(cmd.URL, cmd.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.officeRepo.FindByID(ctx, officeID); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := entity.NewWebhookSubscription(officeID, cmd.URL, eventTypes, secret, cmd.IsActive)
+	if err = s.subscriptionRepo.Create(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookSubscriptionService) GetByID(ctx context.Context, id uuid.UUID,
+) (*entity.WebhookSubscription, error) {
+	return s.findSubscriptionInScope(ctx, id)
+}
+
+func (s *webhookSubscriptionService) GetAll(ctx context.Context, officeID *uuid.UUID,
+) ([]*entity.WebhookSubscription, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	if actor.IsOfficeScoped() {
+		officeID = &actor.OfficeID
+	}
+	return s.subscriptionRepo.FindAll(ctx, officeID)
+}
+
+func (s *webhookSubscriptionService) Update(ctx context.Context, id uuid.UUID,
+	cmd *UpdateWebhookSubscriptionCommand,
+) error {
+	subscription, err := s.findSubscriptionInScope(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	eventTypes, err := validateWebhookSubscription(cmd.URL, cmd.EventTypes)
+	if err != nil {
+		return err
+	}
+	subscription.URL = cmd.URL
+	subscription.SetEventTypes(eventTypes)
+	subscription.IsActive = cmd.IsActive
+
+	return s.subscriptionRepo.Update(ctx, subscription)
+}
+
+func (s *webhookSubscriptionService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.findSubscriptionInScope(ctx, id); err != nil {
+		return err
+	}
+	return s.subscriptionRepo.SoftDelete(ctx, id)
+}
+
+func (s *webhookSubscriptionService) GetDeliveries(ctx context.Context, subscriptionID uuid.UUID,
+) ([]*entity.WebhookDelivery, error) {
+	if _, err := s.findSubscriptionInScope(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return s.deliveryRepo.FindBySubscriptionID(ctx, subscriptionID, webhookDeliveryListLimit)
+}
+
+func (s *webhookSubscriptionService) Redeliver(tx application.Tx, subscriptionID, deliveryID uuid.UUID,
+) (*entity.WebhookDelivery, error) {
+	subscription, err := s.findSubscriptionInScope(tx.GetCtx(), subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.IsActive {
+		return nil, apperror.ErrWebhookSubscriptionInactive
+	}
+
+	delivery, err := s.deliveryRepo.FindByID(tx.GetCtx(), deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID != subscription.ID {
+		return nil, apperror.ErrNotFoundError.WithMessage("Webhook delivery not found")
+	}
+
+	delivery.Redeliver(time.Now())
+	if err = s.deliveryRepo.Update(tx, delivery); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// findSubscriptionInScope loads a subscription on behalf of the actor carried
+// by ctx. Office scoped actors only resolve the subscriptions of their own
+// office, any other is reported as not found.
+func (s *webhookSubscriptionService) findSubscriptionInScope(ctx context.Context, id uuid.UUID,
+) (*entity.WebhookSubscription, error) {
+	actor, ok := application.ActorFromContext(ctx)
+	if !ok {
+		return nil, apperror.ErrMissingUserID
+	}
+
+	subscription, err := s.subscriptionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if actor.IsOfficeScoped() && subscription.OfficeID != actor.OfficeID {
+		return nil, apperror.ErrNotFoundError.WithMessage("Webhook subscription not found")
+	}
+
+	return subscription, nil
+}
+
+// validateWebhookSubscription checks the URL and event types of a
+// subscription and returns the event types without duplicates.
+func validateWebhookSubscription(url string, eventTypes []string) ([]string, error) {
+	if !entity.IsValidWebhookURL(url) {
+		return nil, apperror.ErrInvalidInput.WithMessage("Invalid webhook URL")
+	}
+
+	unique := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !entity.IsValidEventType(eventType) {
+			return nil, apperror.ErrInvalidInput.WithMessage("Invalid event type " + eventType)
+		}
+		if !slices.Contains(unique, eventType) {
+			unique = append(unique, eventType)
+		}
+	}
+
+	return unique, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	bytes := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", apperror.ErrFailedGenerateWebhookSecret.WithError(err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(bytes), nil
+}
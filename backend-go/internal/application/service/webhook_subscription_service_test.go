@@ -0,0 +1,306 @@
+package service_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/pkg/apperror"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/mocks"
+)
+
+var _ = Describe("WebhookSubscriptionService", func() {
+	var (
+		mockSubscriptionRepo *mocks.WebhookSubscriptionRepository
+		mockDeliveryRepo     *mocks.WebhookDeliveryRepository
+		mockOfficeRepo       *mocks.OfficeRepository
+		mockTx               *mocks.Tx
+		subscriptionService  service.WebhookSubscriptionService
+		ctx                  context.Context
+		scopedCtx            context.Context
+		officeID             uuid.UUID
+		subscription         *entity.WebhookSubscription
+	)
+
+	BeforeEach(func() {
+		mockSubscriptionRepo = mocks.NewWebhookSubscriptionRepository(GinkgoT())
+		mockDeliveryRepo = mocks.NewWebhookDeliveryRepository(GinkgoT())
+		mockOfficeRepo = mocks.NewOfficeRepository(GinkgoT())
+		mockTx = mocks.NewTx(GinkgoT())
+		subscriptionService = service.NewWebhookSubscriptionService(mockSubscriptionRepo, mockDeliveryRepo,
+			mockOfficeRepo)
+
+		officeID = uuid.New()
+		ctx = application.WithActor(context.Background(), &application.Actor{
+			UserID: uuid.New(),
+			Role:   entity.UserRoleAdmin,
+		})
+		scopedCtx = application.WithActor(context.Background(), &application.Actor{
+			UserID:   uuid.New(),
+			Role:     entity.UserRoleScStaff,
+			OfficeID: officeID,
+		})
+		subscription = entity.NewWebhookSubscription(officeID, "https://dealer.example.com/hooks",
+			[]string{entity.EventClaimApproved}, "whsec_test", true)
+	})
+
+	Describe("Create", func() {
+		var cmd *service.CreateWebhookSubscriptionCommand
+
+		BeforeEach(func() {
+			cmd = &service.CreateWebhookSubscriptionCommand{
+				URL:        "https://dealer.example.com/hooks",
+				EventTypes: []string{entity.EventClaimApproved, entity.EventClaimRejected, entity.EventClaimApproved},
+				IsActive:   true,
+			}
+		})
+
+		Context("when an office scoped actor subscribes", func() {
+			It("should subscribe their own office with a generated secret", func() {
+				mockOfficeRepo.EXPECT().FindByID(scopedCtx, officeID).Return(&entity.Office{ID: officeID}, nil).Once()
+				mockSubscriptionRepo.EXPECT().Create(scopedCtx, mock.AnythingOfType("*entity.WebhookSubscription")).
+					Return(nil).Once()
+
+				created, err := subscriptionService.Create(scopedCtx, cmd)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.OfficeID).To(Equal(officeID))
+				Expect([]string(created.EventTypes)).To(Equal(
+					[]string{entity.EventClaimApproved, entity.EventClaimRejected}))
+				Expect(strings.HasPrefix(created.Secret, "whsec_")).To(BeTrue())
+				Expect(created.Secret).To(HaveLen(len("whsec_") + 64))
+			})
+		})
+
+		Context("when an office scoped actor subscribes another office", func() {
+			It("should return UnauthorizedRole error", func() {
+				cmd.OfficeID = uuid.New()
+
+				created, err := subscriptionService.Create(scopedCtx, cmd)
+
+				Expect(created).To(BeNil())
+				ExpectAppError(err, apperror.ErrUnauthorizedRole.ErrorCode)
+			})
+		})
+
+		Context("when an admin does not give the office", func() {
+			It("should return InvalidInput error", func() {
+				created, err := subscriptionService.Create(ctx, cmd)
+
+				Expect(created).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when the URL is not an http endpoint", func() {
+			It("should return InvalidInput error", func() {
+				cmd.URL = "ftp://dealer.example.com/hooks"
+
+				created, err := subscriptionService.Create(scopedCtx, cmd)
+
+				Expect(created).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when the URL is not https", func() {
+			It("should return InvalidInput error", func() {
+				cmd.URL = "http://dealer.example.com/hooks"
+
+				created, err := subscriptionService.Create(scopedCtx, cmd)
+
+				Expect(created).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		DescribeTable("when the URL points at an internal address",
+			func(url string) {
+				cmd.URL = url
+
+				created, err := subscriptionService.Create(scopedCtx, cmd)
+
+				Expect(created).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			},
+			Entry("loopback", "https://127.0.0.1/hooks"),
+			Entry("localhost", "https://localhost:8443/hooks"),
+			Entry("cloud metadata", "https://169.254.169.254/latest/meta-data"),
+			Entry("private network", "https://10.0.12.7/hooks"),
+			Entry("unspecified", "https://0.0.0.0/hooks"),
+			Entry("IPv6 loopback", "https://[::1]/hooks"),
+			Entry("IPv4 mapped private", "https://[::ffff:192.168.1.10]/hooks"),
+		)
+
+		Context("when an event type is unknown", func() {
+			It("should return InvalidInput error", func() {
+				cmd.EventTypes = []string{"ClaimExploded"}
+
+				created, err := subscriptionService.Create(scopedCtx, cmd)
+
+				Expect(created).To(BeNil())
+				ExpectAppError(err, apperror.ErrInvalidInput.ErrorCode)
+			})
+		})
+
+		Context("when the office does not exist", func() {
+			It("should return the error", func() {
+				cmd.OfficeID = officeID
+				mockOfficeRepo.EXPECT().FindByID(ctx, officeID).Return(nil, apperror.ErrNotFoundError).Once()
+
+				created, err := subscriptionService.Create(ctx, cmd)
+
+				Expect(created).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("GetByID", func() {
+		Context("when the subscription belongs to another office", func() {
+			It("should report it as not found to an office scoped actor", func() {
+				subscription.OfficeID = uuid.New()
+				mockSubscriptionRepo.EXPECT().FindByID(scopedCtx, subscription.ID).Return(subscription, nil).Once()
+
+				found, err := subscriptionService.GetByID(scopedCtx, subscription.ID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("GetAll", func() {
+		Context("when an office scoped actor asks for another office", func() {
+			It("should only return their own office", func() {
+				otherOffice := uuid.New()
+				mockSubscriptionRepo.EXPECT().FindAll(scopedCtx, &officeID).
+					Return([]*entity.WebhookSubscription{subscription}, nil).Once()
+
+				subscriptions, err := subscriptionService.GetAll(scopedCtx, &otherOffice)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subscriptions).To(HaveLen(1))
+			})
+		})
+
+		Context("when an admin lists every office", func() {
+			It("should not filter by office", func() {
+				mockSubscriptionRepo.EXPECT().FindAll(ctx, (*uuid.UUID)(nil)).
+					Return([]*entity.WebhookSubscription{subscription}, nil).Once()
+
+				subscriptions, err := subscriptionService.GetAll(ctx, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subscriptions).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		Context("when the subscription is updated successfully", func() {
+			It("should keep the secret", func() {
+				cmd := &service.UpdateWebhookSubscriptionCommand{
+					URL:      "https://dealer.example.com/v2/hooks",
+					IsActive: false,
+				}
+				mockSubscriptionRepo.EXPECT().FindByID(scopedCtx, subscription.ID).Return(subscription, nil).Once()
+				mockSubscriptionRepo.EXPECT().Update(scopedCtx, mock.MatchedBy(func(s *entity.WebhookSubscription) bool {
+					return s.URL == cmd.URL && !s.IsActive && len(s.EventTypes) == 0 && s.Secret == "whsec_test"
+				})).Return(nil).Once()
+
+				err := subscriptionService.Update(scopedCtx, subscription.ID, cmd)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		Context("when the subscription is in scope", func() {
+			It("should soft delete it", func() {
+				mockSubscriptionRepo.EXPECT().FindByID(scopedCtx, subscription.ID).Return(subscription, nil).Once()
+				mockSubscriptionRepo.EXPECT().SoftDelete(scopedCtx, subscription.ID).Return(nil).Once()
+
+				err := subscriptionService.Delete(scopedCtx, subscription.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("GetDeliveries", func() {
+		Context("when the subscription is in scope", func() {
+			It("should return its latest deliveries", func() {
+				delivery := entity.NewWebhookDelivery(subscription.ID, uuid.New(), entity.EventClaimApproved, nil)
+				mockSubscriptionRepo.EXPECT().FindByID(scopedCtx, subscription.ID).Return(subscription, nil).Once()
+				mockDeliveryRepo.EXPECT().FindBySubscriptionID(scopedCtx, subscription.ID, 100).
+					Return([]*entity.WebhookDelivery{delivery}, nil).Once()
+
+				deliveries, err := subscriptionService.GetDeliveries(scopedCtx, subscription.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("Redeliver", func() {
+		var delivery *entity.WebhookDelivery
+
+		BeforeEach(func() {
+			delivery = entity.NewWebhookDelivery(subscription.ID, uuid.New(), entity.EventClaimApproved, nil)
+			mockTx.EXPECT().GetCtx().Return(scopedCtx).Maybe()
+		})
+
+		Context("when a dead delivery is redelivered", func() {
+			It("should queue it again with a fresh attempt budget", func() {
+				delivery.Status = entity.WebhookDeliveryStatusDead
+				delivery.Attempts = 10
+				mockSubscriptionRepo.EXPECT().FindByID(scopedCtx, subscription.ID).Return(subscription, nil).Once()
+				mockDeliveryRepo.EXPECT().FindByID(scopedCtx, delivery.ID).Return(delivery, nil).Once()
+				mockDeliveryRepo.EXPECT().Update(mockTx, delivery).Return(nil).Once()
+
+				redelivered, err := subscriptionService.Redeliver(mockTx, subscription.ID, delivery.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(redelivered.Status).To(Equal(entity.WebhookDeliveryStatusPending))
+				Expect(redelivered.Attempts).To(BeZero())
+				Expect(redelivered.NextAttemptAt).To(BeTemporally("~", time.Now(), time.Second))
+			})
+		})
+
+		Context("when the delivery belongs to another subscription", func() {
+			It("should return NotFound error", func() {
+				delivery.SubscriptionID = uuid.New()
+				mockSubscriptionRepo.EXPECT().FindByID(scopedCtx, subscription.ID).Return(subscription, nil).Once()
+				mockDeliveryRepo.EXPECT().FindByID(scopedCtx, delivery.ID).Return(delivery, nil).Once()
+
+				redelivered, err := subscriptionService.Redeliver(mockTx, subscription.ID, delivery.ID)
+
+				Expect(redelivered).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+
+		Context("when the subscription is inactive", func() {
+			It("should return WebhookSubscriptionInactive error", func() {
+				subscription.IsActive = false
+				mockSubscriptionRepo.EXPECT().FindByID(scopedCtx, subscription.ID).Return(subscription, nil).Once()
+
+				redelivered, err := subscriptionService.Redeliver(mockTx, subscription.ID, delivery.ID)
+
+				Expect(redelivered).To(BeNil())
+				ExpectAppError(err, apperror.ErrWebhookSubscriptionInactive.ErrorCode)
+			})
+		})
+	})
+})
@@ -9,6 +9,14 @@ type Tx interface {
 	GetCtx() context.Context
 	Rollback() error
 	Commit() error
+
+	// OnCommit registers fn to run once the transaction has committed.
+	OnCommit(fn func())
+	// OnRollback registers fn to run once the transaction has been rolled
+	// back, including when the commit itself failed. It is how side effects
+	// outside the database, such as a reservation in another service, are
+	// compensated.
+	OnRollback(fn func())
 }
 
 type TxManager interface {
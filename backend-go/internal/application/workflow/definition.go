@@ -0,0 +1,81 @@
+package workflow
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ActionSubmit     = "submit"
+	ActionReview     = "review"
+	ActionCancel     = "cancel"
+	ActionDoneReview = "done_review"
+	ActionComplete   = "complete"
+)
+
+//go:embed claim_workflow.yaml
+var defaultDefinition []byte
+
+// Definition declares the claim states and the transitions between them.
+// It is decoded from YAML, which also accepts the equivalent JSON document.
+type Definition struct {
+	States      []string     `yaml:"states" json:"states"`
+	Transitions []Transition `yaml:"transitions" json:"transitions"`
+}
+
+// Transition moves a claim from one of the From states to To when Action is
+// performed. RequireReason makes a reason code and note mandatory.
+type Transition struct {
+	Action        string   `yaml:"action" json:"action"`
+	From          []string `yaml:"from" json:"from"`
+	To            string   `yaml:"to" json:"to"`
+	Roles         []string `yaml:"roles" json:"roles"`
+	Guards        []string `yaml:"guards" json:"guards"`
+	RequireReason bool     `yaml:"require_reason" json:"require_reason"`
+}
+
+func (t *Transition) isFrom(status string) bool {
+	for _, from := range t.From {
+		if from == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Transition) allows(role string) bool {
+	for _, r := range t.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func Parse(data []byte) (*Definition, error) {
+	var def Definition
+	if err := yaml.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow definition: %w", err)
+	}
+	return &def, nil
+}
+
+func LoadFile(path string) (*Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workflow definition: %w", err)
+	}
+	return Parse(data)
+}
+
+// Default returns the built-in claim workflow.
+func Default() *Definition {
+	def, err := Parse(defaultDefinition)
+	if err != nil {
+		panic(err)
+	}
+	return def
+}
@@ -0,0 +1,168 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+)
+
+// Guard is a named precondition of a transition. Check reports whether the
+// claim satisfies it, Err is returned to the caller when it does not.
+type Guard struct {
+	Check func(ctx context.Context, claim *entity.Claim) (bool, error)
+	Err   *apperror.AppError
+}
+
+type Engine interface {
+	Fire(ctx context.Context, claim *entity.Claim, role, action string) (*Transition, error)
+	AvailableActions(ctx context.Context, claim *entity.Claim, role string) ([]string, error)
+}
+
+type engine struct {
+	transitions []Transition
+	guards      map[string]Guard
+}
+
+// NewEngine validates def against the known claim statuses, user roles and
+// guards, and returns an engine driving claims through it.
+func NewEngine(def *Definition, guards map[string]Guard) (Engine, error) {
+	if err := validate(def, guards); err != nil {
+		return nil, err
+	}
+
+	return &engine{
+		transitions: def.Transitions,
+		guards:      guards,
+	}, nil
+}
+
+// Fire resolves the transition performed by action on claim. Transitions are
+// tried in declaration order and the first one allowed for role whose guards
+// all pass is returned.
+func (e *engine) Fire(ctx context.Context, claim *entity.Claim, role, action string) (*Transition, error) {
+	var rejection error
+	matched := false
+	for i := range e.transitions {
+		transition := &e.transitions[i]
+		if transition.Action != action || !transition.isFrom(claim.Status) {
+			continue
+		}
+		matched = true
+		if !transition.allows(role) {
+			continue
+		}
+
+		blocked, err := e.checkGuards(ctx, transition, claim)
+		if err != nil {
+			return nil, err
+		}
+		if blocked == nil {
+			return transition, nil
+		}
+		if rejection == nil {
+			rejection = blocked
+		}
+	}
+
+	if !matched {
+		return nil, apperror.ErrInvalidClaimAction.
+			WithMessage(fmt.Sprintf("Action %s is not allowed for %s claims", action, claim.Status))
+	}
+	if rejection == nil {
+		return nil, apperror.ErrUnauthorizedRole
+	}
+	return nil, rejection
+}
+
+// AvailableActions lists, in declaration order, the actions role can
+// currently perform on claim.
+func (e *engine) AvailableActions(ctx context.Context, claim *entity.Claim, role string) ([]string, error) {
+	actions := make([]string, 0)
+	seen := make(map[string]bool)
+	for i := range e.transitions {
+		transition := &e.transitions[i]
+		if seen[transition.Action] || !transition.isFrom(claim.Status) || !transition.allows(role) {
+			continue
+		}
+
+		blocked, err := e.checkGuards(ctx, transition, claim)
+		if err != nil {
+			return nil, err
+		}
+		if blocked == nil {
+			seen[transition.Action] = true
+			actions = append(actions, transition.Action)
+		}
+	}
+
+	return actions, nil
+}
+
+func (e *engine) checkGuards(ctx context.Context, transition *Transition, claim *entity.Claim,
+) (*apperror.AppError, error) {
+	for _, name := range transition.Guards {
+		guard := e.guards[name]
+		ok, err := guard.Check(ctx, claim)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return guard.Err, nil
+		}
+	}
+	return nil, nil
+}
+
+func validate(def *Definition, guards map[string]Guard) error {
+	if def == nil || len(def.States) == 0 {
+		return errors.New("workflow must declare at least one state")
+	}
+	if len(def.Transitions) == 0 {
+		return errors.New("workflow must declare at least one transition")
+	}
+
+	states := make(map[string]bool, len(def.States))
+	for _, state := range def.States {
+		if !entity.IsValidClaimStatus(state) {
+			return fmt.Errorf("state %q is not a claim status", state)
+		}
+		if states[state] {
+			return fmt.Errorf("state %q is declared twice", state)
+		}
+		states[state] = true
+	}
+
+	for i, transition := range def.Transitions {
+		if transition.Action == "" {
+			return fmt.Errorf("transition %d has no action", i)
+		}
+		if len(transition.From) == 0 {
+			return fmt.Errorf("transition %d (%s) has no source state", i, transition.Action)
+		}
+		for _, from := range transition.From {
+			if !states[from] {
+				return fmt.Errorf("transition %d (%s) starts from undeclared state %q", i, transition.Action, from)
+			}
+		}
+		if !states[transition.To] {
+			return fmt.Errorf("transition %d (%s) targets undeclared state %q", i, transition.Action, transition.To)
+		}
+		if len(transition.Roles) == 0 {
+			return fmt.Errorf("transition %d (%s) allows no role", i, transition.Action)
+		}
+		for _, role := range transition.Roles {
+			if !entity.IsValidUserRole(role) {
+				return fmt.Errorf("transition %d (%s) allows unknown role %q", i, transition.Action, role)
+			}
+		}
+		for _, name := range transition.Guards {
+			if _, ok := guards[name]; !ok {
+				return fmt.Errorf("transition %d (%s) uses unknown guard %q", i, transition.Action, name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package workflow_test
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/workflow"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Engine", func() {
+	var (
+		ctx     context.Context
+		passing bool
+		guards  map[string]workflow.Guard
+	)
+
+	definition := func() *workflow.Definition {
+		def, err := workflow.Parse([]byte(`{
+			"states": ["DRAFT", "SUBMITTED", "CANCELLED"],
+			"transitions": [
+				{"action": "submit", "from": ["DRAFT"], "to": "SUBMITTED", "roles": ["SC_STAFF"], "guards": ["ready"]},
+				{"action": "cancel", "from": ["DRAFT", "SUBMITTED"], "to": "CANCELLED", "roles": ["SC_STAFF", "ADMIN"]}
+			]
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		return def
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		passing = true
+		guards = map[string]workflow.Guard{
+			"ready": {
+				Check: func(ctx context.Context, claim *entity.Claim) (bool, error) { return passing, nil },
+				Err:   apperror.ErrMissingInformationClaim,
+			},
+		}
+	})
+
+	Describe("NewEngine", func() {
+		It("should accept the default definition", func() {
+			guards := map[string]workflow.Guard{}
+			for _, transition := range workflow.Default().Transitions {
+				for _, name := range transition.Guards {
+					guards[name] = workflow.Guard{}
+				}
+			}
+
+			_, err := workflow.NewEngine(workflow.Default(), guards)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should reject invalid definitions",
+			func(mutate func(def *workflow.Definition)) {
+				def := definition()
+				mutate(def)
+
+				_, err := workflow.NewEngine(def, guards)
+
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("no state", func(def *workflow.Definition) { def.States = nil }),
+			Entry("unknown claim status", func(def *workflow.Definition) { def.States[0] = "ARCHIVED" }),
+			Entry("duplicated state", func(def *workflow.Definition) { def.States[1] = "DRAFT" }),
+			Entry("no transition", func(def *workflow.Definition) { def.Transitions = nil }),
+			Entry("missing action", func(def *workflow.Definition) { def.Transitions[0].Action = "" }),
+			Entry("undeclared source", func(def *workflow.Definition) { def.Transitions[0].From = []string{"APPROVED"} }),
+			Entry("undeclared target", func(def *workflow.Definition) { def.Transitions[0].To = "COMPLETED" }),
+			Entry("no role", func(def *workflow.Definition) { def.Transitions[0].Roles = nil }),
+			Entry("unknown role", func(def *workflow.Definition) { def.Transitions[0].Roles = []string{"GUEST"} }),
+			Entry("unknown guard", func(def *workflow.Definition) { def.Transitions[0].Guards = []string{"nope"} }),
+		)
+	})
+
+	Describe("Fire", func() {
+		var engine workflow.Engine
+
+		BeforeEach(func() {
+			var err error
+			engine, err = workflow.NewEngine(definition(), guards)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the transition when role and guards allow it", func() {
+			transition, err := engine.Fire(ctx, &entity.Claim{Status: entity.ClaimStatusDraft},
+				entity.UserRoleScStaff, "submit")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transition.To).To(Equal(entity.ClaimStatusSubmitted))
+		})
+
+		It("should return InvalidClaimAction when the action is not defined for the status", func() {
+			_, err := engine.Fire(ctx, &entity.Claim{Status: entity.ClaimStatusSubmitted},
+				entity.UserRoleScStaff, "submit")
+
+			ExpectAppError(err, apperror.ErrInvalidClaimAction.ErrorCode)
+		})
+
+		It("should return UnauthorizedRole when the role may not perform the action", func() {
+			_, err := engine.Fire(ctx, &entity.Claim{Status: entity.ClaimStatusDraft},
+				entity.UserRoleEvmStaff, "submit")
+
+			ExpectAppError(err, apperror.ErrUnauthorizedRole.ErrorCode)
+		})
+
+		It("should return the guard error when a guard fails", func() {
+			passing = false
+
+			_, err := engine.Fire(ctx, &entity.Claim{Status: entity.ClaimStatusDraft},
+				entity.UserRoleScStaff, "submit")
+
+			ExpectAppError(err, apperror.ErrMissingInformationClaim.ErrorCode)
+		})
+	})
+
+	Describe("AvailableActions", func() {
+		It("should list the actions the role can perform", func() {
+			engine, err := workflow.NewEngine(definition(), guards)
+			Expect(err).NotTo(HaveOccurred())
+
+			scActions, err := engine.AvailableActions(ctx, &entity.Claim{Status: entity.ClaimStatusDraft},
+				entity.UserRoleScStaff)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scActions).To(Equal([]string{"submit", "cancel"}))
+
+			adminActions, err := engine.AvailableActions(ctx, &entity.Claim{Status: entity.ClaimStatusDraft},
+				entity.UserRoleAdmin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adminActions).To(Equal([]string{"cancel"}))
+		})
+	})
+})
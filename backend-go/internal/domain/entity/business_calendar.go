@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const BusinessDateLayout = "2006-01-02"
+
+var DefaultWorkingDays = []time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+}
+
+// BusinessCalendar sets the days an office works, in its time zone. Holidays
+// are dates in BusinessDateLayout. Offices without a calendar work Monday to
+// Friday in UTC.
+type BusinessCalendar struct {
+	OfficeID    uuid.UUID      `gorm:"primaryKey;type:uuid" json:"office_id"`
+	Timezone    string         `gorm:"not null" json:"timezone"`
+	WorkingDays []time.Weekday `gorm:"not null;type:jsonb;serializer:json" json:"working_days" swaggertype:"array,integer"`
+	Holidays    []string       `gorm:"not null;type:jsonb;serializer:json" json:"holidays"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func NewBusinessCalendar(officeID uuid.UUID) *BusinessCalendar {
+	return &BusinessCalendar{
+		OfficeID:    officeID,
+		Timezone:    time.UTC.String(),
+		WorkingDays: slices.Clone(DefaultWorkingDays),
+		Holidays:    []string{},
+	}
+}
+
+// Location is the time zone of the calendar, UTC when it cannot be loaded.
+func (c *BusinessCalendar) Location() *time.Location {
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// IsBusinessDay reports whether the office works on the day t falls on in its
+// time zone.
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	t = t.In(c.Location())
+	return slices.Contains(c.WorkingDays, t.Weekday()) &&
+		!slices.Contains(c.Holidays, t.Format(BusinessDateLayout))
+}
+
+// AddBusinessDays returns the time of day of start, days business days later.
+// A start on a day off counts from the beginning of the next business day.
+func (c *BusinessCalendar) AddBusinessDays(start time.Time, days int) time.Time {
+	if len(c.WorkingDays) == 0 {
+		return start.AddDate(0, 0, days)
+	}
+
+	location := c.Location()
+	t := start.In(location)
+	if !c.IsBusinessDay(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+		for !c.IsBusinessDay(t) {
+			t = t.AddDate(0, 0, 1)
+		}
+	}
+	for days > 0 {
+		t = t.AddDate(0, 0, 1)
+		if c.IsBusinessDay(t) {
+			days--
+		}
+	}
+	return t
+}
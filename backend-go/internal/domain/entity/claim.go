@@ -24,23 +24,36 @@ const (
 	MaxClaimsPerTechnician = 3
 )
 
+// Claim totals are in minor units of Currency. RequestedTotal is the cost of
+// all the claim's items, ApprovedTotal of the approved ones and
+// ReimbursedTotal what has been paid out for it. SettlementBatchID is set once
+// the claim is part of an issued settlement batch, which locks it. SLA is the
+// deadline of its current status, only loaded when the claim is read.
 type Claim struct {
-	ID           uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
-	CustomerID   uuid.UUID       `gorm:"not null;type:uuid" json:"customer_id"`
-	VehicleID    uuid.UUID       `gorm:"not null;type:uuid" json:"vehicle_id"`
-	Kilometers   int             `gorm:"not null;" json:"kilometers"`
-	Description  string          `gorm:"not null;" json:"description"`
-	Status       string          `gorm:"not null;default:DRAFT" json:"status"`
-	TotalCost    float64         `json:"total_cost"`
-	StaffID      uuid.UUID       `gorm:"type:uuid" json:"staff_id"`
-	TechnicianID uuid.UUID       `gorm:"type:uuid" json:"technician_id"`
-	ApprovedBy   *uuid.UUID      `gorm:"type:uuid" json:"approved_by,omitempty"`
-	CreatedAt    time.Time       `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt    time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
-	DeletedAt    *gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                uuid.UUID         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	CustomerID        uuid.UUID         `gorm:"not null;type:uuid" json:"customer_id"`
+	VehicleID         uuid.UUID         `gorm:"not null;type:uuid" json:"vehicle_id"`
+	Kilometers        int               `gorm:"not null;" json:"kilometers"`
+	Description       string            `gorm:"not null;" json:"description"`
+	Status            string            `gorm:"not null;default:DRAFT" json:"status"`
+	Currency          string            `gorm:"not null" json:"currency"`
+	RequestedTotal    int64             `gorm:"not null;default:0" json:"requested_total"`
+	ApprovedTotal     int64             `gorm:"not null;default:0" json:"approved_total"`
+	ReimbursedTotal   int64             `gorm:"not null;default:0" json:"reimbursed_total"`
+	StaffID           uuid.UUID         `gorm:"type:uuid" json:"staff_id"`
+	TechnicianID      uuid.UUID         `gorm:"type:uuid" json:"technician_id"`
+	ApprovedBy        *uuid.UUID        `gorm:"type:uuid" json:"approved_by,omitempty"`
+	Warranty          *WarrantySnapshot `gorm:"type:jsonb;serializer:json" json:"warranty,omitempty"`
+	SettlementBatchID *uuid.UUID        `gorm:"type:uuid" json:"settlement_batch_id,omitempty"`
+	SLA               *ClaimSLAState    `gorm:"-" json:"sla,omitempty"`
+	CreatedAt         time.Time         `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt         time.Time         `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt         *gorm.DeletedAt   `gorm:"index" json:"-"`
 }
 
-func NewClaim(vehicleID, customerID uuid.UUID, kilometers int, description string, staffID, technicianID uuid.UUID) *Claim {
+func NewClaim(vehicleID, customerID uuid.UUID, kilometers int, description string, staffID, technicianID uuid.UUID,
+	currency string,
+) *Claim {
 
 	return &Claim{
 		ID:           uuid.New(),
@@ -50,6 +63,7 @@ func NewClaim(vehicleID, customerID uuid.UUID, kilometers int, description strin
 		Description:  description,
 		StaffID:      staffID,
 		TechnicianID: technicianID,
+		Currency:     currency,
 	}
 }
 
@@ -63,36 +77,6 @@ func IsValidClaimStatus(status string) bool {
 	}
 }
 
-func IsValidClaimStatusTransition(currentStatus, newStatus string) bool {
-	validTransitions := map[string][]string{
-		ClaimStatusDraft: {
-			ClaimStatusSubmitted,
-		},
-		ClaimStatusSubmitted: {
-			ClaimStatusReviewing,
-			ClaimStatusCancelled,
-		},
-		ClaimStatusReviewing: {
-			ClaimStatusApproved,
-			ClaimStatusPartiallyApproved,
-			ClaimStatusRejected,
-		},
-		ClaimStatusApproved:          {ClaimStatusCompleted},
-		ClaimStatusPartiallyApproved: {ClaimStatusCompleted},
-		ClaimStatusRejected:          {},
-		ClaimStatusCancelled:         {},
-	}
-
-	allowedStatuses, exists := validTransitions[currentStatus]
-	if !exists {
-		return false
-	}
-
-	for _, status := range allowedStatuses {
-		if status == newStatus {
-			return true
-		}
-	}
-
-	return false
+func (c *Claim) IsLocked() bool {
+	return c.SettlementBatchID != nil
 }
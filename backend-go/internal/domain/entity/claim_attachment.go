@@ -12,23 +12,121 @@ const (
 	AttachmentTypeImage = "image"
 )
 
+const (
+	AttachmentProcessingPending = "PENDING"
+	AttachmentProcessingReady   = "READY"
+	AttachmentProcessingFailed  = "FAILED"
+)
+
+// ClaimAttachment is a file stored privately under StorageKey. URL is a
+// download URL valid until URLExpiresAt, signed for each request. Checksum is
+// the hex SHA-256 of the file, unique among the attachments of a claim.
+//
+// Uploads are processed in the background: once READY, PublicKey is the
+// version served, without the location of the original, ThumbnailKey the
+// thumbnail of an image and the EXIF capture time and location or the video
+// duration are recorded. Attachments are only served once processed.
 type ClaimAttachment struct {
-	ID        uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
-	ClaimID   uuid.UUID       `gorm:"not null;type:uuid" json:"claim_id"`
-	Claim     Claim           `gorm:"foreignKey:ClaimID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
-	Type      string          `gorm:"not null" json:"type"`
-	URL       string          `gorm:"not null;type:text" json:"url"`
-	CreatedAt time.Time       `gorm:"autoCreateTime" json:"created_at"`
-	DeletedAt *gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                     uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	ClaimID                uuid.UUID       `gorm:"not null;type:uuid" json:"claim_id"`
+	Claim                  Claim           `gorm:"foreignKey:ClaimID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+	ClaimItemID            *uuid.UUID      `gorm:"type:uuid" json:"claim_item_id,omitempty"`
+	Type                   string          `gorm:"not null" json:"type"`
+	FileName               string          `gorm:"not null" json:"file_name"`
+	MimeType               string          `gorm:"not null" json:"mime_type"`
+	Size                   int64           `gorm:"not null" json:"size"`
+	Checksum               string          `gorm:"not null" json:"checksum"`
+	Caption                *string         `json:"caption,omitempty"`
+	UploadedBy             *uuid.UUID      `gorm:"type:uuid" json:"uploaded_by,omitempty"`
+	StorageKey             string          `gorm:"not null;type:text" json:"-"`
+	PublicKey              *string         `gorm:"type:text" json:"-"`
+	ThumbnailKey           *string         `gorm:"type:text" json:"-"`
+	ProcessingStatus       string          `gorm:"not null;default:PENDING" json:"processing_status"`
+	ProcessingError        *string         `json:"processing_error,omitempty"`
+	ProcessingAttempts     int             `gorm:"not null;default:0" json:"-"`
+	ProcessedAt            *time.Time      `json:"processed_at,omitempty"`
+	ProcessingClaimedUntil *time.Time      `json:"-"`
+	CapturedAt             *time.Time      `json:"captured_at,omitempty"`
+	Latitude               *float64        `json:"latitude,omitempty"`
+	Longitude              *float64        `json:"longitude,omitempty"`
+	DurationSeconds        *float64        `json:"duration_seconds,omitempty"`
+	URL                    string          `gorm:"-" json:"url,omitempty"`
+	ThumbnailURL           string          `gorm:"-" json:"thumbnail_url,omitempty"`
+	URLExpiresAt           *time.Time      `gorm:"-" json:"url_expires_at,omitempty"`
+	CreatedAt              time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	DeletedAt              *gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
-func NewClaimAttachment(claimID uuid.UUID, attachmentType, url string) *ClaimAttachment {
+func NewClaimAttachment(claimID uuid.UUID, claimItemID *uuid.UUID, attachmentType, fileName, mimeType string,
+	size int64, checksum string, caption *string, uploadedBy uuid.UUID, storageKey string,
+) *ClaimAttachment {
 	return &ClaimAttachment{
-		ID:      uuid.New(),
-		ClaimID: claimID,
-		Type:    attachmentType,
-		URL:     url,
+		ID:               uuid.New(),
+		ClaimID:          claimID,
+		ClaimItemID:      claimItemID,
+		Type:             attachmentType,
+		FileName:         fileName,
+		MimeType:         mimeType,
+		Size:             size,
+		Checksum:         checksum,
+		Caption:          caption,
+		UploadedBy:       &uploadedBy,
+		StorageKey:       storageKey,
+		ProcessingStatus: AttachmentProcessingPending,
+	}
+}
+
+// ClaimProcessing hides the attachment from other processing runs until
+// until, while its files are processed.
+func (a *ClaimAttachment) ClaimProcessing(until time.Time) {
+	a.ProcessingClaimedUntil = &until
+}
+
+// MarkReady records a processed attachment served from publicKey, with a
+// thumbnail when thumbnailKey is set.
+func (a *ClaimAttachment) MarkReady(publicKey string, thumbnailKey *string, now time.Time) {
+	a.ProcessingAttempts++
+	a.ProcessingStatus = AttachmentProcessingReady
+	a.PublicKey = &publicKey
+	a.ThumbnailKey = thumbnailKey
+	a.ProcessingError = nil
+	a.ProcessingClaimedUntil = nil
+	a.ProcessedAt = &now
+}
+
+// MarkFailed records a failed attempt to process the attachment. It is
+// retried unless it reached maxAttempts, in which case it failed.
+func (a *ClaimAttachment) MarkFailed(cause error, now time.Time, maxAttempts int) {
+	message := cause.Error()
+	a.ProcessingAttempts++
+	a.ProcessingError = &message
+	a.ProcessingClaimedUntil = nil
+	if a.ProcessingAttempts >= maxAttempts {
+		a.ProcessingStatus = AttachmentProcessingFailed
+		a.ProcessedAt = &now
+	}
+}
+
+// Reject fails the processing of an attachment whose file is not acceptable,
+// such as a video longer than allowed, without retrying it.
+func (a *ClaimAttachment) Reject(reason string, now time.Time) {
+	a.ProcessingAttempts++
+	a.ProcessingStatus = AttachmentProcessingFailed
+	a.ProcessingError = &reason
+	a.ProcessingClaimedUntil = nil
+	a.ProcessedAt = &now
+}
+
+// StorageKeys returns the keys of the original file and of the files derived
+// from it.
+func (a *ClaimAttachment) StorageKeys() []string {
+	keys := []string{a.StorageKey}
+	for _, key := range []*string{a.PublicKey, a.ThumbnailKey} {
+		if key != nil && *key != a.StorageKey {
+			keys = append(keys, *key)
+		}
 	}
+	return keys
 }
 
 func IsValidAttachmentType(attachmentType string) bool {
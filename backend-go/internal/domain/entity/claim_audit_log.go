@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditEntityClaim      = "CLAIM"
+	AuditEntityClaimItem  = "CLAIM_ITEM"
+	AuditEntityAttachment = "CLAIM_ATTACHMENT"
+
+	AuditActionCreate = "CREATE"
+	AuditActionUpdate = "UPDATE"
+	AuditActionDelete = "DELETE"
+)
+
+// ClaimAuditLog is an append-only record of a change made to a claim or to
+// one of its items or attachments. Before and After hold the values of the
+// fields that changed, Before is empty for a creation and After for a
+// deletion.
+type ClaimAuditLog struct {
+	ID         uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	ClaimID    uuid.UUID       `gorm:"not null;type:uuid" json:"claim_id"`
+	EntityType string          `gorm:"not null" json:"entity_type"`
+	EntityID   uuid.UUID       `gorm:"not null;type:uuid" json:"entity_id"`
+	Action     string          `gorm:"not null" json:"action"`
+	Before     json.RawMessage `gorm:"type:jsonb" json:"before,omitempty" swaggertype:"object"`
+	After      json.RawMessage `gorm:"type:jsonb" json:"after,omitempty" swaggertype:"object"`
+	ChangedBy  uuid.UUID       `gorm:"not null;type:uuid" json:"changed_by"`
+	ChangedAt  time.Time       `gorm:"autoCreateTime" json:"changed_at"`
+}
+
+func NewClaimAuditLog(claimID uuid.UUID, entityType string, entityID uuid.UUID, action string,
+	before, after json.RawMessage, changedBy uuid.UUID,
+) *ClaimAuditLog {
+	return &ClaimAuditLog{
+		ID:         uuid.New(),
+		ClaimID:    claimID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Before:     before,
+		After:      after,
+		ChangedBy:  changedBy,
+	}
+}
@@ -7,14 +7,19 @@ import (
 	"gorm.io/gorm"
 )
 
+// ClaimHistory records a status change of a claim, or of one of its items
+// when ClaimItemID is set, together with the reason given for it.
 type ClaimHistory struct {
-	ID        uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
-	ClaimID   uuid.UUID       `gorm:"not null;type:uuid" json:"claim_id"`
-	Claim     Claim           `gorm:"foreignKey:ClaimID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
-	Status    string          `gorm:"not null" json:"status"`
-	ChangedBy uuid.UUID       `gorm:"not null;type:uuid" json:"changed_by"`
-	ChangedAt time.Time       `gorm:"autoCreateTime" json:"changed_at"`
-	DeletedAt *gorm.DeletedAt `gorm:"index" json:"-"`
+	ID          uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	ClaimID     uuid.UUID       `gorm:"not null;type:uuid" json:"claim_id"`
+	Claim       Claim           `gorm:"foreignKey:ClaimID;references:ID;constraint:OnDelete:CASCADE" json:"-"`
+	ClaimItemID *uuid.UUID      `gorm:"type:uuid" json:"claim_item_id,omitempty"`
+	Status      string          `gorm:"not null" json:"status"`
+	ReasonCode  *string         `json:"reason_code,omitempty"`
+	Note        *string         `json:"note,omitempty"`
+	ChangedBy   uuid.UUID       `gorm:"not null;type:uuid" json:"changed_by"`
+	ChangedAt   time.Time       `gorm:"autoCreateTime" json:"changed_at"`
+	DeletedAt   *gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 func NewClaimHistory(claimID uuid.UUID, status string, changedBy uuid.UUID) *ClaimHistory {
@@ -25,3 +30,20 @@ func NewClaimHistory(claimID uuid.UUID, status string, changedBy uuid.UUID) *Cla
 		ChangedBy: changedBy,
 	}
 }
+
+func NewClaimItemHistory(claimID, itemID uuid.UUID, status string, changedBy uuid.UUID) *ClaimHistory {
+	history := NewClaimHistory(claimID, status, changedBy)
+	history.ClaimItemID = &itemID
+	return history
+}
+
+// SetReason attaches a reason to the history entry, empty values are left
+// unset.
+func (h *ClaimHistory) SetReason(reasonCode, note string) {
+	if reasonCode != "" {
+		h.ReasonCode = &reasonCode
+	}
+	if note != "" {
+		h.Note = &note
+	}
+}
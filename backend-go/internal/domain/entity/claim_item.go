@@ -26,7 +26,18 @@ type ClaimItem struct {
 	IssueDescription  string          `gorm:"not null;type:text" json:"issue_description"`
 	Status            string          `gorm:"not null" json:"status"`
 	Type              string          `gorm:"not null" json:"type"`
-	Cost              float64         `json:"cost"`
+	Currency          string          `gorm:"not null" json:"currency"`
+	LaborOperationID  *uuid.UUID      `gorm:"type:uuid" json:"labor_operation_id,omitempty"`
+	StandardMinutes   int             `gorm:"not null;default:0" json:"standard_minutes"`
+	LaborMinutes      int             `gorm:"not null;default:0" json:"labor_minutes"`
+	LaborRate         int64           `gorm:"not null;default:0" json:"labor_rate"`
+	LaborCost         int64           `gorm:"not null;default:0" json:"labor_cost"`
+	PartsCost         int64           `gorm:"not null;default:0" json:"parts_cost"`
+	DiagnosticFee     int64           `gorm:"not null;default:0" json:"diagnostic_fee"`
+	TaxRate           int             `gorm:"not null;default:0" json:"tax_rate"`
+	TaxAmount         int64           `gorm:"not null;default:0" json:"tax_amount"`
+	TotalCost         int64           `gorm:"not null;default:0" json:"total_cost"`
+	Coverage          *ItemCoverage   `gorm:"type:jsonb;serializer:json" json:"coverage,omitempty"`
 	CreatedAt         time.Time       `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt         time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
 	DeletedAt         *gorm.DeletedAt `gorm:"index" json:"-"`
@@ -34,7 +45,7 @@ type ClaimItem struct {
 
 func NewClaimItem(claimID uuid.UUID, partCategoryID uuid.UUID, faultyPartSerial string,
 	replacementPartID *uuid.UUID,
-	issueDescription, status, itemType string, cost float64,
+	issueDescription, status, itemType, currency string,
 ) *ClaimItem {
 
 	return &ClaimItem{
@@ -46,10 +57,38 @@ func NewClaimItem(claimID uuid.UUID, partCategoryID uuid.UUID, faultyPartSerial
 		IssueDescription:  issueDescription,
 		Status:            status,
 		Type:              itemType,
-		Cost:              cost,
+		Currency:          currency,
 	}
 }
 
+// SetLaborOperation charges the item the operation's flat-rate time, or no
+// labor when operation is nil. StandardMinutes keeps the flat-rate time so an
+// adjustment by the reviewer stays visible.
+func (i *ClaimItem) SetLaborOperation(operation *LaborOperation) {
+	if operation == nil {
+		i.LaborOperationID = nil
+		i.StandardMinutes = 0
+		i.LaborMinutes = 0
+		return
+	}
+	i.LaborOperationID = &operation.ID
+	i.StandardMinutes = operation.FlatRateMinutes
+	i.LaborMinutes = operation.FlatRateMinutes
+}
+
+// Price computes the item's costs from its labor time, parts cost and
+// diagnostic fee, at laborRate per hour and taxRate in basis points. The
+// rates are kept on the item so later rate changes leave it as priced.
+func (i *ClaimItem) Price(laborRate int64, taxRate int) {
+	i.LaborRate = laborRate
+	i.TaxRate = taxRate
+	i.LaborCost = mulDiv(laborRate, int64(i.LaborMinutes), 60)
+
+	subtotal := i.LaborCost + i.PartsCost + i.DiagnosticFee
+	i.TaxAmount = mulDiv(subtotal, int64(taxRate), BasisPoints)
+	i.TotalCost = subtotal + i.TaxAmount
+}
+
 func IsValidClaimItemStatus(status string) bool {
 	switch status {
 	case ClaimItemStatusPending, ClaimItemStatusRejected, ClaimItemStatusApproved:
@@ -0,0 +1,22 @@
+package entity
+
+const (
+	ReasonCodeNotCovered           = "NOT_COVERED"
+	ReasonCodeWarrantyExpired      = "WARRANTY_EXPIRED"
+	ReasonCodeInsufficientEvidence = "INSUFFICIENT_EVIDENCE"
+	ReasonCodeMisuse               = "MISUSE_OR_ACCIDENT"
+	ReasonCodeNoFaultFound         = "NO_FAULT_FOUND"
+	ReasonCodeDuplicateClaim       = "DUPLICATE_CLAIM"
+	ReasonCodeCustomerRequest      = "CUSTOMER_REQUEST"
+	ReasonCodeOther                = "OTHER"
+)
+
+func IsValidReasonCode(code string) bool {
+	switch code {
+	case ReasonCodeNotCovered, ReasonCodeWarrantyExpired, ReasonCodeInsufficientEvidence, ReasonCodeMisuse,
+		ReasonCodeNoFaultFound, ReasonCodeDuplicateClaim, ReasonCodeCustomerRequest, ReasonCodeOther:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ClaimSLAStateOnTrack  = "ON_TRACK"
+	ClaimSLAStateBreached = "BREACHED"
+)
+
+// SLAPolicy limits how long a claim may stay in a status: Duration of wall
+// clock time, or BusinessDays of its office's calendar when set.
+type SLAPolicy struct {
+	Duration     time.Duration
+	BusinessDays int
+}
+
+// DueAt is when a claim entering the status at start breaches the policy.
+func (p SLAPolicy) DueAt(start time.Time, calendar *BusinessCalendar) time.Time {
+	if p.BusinessDays > 0 {
+		return calendar.AddBusinessDays(start, p.BusinessDays)
+	}
+	return start.Add(p.Duration)
+}
+
+// ClaimSLA is the deadline a claim was held to while in Status. It is closed
+// when the claim leaves the status, and BreachedAt records when it was found
+// past DueAt.
+type ClaimSLA struct {
+	ID         uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	ClaimID    uuid.UUID  `gorm:"not null;type:uuid" json:"claim_id"`
+	Status     string     `gorm:"not null" json:"status"`
+	StartedAt  time.Time  `gorm:"not null" json:"started_at"`
+	DueAt      time.Time  `gorm:"not null" json:"due_at"`
+	BreachedAt *time.Time `json:"breached_at,omitempty"`
+	ClosedAt   *time.Time `json:"closed_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// ClaimSLAState is the SLA a claim is currently held to, as shown with the
+// claim. RemainingSeconds is negative once the SLA is breached.
+type ClaimSLAState struct {
+	Status           string    `json:"status"`
+	DueAt            time.Time `json:"due_at"`
+	Breached         bool      `json:"breached"`
+	RemainingSeconds int64     `json:"remaining_seconds"`
+}
+
+func NewClaimSLA(claimID uuid.UUID, status string, startedAt, dueAt time.Time) *ClaimSLA {
+	return &ClaimSLA{
+		ID:        uuid.New(),
+		ClaimID:   claimID,
+		Status:    status,
+		StartedAt: startedAt,
+		DueAt:     dueAt,
+	}
+}
+
+func (s *ClaimSLA) IsBreached(now time.Time) bool {
+	return s.BreachedAt != nil || now.After(s.DueAt)
+}
+
+func (s *ClaimSLA) MarkBreached(now time.Time) {
+	s.BreachedAt = &now
+}
+
+// Close stops the SLA as the claim leaves its status, recording a breach if
+// it left too late.
+func (s *ClaimSLA) Close(now time.Time) {
+	if s.BreachedAt == nil && now.After(s.DueAt) {
+		s.MarkBreached(now)
+	}
+	s.ClosedAt = &now
+}
+
+func (s *ClaimSLA) StateAt(now time.Time) *ClaimSLAState {
+	return &ClaimSLAState{
+		Status:           s.Status,
+		DueAt:            s.DueAt,
+		Breached:         s.IsBreached(now),
+		RemainingSeconds: int64(s.DueAt.Sub(now) / time.Second),
+	}
+}
+
+func IsValidClaimSLAState(state string) bool {
+	switch state {
+	case ClaimSLAStateOnTrack, ClaimSLAStateBreached:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClaimWorkflow stores a claim workflow definition document. At most one
+// workflow is active and it is loaded at startup when the workflow source is
+// the database.
+type ClaimWorkflow struct {
+	ID         uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Name       string    `gorm:"not null" json:"name"`
+	Definition string    `gorm:"not null;type:jsonb" json:"definition"`
+	IsActive   bool      `gorm:"not null;default:false" json:"is_active"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CoverageStatusCovered    = "COVERED"
+	CoverageStatusNotCovered = "NOT_COVERED"
+	// CoverageStatusUnknown is an item of a claim created before warranty
+	// eligibility was recorded, which has no policy to check against.
+	CoverageStatusUnknown = "UNKNOWN"
+)
+
+// ItemCoverage records whether a claim item's part category is covered by the
+// warranty policy of the claim, as decided when the item was added.
+type ItemCoverage struct {
+	Status   string     `json:"status"`
+	PolicyID *uuid.UUID `json:"policy_id,omitempty"`
+	// CoveredCategoryID is the category the coverage is defined on, a parent
+	// of the item's category when Inherited is set.
+	CoveredCategoryID *uuid.UUID `json:"covered_category_id,omitempty"`
+	Inherited         bool       `json:"inherited"`
+	Conditions        string     `json:"conditions,omitempty"`
+	CheckedAt         time.Time  `json:"checked_at"`
+}
+
+func (c *ItemCoverage) IsUncovered() bool {
+	return c != nil && c.Status == CoverageStatusNotCovered
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LaborOperation is a catalog entry for a standard repair job. Claim items
+// are charged its flat-rate time whatever the job actually took, unless a
+// reviewer approves adjusted time. An operation without a PartCategoryID can
+// be used on items of any category.
+type LaborOperation struct {
+	ID              uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	Code            string          `gorm:"not null" json:"code"`
+	Description     string          `gorm:"not null;type:text" json:"description"`
+	FlatRateMinutes int             `gorm:"not null" json:"flat_rate_minutes"`
+	PartCategoryID  *uuid.UUID      `gorm:"type:uuid" json:"part_category_id,omitempty"`
+	IsActive        bool            `gorm:"not null" json:"is_active"`
+	CreatedAt       time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt       *gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+func NewLaborOperation(code, description string, flatRateMinutes int, partCategoryID *uuid.UUID, isActive bool,
+) *LaborOperation {
+	return &LaborOperation{
+		ID:              uuid.New(),
+		Code:            code,
+		Description:     description,
+		FlatRateMinutes: flatRateMinutes,
+		PartCategoryID:  partCategoryID,
+		IsActive:        isActive,
+	}
+}
+
+// AppliesTo reports whether the operation can be used on an item of the
+// part category.
+func (o *LaborOperation) AppliesTo(partCategoryID uuid.UUID) bool {
+	return o.PartCategoryID == nil || *o.PartCategoryID == partCategoryID
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Amounts of money are int64 counts of the minor unit of their currency, such
+// as cents for USD. VND has no unit smaller than the dong.
+
+const DefaultCurrency = "VND"
+
+// BasisPoints is a rate in hundredths of a percent, 1000 being 10%.
+const BasisPoints = 10000
+
+var currencyExponents = map[string]int64{
+	"VND": 0,
+	"USD": 2,
+	"EUR": 2,
+	"JPY": 0,
+}
+
+func IsValidCurrency(currency string) bool {
+	_, ok := currencyExponents[currency]
+	return ok
+}
+
+// ToMinorUnits converts a decimal amount in the major unit of currency, such
+// as "1250.50", to minor units, rounding half away from zero.
+func ToMinorUnits(amount, currency string) (int64, error) {
+	exponent, ok := currencyExponents[currency]
+	if !ok {
+		return 0, fmt.Errorf("unknown currency %q", currency)
+	}
+
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(exponent), nil)
+	value.Mul(value, new(big.Rat).SetInt(scale))
+	return roundRat(value), nil
+}
+
+// mulDiv returns a*b/c rounded half away from zero, without overflowing on
+// the intermediate product.
+func mulDiv(a, b, c int64) int64 {
+	value := new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(a), big.NewInt(b)), big.NewInt(c))
+	return roundRat(value)
+}
+
+func roundRat(value *big.Rat) int64 {
+	num, denom := value.Num(), value.Denom()
+	quotient, remainder := new(big.Int).QuoRem(num, denom, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(denom) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(num.Sign())))
+	}
+	return quotient.Int64()
+}
+
+// FormatMinorUnits renders amount of currency in its major unit, such as
+// "1250.50" for 125050 USD.
+func FormatMinorUnits(amount int64, currency string) string {
+	exponent := currencyExponents[currency]
+	if exponent == 0 {
+		return strconv.FormatInt(amount, 10)
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	scale := int64(1)
+	for range exponent {
+		scale *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, int(exponent), amount%scale)
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification is an entry of a user's in-app inbox. It is created from the
+// same claim events as notification emails, whether or not the user gets
+// emails, and titled like the email would be.
+type Notification struct {
+	ID        uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID    uuid.UUID  `gorm:"not null;type:uuid" json:"user_id"`
+	EventID   uuid.UUID  `gorm:"not null;type:uuid" json:"event_id"`
+	Kind      string     `gorm:"not null" json:"kind"`
+	ClaimID   uuid.UUID  `gorm:"not null;type:uuid" json:"claim_id"`
+	Title     string     `gorm:"not null" json:"title"`
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func NewNotification(userID, eventID uuid.UUID, kind string, claimID uuid.UUID, title string) *Notification {
+	return &Notification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		EventID:   eventID,
+		Kind:      kind,
+		ClaimID:   claimID,
+		Title:     title,
+		CreatedAt: time.Now(),
+	}
+}
+
+// MarkRead records when the user read the notification, the first time only.
+func (n *Notification) MarkRead(now time.Time) {
+	if n.ReadAt == nil {
+		n.ReadAt = &now
+	}
+}
@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationEmailStatusPending = "PENDING"
+	NotificationEmailStatusSent    = "SENT"
+	NotificationEmailStatusDead    = "DEAD"
+)
+
+// NotificationEmail is the email notifying one user of one outbox event. It
+// is rendered when queued, so retries send the exact same message even if
+// the claim or the templates changed since.
+type NotificationEmail struct {
+	ID            uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	UserID        uuid.UUID  `gorm:"not null;type:uuid" json:"user_id"`
+	EventID       uuid.UUID  `gorm:"not null;type:uuid" json:"event_id"`
+	Kind          string     `gorm:"not null" json:"kind"`
+	Recipient     string     `gorm:"not null" json:"recipient"`
+	Subject       string     `gorm:"not null" json:"subject"`
+	TextBody      string     `gorm:"not null" json:"text_body"`
+	HTMLBody      string     `gorm:"column:html_body;not null" json:"html_body"`
+	Status        string     `gorm:"not null;default:PENDING" json:"status"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	LastError     *string    `json:"last_error"`
+	NextAttemptAt time.Time  `gorm:"not null" json:"next_attempt_at"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	SentAt        *time.Time `json:"sent_at"`
+}
+
+func NewNotificationEmail(userID, eventID uuid.UUID, kind, recipient, subject, textBody, htmlBody string,
+) *NotificationEmail {
+	return &NotificationEmail{
+		ID:            uuid.New(),
+		UserID:        userID,
+		EventID:       eventID,
+		Kind:          kind,
+		Recipient:     recipient,
+		Subject:       subject,
+		TextBody:      textBody,
+		HTMLBody:      htmlBody,
+		Status:        NotificationEmailStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+}
+
+// MarkSent records an email accepted by the mail server.
+func (e *NotificationEmail) MarkSent(now time.Time) {
+	e.Attempts++
+	e.Status = NotificationEmailStatusSent
+	e.SentAt = &now
+	e.LastError = nil
+}
+
+// ClaimDispatch hides a due email from other runs until until, while it is
+// sent.
+func (e *NotificationEmail) ClaimDispatch(until time.Time) {
+	e.NextAttemptAt = until
+}
+
+// MarkFailed records a failed attempt. The email is retried at nextAttemptAt
+// unless it reached maxAttempts, in which case it is dead.
+func (e *NotificationEmail) MarkFailed(cause error, nextAttemptAt time.Time, maxAttempts int) {
+	message := cause.Error()
+	e.Attempts++
+	e.LastError = &message
+	e.NextAttemptAt = nextAttemptAt
+	if e.Attempts >= maxAttempts {
+		e.Status = NotificationEmailStatusDead
+	}
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification kinds users are notified of and can mute.
+const (
+	NotificationClaimSubmitted = "CLAIM_SUBMITTED"
+	NotificationClaimAssigned  = "CLAIM_ASSIGNED"
+	NotificationClaimApproved  = "CLAIM_APPROVED"
+	NotificationClaimRejected  = "CLAIM_REJECTED"
+	NotificationClaimCompleted = "CLAIM_COMPLETED"
+)
+
+// NotificationPreference is how a user wants to be notified. Muted kinds are
+// neither emailed nor added to the inbox, EmailEnabled only turns emails off.
+// Users without one get every notification by email and in their inbox.
+type NotificationPreference struct {
+	UserID       uuid.UUID `gorm:"primaryKey;type:uuid" json:"user_id"`
+	EmailEnabled bool      `gorm:"not null;default:true" json:"email_enabled"`
+	MutedKinds   []string  `gorm:"type:jsonb;serializer:json;not null" json:"muted_kinds"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func NewNotificationPreference(userID uuid.UUID) *NotificationPreference {
+	return &NotificationPreference{
+		UserID:       userID,
+		EmailEnabled: true,
+		MutedKinds:   []string{},
+	}
+}
+
+// Wants reports whether the user wants to be notified of kind at all.
+func (p *NotificationPreference) Wants(kind string) bool {
+	return !slices.Contains(p.MutedKinds, kind)
+}
+
+// WantsEmail reports whether the user wants an email for kind.
+func (p *NotificationPreference) WantsEmail(kind string) bool {
+	return p.EmailEnabled && p.Wants(kind)
+}
+
+func IsValidNotificationKind(kind string) bool {
+	switch kind {
+	case NotificationClaimSubmitted, NotificationClaimAssigned, NotificationClaimApproved,
+		NotificationClaimRejected, NotificationClaimCompleted:
+		return true
+	default:
+		return false
+	}
+}
@@ -18,6 +18,7 @@ type Office struct {
 	OfficeType string          `gorm:"not null;" json:"office_type"`
 	Address    string          `gorm:"not null" json:"address"`
 	IsActive   bool            `gorm:"not null;" json:"is_active"`
+	LaborRate  int64           `gorm:"not null;default:0" json:"labor_rate"`
 	CreatedAt  time.Time       `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt  time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
 	DeletedAt  *gorm.DeletedAt `gorm:"index" json:"-"`
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	OutboxStatusPending   = "PENDING"
+	OutboxStatusDelivered = "DELIVERED"
+	OutboxStatusDead      = "DEAD"
+
+	AggregateTypeClaim = "CLAIM"
+)
+
+const (
+	EventClaimCreated           = "ClaimCreated"
+	EventClaimUpdated           = "ClaimUpdated"
+	EventClaimDeleted           = "ClaimDeleted"
+	EventClaimStatusChanged     = "ClaimStatusChanged"
+	EventClaimSubmitted         = "ClaimSubmitted"
+	EventClaimReviewStarted     = "ClaimReviewStarted"
+	EventClaimCancelled         = "ClaimCancelled"
+	EventClaimApproved          = "ClaimApproved"
+	EventClaimPartiallyApproved = "ClaimPartiallyApproved"
+	EventClaimRejected          = "ClaimRejected"
+	EventClaimCompleted         = "ClaimCompleted"
+
+	EventClaimItemAdded    = "ClaimItemAdded"
+	EventClaimItemUpdated  = "ClaimItemUpdated"
+	EventClaimItemRemoved  = "ClaimItemRemoved"
+	EventClaimItemApproved = "ClaimItemApproved"
+	EventClaimItemRejected = "ClaimItemRejected"
+
+	EventClaimAttachmentAdded   = "ClaimAttachmentAdded"
+	EventClaimAttachmentRemoved = "ClaimAttachmentRemoved"
+
+	EventClaimSettled    = "ClaimSettled"
+	EventClaimReimbursed = "ClaimReimbursed"
+
+	EventClaimSLABreached = "ClaimSLABreached"
+)
+
+// OutboxEvent is a domain event written in the same transaction as the change
+// it describes and delivered to the configured sinks afterwards. Events that
+// keep failing are retried until MaxAttempts and then parked as dead.
+type OutboxEvent struct {
+	ID            uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	AggregateType string          `gorm:"not null" json:"aggregate_type"`
+	AggregateID   uuid.UUID       `gorm:"not null;type:uuid" json:"aggregate_id"`
+	EventType     string          `gorm:"not null" json:"event_type"`
+	Payload       json.RawMessage `gorm:"not null;type:jsonb" json:"payload" swaggertype:"object"`
+	Status        string          `gorm:"not null;default:PENDING" json:"-"`
+	Attempts      int             `gorm:"not null;default:0" json:"-"`
+	LastError     *string         `json:"-"`
+	NextAttemptAt time.Time       `gorm:"not null" json:"-"`
+	OccurredAt    time.Time       `gorm:"autoCreateTime" json:"occurred_at"`
+	DeliveredAt   *time.Time      `json:"-"`
+}
+
+func NewOutboxEvent(aggregateType string, aggregateID uuid.UUID, eventType string, payload json.RawMessage,
+) *OutboxEvent {
+	return &OutboxEvent{
+		ID:            uuid.New(),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        OutboxStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+}
+
+// MarkDelivered records a successful delivery to every sink.
+func (e *OutboxEvent) MarkDelivered(now time.Time) {
+	e.Status = OutboxStatusDelivered
+	e.DeliveredAt = &now
+	e.LastError = nil
+}
+
+// ClaimDispatch hides a due event from other runs until until, while it is
+// published to the sinks.
+func (e *OutboxEvent) ClaimDispatch(until time.Time) {
+	e.NextAttemptAt = until
+}
+
+// MarkFailed records a failed delivery attempt. The event is retried at
+// nextAttemptAt unless it reached maxAttempts, in which case it is dead.
+func (e *OutboxEvent) MarkFailed(cause error, nextAttemptAt time.Time, maxAttempts int) {
+	message := cause.Error()
+	e.Attempts++
+	e.LastError = &message
+	e.NextAttemptAt = nextAttemptAt
+	if e.Attempts >= maxAttempts {
+		e.Status = OutboxStatusDead
+	}
+}
+
+func IsValidEventType(eventType string) bool {
+	switch eventType {
+	case EventClaimCreated, EventClaimUpdated, EventClaimDeleted, EventClaimStatusChanged,
+		EventClaimSubmitted, EventClaimReviewStarted, EventClaimCancelled, EventClaimApproved,
+		EventClaimPartiallyApproved, EventClaimRejected, EventClaimCompleted,
+		EventClaimItemAdded, EventClaimItemUpdated, EventClaimItemRemoved,
+		EventClaimItemApproved, EventClaimItemRejected,
+		EventClaimAttachmentAdded, EventClaimAttachmentRemoved,
+		EventClaimSettled, EventClaimReimbursed, EventClaimSLABreached:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// PartReservationStatusPending is a part reserved in the inventory for a
+	// claim item that has not been committed yet.
+	PartReservationStatusPending = "PENDING"
+	// PartReservationStatusActive is a part held by a committed claim item.
+	PartReservationStatusActive = "ACTIVE"
+	// PartReservationStatusReleasing is a part that must be unreserved in the
+	// inventory, retried until it succeeds or runs out of attempts.
+	PartReservationStatusReleasing = "RELEASING"
+	PartReservationStatusReleased  = "RELEASED"
+	PartReservationStatusFailed    = "FAILED"
+)
+
+const (
+	ReleaseReasonRollback     = "ROLLBACK"
+	ReleaseReasonItemRemoved  = "ITEM_REMOVED"
+	ReleaseReasonItemRejected = "ITEM_REJECTED"
+	ReleaseReasonItemRepair   = "ITEM_REPAIR"
+	ReleaseReasonOrphaned     = "ORPHANED"
+)
+
+// PartReservation tracks a part reserved in the .NET inventory on behalf of
+// a claim item, so a reservation is never left behind when the claim change
+// that needed it is rolled back or undone. Orphaned reservations found by the
+// reconciliation have no claim item.
+type PartReservation struct {
+	ID            uuid.UUID  `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	PartID        uuid.UUID  `gorm:"not null;type:uuid" json:"part_id"`
+	ClaimID       *uuid.UUID `gorm:"type:uuid" json:"claim_id"`
+	ClaimItemID   *uuid.UUID `gorm:"type:uuid" json:"claim_item_id"`
+	Status        string     `gorm:"not null" json:"status"`
+	ReleaseReason *string    `json:"release_reason"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	LastError     *string    `json:"last_error"`
+	NextAttemptAt time.Time  `gorm:"not null" json:"next_attempt_at"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+	ReleasedAt    *time.Time `json:"released_at"`
+}
+
+func NewPartReservation(partID uuid.UUID, claimID, claimItemID *uuid.UUID) *PartReservation {
+	return &PartReservation{
+		ID:            uuid.New(),
+		PartID:        partID,
+		ClaimID:       claimID,
+		ClaimItemID:   claimItemID,
+		Status:        PartReservationStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+}
+
+func (r *PartReservation) Activate() {
+	r.Status = PartReservationStatusActive
+}
+
+// ScheduleRelease marks the part to be unreserved, not before notBefore.
+func (r *PartReservation) ScheduleRelease(reason string, notBefore time.Time) {
+	r.Status = PartReservationStatusReleasing
+	r.ReleaseReason = &reason
+	r.NextAttemptAt = notBefore
+}
+
+// ClaimRelease hides a due release from other runs until until, while its
+// unreserve call is made.
+func (r *PartReservation) ClaimRelease(until time.Time) {
+	r.NextAttemptAt = until
+}
+
+func (r *PartReservation) MarkReleased(now time.Time) {
+	r.Status = PartReservationStatusReleased
+	r.ReleasedAt = &now
+	r.LastError = nil
+}
+
+// MarkReleaseFailed records a failed unreserve call. The release is retried
+// at nextAttemptAt unless it reached maxAttempts, in which case it failed for
+// good and needs a person to look at it.
+func (r *PartReservation) MarkReleaseFailed(cause error, nextAttemptAt time.Time, maxAttempts int) {
+	message := cause.Error()
+	r.Attempts++
+	r.LastError = &message
+	r.NextAttemptAt = nextAttemptAt
+	if r.Attempts >= maxAttempts {
+		r.Status = PartReservationStatusFailed
+	}
+}
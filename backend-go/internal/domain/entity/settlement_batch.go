@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	SettlementStatusDraft    = "DRAFT"
+	SettlementStatusIssued   = "ISSUED"
+	SettlementStatusPaid     = "PAID"
+	SettlementStatusDisputed = "DISPUTED"
+)
+
+// settlementTransitions lists the statuses a batch can move to from each
+// status. A disputed batch is issued again once corrected, or paid as is.
+var settlementTransitions = map[string][]string{
+	SettlementStatusDraft:    {SettlementStatusIssued},
+	SettlementStatusIssued:   {SettlementStatusPaid, SettlementStatusDisputed},
+	SettlementStatusDisputed: {SettlementStatusIssued, SettlementStatusPaid},
+}
+
+// SettlementBatch is a reimbursement statement the manufacturer owes a
+// service center for the claims it completed in a period. Amounts are in
+// minor units of Currency. The period is the half-open [PeriodStart,
+// PeriodEnd) range in which the claims were completed.
+type SettlementBatch struct {
+	ID            uuid.UUID         `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	OfficeID      uuid.UUID         `gorm:"not null;type:uuid" json:"office_id"`
+	PeriodStart   time.Time         `gorm:"not null" json:"period_start"`
+	PeriodEnd     time.Time         `gorm:"not null" json:"period_end"`
+	Status        string            `gorm:"not null;default:DRAFT" json:"status"`
+	Currency      string            `gorm:"not null" json:"currency"`
+	TotalAmount   int64             `gorm:"not null;default:0" json:"total_amount"`
+	DisputeReason *string           `json:"dispute_reason,omitempty"`
+	CreatedBy     uuid.UUID         `gorm:"not null;type:uuid" json:"created_by"`
+	IssuedAt      *time.Time        `json:"issued_at,omitempty"`
+	PaidAt        *time.Time        `json:"paid_at,omitempty"`
+	Lines         []*SettlementLine `gorm:"foreignKey:BatchID" json:"lines,omitempty"`
+	CreatedAt     time.Time         `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time         `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt     *gorm.DeletedAt   `gorm:"index" json:"-"`
+}
+
+// SettlementLine is the approved amount of one claim item in a batch.
+type SettlementLine struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	BatchID     uuid.UUID `gorm:"not null;type:uuid" json:"batch_id"`
+	ClaimID     uuid.UUID `gorm:"not null;type:uuid" json:"claim_id"`
+	ClaimItemID uuid.UUID `gorm:"not null;type:uuid" json:"claim_item_id"`
+	Description string    `gorm:"not null;type:text" json:"description"`
+	Amount      int64     `gorm:"not null" json:"amount"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func NewSettlementBatch(officeID uuid.UUID, periodStart, periodEnd time.Time, currency string,
+	createdBy uuid.UUID,
+) *SettlementBatch {
+	return &SettlementBatch{
+		ID:          uuid.New(),
+		OfficeID:    officeID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Status:      SettlementStatusDraft,
+		Currency:    currency,
+		CreatedBy:   createdBy,
+	}
+}
+
+// AddItem adds a line for the approved cost of item and keeps TotalAmount in
+// step.
+func (b *SettlementBatch) AddItem(item *ClaimItem) {
+	b.Lines = append(b.Lines, &SettlementLine{
+		ID:          uuid.New(),
+		BatchID:     b.ID,
+		ClaimID:     item.ClaimID,
+		ClaimItemID: item.ID,
+		Description: item.IssueDescription,
+		Amount:      item.TotalCost,
+	})
+	b.TotalAmount += item.TotalCost
+}
+
+// ClaimIDs returns the distinct claims of the batch lines, in line order.
+func (b *SettlementBatch) ClaimIDs() []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, line := range b.Lines {
+		if !seen[line.ClaimID] {
+			seen[line.ClaimID] = true
+			ids = append(ids, line.ClaimID)
+		}
+	}
+	return ids
+}
+
+// ClaimAmount is the sum of the batch lines of a claim.
+func (b *SettlementBatch) ClaimAmount(claimID uuid.UUID) int64 {
+	var amount int64
+	for _, line := range b.Lines {
+		if line.ClaimID == claimID {
+			amount += line.Amount
+		}
+	}
+	return amount
+}
+
+func (b *SettlementBatch) CanTransitionTo(status string) bool {
+	return slices.Contains(settlementTransitions[b.Status], status)
+}
+
+func IsValidSettlementStatus(status string) bool {
+	switch status {
+	case SettlementStatusDraft, SettlementStatusIssued, SettlementStatusPaid, SettlementStatusDisputed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	IneligibleNoPolicy          = "NO_POLICY"
+	IneligiblePolicyNotInEffect = "POLICY_NOT_IN_EFFECT"
+	IneligibleNoPurchaseDate    = "NO_PURCHASE_DATE"
+	IneligibleWarrantyExpired   = "WARRANTY_EXPIRED"
+	IneligibleKilometersExceed  = "KILOMETER_LIMIT_EXCEEDED"
+)
+
+// WarrantySnapshot records the vehicle, model and policy a claim's warranty
+// eligibility was decided on. It is stored with the claim when it is created
+// so later edits to the policy in the .NET backend do not change the decision.
+type WarrantySnapshot struct {
+	Eligible               bool       `json:"eligible"`
+	Reasons                []string   `json:"reasons"`
+	CheckedAt              time.Time  `json:"checked_at"`
+	VehicleID              uuid.UUID  `json:"vehicle_id"`
+	VIN                    string     `json:"vin"`
+	CustomerID             uuid.UUID  `json:"customer_id"`
+	PurchaseDate           *time.Time `json:"purchase_date,omitempty"`
+	Kilometers             int        `json:"kilometers"`
+	ModelID                uuid.UUID  `json:"model_id"`
+	ModelName              string     `json:"model_name"`
+	PolicyID               *uuid.UUID `json:"policy_id,omitempty"`
+	PolicyName             string     `json:"policy_name,omitempty"`
+	PolicyStatus           string     `json:"policy_status,omitempty"`
+	WarrantyDurationMonths int        `json:"warranty_duration_months,omitempty"`
+	KilometerLimit         *int       `json:"kilometer_limit,omitempty"`
+	WarrantyExpiresAt      *time.Time `json:"warranty_expires_at,omitempty"`
+}
+
+// Ineligible records why the vehicle is not covered.
+func (s *WarrantySnapshot) Ineligible(reason string) {
+	s.Eligible = false
+	s.Reasons = append(s.Reasons, reason)
+}
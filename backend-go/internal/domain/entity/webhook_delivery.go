@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	WebhookDeliveryStatusPending   = "PENDING"
+	WebhookDeliveryStatusDelivered = "DELIVERED"
+	WebhookDeliveryStatusDead      = "DEAD"
+)
+
+// WebhookDelivery is one outbox event addressed to one subscription. The
+// payload is frozen when the delivery is created so every attempt, including
+// a manual redelivery, sends and signs the exact same body.
+type WebhookDelivery struct {
+	ID             uuid.UUID            `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	SubscriptionID uuid.UUID            `gorm:"not null;type:uuid" json:"subscription_id"`
+	Subscription   *WebhookSubscription `gorm:"foreignKey:SubscriptionID" json:"-"`
+	EventID        uuid.UUID            `gorm:"not null;type:uuid" json:"event_id"`
+	EventType      string               `gorm:"not null" json:"event_type"`
+	Payload        json.RawMessage      `gorm:"not null;type:jsonb" json:"payload" swaggertype:"object"`
+	Status         string               `gorm:"not null;default:PENDING" json:"status"`
+	Attempts       int                  `gorm:"not null;default:0" json:"attempts"`
+	LastError      *string              `json:"last_error"`
+	ResponseStatus *int                 `json:"response_status"`
+	NextAttemptAt  time.Time            `gorm:"not null" json:"next_attempt_at"`
+	CreatedAt      time.Time            `gorm:"autoCreateTime" json:"created_at"`
+	DeliveredAt    *time.Time           `json:"delivered_at"`
+}
+
+func NewWebhookDelivery(subscriptionID, eventID uuid.UUID, eventType string, payload json.RawMessage,
+) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         WebhookDeliveryStatusPending,
+		NextAttemptAt:  time.Now(),
+	}
+}
+
+// MarkDelivered records a delivery acknowledged by the subscriber.
+func (d *WebhookDelivery) MarkDelivered(now time.Time, responseStatus int) {
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusDelivered
+	d.DeliveredAt = &now
+	d.LastError = nil
+	d.setResponseStatus(responseStatus)
+}
+
+// ClaimDispatch hides a due delivery from other runs until until, while it is
+// sent.
+func (d *WebhookDelivery) ClaimDispatch(until time.Time) {
+	d.NextAttemptAt = until
+}
+
+// MarkFailed records a failed attempt. responseStatus is zero when the
+// subscriber could not be reached at all. The delivery is retried at
+// nextAttemptAt unless it reached maxAttempts, in which case it is dead.
+func (d *WebhookDelivery) MarkFailed(cause error, responseStatus int, nextAttemptAt time.Time, maxAttempts int) {
+	message := cause.Error()
+	d.Attempts++
+	d.LastError = &message
+	d.NextAttemptAt = nextAttemptAt
+	d.setResponseStatus(responseStatus)
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryStatusDead
+	}
+}
+
+// Redeliver queues the delivery again with a fresh attempt budget, whatever
+// its current status.
+func (d *WebhookDelivery) Redeliver(now time.Time) {
+	d.Status = WebhookDeliveryStatusPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+	d.DeliveredAt = nil
+}
+
+func (d *WebhookDelivery) setResponseStatus(responseStatus int) {
+	if responseStatus == 0 {
+		d.ResponseStatus = nil
+		return
+	}
+	d.ResponseStatus = &responseStatus
+}
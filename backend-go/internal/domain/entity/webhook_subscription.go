@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// WebhookSubscription registers an endpoint of a dealer or partner system
+// that is notified of the claim events of one office. An empty EventTypes
+// list subscribes to every event. Secret signs every payload and is only
+// revealed when the subscription is created.
+type WebhookSubscription struct {
+	ID         uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()" json:"id"`
+	OfficeID   uuid.UUID       `gorm:"not null;type:uuid" json:"office_id"`
+	URL        string          `gorm:"not null" json:"url"`
+	EventTypes pq.StringArray  `gorm:"not null;type:text[]" json:"event_types" swaggertype:"array,string"`
+	Secret     string          `gorm:"not null" json:"-"`
+	IsActive   bool            `gorm:"not null" json:"is_active"`
+	CreatedAt  time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt  *gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+func NewWebhookSubscription(officeID uuid.UUID, url string, eventTypes []string, secret string, isActive bool,
+) *WebhookSubscription {
+	return &WebhookSubscription{
+		ID:         uuid.New(),
+		OfficeID:   officeID,
+		URL:        url,
+		EventTypes: normalizeEventTypes(eventTypes),
+		Secret:     secret,
+		IsActive:   isActive,
+	}
+}
+
+func (s *WebhookSubscription) SetEventTypes(eventTypes []string) {
+	s.EventTypes = normalizeEventTypes(eventTypes)
+}
+
+func normalizeEventTypes(eventTypes []string) pq.StringArray {
+	if eventTypes == nil {
+		return pq.StringArray{}
+	}
+	return eventTypes
+}
+
+// IsValidWebhookURL reports whether rawURL is an https endpoint that is not
+// addressed to the server's own network. Host names are only checked against
+// localhost here, the addresses they resolve to are checked when connecting.
+func IsValidWebhookURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return false
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return IsPublicWebhookAddr(addr)
+	}
+	return true
+}
+
+// IsPublicWebhookAddr reports whether webhooks may be delivered to addr:
+// loopback, private, link-local, such as the cloud metadata endpoint,
+// unspecified and multicast addresses are refused.
+func IsPublicWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() && !addr.IsLoopback() && !addr.IsPrivate() && !addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() && !addr.IsInterfaceLocalMulticast() && !addr.IsMulticast() &&
+		!addr.IsUnspecified() && !sharedAddressSpace.Contains(addr)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, not public
+// either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
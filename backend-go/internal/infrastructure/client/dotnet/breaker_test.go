@@ -0,0 +1,97 @@
+package dotnet
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("breaker", func() {
+	var b *breaker
+
+	// expire ends the cooldown of an open circuit.
+	expire := func() {
+		b.openedAt = time.Now().Add(-2 * b.cooldown)
+	}
+
+	BeforeEach(func() {
+		b = newBreaker(3, time.Minute)
+	})
+
+	Context("when disabled", func() {
+		It("should allow every call", func() {
+			b = newBreaker(0, time.Minute)
+			for range 5 {
+				Expect(b.allow()).To(BeTrue())
+				Expect(b.record(true)).To(BeFalse())
+			}
+		})
+	})
+
+	Context("when calls fail in a row", func() {
+		It("should open after threshold failures and reject calls", func() {
+			Expect(b.record(true)).To(BeFalse())
+			Expect(b.record(true)).To(BeFalse())
+			Expect(b.record(true)).To(BeTrue())
+
+			Expect(b.state).To(Equal(breakerOpen))
+			Expect(b.allow()).To(BeFalse())
+		})
+
+		It("should start counting again after a success", func() {
+			b.record(true)
+			b.record(true)
+			b.record(false)
+			b.record(true)
+
+			Expect(b.state).To(Equal(breakerClosed))
+			Expect(b.allow()).To(BeTrue())
+		})
+	})
+
+	Context("when the cooldown is over", func() {
+		BeforeEach(func() {
+			for range 3 {
+				b.record(true)
+			}
+			expire()
+		})
+
+		It("should let a single probe through", func() {
+			Expect(b.allow()).To(BeTrue())
+			Expect(b.state).To(Equal(breakerHalfOpen))
+			Expect(b.allow()).To(BeFalse())
+		})
+
+		It("should close when the probe succeeds", func() {
+			Expect(b.allow()).To(BeTrue())
+
+			Expect(b.record(false)).To(BeFalse())
+
+			Expect(b.state).To(Equal(breakerClosed))
+			Expect(b.failures).To(BeZero())
+			Expect(b.allow()).To(BeTrue())
+			Expect(b.allow()).To(BeTrue())
+		})
+
+		It("should open again when the probe fails", func() {
+			Expect(b.allow()).To(BeTrue())
+
+			Expect(b.record(true)).To(BeTrue())
+
+			Expect(b.state).To(Equal(breakerOpen))
+			Expect(b.allow()).To(BeFalse())
+		})
+
+		It("should let another probe through when the probe is released", func() {
+			Expect(b.allow()).To(BeTrue())
+			Expect(b.allow()).To(BeFalse())
+
+			b.release()
+
+			Expect(b.state).To(Equal(breakerHalfOpen))
+			Expect(b.allow()).To(BeTrue())
+		})
+	})
+})
@@ -4,105 +4,304 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"ev-warranty-go/pkg/apperror"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-const (
-	defaultTimeout = 30 * time.Second
-)
-
 type Client interface {
 	ReservePart(ctx context.Context, officeLocationID, categoryID uuid.UUID, authToken string) (*PartResponse, error)
 	UnreservePart(ctx context.Context, partID uuid.UUID, authToken string) error
+	ListReservedParts(ctx context.Context, authToken string) ([]PartResponse, error)
+
+	GetVehicle(ctx context.Context, vehicleID uuid.UUID, authToken string) (*VehicleResponse, error)
+	GetVehicleModel(ctx context.Context, modelID uuid.UUID, authToken string) (*VehicleModelResponse, error)
+	GetWarrantyPolicy(ctx context.Context, policyID uuid.UUID, authToken string) (*WarrantyPolicyResponse, error)
+	// GetCoverage returns the coverage the policy defines for the category or
+	// its nearest parent, or nil when the category is not covered.
+	GetCoverage(ctx context.Context, policyID, categoryID uuid.UUID, authToken string) (*CoverageResponse, error)
+}
+
+// Config tunes how the client copes with a slow or failing .NET backend.
+type Config struct {
+	Timeout time.Duration
+	// MaxRetries is how many times a call is retried after a temporary
+	// failure. Calls that are not idempotent are only retried when the
+	// request never reached the backend.
+	MaxRetries  int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// BreakerThreshold consecutive temporary failures open the circuit for
+	// BreakerCooldown, during which calls fail fast. Zero disables it.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
 }
 
 type client struct {
 	baseURL    string
 	httpClient *http.Client
+	cfg        Config
+	breaker    *breaker
 }
 
-func NewClient(baseURL string) Client {
+func NewClient(baseURL string, cfg Config) Client {
 	return &client{
 		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout: defaultTimeout,
+			Timeout: cfg.Timeout,
 		},
+		cfg:     cfg,
+		breaker: newBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
 	}
 }
 
+// request is one call to the .NET backend. codes maps the backend's error
+// codes for this call to the apperror returned for them.
+type request struct {
+	op         string
+	action     string
+	method     string
+	url        string
+	body       any
+	authToken  string
+	idempotent bool
+	codes      map[string]*apperror.AppError
+}
+
 func (c *client) ReservePart(ctx context.Context, officeLocationID, categoryID uuid.UUID, authToken string) (*PartResponse,
 	error) {
-	url := fmt.Sprintf("%s/parts/reserve", c.baseURL)
+	var part *PartResponse
+	err := c.send(ctx, request{
+		op:     "reserve_part",
+		action: "reserve part",
+		method: http.MethodPost,
+		url:    fmt.Sprintf("%s/parts/reserve", c.baseURL),
+		body: ReservePartRequest{
+			OfficeLocationID: officeLocationID,
+			CategoryID:       categoryID,
+		},
+		authToken: authToken,
+		// The backend answers NOT_FOUND when no part of the category is left
+		// at the office.
+		codes: map[string]*apperror.AppError{errorCodeNotFound: apperror.ErrPartOutOfStock},
+	}, &part)
+	if err != nil {
+		return nil, err
+	}
 
-	reqBody := ReservePartRequest{
-		OfficeLocationID: officeLocationID,
-		CategoryID:       categoryID,
+	if part == nil {
+		return nil, apperror.ErrExternalServiceError.WithMessage("Failed to reserve part: no part data in response")
 	}
 
-	jsonData, err := json.Marshal(reqBody)
+	return part, nil
+}
+
+func (c *client) UnreservePart(ctx context.Context, partID uuid.UUID, authToken string) error {
+	return c.send(ctx, request{
+		op:         "unreserve_part",
+		action:     "unreserve part",
+		method:     http.MethodPost,
+		url:        fmt.Sprintf("%s/api/v1/parts/%s/unreserve", c.baseURL, partID.String()),
+		authToken:  authToken,
+		idempotent: true,
+		codes:      map[string]*apperror.AppError{errorCodeNotFound: apperror.ErrPartNotFound},
+	}, nil)
+}
+
+func (c *client) ListReservedParts(ctx context.Context, authToken string) ([]PartResponse, error) {
+	var parts []PartResponse
+	err := c.send(ctx, request{
+		op:         "list_reserved_parts",
+		action:     "list reserved parts",
+		method:     http.MethodGet,
+		url:        fmt.Sprintf("%s/parts?status=%s", c.baseURL, PartStatusReserved),
+		authToken:  authToken,
+		idempotent: true,
+	}, &parts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+		return nil, err
+	}
+
+	if parts == nil {
+		return []PartResponse{}, nil
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	return parts, nil
+}
+
+func (c *client) GetVehicle(ctx context.Context, vehicleID uuid.UUID, authToken string) (*VehicleResponse, error) {
+	var vehicle *VehicleResponse
+	err := c.send(ctx, request{
+		op:         "get_vehicle",
+		action:     "get vehicle",
+		method:     http.MethodGet,
+		url:        fmt.Sprintf("%s/vehicles/%s", c.baseURL, vehicleID.String()),
+		authToken:  authToken,
+		idempotent: true,
+		codes:      map[string]*apperror.AppError{errorCodeNotFound: apperror.ErrVehicleNotFound},
+	}, &vehicle)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
+	}
+
+	if vehicle == nil {
+		return nil, apperror.ErrExternalServiceError.WithMessage("Failed to get vehicle: no vehicle data in response")
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", authToken)
+	return vehicle, nil
+}
 
-	resp, err := c.httpClient.Do(req)
+func (c *client) GetVehicleModel(ctx context.Context, modelID uuid.UUID, authToken string,
+) (*VehicleModelResponse, error) {
+	var model *VehicleModelResponse
+	err := c.send(ctx, request{
+		op:         "get_vehicle_model",
+		action:     "get vehicle model",
+		method:     http.MethodGet,
+		url:        fmt.Sprintf("%s/vehicle-models/%s", c.baseURL, modelID.String()),
+		authToken:  authToken,
+		idempotent: true,
+	}, &model)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, err
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
 
-	body, err := io.ReadAll(resp.Body)
+	if model == nil {
+		return nil, apperror.ErrExternalServiceError.
+			WithMessage("Failed to get vehicle model: no vehicle model data in response")
+	}
+
+	return model, nil
+}
+
+func (c *client) GetWarrantyPolicy(ctx context.Context, policyID uuid.UUID, authToken string,
+) (*WarrantyPolicyResponse, error) {
+	var policy *WarrantyPolicyResponse
+	err := c.send(ctx, request{
+		op:         "get_warranty_policy",
+		action:     "get warranty policy",
+		method:     http.MethodGet,
+		url:        fmt.Sprintf("%s/warranty-policies/%s", c.baseURL, policyID.String()),
+		authToken:  authToken,
+		idempotent: true,
+	}, &policy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, err
 	}
 
-	var response BaseDataResponse[PartResponse]
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	if policy == nil {
+		return nil, apperror.ErrExternalServiceError.
+			WithMessage("Failed to get warranty policy: no warranty policy data in response")
 	}
 
-	if !response.IsSuccess || resp.StatusCode != http.StatusOK {
-		if response.Message != "" {
-			return nil, fmt.Errorf("failed to reserve part: %s (code: %s)", response.Message, response.ErrorCode)
+	return policy, nil
+}
+
+func (c *client) GetCoverage(ctx context.Context, policyID, categoryID uuid.UUID, authToken string,
+) (*CoverageResponse, error) {
+	var coverage *CoverageResponse
+	err := c.send(ctx, request{
+		op:     "get_coverage",
+		action: "get coverage",
+		method: http.MethodGet,
+		url: fmt.Sprintf("%s/policy-coverage-parts/coverage-details?policyId=%s&partCategoryId=%s", c.baseURL,
+			policyID.String(), categoryID.String()),
+		authToken:  authToken,
+		idempotent: true,
+		codes: map[string]*apperror.AppError{
+			errorCodeCategoryNotFound: apperror.ErrNotFoundError.WithMessage("Part category not found"),
+			errorCodeCoverageNotFound: apperror.ErrClaimItemNotCovered,
+		},
+	}, &coverage)
+	var appErr *apperror.AppError
+	if errors.As(err, &appErr) && appErr.ErrorCode == apperror.ErrClaimItemNotCovered.ErrorCode {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if coverage == nil {
+		return nil, apperror.ErrExternalServiceError.WithMessage("Failed to get coverage: no coverage data in response")
+	}
+
+	return coverage, nil
+}
+
+// send makes req through the circuit breaker, retrying temporary failures
+// with jittered exponential backoff, and decodes the response data into out
+// when it is not nil.
+func (c *client) send(ctx context.Context, req request, out any) error {
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return apperror.ErrInternalServerError.WithError(fmt.Errorf("failed to marshal request: %w", err))
+		}
+	}
+
+	if !c.breaker.allow() {
+		metrics.Add(req.op+metricRejected, 1)
+		return apperror.ErrExternalServiceUnavailable.
+			WithMessage("Failed to " + req.action + ": inventory is unavailable, try again later")
+	}
+
+	start := time.Now()
+	var callErr *callError
+	for attempt := 0; ; attempt++ {
+		callErr = c.attempt(ctx, req, body, out)
+		if !c.shouldRetry(req, callErr, attempt) {
+			break
 		}
-		return nil, fmt.Errorf("failed to reserve part: unexpected status code %d", resp.StatusCode)
+
+		metrics.Add(req.op+metricRetries, 1)
+		if !sleep(ctx, c.backoff(attempt+1)) {
+			break
+		}
+	}
+
+	switch {
+	case callErr != nil && ctx.Err() != nil:
+		c.breaker.release()
+	case c.breaker.record(callErr != nil && callErr.temporary()):
+		metrics.Add(metricBreakerOpened, 1)
 	}
 
-	if response.Data == nil {
-		return nil, fmt.Errorf("no part data in response")
+	if callErr != nil {
+		err := callErr.toAppError(req.action, req.codes)
+		recordCall(req.op, time.Since(start), err)
+		return err
 	}
 
-	return response.Data, nil
+	recordCall(req.op, time.Since(start), nil)
+	return nil
 }
 
-func (c *client) UnreservePart(ctx context.Context, partID uuid.UUID, authToken string) error {
-	url := fmt.Sprintf("%s/api/v1/parts/%s/unreserve", c.baseURL, partID.String())
+func (c *client) shouldRetry(req request, callErr *callError, attempt int) bool {
+	return callErr != nil && callErr.temporary() && attempt < c.cfg.MaxRetries &&
+		(req.idempotent || callErr.notSent())
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+func (c *client) attempt(ctx context.Context, req request, body []byte, out any) *callError {
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, req.url, bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return &callError{statusCode: http.StatusBadRequest, err: fmt.Errorf("failed to create request: %w", err)}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", authToken)
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	httpReq.Header.Set("Authorization", req.authToken)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
+		return &callError{err: fmt.Errorf("failed to make request: %w", err)}
 	}
 	defer func() {
 		_ = resp.Body.Close()
@@ -112,22 +311,59 @@ func (c *client) UnreservePart(ctx context.Context, partID uuid.UUID, authToken
 		return nil
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return &callError{err: fmt.Errorf("failed to read response body: %w", err)}
 	}
 
-	var response BaseResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return fmt.Errorf("failed to unmarshal response: %w", err)
+	// Authorization failures and proxy errors come back without a JSON body.
+	var response BaseDataResponse[json.RawMessage]
+	if len(respBody) > 0 {
+		if err = json.Unmarshal(respBody, &response); err != nil && resp.StatusCode < 300 {
+			return &callError{statusCode: resp.StatusCode, err: fmt.Errorf("failed to unmarshal response: %w", err)}
+		}
+	}
+
+	if resp.StatusCode >= 300 || !response.IsSuccess {
+		return &callError{
+			statusCode: resp.StatusCode,
+			code:       response.ErrorCode,
+			message:    response.Message,
+		}
 	}
 
-	if !response.IsSuccess {
-		if response.Message != "" {
-			return fmt.Errorf("failed to unreserve part: %s (code: %s)", response.Message, response.ErrorCode)
+	if out != nil && response.Data != nil {
+		if err = json.Unmarshal(*response.Data, out); err != nil {
+			return &callError{statusCode: resp.StatusCode, err: fmt.Errorf("failed to unmarshal response: %w", err)}
 		}
-		return fmt.Errorf("failed to unreserve part: unexpected status code %d", resp.StatusCode)
 	}
 
 	return nil
 }
+
+// backoff returns a random delay up to the exponential backoff of the given
+// retry, so clients retrying together spread out.
+func (c *client) backoff(retry int) time.Duration {
+	delay := c.cfg.BaseBackoff
+	for i := 1; i < retry && delay < c.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, c.cfg.MaxBackoff)
+	if delay <= 0 {
+		return 0
+	}
+	return rand.N(delay)
+}
+
+// sleep waits for delay and reports false when ctx was done first.
+func sleep(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,253 @@
+package dotnet
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("client", func() {
+	var (
+		calls   atomic.Int32
+		respond func(w http.ResponseWriter, r *http.Request, call int32)
+		server  *httptest.Server
+		c       *client
+	)
+
+	BeforeEach(func() {
+		calls.Store(0)
+		respond = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			respond(w, r, calls.Add(1))
+		}))
+		DeferCleanup(server.Close)
+
+		c = NewClient(server.URL, Config{
+			Timeout:          time.Second,
+			MaxRetries:       2,
+			BaseBackoff:      time.Millisecond,
+			MaxBackoff:       time.Millisecond,
+			BreakerThreshold: 5,
+			BreakerCooldown:  time.Minute,
+		}).(*client)
+	})
+
+	writeStatus := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+
+	Describe("retries", func() {
+		Context("when an idempotent call fails temporarily", func() {
+			It("should retry it until it succeeds", func() {
+				respond = func(w http.ResponseWriter, _ *http.Request, call int32) {
+					if call < 3 {
+						writeStatus(w, http.StatusServiceUnavailable, "")
+						return
+					}
+					writeStatus(w, http.StatusOK, `{"is_success":true,"data":{"id":"`+uuid.NewString()+`"}}`)
+				}
+
+				vehicle, err := c.GetVehicle(context.Background(), uuid.New(), "token")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vehicle).NotTo(BeNil())
+				Expect(calls.Load()).To(BeEquivalentTo(3))
+			})
+
+			It("should give up after MaxRetries", func() {
+				respond = func(w http.ResponseWriter, _ *http.Request, _ int32) {
+					writeStatus(w, http.StatusBadGateway, "")
+				}
+
+				_, err := c.GetVehicle(context.Background(), uuid.New(), "token")
+
+				ExpectAppError(err, apperror.ErrExternalServiceUnavailable.ErrorCode)
+				Expect(calls.Load()).To(BeEquivalentTo(3))
+			})
+		})
+
+		Context("when a non idempotent call reached the backend", func() {
+			It("should not retry it", func() {
+				respond = func(w http.ResponseWriter, _ *http.Request, _ int32) {
+					writeStatus(w, http.StatusServiceUnavailable, "")
+				}
+
+				_, err := c.ReservePart(context.Background(), uuid.New(), uuid.New(), "token")
+
+				ExpectAppError(err, apperror.ErrExternalServiceUnavailable.ErrorCode)
+				Expect(calls.Load()).To(BeEquivalentTo(1))
+			})
+		})
+
+		Context("when the backend rejects the call", func() {
+			It("should not retry it", func() {
+				respond = func(w http.ResponseWriter, _ *http.Request, _ int32) {
+					writeStatus(w, http.StatusInternalServerError, `{"is_success":false,"message":"boom"}`)
+				}
+
+				_, err := c.GetVehicle(context.Background(), uuid.New(), "token")
+
+				ExpectAppError(err, apperror.ErrExternalServiceError.ErrorCode)
+				Expect(calls.Load()).To(BeEquivalentTo(1))
+			})
+		})
+	})
+
+	DescribeTable("shouldRetry",
+		func(idempotent bool, callErr *callError, attempt int, expected bool) {
+			Expect(c.shouldRetry(request{idempotent: idempotent}, callErr, attempt)).To(Equal(expected))
+		},
+		Entry("success", true, nil, 0, false),
+		Entry("idempotent temporary failure", true, &callError{statusCode: http.StatusServiceUnavailable}, 0, true),
+		Entry("idempotent failure out of retries", true, &callError{statusCode: http.StatusServiceUnavailable}, 2,
+			false),
+		Entry("idempotent rejection", true, &callError{statusCode: http.StatusBadRequest}, 0, false),
+		Entry("sent non idempotent failure", false, &callError{statusCode: http.StatusGatewayTimeout}, 0, false),
+		Entry("sent non idempotent call without response", false,
+			&callError{err: errors.New("connection reset by peer")}, 0, false),
+		Entry("non idempotent call never sent", false,
+			&callError{err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, 0, true),
+	)
+
+	DescribeTable("toAppError",
+		func(callErr *callError, codes map[string]*apperror.AppError, expectedCode string) {
+			ExpectAppError(callErr.toAppError("reserve part", codes), expectedCode)
+		},
+		Entry("NOT_FOUND mapped by the call", &callError{statusCode: http.StatusNotFound, code: errorCodeNotFound},
+			map[string]*apperror.AppError{errorCodeNotFound: apperror.ErrPartOutOfStock},
+			apperror.ErrPartOutOfStock.ErrorCode),
+		Entry("NOT_FOUND not mapped by the call", &callError{statusCode: http.StatusNotFound, code: errorCodeNotFound},
+			nil, apperror.ErrExternalServiceError.ErrorCode),
+		Entry("401", &callError{statusCode: http.StatusUnauthorized}, nil,
+			apperror.ErrExternalServiceUnauthorized.ErrorCode),
+		Entry("403", &callError{statusCode: http.StatusForbidden}, nil,
+			apperror.ErrExternalServiceUnauthorized.ErrorCode),
+		Entry("500", &callError{statusCode: http.StatusInternalServerError}, nil,
+			apperror.ErrExternalServiceError.ErrorCode),
+		Entry("502", &callError{statusCode: http.StatusBadGateway}, nil,
+			apperror.ErrExternalServiceUnavailable.ErrorCode),
+		Entry("503", &callError{statusCode: http.StatusServiceUnavailable}, nil,
+			apperror.ErrExternalServiceUnavailable.ErrorCode),
+		Entry("504", &callError{statusCode: http.StatusGatewayTimeout}, nil,
+			apperror.ErrExternalServiceUnavailable.ErrorCode),
+		Entry("429", &callError{statusCode: http.StatusTooManyRequests}, nil,
+			apperror.ErrExternalServiceUnavailable.ErrorCode),
+		Entry("no response", &callError{err: errors.New("timeout")}, nil,
+			apperror.ErrExternalServiceUnavailable.ErrorCode),
+	)
+
+	Describe("error codes", func() {
+		Context("when the backend answers NOT_FOUND for a vehicle", func() {
+			It("should return VehicleNotFound error", func() {
+				respond = func(w http.ResponseWriter, _ *http.Request, _ int32) {
+					writeStatus(w, http.StatusNotFound, `{"is_success":false,"error":"NOT_FOUND","message":"missing"}`)
+				}
+
+				_, err := c.GetVehicle(context.Background(), uuid.New(), "token")
+
+				ExpectAppError(err, apperror.ErrVehicleNotFound.ErrorCode)
+			})
+		})
+
+		Context("when the backend refuses the token without a body", func() {
+			It("should return ExternalServiceUnauthorized error", func() {
+				respond = func(w http.ResponseWriter, _ *http.Request, _ int32) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}
+
+				_, err := c.GetVehicle(context.Background(), uuid.New(), "token")
+
+				ExpectAppError(err, apperror.ErrExternalServiceUnauthorized.ErrorCode)
+			})
+		})
+	})
+
+	Describe("circuit breaker", func() {
+		Context("when temporary failures reach the threshold", func() {
+			It("should fail fast without calling the backend", func() {
+				c.breaker = newBreaker(1, time.Minute)
+				respond = func(w http.ResponseWriter, _ *http.Request, _ int32) {
+					writeStatus(w, http.StatusServiceUnavailable, "")
+				}
+
+				_, err := c.ReservePart(context.Background(), uuid.New(), uuid.New(), "token")
+				ExpectAppError(err, apperror.ErrExternalServiceUnavailable.ErrorCode)
+
+				_, err = c.ReservePart(context.Background(), uuid.New(), uuid.New(), "token")
+				ExpectAppError(err, apperror.ErrExternalServiceUnavailable.ErrorCode)
+				Expect(calls.Load()).To(BeEquivalentTo(1))
+			})
+		})
+
+		Context("when the backend rejects calls", func() {
+			It("should not open the circuit", func() {
+				c.breaker = newBreaker(1, time.Minute)
+				respond = func(w http.ResponseWriter, _ *http.Request, _ int32) {
+					writeStatus(w, http.StatusNotFound, `{"is_success":false,"error":"NOT_FOUND"}`)
+				}
+
+				for range 3 {
+					_, err := c.GetVehicle(context.Background(), uuid.New(), "token")
+					ExpectAppError(err, apperror.ErrVehicleNotFound.ErrorCode)
+				}
+				Expect(calls.Load()).To(BeEquivalentTo(3))
+			})
+		})
+
+		Context("when the caller cancels the probe", func() {
+			It("should release it so another probe may be made", func() {
+				c.breaker = newBreaker(1, time.Minute)
+				c.breaker.record(true)
+				c.breaker.openedAt = time.Now().Add(-2 * time.Minute)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				respond = func(w http.ResponseWriter, _ *http.Request, call int32) {
+					if call == 1 {
+						cancel()
+						time.Sleep(50 * time.Millisecond)
+					}
+					writeStatus(w, http.StatusOK, `{"is_success":true,"data":[]}`)
+				}
+
+				_, err := c.ListReservedParts(ctx, "token")
+				Expect(err).To(HaveOccurred())
+				Expect(c.breaker.state).To(Equal(breakerHalfOpen))
+
+				parts, err := c.ListReservedParts(context.Background(), "token")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parts).To(BeEmpty())
+				Expect(c.breaker.state).To(Equal(breakerClosed))
+			})
+		})
+	})
+
+	Describe("backoff", func() {
+		It("should stay under the exponential delay of the retry, capped at MaxBackoff", func() {
+			c.cfg.BaseBackoff = 100 * time.Millisecond
+			c.cfg.MaxBackoff = 300 * time.Millisecond
+
+			for range 20 {
+				Expect(c.backoff(1)).To(BeNumerically("<", 100*time.Millisecond))
+				Expect(c.backoff(2)).To(BeNumerically("<", 200*time.Millisecond))
+				Expect(c.backoff(5)).To(BeNumerically("<", 300*time.Millisecond))
+			}
+		})
+
+		It("should not wait without a base backoff", func() {
+			c.cfg.BaseBackoff = 0
+
+			Expect(c.backoff(3)).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package dotnet
+
+import (
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// Error codes returned by the .NET backend in the "error" field.
+const (
+	errorCodeNotFound         = "NOT_FOUND"
+	errorCodeCategoryNotFound = "CATEGORY_NOT_FOUND"
+	errorCodeCoverageNotFound = "COVERAGE_NOT_FOUND"
+)
+
+// callError is a failed call to the .NET backend, before it is mapped to an
+// apperror for the caller.
+type callError struct {
+	statusCode int
+	code       string
+	message    string
+	err        error
+}
+
+func (e *callError) Error() string {
+	switch {
+	case e.err != nil:
+		return e.err.Error()
+	case e.message != "":
+		return fmt.Sprintf("%s (code: %s)", e.message, e.code)
+	default:
+		return fmt.Sprintf("unexpected status code %d", e.statusCode)
+	}
+}
+
+func (e *callError) Unwrap() error {
+	return e.err
+}
+
+// temporary reports whether the backend could not serve the call at all, as
+// opposed to rejecting it. Only temporary failures are retried and count
+// against the circuit breaker.
+func (e *callError) temporary() bool {
+	switch e.statusCode {
+	case 0, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// notSent reports whether the request never reached the backend, which makes
+// even a non idempotent call safe to retry.
+func (e *callError) notSent() bool {
+	var opErr *net.OpError
+	return errors.As(e.err, &opErr) && opErr.Op == "dial"
+}
+
+// toAppError maps a failed call to the apperror the services return. Error
+// codes listed in codes take precedence over the generic mapping.
+func (e *callError) toAppError(action string, codes map[string]*apperror.AppError) *apperror.AppError {
+	if appErr, ok := codes[e.code]; ok {
+		return appErr.WithError(e)
+	}
+
+	switch {
+	case e.temporary():
+		return apperror.ErrExternalServiceUnavailable.WithMessage("Failed to " + action + ": inventory is unavailable").
+			WithError(e)
+	case e.statusCode == http.StatusUnauthorized || e.statusCode == http.StatusForbidden:
+		return apperror.ErrExternalServiceUnauthorized.WithError(e)
+	default:
+		return apperror.ErrExternalServiceError.WithMessage("Failed to " + action + ": " + e.Error()).WithError(e)
+	}
+}
@@ -0,0 +1,27 @@
+package dotnet
+
+import (
+	"expvar"
+	"time"
+)
+
+// metrics are published under "dotnet_client" on /debug/vars. Every key is
+// prefixed by the operation, e.g. reserve_part.calls.
+var metrics = expvar.NewMap("dotnet_client")
+
+const (
+	metricCalls         = ".calls"
+	metricFailures      = ".failures"
+	metricRetries       = ".retries"
+	metricRejected      = ".rejected"
+	metricDurationMs    = ".duration_ms"
+	metricBreakerOpened = "breaker.opened"
+)
+
+func recordCall(op string, duration time.Duration, err error) {
+	metrics.Add(op+metricCalls, 1)
+	metrics.Add(op+metricDurationMs, duration.Milliseconds())
+	if err != nil {
+		metrics.Add(op+metricFailures, 1)
+	}
+}
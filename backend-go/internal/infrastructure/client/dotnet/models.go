@@ -1,9 +1,22 @@
 package dotnet
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/google/uuid"
 )
 
+const PartStatusReserved = "Reserved"
+
+const (
+	PolicyStatusDraft      = "Draft"
+	PolicyStatusActive     = "Active"
+	PolicyStatusExpired    = "Expired"
+	PolicyStatusSuperseded = "Superseded"
+	PolicyStatusArchived   = "Archived"
+)
+
 type BaseResponse struct {
 	IsSuccess bool   `json:"is_success"`
 	Message   string `json:"message"`
@@ -21,14 +34,49 @@ type ReservePartRequest struct {
 }
 
 type PartResponse struct {
-	ID                   uuid.UUID  `json:"id"`
-	SerialNumber         string     `json:"serial_number"`
-	PartName             string     `json:"part_name"`
-	UnitPrice            float64    `json:"unit_price"`
-	CategoryID           uuid.UUID  `json:"category_id"`
-	CategoryName         *string    `json:"category_name,omitempty"`
-	OfficeLocationID     *uuid.UUID `json:"office_location_id,omitempty"`
-	Status               string     `json:"status"`
-	CanBeUsedInWorkOrder bool       `json:"can_be_used_in_work_order"`
-	IsInStock            bool       `json:"is_in_stock"`
+	ID                   uuid.UUID   `json:"id"`
+	SerialNumber         string      `json:"serial_number"`
+	PartName             string      `json:"part_name"`
+	UnitPrice            json.Number `json:"unit_price"`
+	CategoryID           uuid.UUID   `json:"category_id"`
+	CategoryName         *string     `json:"category_name,omitempty"`
+	OfficeLocationID     *uuid.UUID  `json:"office_location_id,omitempty"`
+	Status               string      `json:"status"`
+	CanBeUsedInWorkOrder bool        `json:"can_be_used_in_work_order"`
+	IsInStock            bool        `json:"is_in_stock"`
+}
+
+type VehicleResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	VIN          string     `json:"vin"`
+	LicensePlate *string    `json:"license_plate,omitempty"`
+	CustomerID   uuid.UUID  `json:"customer_id"`
+	ModelID      uuid.UUID  `json:"model_id"`
+	PurchaseDate *time.Time `json:"purchase_date,omitempty"`
+}
+
+type VehicleModelResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Brand      string     `json:"brand"`
+	ModelName  string     `json:"model_name"`
+	Year       int        `json:"year"`
+	PolicyID   *uuid.UUID `json:"policy_id,omitempty"`
+	PolicyName *string    `json:"policy_name,omitempty"`
+}
+
+type WarrantyPolicyResponse struct {
+	ID                     uuid.UUID `json:"id"`
+	PolicyName             string    `json:"policy_name"`
+	WarrantyDurationMonths int       `json:"warranty_duration_months"`
+	KilometerLimit         *int      `json:"kilometer_limit,omitempty"`
+	Status                 string    `json:"status"`
+}
+
+type CoverageResponse struct {
+	ID                 uuid.UUID `json:"id"`
+	PolicyID           uuid.UUID `json:"policy_id"`
+	PartCategoryID     uuid.UUID `json:"part_category_id"`
+	PartCategoryName   string    `json:"part_category_name"`
+	CoverageConditions string    `json:"coverage_conditions"`
+	IsInherited        bool      `json:"is_inherited"`
 }
@@ -1,10 +1,109 @@
 package config
 
 import (
+	"fmt"
+	"math"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	AuthModeHeader = "header"
+	AuthModeJWT    = "jwt"
+)
+
+const (
+	WorkflowSourceDefault = "default"
+	WorkflowSourceFile    = "file"
+	WorkflowSourceDB      = "db"
+)
+
+const (
+	NotificationSenderLog  = "log"
+	NotificationSenderFile = "file"
+	NotificationSenderSMTP = "smtp"
+)
+
+const (
+	StorageBackendCloudinary = "cloudinary"
+	StorageBackendLocal      = "local"
+	StorageBackendS3         = "s3"
+)
+
+const (
+	WarrantyModeReject = "reject"
+	WarrantyModeFlag   = "flag"
+)
+
+type AuthConfig struct {
+	Mode string
+}
+
+type WorkflowConfig struct {
+	Source string
+	File   string
+}
+
+type OutboxConfig struct {
+	PollInterval   time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	BaseBackoff    time.Duration
+	MaxBackoff     time.Duration
+	WebhookURL     string
+	WebhookTimeout time.Duration
+}
+
+// NotificationConfig selects how notification emails are sent. FileDir is
+// only used by the file sender and the SMTP settings by the smtp one.
+type NotificationConfig struct {
+	Sender       string
+	From         string
+	FileDir      string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SMTPTimeout  time.Duration
+}
+
+type PartReservationConfig struct {
+	ServiceToken      string
+	Grace             time.Duration
+	RetryInterval     time.Duration
+	MaxBackoff        time.Duration
+	ReconcileInterval time.Duration
+	MaxAttempts       int
+	ReleaseOrphans    bool
+}
+
+// SLAPolicy limits how long a claim may stay in a status: a duration, or a
+// number of business days of the claim's office when BusinessDays is set.
+type SLAPolicy struct {
+	Duration     time.Duration
+	BusinessDays int
+}
+
+// SLAConfig maps claim statuses to their SLA policy.
+type SLAConfig struct {
+	Policies      map[string]SLAPolicy
+	CheckInterval time.Duration
+	BatchSize     int
+}
+
+type WarrantyConfig struct {
+	EligibilityMode string
+	CoverageMode    string
+}
+
+// CostConfig prices claim items. TaxRate is in basis points.
+type CostConfig struct {
+	Currency string
+	TaxRate  int
+}
+
 type OAuthConfig struct {
 	GoogleClientID     string
 	GoogleClientSecret string
@@ -17,8 +116,47 @@ type CloudinaryConfig struct {
 	UploadFolder string
 }
 
+// StorageConfig selects where claim attachments are stored. The local
+// settings are only used by the local backend, which serves files from
+// LocalBaseURL with URLs signed by SigningKey, and the S3 ones by the s3
+// backend. Download URLs expire after URLTTL.
+type StorageConfig struct {
+	Backend          string
+	URLTTL           time.Duration
+	SigningKey       string
+	LocalDir         string
+	LocalBaseURL     string
+	S3Endpoint       string
+	S3PublicEndpoint string
+	S3Region         string
+	S3Bucket         string
+	S3AccessKey      string
+	S3SecretKey      string
+	S3PathStyle      bool
+}
+
+// AttachmentConfig limits the size of uploaded images and videos, in bytes,
+// and the duration of videos. Uploads are processed every ProcessInterval by
+// batches of ProcessBatchSize, up to ProcessMaxAttempts times, into
+// thumbnails fitting in a ThumbnailSize pixels square.
+type AttachmentConfig struct {
+	MaxImageSize       int64
+	MaxVideoSize       int64
+	MaxVideoDuration   time.Duration
+	ThumbnailSize      int
+	ProcessInterval    time.Duration
+	ProcessBatchSize   int
+	ProcessMaxAttempts int
+}
+
 type ExternalServiceConfig struct {
-	DotnetBackendURL string
+	DotnetBackendURL       string
+	DotnetTimeout          time.Duration
+	DotnetMaxRetries       int
+	DotnetRetryBackoff     time.Duration
+	DotnetRetryMaxBackoff  time.Duration
+	DotnetBreakerThreshold int
+	DotnetBreakerCooldown  time.Duration
 }
 
 type Config struct {
@@ -29,8 +167,18 @@ type Config struct {
 	PrivateKeyPath  string
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
+	Auth            AuthConfig
+	Workflow        WorkflowConfig
+	Outbox          OutboxConfig
+	Notification    NotificationConfig
+	PartReservation PartReservationConfig
+	SLA             SLAConfig
+	Warranty        WarrantyConfig
+	Cost            CostConfig
 	OAuth           OAuthConfig
 	Cloudinary      CloudinaryConfig
+	Storage         StorageConfig
+	Attachment      AttachmentConfig
 	ExternalService ExternalServiceConfig
 }
 
@@ -49,8 +197,123 @@ func Load() *Config {
 		panic("Google OAuth credentials are not set in environment variables")
 	}
 	cloudinaryURL := os.Getenv("CLOUDINARY_URL")
-	if cloudinaryURL == "" {
-		panic("Cloudinary URL are not set in environment variables")
+	defaultStorageBackend := StorageBackendLocal
+	if cloudinaryURL != "" {
+		defaultStorageBackend = StorageBackendCloudinary
+	}
+	storageBackend := getEnv("STORAGE_BACKEND", defaultStorageBackend)
+	switch storageBackend {
+	case StorageBackendCloudinary:
+		if cloudinaryURL == "" {
+			panic("CLOUDINARY_URL must be set when STORAGE_BACKEND is cloudinary")
+		}
+	case StorageBackendLocal:
+		if os.Getenv("STORAGE_SIGNING_KEY") == "" {
+			panic("STORAGE_SIGNING_KEY must be set when STORAGE_BACKEND is local")
+		}
+	case StorageBackendS3:
+		if os.Getenv("S3_ENDPOINT") == "" || os.Getenv("S3_BUCKET") == "" {
+			panic("S3_ENDPOINT and S3_BUCKET must be set when STORAGE_BACKEND is s3")
+		}
+	default:
+		panic("STORAGE_BACKEND must be one of cloudinary, local or s3")
+	}
+	s3PathStyle, err := strconv.ParseBool(getEnv("S3_PATH_STYLE", "true"))
+	if err != nil {
+		panic("S3_PATH_STYLE must be a boolean")
+	}
+	maxImageMB, err := strconv.Atoi(getEnv("ATTACHMENT_MAX_IMAGE_MB", "20"))
+	if err != nil || maxImageMB < 1 {
+		panic("ATTACHMENT_MAX_IMAGE_MB must be a positive integer")
+	}
+	maxVideoMB, err := strconv.Atoi(getEnv("ATTACHMENT_MAX_VIDEO_MB", "100"))
+	if err != nil || maxVideoMB < 1 {
+		panic("ATTACHMENT_MAX_VIDEO_MB must be a positive integer")
+	}
+	thumbnailSize, err := strconv.Atoi(getEnv("ATTACHMENT_THUMBNAIL_SIZE", "320"))
+	if err != nil || thumbnailSize < 1 {
+		panic("ATTACHMENT_THUMBNAIL_SIZE must be a positive integer")
+	}
+	attachmentBatchSize, err := strconv.Atoi(getEnv("ATTACHMENT_PROCESS_BATCH_SIZE", "10"))
+	if err != nil || attachmentBatchSize < 1 {
+		panic("ATTACHMENT_PROCESS_BATCH_SIZE must be a positive integer")
+	}
+	attachmentMaxAttempts, err := strconv.Atoi(getEnv("ATTACHMENT_PROCESS_MAX_ATTEMPTS", "5"))
+	if err != nil || attachmentMaxAttempts < 1 {
+		panic("ATTACHMENT_PROCESS_MAX_ATTEMPTS must be a positive integer")
+	}
+	authMode := getEnv("AUTH_MODE", AuthModeHeader)
+	if authMode != AuthModeHeader && authMode != AuthModeJWT {
+		panic("AUTH_MODE must be either header or jwt")
+	}
+	workflowSource := getEnv("CLAIM_WORKFLOW_SOURCE", WorkflowSourceDefault)
+	workflowFile := os.Getenv("CLAIM_WORKFLOW_FILE")
+	switch workflowSource {
+	case WorkflowSourceDefault, WorkflowSourceDB:
+	case WorkflowSourceFile:
+		if workflowFile == "" {
+			panic("CLAIM_WORKFLOW_FILE must be set when CLAIM_WORKFLOW_SOURCE is file")
+		}
+	default:
+		panic("CLAIM_WORKFLOW_SOURCE must be one of default, file or db")
+	}
+	eligibilityMode := getEnv("WARRANTY_ELIGIBILITY_MODE", WarrantyModeReject)
+	if eligibilityMode != WarrantyModeReject && eligibilityMode != WarrantyModeFlag {
+		panic("WARRANTY_ELIGIBILITY_MODE must be either reject or flag")
+	}
+	coverageMode := getEnv("ITEM_COVERAGE_MODE", WarrantyModeFlag)
+	if coverageMode != WarrantyModeReject && coverageMode != WarrantyModeFlag {
+		panic("ITEM_COVERAGE_MODE must be either reject or flag")
+	}
+	outboxBatchSize, err := strconv.Atoi(getEnv("OUTBOX_BATCH_SIZE", "50"))
+	if err != nil || outboxBatchSize < 1 {
+		panic("OUTBOX_BATCH_SIZE must be a positive integer")
+	}
+	outboxMaxAttempts, err := strconv.Atoi(getEnv("OUTBOX_MAX_ATTEMPTS", "10"))
+	if err != nil || outboxMaxAttempts < 1 {
+		panic("OUTBOX_MAX_ATTEMPTS must be a positive integer")
+	}
+	notificationSender := getEnv("NOTIFICATION_SENDER", NotificationSenderLog)
+	switch notificationSender {
+	case NotificationSenderLog, NotificationSenderFile:
+	case NotificationSenderSMTP:
+		if os.Getenv("SMTP_HOST") == "" {
+			panic("SMTP_HOST must be set when NOTIFICATION_SENDER is smtp")
+		}
+	default:
+		panic("NOTIFICATION_SENDER must be one of log, file or smtp")
+	}
+	smtpPort, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+	if err != nil || smtpPort < 1 || smtpPort > 65535 {
+		panic("SMTP_PORT must be a valid port number")
+	}
+	reservationMaxAttempts, err := strconv.Atoi(getEnv("PART_RESERVATION_MAX_ATTEMPTS", "10"))
+	if err != nil || reservationMaxAttempts < 1 {
+		panic("PART_RESERVATION_MAX_ATTEMPTS must be a positive integer")
+	}
+	releaseOrphans, err := strconv.ParseBool(getEnv("PART_RESERVATION_RELEASE_ORPHANS", "false"))
+	if err != nil {
+		panic("PART_RESERVATION_RELEASE_ORPHANS must be a boolean")
+	}
+	dotnetMaxRetries, err := strconv.Atoi(getEnv("DOTNET_MAX_RETRIES", "2"))
+	if err != nil || dotnetMaxRetries < 0 {
+		panic("DOTNET_MAX_RETRIES must be a non-negative integer")
+	}
+	dotnetBreakerThreshold, err := strconv.Atoi(getEnv("DOTNET_BREAKER_THRESHOLD", "5"))
+	if err != nil || dotnetBreakerThreshold < 0 {
+		panic("DOTNET_BREAKER_THRESHOLD must be a non-negative integer")
+	}
+	slaPolicies, err := parseSLAPolicies(getEnv("CLAIM_SLAS", "SUBMITTED=48h,REVIEWING=5bd"))
+	if err != nil {
+		panic("CLAIM_SLAS must be none or a comma separated list of STATUS=<duration> or STATUS=<days>bd")
+	}
+	slaBatchSize, err := strconv.Atoi(getEnv("CLAIM_SLA_BATCH_SIZE", "50"))
+	if err != nil || slaBatchSize < 1 {
+		panic("CLAIM_SLA_BATCH_SIZE must be a positive integer")
+	}
+	taxRate, err := strconv.ParseFloat(getEnv("COST_TAX_RATE", "0"), 64)
+	if err != nil || taxRate < 0 || taxRate > 100 {
+		panic("COST_TAX_RATE must be a percentage between 0 and 100")
 	}
 	return &Config{
 		Port:            getEnv("PORT", "8080"),
@@ -60,6 +323,54 @@ func Load() *Config {
 		PrivateKeyPath:  getEnv("PRIVATE_KEY_PATH", "./keys/private.pem"),
 		AccessTokenTTL:  accessTokenTTL,
 		RefreshTokenTTL: refreshTokenTTL,
+		Auth: AuthConfig{
+			Mode: authMode,
+		},
+		Workflow: WorkflowConfig{
+			Source: workflowSource,
+			File:   workflowFile,
+		},
+		Outbox: OutboxConfig{
+			PollInterval:   getEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:      outboxBatchSize,
+			MaxAttempts:    outboxMaxAttempts,
+			BaseBackoff:    getEnvDuration("OUTBOX_BASE_BACKOFF", 5*time.Second),
+			MaxBackoff:     getEnvDuration("OUTBOX_MAX_BACKOFF", time.Hour),
+			WebhookURL:     os.Getenv("OUTBOX_WEBHOOK_URL"),
+			WebhookTimeout: getEnvDuration("OUTBOX_WEBHOOK_TIMEOUT", 10*time.Second),
+		},
+		Notification: NotificationConfig{
+			Sender:       notificationSender,
+			From:         getEnv("NOTIFICATION_FROM", "EV Warranty <no-reply@ev-warranty.local>"),
+			FileDir:      getEnv("NOTIFICATION_FILE_DIR", "./tmp/mail"),
+			SMTPHost:     os.Getenv("SMTP_HOST"),
+			SMTPPort:     smtpPort,
+			SMTPUsername: os.Getenv("SMTP_USERNAME"),
+			SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+			SMTPTimeout:  getEnvDuration("SMTP_TIMEOUT", 10*time.Second),
+		},
+		PartReservation: PartReservationConfig{
+			ServiceToken:      os.Getenv("DOTNET_SERVICE_TOKEN"),
+			Grace:             getEnvDuration("PART_RESERVATION_GRACE", 5*time.Minute),
+			RetryInterval:     getEnvDuration("PART_RESERVATION_RETRY_INTERVAL", time.Minute),
+			MaxBackoff:        getEnvDuration("PART_RESERVATION_MAX_BACKOFF", time.Hour),
+			ReconcileInterval: getEnvDuration("PART_RESERVATION_RECONCILE_INTERVAL", time.Hour),
+			MaxAttempts:       reservationMaxAttempts,
+			ReleaseOrphans:    releaseOrphans,
+		},
+		SLA: SLAConfig{
+			Policies:      slaPolicies,
+			CheckInterval: getEnvDuration("CLAIM_SLA_CHECK_INTERVAL", time.Minute),
+			BatchSize:     slaBatchSize,
+		},
+		Warranty: WarrantyConfig{
+			EligibilityMode: eligibilityMode,
+			CoverageMode:    coverageMode,
+		},
+		Cost: CostConfig{
+			Currency: getEnv("COST_CURRENCY", "VND"),
+			TaxRate:  int(math.Round(taxRate * 100)),
+		},
 		OAuth: OAuthConfig{
 			GoogleClientID:     ggClientID,
 			GoogleClientSecret: ggClientSecret,
@@ -70,8 +381,37 @@ func Load() *Config {
 			URL:          cloudinaryURL,
 			UploadFolder: getEnv("CLOUDINARY_UPLOAD_FOLDER", "ev-warranty-claim-attachment"),
 		},
+		Storage: StorageConfig{
+			Backend:          storageBackend,
+			URLTTL:           getEnvDuration("STORAGE_URL_TTL", 15*time.Minute),
+			SigningKey:       os.Getenv("STORAGE_SIGNING_KEY"),
+			LocalDir:         getEnv("STORAGE_LOCAL_DIR", "./tmp/attachments"),
+			LocalBaseURL:     getEnv("STORAGE_LOCAL_BASE_URL", "http://localhost:8080"),
+			S3Endpoint:       os.Getenv("S3_ENDPOINT"),
+			S3PublicEndpoint: os.Getenv("S3_PUBLIC_ENDPOINT"),
+			S3Region:         getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:         os.Getenv("S3_BUCKET"),
+			S3AccessKey:      os.Getenv("S3_ACCESS_KEY"),
+			S3SecretKey:      os.Getenv("S3_SECRET_KEY"),
+			S3PathStyle:      s3PathStyle,
+		},
+		Attachment: AttachmentConfig{
+			MaxImageSize:       int64(maxImageMB) << 20,
+			MaxVideoSize:       int64(maxVideoMB) << 20,
+			MaxVideoDuration:   getEnvDuration("ATTACHMENT_MAX_VIDEO_DURATION", 3*time.Minute),
+			ThumbnailSize:      thumbnailSize,
+			ProcessInterval:    getEnvDuration("ATTACHMENT_PROCESS_INTERVAL", 10*time.Second),
+			ProcessBatchSize:   attachmentBatchSize,
+			ProcessMaxAttempts: attachmentMaxAttempts,
+		},
 		ExternalService: ExternalServiceConfig{
-			DotnetBackendURL: getEnv("DOTNET_BACKEND_URL", "http://localhost"),
+			DotnetBackendURL:       getEnv("DOTNET_BACKEND_URL", "http://localhost"),
+			DotnetTimeout:          getEnvDuration("DOTNET_TIMEOUT", 30*time.Second),
+			DotnetMaxRetries:       dotnetMaxRetries,
+			DotnetRetryBackoff:     getEnvDuration("DOTNET_RETRY_BACKOFF", 200*time.Millisecond),
+			DotnetRetryMaxBackoff:  getEnvDuration("DOTNET_RETRY_MAX_BACKOFF", 2*time.Second),
+			DotnetBreakerThreshold: dotnetBreakerThreshold,
+			DotnetBreakerCooldown:  getEnvDuration("DOTNET_BREAKER_COOLDOWN", 30*time.Second),
 		},
 	}
 }
@@ -83,3 +423,45 @@ func getEnv(key string, defaultVal string) string {
 
 	return defaultVal
 }
+
+func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultVal
+	}
+
+	return value
+}
+
+// parseSLAPolicies reads policies such as "SUBMITTED=48h,REVIEWING=5bd", where
+// a bd suffix counts business days. "none" disables every SLA.
+func parseSLAPolicies(value string) (map[string]SLAPolicy, error) {
+	policies := make(map[string]SLAPolicy)
+	if strings.EqualFold(value, "none") {
+		return policies, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		status, limit, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || status == "" {
+			return nil, fmt.Errorf("invalid SLA policy %q", entry)
+		}
+
+		var policy SLAPolicy
+		if days, isBusinessDays := strings.CutSuffix(limit, "bd"); isBusinessDays {
+			businessDays, err := strconv.Atoi(days)
+			if err != nil || businessDays < 1 {
+				return nil, fmt.Errorf("invalid business days in SLA policy %q", entry)
+			}
+			policy.BusinessDays = businessDays
+		} else {
+			duration, err := time.ParseDuration(limit)
+			if err != nil || duration <= 0 {
+				return nil, fmt.Errorf("invalid duration in SLA policy %q", entry)
+			}
+			policy.Duration = duration
+		}
+		policies[strings.ToUpper(status)] = policy
+	}
+	return policies, nil
+}
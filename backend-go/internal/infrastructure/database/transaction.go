@@ -11,8 +11,10 @@ import (
 )
 
 type Tx struct {
-	tx  *gorm.DB
-	ctx context.Context
+	tx         *gorm.DB
+	ctx        context.Context
+	onCommit   []func()
+	onRollback []func()
 }
 
 func (t *Tx) GetTx() any {
@@ -31,6 +33,20 @@ func (t *Tx) Commit() error {
 	return t.tx.Commit().Error
 }
 
+func (t *Tx) OnCommit(fn func()) {
+	t.onCommit = append(t.onCommit, fn)
+}
+
+func (t *Tx) OnRollback(fn func()) {
+	t.onRollback = append(t.onRollback, fn)
+}
+
+func runHooks(hooks []func()) {
+	for _, hook := range hooks {
+		hook()
+	}
+}
+
 type txManager struct {
 	log logger.Logger
 	db  *gorm.DB
@@ -54,13 +70,16 @@ func (m *txManager) Do(ctx context.Context, fn func(tx application.Tx) error) er
 		if rbErr := t.Rollback(); rbErr != nil {
 			log.Printf("[TX ROLLBACK FAILED] original error: %v, rollback error: %v", err, rbErr)
 		}
+		runHooks(t.onRollback)
 		return err
 	}
 
 	if err := t.Commit(); err != nil {
 		log.Printf("[TX COMMIT FAILED] commit error: %v", err)
+		runHooks(t.onRollback)
 		return apperror.ErrInternalServerError.WithError(err)
 	}
 
+	runHooks(t.onCommit)
 	return nil
 }
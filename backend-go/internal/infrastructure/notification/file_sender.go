@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+type fileSender struct {
+	dir  string
+	from string
+}
+
+// NewFileSender returns a sender for local use that writes every email to
+// dir as an .eml file named after its ID, ready to open in a mail client.
+func NewFileSender(dir, from string) outbox.EmailSender {
+	return &fileSender{dir: dir, from: from}
+}
+
+func (s *fileSender) Send(_ context.Context, email *entity.NotificationEmail) error {
+	message, err := buildMessage(s.from, email)
+	if err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
+	if err = os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.dir, email.ID.String()+".eml"), message, 0o644)
+}
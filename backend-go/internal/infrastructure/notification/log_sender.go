@@ -0,0 +1,24 @@
+package notification
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/logger"
+)
+
+type logSender struct {
+	log logger.Logger
+}
+
+// NewLogSender returns a sender that only logs the emails it is given, for
+// environments that must not send any.
+func NewLogSender(log logger.Logger) outbox.EmailSender {
+	return &logSender{log: log}
+}
+
+func (s *logSender) Send(_ context.Context, email *entity.NotificationEmail) error {
+	s.log.Info("[Notification] Email", "email_id", email.ID, "kind", email.Kind, "to", email.Recipient,
+		"subject", email.Subject)
+	return nil
+}
@@ -0,0 +1,99 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/google/uuid"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// claimPaths is where each role opens a claim in the frontend. Roles without
+// a claim page get emails without a link.
+var claimPaths = map[string]string{
+	entity.UserRoleEvmStaff:     "/evm-staff/claims/",
+	entity.UserRoleScStaff:      "/sc-staff/claims/",
+	entity.UserRoleScTechnician: "/sc-technician/claims/",
+}
+
+var templateFuncs = map[string]any{
+	"money": entity.FormatMinorUnits,
+	"shortID": func(id uuid.UUID) string {
+		return strings.ToUpper(id.String()[:8])
+	},
+}
+
+// templateData is the data templates are executed with.
+type templateData struct {
+	*outbox.NotificationData
+	ClaimURL string
+}
+
+type templateRenderer struct {
+	frontendBaseURL string
+	text            map[string]*texttemplate.Template
+	html            map[string]*htmltemplate.Template
+}
+
+// NewTemplateRenderer returns a renderer of the embedded templates. Each
+// notification kind has a text template, named after the kind in lower case,
+// defining its "subject" and "body", and an HTML one defining the "content"
+// of the shared layout.
+func NewTemplateRenderer(frontendBaseURL string) outbox.EmailRenderer {
+	layout := htmltemplate.Must(htmltemplate.New("layout").Funcs(templateFuncs).
+		ParseFS(templateFS, "templates/layout.html.tmpl"))
+
+	renderer := &templateRenderer{
+		frontendBaseURL: strings.TrimSuffix(frontendBaseURL, "/"),
+		text:            make(map[string]*texttemplate.Template),
+		html:            make(map[string]*htmltemplate.Template),
+	}
+	for _, kind := range []string{
+		entity.NotificationClaimSubmitted, entity.NotificationClaimAssigned, entity.NotificationClaimApproved,
+		entity.NotificationClaimRejected, entity.NotificationClaimCompleted,
+	} {
+		name := strings.ToLower(kind)
+		renderer.text[kind] = texttemplate.Must(texttemplate.New(name).Funcs(templateFuncs).
+			ParseFS(templateFS, "templates/"+name+".txt.tmpl"))
+		renderer.html[kind] = htmltemplate.Must(htmltemplate.Must(layout.Clone()).
+			ParseFS(templateFS, "templates/"+name+".html.tmpl"))
+	}
+	return renderer
+}
+
+func (r *templateRenderer) Render(data *outbox.NotificationData) (*outbox.EmailContent, error) {
+	text, ok := r.text[data.Kind]
+	if !ok {
+		return nil, fmt.Errorf("no template for notification %s", data.Kind)
+	}
+
+	values := &templateData{NotificationData: data}
+	if path, ok := claimPaths[data.Recipient.Role]; ok {
+		values.ClaimURL = r.frontendBaseURL + path + data.Claim.ID.String()
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", values); err != nil {
+		return nil, err
+	}
+	if err := text.ExecuteTemplate(&textBody, "body", values); err != nil {
+		return nil, err
+	}
+	if err := r.html[data.Kind].ExecuteTemplate(&htmlBody, "layout", values); err != nil {
+		return nil, err
+	}
+
+	return &outbox.EmailContent{
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: strings.TrimSpace(textBody.String()) + "\n",
+		HTMLBody: htmlBody.String(),
+	}, nil
+}
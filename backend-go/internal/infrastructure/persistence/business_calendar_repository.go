@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type businessCalendarRepository struct {
+	db *gorm.DB
+}
+
+func NewBusinessCalendarRepository(db *gorm.DB) repository.BusinessCalendarRepository {
+	return &businessCalendarRepository{db: db}
+}
+
+func (b *businessCalendarRepository) FindByOfficeID(ctx context.Context, officeID uuid.UUID,
+) (*entity.BusinessCalendar, error) {
+	var calendar entity.BusinessCalendar
+	if err := b.db.WithContext(ctx).Where("office_id = ?", officeID).First(&calendar).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &calendar, nil
+}
+
+func (b *businessCalendarRepository) Save(ctx context.Context, calendar *entity.BusinessCalendar) error {
+	if err := b.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "office_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"timezone", "working_days", "holidays", "updated_at"}),
+		}).
+		Create(calendar).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("BusinessCalendarRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.BusinessCalendarRepository
+		ctx        context.Context
+		calendar   *entity.BusinessCalendar
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewBusinessCalendarRepository(db)
+		ctx = context.Background()
+		calendar = entity.NewBusinessCalendar(uuid.New())
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("FindByOfficeID", func() {
+		Context("when the office has a calendar", func() {
+			It("should return it", func() {
+				rows := sqlmock.NewRows([]string{"office_id", "timezone", "working_days", "holidays"}).
+					AddRow(calendar.OfficeID, "Asia/Ho_Chi_Minh", []byte(`[1,2,3,4,5,6]`), []byte(`["2026-09-02"]`))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "business_calendars" WHERE office_id = $1`)).
+					WithArgs(calendar.OfficeID, 1).
+					WillReturnRows(rows)
+
+				found, err := repository.FindByOfficeID(ctx, calendar.OfficeID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.Timezone).To(Equal("Asia/Ho_Chi_Minh"))
+				Expect(found.WorkingDays).To(HaveLen(6))
+				Expect(found.Holidays).To(Equal([]string{"2026-09-02"}))
+			})
+		})
+
+		Context("when the office has no calendar", func() {
+			It("should return nil without error", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "business_calendars" WHERE office_id = $1`)).
+					WithArgs(calendar.OfficeID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				found, err := repository.FindByOfficeID(ctx, calendar.OfficeID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeNil())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				found, err := repository.FindByOfficeID(ctx, calendar.OfficeID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Save", func() {
+		Context("when the calendar is saved successfully", func() {
+			It("should replace the existing calendar of the office", func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "business_calendars"`) + `.*` +
+					regexp.QuoteMeta(`ON CONFLICT ("office_id") DO UPDATE SET "timezone"="excluded"."timezone",`+
+						`"working_days"="excluded"."working_days","holidays"="excluded"."holidays",`+
+						`"updated_at"="excluded"."updated_at"`)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := repository.Save(ctx, calendar)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "business_calendars"`)).
+					WillReturnError(sqlmock.ErrCancelled)
+				mock.ExpectRollback()
+
+				err := repository.Save(ctx, calendar)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -7,11 +7,17 @@ import (
 	"ev-warranty-go/internal/application/repository"
 	"ev-warranty-go/internal/domain/entity"
 	"ev-warranty-go/pkg/apperror"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// publicUploadKeyPattern matches the keys of files uploaded to Cloudinary with
+// the public upload delivery type.
+const publicUploadKeyPattern = "^[a-z]+/upload/"
+
 type claimAttachmentRepository struct {
 	db *gorm.DB
 }
@@ -32,6 +38,31 @@ func (c *claimAttachmentRepository) Create(tx application.Tx, attachment *entity
 	return nil
 }
 
+func (c *claimAttachmentRepository) Update(tx application.Tx, attachment *entity.ClaimAttachment) error {
+	db := tx.GetTx().(*gorm.DB)
+	result := db.Model(attachment).
+		Select("public_key", "thumbnail_key", "processing_status", "processing_error", "processing_attempts",
+			"processed_at", "processing_claimed_until", "captured_at", "latitude", "longitude", "duration_seconds").
+		Updates(attachment)
+	if result.Error != nil {
+		return apperror.ErrDBOperation.WithError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperror.ErrNotFoundError.WithMessage("Claim attachment not found")
+	}
+	return nil
+}
+
+func (c *claimAttachmentRepository) UpdateStorageKeys(tx application.Tx, attachment *entity.ClaimAttachment) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Unscoped().Model(attachment).
+		Select("storage_key", "public_key", "thumbnail_key").
+		Updates(attachment).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
 func (c *claimAttachmentRepository) HardDelete(tx application.Tx, id uuid.UUID) error {
 	db := tx.GetTx().(*gorm.DB)
 	if err := db.Unscoped().Delete(&entity.ClaimAttachment{}, "id = ?", id).Error; err != nil {
@@ -94,3 +125,56 @@ func (c *claimAttachmentRepository) FindByType(ctx context.Context, claimID uuid
 	}
 	return attachments, nil
 }
+
+func (c *claimAttachmentRepository) FindByClaimItemID(ctx context.Context, claimID, claimItemID uuid.UUID,
+) ([]*entity.ClaimAttachment, error) {
+	var attachments []*entity.ClaimAttachment
+	if err := c.db.WithContext(ctx).
+		Where("claim_id = ? AND claim_item_id = ?", claimID, claimItemID).
+		Order("created_at DESC").
+		Find(&attachments).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return attachments, nil
+}
+
+func (c *claimAttachmentRepository) ExistsByChecksum(tx application.Tx, claimID uuid.UUID, checksum string,
+) (bool, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var count int64
+	if err := db.Model(&entity.ClaimAttachment{}).
+		Where("claim_id = ? AND checksum = ?", claimID, checksum).
+		Count(&count).Error; err != nil {
+		return false, apperror.ErrDBOperation.WithError(err)
+	}
+	return count > 0, nil
+}
+
+func (c *claimAttachmentRepository) FindPendingProcessing(tx application.Tx, now time.Time, limit int,
+) ([]*entity.ClaimAttachment, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var attachments []*entity.ClaimAttachment
+	if err := db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("processing_status = ? AND (processing_claimed_until IS NULL OR processing_claimed_until <= ?)",
+			entity.AttachmentProcessingPending, now).
+		Order("created_at").
+		Limit(limit).
+		Find(&attachments).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return attachments, nil
+}
+
+func (c *claimAttachmentRepository) FindPublicUploads(ctx context.Context, afterID uuid.UUID, limit int,
+) ([]*entity.ClaimAttachment, error) {
+	var attachments []*entity.ClaimAttachment
+	if err := c.db.WithContext(ctx).Unscoped().
+		Where("storage_key ~ ? AND id > ?", publicUploadKeyPattern, afterID).
+		Order("id").
+		Limit(limit).
+		Find(&attachments).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return attachments, nil
+}
@@ -104,11 +104,11 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 			})
 		})
 
-		Context("boundary cases for URL", func() {
-			It("should handle empty URL", func() {
+		Context("boundary cases for storage key", func() {
+			It("should handle empty storage key", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.EXPECT().GetTx().Return(db)
-				attachment.URL = ""
+				attachment.StorageKey = ""
 				MockSuccessfulInsert(mock, "claim_attachments", attachment.ID)
 
 				err := repository.Create(mockTx, attachment)
@@ -116,10 +116,10 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
-			It("should handle very long URL", func() {
+			It("should handle very long storage key", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.EXPECT().GetTx().Return(db)
-				attachment.URL = "https://example.com/" + string(make([]byte, 1000))
+				attachment.StorageKey = "image/" + string(make([]byte, 1000))
 				MockSuccessfulInsert(mock, "claim_attachments", attachment.ID)
 
 				err := repository.Create(mockTx, attachment)
@@ -221,10 +221,10 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 				expected := newClaimAttachment()
 				expected.ID = attachmentID
 				rows := sqlmock.NewRows([]string{
-					"id", "claim_id", "type", "url", "created_at", "deleted_at",
+					"id", "claim_id", "type", "storage_key", "created_at", "deleted_at",
 				}).AddRow(
 					expected.ID, expected.ClaimID, expected.Type,
-					expected.URL, expected.CreatedAt, expected.DeletedAt,
+					expected.StorageKey, expected.CreatedAt, expected.DeletedAt,
 				)
 
 				MockFindByID(mock, "claim_attachments", attachmentID, rows)
@@ -236,7 +236,7 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 				Expect(attachment.ID).To(Equal(expected.ID))
 				Expect(attachment.ClaimID).To(Equal(expected.ClaimID))
 				Expect(attachment.Type).To(Equal(expected.Type))
-				Expect(attachment.URL).To(Equal(expected.URL))
+				Expect(attachment.StorageKey).To(Equal(expected.StorageKey))
 			})
 		})
 
@@ -276,7 +276,7 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 				attachmentID2 := uuid.New()
 
 				rows := sqlmock.NewRows([]string{
-					"id", "claim_id", "type", "url", "created_at", "deleted_at",
+					"id", "claim_id", "type", "storage_key", "created_at", "deleted_at",
 				}).AddRow(
 					attachmentID1, claimID, entity.AttachmentTypeImage,
 					"https://example.com/image1.jpg", time.Now(), nil,
@@ -301,7 +301,7 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 		Context("when no attachments are found", func() {
 			It("should return empty slice", func() {
 				rows := sqlmock.NewRows([]string{
-					"id", "claim_id", "type", "url", "created_at", "deleted_at",
+					"id", "claim_id", "type", "storage_key", "created_at", "deleted_at",
 				})
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments" WHERE claim_id = $1 AND "claim_attachments"."deleted_at" IS NULL ORDER BY created_at DESC`)).
@@ -410,7 +410,7 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 				attachmentID2 := uuid.New()
 
 				rows := sqlmock.NewRows([]string{
-					"id", "claim_id", "type", "url", "created_at", "deleted_at",
+					"id", "claim_id", "type", "storage_key", "created_at", "deleted_at",
 				}).AddRow(
 					attachmentID1, claimID, entity.AttachmentTypeImage,
 					"https://example.com/image1.jpg", time.Now(), nil,
@@ -435,7 +435,7 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 		Context("when no attachments of type are found", func() {
 			It("should return empty slice", func() {
 				rows := sqlmock.NewRows([]string{
-					"id", "claim_id", "type", "url", "created_at", "deleted_at",
+					"id", "claim_id", "type", "storage_key", "created_at", "deleted_at",
 				})
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments" WHERE (claim_id = $1 AND attachment_type = $2) AND "claim_attachments"."deleted_at" IS NULL ORDER BY created_at DESC`)).
@@ -468,7 +468,7 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 				attachmentID := uuid.New()
 
 				rows := sqlmock.NewRows([]string{
-					"id", "claim_id", "type", "url", "created_at", "deleted_at",
+					"id", "claim_id", "type", "storage_key", "created_at", "deleted_at",
 				}).AddRow(
 					attachmentID, claimID, entity.AttachmentTypeVideo,
 					"https://example.com/video.mp4", time.Now(), nil,
@@ -488,7 +488,7 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 			It("should handle empty type string", func() {
 				attachmentType = ""
 				rows := sqlmock.NewRows([]string{
-					"id", "claim_id", "type", "url", "created_at", "deleted_at",
+					"id", "claim_id", "type", "storage_key", "created_at", "deleted_at",
 				})
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments" WHERE (claim_id = $1 AND attachment_type = $2) AND "claim_attachments"."deleted_at" IS NULL ORDER BY created_at DESC`)).
@@ -502,15 +502,196 @@ var _ = Describe("ClaimAttachmentRepository", func() {
 			})
 		})
 	})
+
+	Describe("FindByClaimItemID", func() {
+		It("should return the attachments of the claim item", func() {
+			claimID := uuid.New()
+			itemID := uuid.New()
+			rows := sqlmock.NewRows([]string{
+				"id", "claim_id", "claim_item_id", "type", "storage_key", "created_at", "deleted_at",
+			}).AddRow(uuid.New(), claimID, itemID, entity.AttachmentTypeImage, "image/item.jpg", time.Now(), nil)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments" WHERE (claim_id = $1 AND claim_item_id = $2) AND "claim_attachments"."deleted_at" IS NULL ORDER BY created_at DESC`)).
+				WithArgs(claimID, itemID).
+				WillReturnRows(rows)
+
+			attachments, err := repository.FindByClaimItemID(ctx, claimID, itemID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attachments).To(HaveLen(1))
+			Expect(*attachments[0].ClaimItemID).To(Equal(itemID))
+		})
+	})
+
+	Describe("ExistsByChecksum", func() {
+		var (
+			claimID uuid.UUID
+			mockTx  *mocks.Tx
+		)
+
+		BeforeEach(func() {
+			claimID = uuid.New()
+			mockTx = mocks.NewTx(GinkgoT())
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		It("should report an attachment with the checksum", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "claim_attachments" WHERE (claim_id = $1 AND checksum = $2) AND "claim_attachments"."deleted_at" IS NULL`)).
+				WithArgs(claimID, "abc123").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+			exists, err := repository.ExistsByChecksum(mockTx, claimID, "abc123")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("should return DBOperationError on database error", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "claim_attachments"`)).
+				WillReturnError(errors.New("database connection failed"))
+
+			exists, err := repository.ExistsByChecksum(mockTx, claimID, "abc123")
+
+			Expect(exists).To(BeFalse())
+			ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+		})
+	})
+	Describe("Update", func() {
+		It("should only update the processing columns", func() {
+			attachment := newClaimAttachment()
+			attachment.MarkReady("image/public.jpg", nil, time.Now())
+			mockTx := mocks.NewTx(GinkgoT())
+			mockTx.EXPECT().GetTx().Return(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "claim_attachments" SET "public_key"=$1,"thumbnail_key"=$2,`+
+				`"processing_status"=$3,"processing_error"=$4,"processing_attempts"=$5,"processed_at"=$6,`+
+				`"processing_claimed_until"=$7,"captured_at"=$8,"latitude"=$9,"longitude"=$10,`+
+				`"duration_seconds"=$11 WHERE "claim_attachments"."deleted_at" IS NULL AND "id" = $12`)).
+				WithArgs(attachment.PublicKey, nil, entity.AttachmentProcessingReady, nil, 1, attachment.ProcessedAt,
+					nil, nil, nil, nil, nil, attachment.ID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			err := repository.Update(mockTx, attachment)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return NotFound error when the attachment is deleted", func() {
+			attachment := newClaimAttachment()
+			mockTx := mocks.NewTx(GinkgoT())
+			mockTx.EXPECT().GetTx().Return(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "claim_attachments"`).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectCommit()
+
+			err := repository.Update(mockTx, attachment)
+
+			ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+		})
+	})
+
+	Describe("UpdateStorageKeys", func() {
+		It("should update the file keys of deleted attachments too", func() {
+			attachment := newClaimAttachment()
+			attachment.StorageKey = "image/authenticated/photo.jpg"
+			attachment.PublicKey = &attachment.StorageKey
+			mockTx := mocks.NewTx(GinkgoT())
+			mockTx.EXPECT().GetTx().Return(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "claim_attachments" SET "storage_key"=$1,"public_key"=$2,`+
+				`"thumbnail_key"=$3 WHERE "id" = $4`)).
+				WithArgs(attachment.StorageKey, attachment.StorageKey, nil, attachment.ID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			err := repository.UpdateStorageKeys(mockTx, attachment)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("FindPublicUploads", func() {
+		It("should return the attachments with public Cloudinary keys after the given ID", func() {
+			attachment := newClaimAttachment()
+			attachment.StorageKey = "image/upload/ev-warranty/photo.jpg"
+			afterID := uuid.New()
+			rows := sqlmock.NewRows([]string{"id", "claim_id", "type", "storage_key"}).
+				AddRow(attachment.ID, attachment.ClaimID, attachment.Type, attachment.StorageKey)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments" WHERE storage_key ~ $1 AND `+
+				`id > $2 ORDER BY id LIMIT $3`)).
+				WithArgs("^[a-z]+/upload/", afterID, 10).
+				WillReturnRows(rows)
+
+			attachments, err := repository.FindPublicUploads(ctx, afterID, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attachments).To(HaveLen(1))
+			Expect(attachments[0].StorageKey).To(Equal(attachment.StorageKey))
+		})
+
+		It("should return DBOperationError on database error", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments"`)).
+				WillReturnError(errors.New("database connection failed"))
+
+			attachments, err := repository.FindPublicUploads(ctx, uuid.Nil, 10)
+
+			Expect(attachments).To(BeNil())
+			ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+		})
+	})
+
+	Describe("FindPendingProcessing", func() {
+		var mockTx *mocks.Tx
+
+		BeforeEach(func() {
+			mockTx = mocks.NewTx(GinkgoT())
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		It("should lock and return the oldest pending attachments not claimed by another run", func() {
+			attachment := newClaimAttachment()
+			now := time.Now()
+			rows := sqlmock.NewRows([]string{"id", "claim_id", "type", "storage_key", "processing_status"}).
+				AddRow(attachment.ID, attachment.ClaimID, attachment.Type, attachment.StorageKey,
+					entity.AttachmentProcessingPending)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments" WHERE (processing_status = $1 AND `+
+				`(processing_claimed_until IS NULL OR processing_claimed_until <= $2)) AND `+
+				`"claim_attachments"."deleted_at" IS NULL ORDER BY created_at LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+				WithArgs(entity.AttachmentProcessingPending, now, 10).
+				WillReturnRows(rows)
+
+			attachments, err := repository.FindPendingProcessing(mockTx, now, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attachments).To(HaveLen(1))
+			Expect(attachments[0].StorageKey).To(Equal(attachment.StorageKey))
+		})
+
+		It("should return DBOperationError on database error", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_attachments"`)).
+				WillReturnError(errors.New("database connection failed"))
+
+			attachments, err := repository.FindPendingProcessing(mockTx, time.Now(), 10)
+
+			Expect(attachments).To(BeNil())
+			ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+		})
+	})
 })
 
 func newClaimAttachment() *entity.ClaimAttachment {
 	return &entity.ClaimAttachment{
-		ID:        uuid.New(),
-		ClaimID:   uuid.New(),
-		Type:      entity.AttachmentTypeImage,
-		URL:       "https://example.com/test-image.jpg",
-		CreatedAt: time.Now(),
-		DeletedAt: nil,
+		ID:         uuid.New(),
+		ClaimID:    uuid.New(),
+		Type:       entity.AttachmentTypeImage,
+		StorageKey: "image/test-image.jpg",
+		CreatedAt:  time.Now(),
+		DeletedAt:  nil,
 	}
 }
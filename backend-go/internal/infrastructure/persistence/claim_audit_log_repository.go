@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type claimAuditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewClaimAuditLogRepository(db *gorm.DB) repository.ClaimAuditLogRepository {
+	return &claimAuditLogRepository{db: db}
+}
+
+func (c *claimAuditLogRepository) Create(tx application.Tx, auditLog *entity.ClaimAuditLog) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Create(auditLog).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Claim audit log with " + dup + " already existed").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (c *claimAuditLogRepository) FindByClaimID(ctx context.Context, claimID uuid.UUID,
+) ([]*entity.ClaimAuditLog, error) {
+	var logs []*entity.ClaimAuditLog
+	if err := c.db.WithContext(ctx).
+		Where("claim_id = ?", claimID).
+		Order("changed_at DESC").
+		Find(&logs).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return logs, nil
+}
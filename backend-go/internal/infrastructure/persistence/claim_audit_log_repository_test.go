@@ -0,0 +1,131 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("ClaimAuditLogRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.ClaimAuditLogRepository
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewClaimAuditLogRepository(db)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		var auditLog *entity.ClaimAuditLog
+
+		BeforeEach(func() {
+			claimID := uuid.New()
+			auditLog = entity.NewClaimAuditLog(claimID, entity.AuditEntityClaim, claimID, entity.AuditActionUpdate,
+				[]byte(`{"description":"old"}`), []byte(`{"description":"new"}`), uuid.New())
+		})
+
+		Context("when audit log is created successfully", func() {
+			It("should return nil error", func() {
+				mockTx := mocks.NewTx(GinkgoT())
+				mockTx.EXPECT().GetTx().Return(db)
+				MockSuccessfulInsert(mock, "claim_audit_logs", auditLog.ID)
+
+				err := repository.Create(mockTx, auditLog)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a duplicate key constraint", func() {
+			It("should return DBDuplicateKeyError", func() {
+				mockTx := mocks.NewTx(GinkgoT())
+				mockTx.EXPECT().GetTx().Return(db)
+				MockDuplicateKeyError(mock, "claim_audit_logs", "claim_audit_logs_pkey")
+
+				err := repository.Create(mockTx, auditLog)
+
+				ExpectAppError(err, apperror.ErrDuplicateKey.ErrorCode)
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mockTx := mocks.NewTx(GinkgoT())
+				mockTx.EXPECT().GetTx().Return(db)
+				MockInsertError(mock, "claim_audit_logs")
+
+				err := repository.Create(mockTx, auditLog)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByClaimID", func() {
+		var (
+			claimID uuid.UUID
+			query   string
+		)
+
+		BeforeEach(func() {
+			claimID = uuid.New()
+			query = `SELECT * FROM "claim_audit_logs" WHERE claim_id = $1 ORDER BY changed_at DESC`
+		})
+
+		Context("when audit logs are found", func() {
+			It("should return them with their before and after values", func() {
+				itemID := uuid.New()
+				rows := sqlmock.NewRows([]string{
+					"id", "claim_id", "entity_type", "entity_id", "action", "before", "after", "changed_by",
+					"changed_at",
+				}).AddRow(
+					uuid.New(), claimID, entity.AuditEntityClaimItem, itemID, entity.AuditActionUpdate,
+					[]byte(`{"cost":0}`), []byte(`{"cost":120.5}`), uuid.New(), time.Now(),
+				)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(claimID).
+					WillReturnRows(rows)
+
+				auditLogs, err := repository.FindByClaimID(ctx, claimID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(auditLogs).To(HaveLen(1))
+				Expect(auditLogs[0].EntityID).To(Equal(itemID))
+				Expect(string(auditLogs[0].After)).To(Equal(`{"cost":120.5}`))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, query)
+
+				auditLogs, err := repository.FindByClaimID(ctx, claimID)
+
+				Expect(auditLogs).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
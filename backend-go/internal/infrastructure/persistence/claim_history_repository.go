@@ -80,3 +80,34 @@ func (c *claimHistoryRepository) FindByDateRange(ctx context.Context, claimID uu
 	}
 	return histories, nil
 }
+
+func (c *claimHistoryRepository) CountRejectionReasons(ctx context.Context,
+	filters repository.RejectionReasonFilters,
+) ([]*repository.ReasonCodeCount, error) {
+	db := c.db.WithContext(ctx).
+		Model(&entity.ClaimHistory{}).
+		Select("reason_code, COUNT(*) AS count").
+		Where("reason_code IS NOT NULL AND status IN ?",
+			[]string{entity.ClaimStatusRejected, entity.ClaimStatusCancelled})
+	if filters.OfficeID != nil {
+		officeClaims := applyClaimFilters(db.Session(&gorm.Session{NewDB: true}).Model(&entity.Claim{}).Select("id"),
+			repository.ClaimFilters{OfficeID: filters.OfficeID})
+		db = db.Where("claim_id IN (?)", officeClaims)
+	}
+	if filters.FromDate != nil {
+		db = db.Where("changed_at >= ?", *filters.FromDate)
+	}
+	if filters.ToDate != nil {
+		db = db.Where("changed_at <= ?", *filters.ToDate)
+	}
+
+	var counts []*repository.ReasonCodeCount
+	if err := db.
+		Group("reason_code").
+		Order("count DESC, reason_code").
+		Limit(filters.Limit).
+		Scan(&counts).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return counts, nil
+}
@@ -19,6 +19,8 @@ import (
 	"ev-warranty-go/internal/infrastructure/persistence"
 )
 
+type rejectionReasonFilters = repository.RejectionReasonFilters
+
 var _ = Describe("ClaimHistoryRepository", func() {
 	var (
 		mock       sqlmock.Sqlmock
@@ -460,6 +462,47 @@ var _ = Describe("ClaimHistoryRepository", func() {
 			})
 		})
 	})
+
+	Describe("CountRejectionReasons", func() {
+		Context("when filtering by office and date range", func() {
+			It("should return reason codes ordered by count", func() {
+				officeID := uuid.New()
+				from := time.Now().Add(-24 * time.Hour)
+				to := time.Now()
+				filters := rejectionReasonFilters{OfficeID: &officeID, FromDate: &from, ToDate: &to, Limit: 5}
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT reason_code, COUNT(*) AS count FROM "claim_histories" `+
+					`WHERE (reason_code IS NOT NULL AND status IN ($1,$2)) AND claim_id IN (SELECT "id" FROM `+
+					`"claims" WHERE id IN (SELECT claims.id FROM "claims" JOIN users ON users.id IN `+
+					`(claims.staff_id, claims.technician_id) AND users.deleted_at IS NULL WHERE users.office_id = $3 `+
+					`AND "claims"."deleted_at" IS NULL) AND "claims"."deleted_at" IS NULL) `+
+					`AND changed_at >= $4 AND changed_at <= $5 AND "claim_histories"."deleted_at" IS NULL `+
+					`GROUP BY "reason_code" ORDER BY count DESC, reason_code LIMIT $6`)).
+					WithArgs(entity.ClaimStatusRejected, entity.ClaimStatusCancelled, officeID, from, to, 5).
+					WillReturnRows(sqlmock.NewRows([]string{"reason_code", "count"}).
+						AddRow(entity.ReasonCodeNotCovered, 7).
+						AddRow(entity.ReasonCodeMisuse, 2))
+
+				counts, err := repository.CountRejectionReasons(ctx, filters)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(HaveLen(2))
+				Expect(counts[0].ReasonCode).To(Equal(entity.ReasonCodeNotCovered))
+				Expect(counts[0].Count).To(Equal(int64(7)))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT reason_code, COUNT(*) AS count FROM "claim_histories"`)
+
+				counts, err := repository.CountRejectionReasons(ctx, rejectionReasonFilters{Limit: 10})
+
+				Expect(counts).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
 })
 
 func newClaimHistory() *entity.ClaimHistory {
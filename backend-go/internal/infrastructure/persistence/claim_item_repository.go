@@ -36,7 +36,8 @@ func (c *claimItemRepository) Update(tx application.Tx, item *entity.ClaimItem)
 	db := tx.GetTx().(*gorm.DB)
 	if err := db.Model(item).
 		Select("part_category_id", "faulty_part_id", "replacement_part_id",
-			"issue_description", "status", "type", "cost").
+			"issue_description", "status", "type", "labor_operation_id", "standard_minutes", "labor_minutes",
+			"labor_rate", "labor_cost", "parts_cost", "diagnostic_fee", "tax_rate", "tax_amount", "total_cost").
 		Updates(item).Error; err != nil {
 		return apperror.ErrDBOperation.WithError(err)
 	}
@@ -69,17 +70,27 @@ func (c *claimItemRepository) UpdateStatus(tx application.Tx, id uuid.UUID, stat
 	return nil
 }
 
-func (c *claimItemRepository) SumCostByClaimID(tx application.Tx, claimID uuid.UUID) (float64, error) {
+func (c *claimItemRepository) SumCostByClaimID(tx application.Tx, claimID uuid.UUID) (int64, error) {
 	db := tx.GetTx().(*gorm.DB)
-	var totalCost float64
+	var totalCost int64
 	if err := db.Model(&entity.ClaimItem{}).Where("claim_id = ? AND status = 'APPROVED'", claimID).
-		Select("COALESCE(SUM(cost), 0)").Scan(&totalCost).Error; err != nil {
+		Select("COALESCE(SUM(total_cost), 0)").Scan(&totalCost).Error; err != nil {
 
 		return 0, apperror.ErrDBOperation.WithError(err)
 	}
 	return totalCost, nil
 }
 
+func (c *claimItemRepository) SumRequestedCostByClaimID(tx application.Tx, claimID uuid.UUID) (int64, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var totalCost int64
+	if err := db.Model(&entity.ClaimItem{}).Where("claim_id = ?", claimID).
+		Select("COALESCE(SUM(total_cost), 0)").Scan(&totalCost).Error; err != nil {
+		return 0, apperror.ErrDBOperation.WithError(err)
+	}
+	return totalCost, nil
+}
+
 func (c *claimItemRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ClaimItem, error) {
 	var item entity.ClaimItem
 	if err := c.db.WithContext(ctx).Where("id = ?", id).First(&item).Error; err != nil {
@@ -125,3 +136,14 @@ func (c *claimItemRepository) FindByStatus(ctx context.Context, claimID uuid.UUI
 	}
 	return items, nil
 }
+
+func (c *claimItemRepository) FindReplacementPartIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var partIDs []uuid.UUID
+	if err := c.db.WithContext(ctx).
+		Model(&entity.ClaimItem{}).
+		Where("replacement_part_id IS NOT NULL AND status <> ?", entity.ClaimItemStatusRejected).
+		Pluck("replacement_part_id", &partIDs).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return partIDs, nil
+}
@@ -111,7 +111,7 @@ var _ = Describe("ClaimItemRepository", func() {
 		BeforeEach(func() {
 			item = newClaimItem()
 			item.IssueDescription = "Updated issue description"
-			item.Cost = 2000.0
+			item.TotalCost = 2000
 		})
 
 		Context("when claim item is updated successfully", func() {
@@ -142,7 +142,7 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should handle zero cost", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.EXPECT().GetTx().Return(db)
-				item.Cost = 0.0
+				item.TotalCost = 0
 				MockSuccessfulUpdate(mock, "claim_items")
 
 				err := repository.Update(mockTx, item)
@@ -153,7 +153,7 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should handle negative cost", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.EXPECT().GetTx().Return(db)
-				item.Cost = -100.0
+				item.TotalCost = -100
 				MockSuccessfulUpdate(mock, "claim_items")
 
 				err := repository.Update(mockTx, item)
@@ -164,7 +164,7 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should handle very large cost", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.On("GetTx").Return(db)
-				item.Cost = 999999999.99
+				item.TotalCost = 999999999999
 				MockSuccessfulUpdate(mock, "claim_items")
 
 				err := repository.Update(mockTx, item)
@@ -330,16 +330,16 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should return total cost", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.EXPECT().GetTx().Return(db)
-				rows := sqlmock.NewRows([]string{"sum"}).AddRow(5000.0)
+				rows := sqlmock.NewRows([]string{"sum"}).AddRow(5000)
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(total_cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
 					WithArgs(claimID).
 					WillReturnRows(rows)
 
 				totalCost, err := repository.SumCostByClaimID(mockTx, claimID)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(totalCost).To(Equal(5000.0))
+				Expect(totalCost).To(Equal(int64(5000)))
 			})
 		})
 
@@ -347,16 +347,16 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should return zero", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.EXPECT().GetTx().Return(db)
-				rows := sqlmock.NewRows([]string{"sum"}).AddRow(0.0)
+				rows := sqlmock.NewRows([]string{"sum"}).AddRow(0)
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(total_cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
 					WithArgs(claimID).
 					WillReturnRows(rows)
 
 				totalCost, err := repository.SumCostByClaimID(mockTx, claimID)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(totalCost).To(Equal(0.0))
+				Expect(totalCost).To(BeZero())
 			})
 		})
 
@@ -364,13 +364,13 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should return DBOperationError", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.EXPECT().GetTx().Return(db)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(total_cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
 					WithArgs(claimID).
 					WillReturnError(errors.New("database connection failed"))
 
 				totalCost, err := repository.SumCostByClaimID(mockTx, claimID)
 
-				Expect(totalCost).To(Equal(0.0))
+				Expect(totalCost).To(BeZero())
 				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
 			})
 		})
@@ -379,16 +379,56 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should handle very large sums", func() {
 				mockTx := mocks.NewTx(GinkgoT())
 				mockTx.On("GetTx").Return(db)
-				rows := sqlmock.NewRows([]string{"sum"}).AddRow(999999999.99)
+				rows := sqlmock.NewRows([]string{"sum"}).AddRow(int64(999999999999))
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(total_cost), 0) FROM "claim_items" WHERE (claim_id = $1 AND status = 'APPROVED') AND "claim_items"."deleted_at" IS NULL`)).
 					WithArgs(claimID).
 					WillReturnRows(rows)
 
 				totalCost, err := repository.SumCostByClaimID(mockTx, claimID)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(totalCost).To(Equal(999999999.99))
+				Expect(totalCost).To(Equal(int64(999999999999)))
+			})
+		})
+	})
+
+	Describe("SumRequestedCostByClaimID", func() {
+		var claimID uuid.UUID
+
+		BeforeEach(func() {
+			claimID = uuid.New()
+		})
+
+		Context("when sum is calculated successfully", func() {
+			It("should return the total of all items regardless of status", func() {
+				mockTx := mocks.NewTx(GinkgoT())
+				mockTx.EXPECT().GetTx().Return(db)
+				rows := sqlmock.NewRows([]string{"sum"}).AddRow(7500)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(total_cost), 0) FROM "claim_items" WHERE claim_id = $1 AND "claim_items"."deleted_at" IS NULL`)).
+					WithArgs(claimID).
+					WillReturnRows(rows)
+
+				totalCost, err := repository.SumRequestedCostByClaimID(mockTx, claimID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(totalCost).To(Equal(int64(7500)))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mockTx := mocks.NewTx(GinkgoT())
+				mockTx.EXPECT().GetTx().Return(db)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(total_cost), 0) FROM "claim_items" WHERE claim_id = $1 AND "claim_items"."deleted_at" IS NULL`)).
+					WithArgs(claimID).
+					WillReturnError(errors.New("database connection failed"))
+
+				totalCost, err := repository.SumRequestedCostByClaimID(mockTx, claimID)
+
+				Expect(totalCost).To(BeZero())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
 			})
 		})
 	})
@@ -406,11 +446,11 @@ var _ = Describe("ClaimItemRepository", func() {
 				expected.ID = itemID
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					expected.ID, expected.ClaimID, expected.PartCategoryID, expected.FaultyPartSerial,
 					expected.ReplacementPartID, expected.IssueDescription, expected.Status,
-					expected.Type, expected.Cost, expected.CreatedAt, expected.UpdatedAt, expected.DeletedAt,
+					expected.Type, expected.TotalCost, expected.CreatedAt, expected.UpdatedAt, expected.DeletedAt,
 				)
 
 				MockFindByID(mock, "claim_items", itemID, rows)
@@ -422,7 +462,7 @@ var _ = Describe("ClaimItemRepository", func() {
 				Expect(item.ID).To(Equal(expected.ID))
 				Expect(item.ClaimID).To(Equal(expected.ClaimID))
 				Expect(item.IssueDescription).To(Equal(expected.IssueDescription))
-				Expect(item.Cost).To(Equal(expected.Cost))
+				Expect(item.TotalCost).To(Equal(expected.TotalCost))
 			})
 		})
 
@@ -466,15 +506,15 @@ var _ = Describe("ClaimItemRepository", func() {
 
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					itemID1, claimID, cateID, partID, &replacementPartID, "Issue 1",
 					entity.ClaimItemStatusPending, entity.ClaimItemTypeReplacement,
-					1000.0, time.Now(), time.Now(), nil,
+					1000, time.Now(), time.Now(), nil,
 				).AddRow(
 					itemID2, claimID, cateID, partID, nil, "Issue 2",
 					entity.ClaimItemStatusApproved, entity.ClaimItemTypeRepair,
-					2000.0, time.Now(), time.Now(), nil,
+					2000, time.Now(), time.Now(), nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_items" WHERE claim_id = $1 AND "claim_items"."deleted_at" IS NULL ORDER BY created_at ASC`)).
@@ -494,7 +534,7 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should return empty slice", func() {
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				})
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_items" WHERE claim_id = $1 AND "claim_items"."deleted_at" IS NULL ORDER BY created_at ASC`)).
@@ -529,11 +569,11 @@ var _ = Describe("ClaimItemRepository", func() {
 
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					itemID, claimID, cateID, partID, nil, "Repair only",
 					entity.ClaimItemStatusPending, entity.ClaimItemTypeRepair,
-					500.0, time.Now(), time.Now(), nil,
+					500, time.Now(), time.Now(), nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_items" WHERE claim_id = $1 AND "claim_items"."deleted_at" IS NULL ORDER BY created_at ASC`)).
@@ -618,15 +658,15 @@ var _ = Describe("ClaimItemRepository", func() {
 
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					itemID1, claimID, cateID, partID, nil, "Issue 1",
 					entity.ClaimItemStatusApproved, entity.ClaimItemTypeReplacement,
-					1000.0, time.Now(), time.Now(), nil,
+					1000, time.Now(), time.Now(), nil,
 				).AddRow(
 					itemID2, claimID, cateID, partID, nil, "Issue 2",
 					entity.ClaimItemStatusApproved, entity.ClaimItemTypeRepair,
-					2000.0, time.Now(), time.Now(), nil,
+					2000, time.Now(), time.Now(), nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_items" WHERE (claim_id = $1 AND status = $2) AND "claim_items"."deleted_at" IS NULL ORDER BY created_at ASC`)).
@@ -646,7 +686,7 @@ var _ = Describe("ClaimItemRepository", func() {
 			It("should return empty slice", func() {
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				})
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_items" WHERE (claim_id = $1 AND status = $2) AND "claim_items"."deleted_at" IS NULL ORDER BY created_at ASC`)).
@@ -681,11 +721,11 @@ var _ = Describe("ClaimItemRepository", func() {
 
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					itemID, claimID, cateID, partID, nil, "Pending item",
 					entity.ClaimItemStatusPending, entity.ClaimItemTypeRepair,
-					500.0, time.Now(), time.Now(), nil,
+					500, time.Now(), time.Now(), nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_items" WHERE (claim_id = $1 AND status = $2) AND "claim_items"."deleted_at" IS NULL ORDER BY created_at ASC`)).
@@ -706,11 +746,11 @@ var _ = Describe("ClaimItemRepository", func() {
 
 				rows := sqlmock.NewRows([]string{
 					"id", "claim_id", "part_category_id", "faulty_part_id", "replacement_part_id",
-					"issue_description", "status", "type", "cost", "created_at", "updated_at", "deleted_at",
+					"issue_description", "status", "type", "total_cost", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					itemID, claimID, cateID, partID, nil, "Rejected item",
 					entity.ClaimItemStatusRejected, entity.ClaimItemTypeRepair,
-					500.0, time.Now(), time.Now(), nil,
+					500, time.Now(), time.Now(), nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_items" WHERE (claim_id = $1 AND status = $2) AND "claim_items"."deleted_at" IS NULL ORDER BY created_at ASC`)).
@@ -725,6 +765,34 @@ var _ = Describe("ClaimItemRepository", func() {
 			})
 		})
 	})
+
+	Describe("FindReplacementPartIDs", func() {
+		Context("when items hold replacement parts", func() {
+			It("should return the parts of items not rejected", func() {
+				partID := uuid.New()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT "replacement_part_id" FROM "claim_items" WHERE ` +
+					`(replacement_part_id IS NOT NULL AND status <> $1) AND "claim_items"."deleted_at" IS NULL`)).
+					WithArgs(entity.ClaimItemStatusRejected).
+					WillReturnRows(sqlmock.NewRows([]string{"replacement_part_id"}).AddRow(partID))
+
+				partIDs, err := repository.FindReplacementPartIDs(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(partIDs).To(Equal([]uuid.UUID{partID}))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT "replacement_part_id"`)
+
+				partIDs, err := repository.FindReplacementPartIDs(ctx)
+
+				Expect(partIDs).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
 })
 
 func newClaimItem() *entity.ClaimItem {
@@ -738,7 +806,9 @@ func newClaimItem() *entity.ClaimItem {
 		IssueDescription:  "Test issue description",
 		Status:            entity.ClaimItemStatusPending,
 		Type:              entity.ClaimItemTypeReplacement,
-		Cost:              1000.0,
+		Currency:          entity.DefaultCurrency,
+		PartsCost:         1000,
+		TotalCost:         1000,
 		CreatedAt:         time.Now(),
 		UpdatedAt:         time.Now(),
 		DeletedAt:         nil,
@@ -7,6 +7,7 @@ import (
 	"ev-warranty-go/internal/application/repository"
 	"ev-warranty-go/internal/domain/entity"
 	"ev-warranty-go/pkg/apperror"
+	"fmt"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -35,7 +36,8 @@ func (c *claimRepository) Create(tx application.Tx, claim *entity.Claim) error {
 func (c *claimRepository) Update(tx application.Tx, claim *entity.Claim) error {
 	db := tx.GetTx().(*gorm.DB)
 	if err := db.Model(claim).Select("vehicle_id",
-		"customer_id", "description", "status", "total_cost", "approved_by").
+		"customer_id", "description", "status", "requested_total", "approved_total", "reimbursed_total",
+		"approved_by", "settlement_batch_id").
 		Updates(claim).Error; err != nil {
 		return apperror.ErrDBOperation.WithError(err)
 	}
@@ -79,14 +81,42 @@ func (c *claimRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.C
 	return &claim, nil
 }
 
-func (c *claimRepository) FindAll(ctx context.Context) ([]*entity.Claim, error) {
-	var claims []*entity.Claim
-	if err := c.db.WithContext(ctx).Find(&claims).Error; err != nil {
+func (c *claimRepository) FindByIDInOffice(ctx context.Context, id, officeID uuid.UUID) (*entity.Claim, error) {
+	var claim entity.Claim
+	db := c.db.WithContext(ctx).Where("id = ?", id)
+	if err := applyClaimFilters(db, repository.ClaimFilters{OfficeID: &officeID}).
+		First(&claim).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFoundError.WithMessage("Claim not found").WithError(err)
+		}
 		return nil, apperror.ErrDBOperation.WithError(err)
 	}
+	return &claim, nil
+}
 
-	return claims, nil
+func (c *claimRepository) FindAll(ctx context.Context, filters repository.ClaimFilters,
+	pagination repository.Pagination,
+) ([]*entity.Claim, int64, error) {
+	query := applyClaimFilters(c.db.WithContext(ctx).Model(&entity.Claim{}), filters).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperror.ErrDBOperation.WithError(err)
+	}
+
+	var claims []*entity.Claim
+	if err := query.
+		Order(fmt.Sprintf("%s %s", pagination.SortBy, pagination.SortDir)).
+		Offset(pagination.Offset()).
+		Limit(pagination.PageSize).
+		Find(&claims).Error; err != nil {
+		return nil, 0, apperror.ErrDBOperation.WithError(err)
+	}
+
+	return claims, total, nil
 }
+
 func (c *claimRepository) CountPendingByTechnician(ctx context.Context, id uuid.UUID) (int64, error) {
 	var count int64
 
@@ -124,3 +154,121 @@ func (c *claimRepository) FindByVehicleID(ctx context.Context, vehicleID uuid.UU
 	}
 	return claims, nil
 }
+
+func (c *claimRepository) Export(ctx context.Context, filters repository.ClaimFilters,
+	fn func(row *repository.ClaimExportRow) error,
+) error {
+	db := c.db.WithContext(ctx)
+	newDB := db.Session(&gorm.Session{NewDB: true})
+
+	// The filters use unqualified claim columns, so they are applied to the
+	// claims before joining tables sharing those names.
+	claims := applyClaimFilters(newDB.Model(&entity.Claim{}), filters)
+	statuses := newDB.Model(&entity.ClaimHistory{}).
+		Select("claim_id, "+
+			"MAX(changed_at) FILTER (WHERE status = ?) AS submitted_at, "+
+			"MAX(changed_at) FILTER (WHERE status = ?) AS reviewing_at, "+
+			"MAX(changed_at) FILTER (WHERE status IN ?) AS approved_at, "+
+			"MAX(changed_at) FILTER (WHERE status = ?) AS rejected_at, "+
+			"MAX(changed_at) FILTER (WHERE status = ?) AS cancelled_at, "+
+			"MAX(changed_at) FILTER (WHERE status = ?) AS completed_at",
+			entity.ClaimStatusSubmitted, entity.ClaimStatusReviewing,
+			[]string{entity.ClaimStatusApproved, entity.ClaimStatusPartiallyApproved},
+			entity.ClaimStatusRejected, entity.ClaimStatusCancelled, entity.ClaimStatusCompleted).
+		Where("claim_item_id IS NULL").
+		Group("claim_id")
+	attachments := newDB.Model(&entity.ClaimAttachment{}).
+		Select("claim_id, COUNT(*) AS attachment_count").
+		Group("claim_id")
+
+	rows, err := db.Table("(?) AS claims", claims).
+		Select("claims.id AS claim_id, claims.customer_id, claims.vehicle_id, claims.kilometers, "+
+			"claims.description, claims.status AS claim_status, claims.currency, claims.requested_total, "+
+			"claims.approved_total, claims.reimbursed_total, claims.staff_id, claims.technician_id, "+
+			"claims.created_at, COALESCE(attachments.attachment_count, 0) AS attachment_count, "+
+			"statuses.submitted_at, statuses.reviewing_at, statuses.approved_at, statuses.rejected_at, "+
+			"statuses.cancelled_at, statuses.completed_at, "+
+			"claim_items.id AS item_id, claim_items.part_category_id, claim_items.faulty_part_serial, "+
+			"claim_items.type AS item_type, claim_items.status AS item_status, claim_items.total_cost AS item_cost").
+		Joins("LEFT JOIN claim_items ON claim_items.claim_id = claims.id AND claim_items.deleted_at IS NULL").
+		Joins("LEFT JOIN (?) AS statuses ON statuses.claim_id = claims.id", statuses).
+		Joins("LEFT JOIN (?) AS attachments ON attachments.claim_id = claims.id", attachments).
+		Order("claims.created_at DESC, claims.id, claim_items.created_at").
+		Rows()
+	if err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row repository.ClaimExportRow
+		if err = db.ScanRows(rows, &row); err != nil {
+			return apperror.ErrDBOperation.WithError(err)
+		}
+		if err = fn(&row); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func applyClaimFilters(db *gorm.DB, filters repository.ClaimFilters) *gorm.DB {
+	if filters.CustomerID != nil {
+		db = db.Where("customer_id = ?", *filters.CustomerID)
+	}
+	if filters.VehicleID != nil {
+		db = db.Where("vehicle_id = ?", *filters.VehicleID)
+	}
+	if filters.TechnicianID != nil {
+		db = db.Where("technician_id = ?", *filters.TechnicianID)
+	}
+	if filters.StaffID != nil {
+		db = db.Where("staff_id = ?", *filters.StaffID)
+	}
+	if filters.OfficeID != nil {
+		db = db.Where("id IN (?)", officeClaimIDs(db, *filters.OfficeID))
+	}
+	if filters.Status != nil {
+		db = db.Where("status = ?", *filters.Status)
+	}
+	if filters.FromDate != nil {
+		db = db.Where("created_at >= ?", *filters.FromDate)
+	}
+	if filters.ToDate != nil {
+		db = db.Where("created_at <= ?", *filters.ToDate)
+	}
+	if filters.SLAState != nil {
+		db = db.Where("id IN (?)", slaStateClaimIDs(db, *filters.SLAState))
+	}
+	return db
+}
+
+// slaStateClaimIDs selects the claims whose open SLA is in state, as of the
+// database clock.
+func slaStateClaimIDs(db *gorm.DB, state string) *gorm.DB {
+	open := db.Session(&gorm.Session{NewDB: true}).
+		Model(&entity.ClaimSLA{}).Select("claim_id").Where("closed_at IS NULL")
+	if state == entity.ClaimSLAStateBreached {
+		return open.Where("breached_at IS NOT NULL OR due_at <= NOW()")
+	}
+	return open.Where("breached_at IS NULL AND due_at > NOW()")
+}
+
+// claimOffices pairs each claim with the offices it belongs to: those of its
+// staff member and of its technician. Claim scoping, settlements and reports
+// all attribute claims to offices through it, so a claim handled across two
+// offices counts for both.
+func claimOffices(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).
+		Model(&entity.Claim{}).
+		Select("DISTINCT claims.id AS claim_id, users.office_id").
+		Joins("JOIN users ON users.id IN (claims.staff_id, claims.technician_id) AND users.deleted_at IS NULL")
+}
+
+// officeClaimIDs selects the claims that belong to an office.
+func officeClaimIDs(db *gorm.DB, officeID uuid.UUID) *gorm.DB {
+	return claimOffices(db).Select("claims.id").Where("users.office_id = ?", officeID)
+}
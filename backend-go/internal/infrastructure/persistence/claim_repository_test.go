@@ -268,10 +268,10 @@ var _ = Describe("ClaimRepository", func() {
 				expected.ID = claimID
 				rows := sqlmock.NewRows([]string{
 					"id", "vehicle_id", "customer_id", "description", "status",
-					"total_cost", "approved_by", "created_at", "updated_at", "deleted_at",
+					"approved_total", "approved_by", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					expected.ID, expected.VehicleID, expected.CustomerID,
-					expected.Description, expected.Status, expected.TotalCost,
+					expected.Description, expected.Status, expected.ApprovedTotal,
 					expected.ApprovedBy, expected.CreatedAt, expected.UpdatedAt, expected.DeletedAt,
 				)
 
@@ -312,9 +312,74 @@ var _ = Describe("ClaimRepository", func() {
 		})
 	})
 
+	Describe("FindByIDInOffice", func() {
+		var (
+			claimID  uuid.UUID
+			officeID uuid.UUID
+			query    string
+		)
+
+		BeforeEach(func() {
+			claimID = uuid.New()
+			officeID = uuid.New()
+			query = `SELECT * FROM "claims" WHERE id = $1 AND id IN (SELECT claims.id FROM "claims" ` +
+				`JOIN users ON users.id IN (claims.staff_id, claims.technician_id) AND users.deleted_at IS NULL ` +
+				`WHERE users.office_id = $2 AND "claims"."deleted_at" IS NULL) AND "claims"."deleted_at" IS NULL`
+		})
+
+		Context("when claim belongs to the office", func() {
+			It("should return the claim", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(claimID, officeID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(claimID))
+
+				claim, err := repository.FindByIDInOffice(ctx, claimID, officeID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claim).NotTo(BeNil())
+				Expect(claim.ID).To(Equal(claimID))
+			})
+		})
+
+		Context("when claim is outside the office", func() {
+			It("should return NotFound error", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(claimID, officeID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				claim, err := repository.FindByIDInOffice(ctx, claimID, officeID)
+
+				Expect(claim).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, query)
+
+				claim, err := repository.FindByIDInOffice(ctx, claimID, officeID)
+
+				Expect(claim).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
 	Describe("FindAll", func() {
+		var pagination claimPagination
+
+		BeforeEach(func() {
+			pagination = claimPagination{
+				Page:     2,
+				PageSize: 10,
+				SortBy:   claimSortByCreatedAt,
+				SortDir:  sortDirDesc,
+			}
+		})
+
 		Context("when claims are found", func() {
-			It("should return all claims", func() {
+			It("should return the page of claims and the total count", func() {
 				claimID1 := uuid.New()
 				claimID2 := uuid.New()
 				vehicleID := uuid.New()
@@ -322,48 +387,105 @@ var _ = Describe("ClaimRepository", func() {
 
 				rows := sqlmock.NewRows([]string{
 					"id", "vehicle_id", "customer_id", "description", "status",
-					"total_cost", "approved_by", "created_at", "updated_at", "deleted_at",
+					"approved_total", "approved_by", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					claimID1, vehicleID, customerID, "Claim 1", entity.ClaimStatusDraft,
-					1000.0, nil, time.Now(), time.Now(), nil,
+					1000, nil, time.Now(), time.Now(), nil,
 				).AddRow(
 					claimID2, vehicleID, customerID, "Claim 2", entity.ClaimStatusSubmitted,
-					2000.0, nil, time.Now(), time.Now(), nil,
+					2000, nil, time.Now(), time.Now(), nil,
 				)
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" WHERE "claims"."deleted_at" IS NULL`)).
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT count(*) FROM "claims" WHERE "claims"."deleted_at" IS NULL`)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					`SELECT * FROM "claims" WHERE "claims"."deleted_at" IS NULL ORDER BY created_at desc LIMIT $1 OFFSET $2`)).
+					WithArgs(10, 10).
 					WillReturnRows(rows)
 
-				claims, err := repository.FindAll(ctx)
+				claims, total, err := repository.FindAll(ctx, claimFilters{}, pagination)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(claims).To(HaveLen(2))
+				Expect(total).To(Equal(int64(12)))
 			})
 		})
 
-		Context("when no claims are found", func() {
-			It("should return empty slice", func() {
-				rows := sqlmock.NewRows([]string{
-					"id", "vehicle_id", "customer_id", "description", "status",
-					"total_cost", "approved_by", "created_at", "updated_at", "deleted_at",
-				})
+		Context("when filters are provided", func() {
+			It("should apply them to both the count and the page query", func() {
+				customerID := uuid.New()
+				officeID := uuid.New()
+				status := entity.ClaimStatusSubmitted
+				from := time.Now().Add(-24 * time.Hour)
+				to := time.Now()
+				filters := claimFilters{
+					CustomerID: &customerID,
+					OfficeID:   &officeID,
+					Status:     &status,
+					FromDate:   &from,
+					ToDate:     &to,
+				}
+				where := `WHERE customer_id = $1 AND id IN (SELECT claims.id FROM "claims" JOIN users ` +
+					`ON users.id IN (claims.staff_id, claims.technician_id) AND users.deleted_at IS NULL ` +
+					`WHERE users.office_id = $2 AND "claims"."deleted_at" IS NULL) AND status = $3 ` +
+					`AND created_at >= $4 AND created_at <= $5 AND "claims"."deleted_at" IS NULL`
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" WHERE "claims"."deleted_at" IS NULL`)).
-					WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "claims" `+where)).
+					WithArgs(customerID, officeID, status, from, to).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" `+where)).
+					WithArgs(customerID, officeID, status, from, to, 10, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
 
-				claims, err := repository.FindAll(ctx)
+				claims, total, err := repository.FindAll(ctx, filters, pagination)
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(claims).To(BeEmpty())
+				Expect(total).To(BeZero())
 			})
 		})
 
-		Context("when there is a database error", func() {
+		Context("when filtering by SLA state", func() {
+			It("should only match claims whose open SLA is breached", func() {
+				state := entity.ClaimSLAStateBreached
+				where := `WHERE id IN (SELECT "claim_id" FROM "claim_slas" WHERE closed_at IS NULL AND ` +
+					`(breached_at IS NOT NULL OR due_at <= NOW())) AND "claims"."deleted_at" IS NULL`
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "claims" ` + where)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" `+where)).
+					WithArgs(10, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+				claims, total, err := repository.FindAll(ctx, claimFilters{SLAState: &state}, pagination)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claims).To(BeEmpty())
+				Expect(total).To(BeZero())
+			})
+		})
+
+		Context("when the count query fails", func() {
 			It("should return DBOperationError", func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" WHERE "claims"."deleted_at" IS NULL`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "claims"`)).
 					WillReturnError(errors.New("database connection failed"))
 
-				claims, err := repository.FindAll(ctx)
+				claims, _, err := repository.FindAll(ctx, claimFilters{}, pagination)
+
+				Expect(claims).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+
+		Context("when the page query fails", func() {
+			It("should return DBOperationError", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "claims"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims"`)).
+					WillReturnError(errors.New("database connection failed"))
+
+				claims, _, err := repository.FindAll(ctx, claimFilters{}, pagination)
 
 				Expect(claims).To(BeNil())
 				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
@@ -385,13 +507,13 @@ var _ = Describe("ClaimRepository", func() {
 				vehicleID := uuid.New()
 				rows := sqlmock.NewRows([]string{
 					"id", "vehicle_id", "customer_id", "description", "status",
-					"total_cost", "approved_by", "created_at", "updated_at", "deleted_at",
+					"approved_total", "approved_by", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					claimID1, vehicleID, customerID, "Claim 1", entity.ClaimStatusDraft,
-					1000.0, nil, time.Now(), time.Now(), nil,
+					1000, nil, time.Now(), time.Now(), nil,
 				).AddRow(
 					claimID2, vehicleID, customerID, "Claim 2", entity.ClaimStatusSubmitted,
-					2000.0, nil, time.Now(), time.Now(), nil,
+					2000, nil, time.Now(), time.Now(), nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" WHERE customer_id = $1 AND "claims"."deleted_at" IS NULL ORDER BY created_at DESC`)).
@@ -411,7 +533,7 @@ var _ = Describe("ClaimRepository", func() {
 			It("should return empty slice", func() {
 				rows := sqlmock.NewRows([]string{
 					"id", "vehicle_id", "customer_id", "description", "status",
-					"total_cost", "approved_by", "created_at", "updated_at", "deleted_at",
+					"approved_total", "approved_by", "created_at", "updated_at", "deleted_at",
 				})
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" WHERE customer_id = $1 AND "claims"."deleted_at" IS NULL ORDER BY created_at DESC`)).
@@ -453,13 +575,13 @@ var _ = Describe("ClaimRepository", func() {
 				customerID := uuid.New()
 				rows := sqlmock.NewRows([]string{
 					"id", "vehicle_id", "customer_id", "description", "status",
-					"total_cost", "approved_by", "created_at", "updated_at", "deleted_at",
+					"approved_total", "approved_by", "created_at", "updated_at", "deleted_at",
 				}).AddRow(
 					claimID1, vehicleID, customerID, "Claim 1", entity.ClaimStatusDraft,
-					1000.0, nil, time.Now(), time.Now(), nil,
+					1000, nil, time.Now(), time.Now(), nil,
 				).AddRow(
 					claimID2, vehicleID, customerID, "Claim 2", entity.ClaimStatusSubmitted,
-					2000.0, nil, time.Now(), time.Now(), nil,
+					2000, nil, time.Now(), time.Now(), nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" WHERE vehicle_id = $1 AND "claims"."deleted_at" IS NULL ORDER BY created_at DESC`)).
@@ -479,7 +601,7 @@ var _ = Describe("ClaimRepository", func() {
 			It("should return empty slice", func() {
 				rows := sqlmock.NewRows([]string{
 					"id", "vehicle_id", "customer_id", "description", "status",
-					"total_cost", "approved_by", "created_at", "updated_at", "deleted_at",
+					"approved_total", "approved_by", "created_at", "updated_at", "deleted_at",
 				})
 
 				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claims" WHERE vehicle_id = $1 AND "claims"."deleted_at" IS NULL ORDER BY created_at DESC`)).
@@ -506,19 +628,114 @@ var _ = Describe("ClaimRepository", func() {
 			})
 		})
 	})
+
+	Describe("Export", func() {
+		var columns []string
+
+		BeforeEach(func() {
+			columns = []string{
+				"claim_id", "customer_id", "vehicle_id", "kilometers", "description", "claim_status", "currency",
+				"requested_total", "approved_total", "reimbursed_total", "staff_id", "technician_id", "created_at",
+				"attachment_count", "submitted_at", "reviewing_at", "approved_at", "rejected_at", "cancelled_at",
+				"completed_at", "item_id", "part_category_id", "faulty_part_serial", "item_type", "item_status",
+				"item_cost",
+			}
+		})
+
+		Context("when claims are exported", func() {
+			It("should call fn for every row with the claim filters applied", func() {
+				status := entity.ClaimStatusSubmitted
+				claimID := uuid.New()
+				itemID := uuid.New()
+				submittedAt := time.Now()
+				rows := sqlmock.NewRows(columns).
+					AddRow(claimID, uuid.New(), uuid.New(), 12000, "Battery overheating", status, "VND",
+						1500000, 0, 0, uuid.New(), uuid.New(), time.Now(), 2,
+						submittedAt, nil, nil, nil, nil, nil,
+						itemID, uuid.New(), "SN-001", entity.ClaimItemTypeReplacement, entity.ClaimItemStatusPending,
+						1500000).
+					AddRow(uuid.New(), uuid.New(), uuid.New(), 300, "Charging port loose", status, "VND",
+						0, 0, 0, uuid.New(), uuid.New(), time.Now(), 0,
+						submittedAt, nil, nil, nil, nil, nil,
+						nil, nil, nil, nil, nil, nil)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT claims.id AS claim_id, claims.customer_id`) +
+					`.*` + regexp.QuoteMeta(`FROM (SELECT * FROM "claims" WHERE status = $1 AND "claims"."deleted_at" IS NULL) AS claims`) +
+					`.*` + regexp.QuoteMeta(`ORDER BY claims.created_at DESC, claims.id, claim_items.created_at`)).
+					WillReturnRows(rows)
+
+				var exported []*claimExportRow
+				err := repository.Export(ctx, claimFilters{Status: &status}, func(row *claimExportRow) error {
+					exported = append(exported, row)
+					return nil
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exported).To(HaveLen(2))
+				Expect(exported[0].ClaimID).To(Equal(claimID))
+				Expect(exported[0].AttachmentCount).To(Equal(2))
+				Expect(*exported[0].ItemID).To(Equal(itemID))
+				Expect(*exported[0].ItemCost).To(Equal(int64(1500000)))
+				Expect(exported[0].SubmittedAt).NotTo(BeNil())
+				Expect(exported[0].CompletedAt).To(BeNil())
+				Expect(exported[1].ItemID).To(BeNil())
+			})
+		})
+
+		Context("when fn fails", func() {
+			It("should stop and return its error", func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), uuid.New(), uuid.New(), 300, "Charging port loose", "DRAFT", "VND",
+						0, 0, 0, uuid.New(), uuid.New(), time.Now(), 0,
+						nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT claims.id AS claim_id`)).WillReturnRows(rows)
+				writeErr := errors.New("client went away")
+
+				err := repository.Export(ctx, claimFilters{}, func(*claimExportRow) error {
+					return writeErr
+				})
+
+				Expect(err).To(Equal(writeErr))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT claims.id AS claim_id`)
+
+				err := repository.Export(ctx, claimFilters{}, func(*claimExportRow) error {
+					return nil
+				})
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
 })
 
+type (
+	claimFilters    = repository.ClaimFilters
+	claimPagination = repository.Pagination
+	claimExportRow  = repository.ClaimExportRow
+)
+
+const (
+	claimSortByCreatedAt = repository.ClaimSortByCreatedAt
+	sortDirDesc          = repository.SortDirDesc
+)
+
 func newClaim() *entity.Claim {
 	return &entity.Claim{
-		ID:          uuid.New(),
-		VehicleID:   uuid.New(),
-		CustomerID:  uuid.New(),
-		Description: "Test claim description",
-		Status:      entity.ClaimStatusDraft,
-		TotalCost:   1000.0,
-		ApprovedBy:  nil,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-		DeletedAt:   nil,
+		ID:            uuid.New(),
+		VehicleID:     uuid.New(),
+		CustomerID:    uuid.New(),
+		Description:   "Test claim description",
+		Status:        entity.ClaimStatusDraft,
+		Currency:      entity.DefaultCurrency,
+		ApprovedTotal: 1000,
+		ApprovedBy:    nil,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		DeletedAt:     nil,
 	}
 }
@@ -0,0 +1,87 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type claimSLARepository struct {
+	db *gorm.DB
+}
+
+func NewClaimSLARepository(db *gorm.DB) repository.ClaimSLARepository {
+	return &claimSLARepository{db: db}
+}
+
+func (c *claimSLARepository) Create(tx application.Tx, sla *entity.ClaimSLA) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Create(sla).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Claim SLA with " + dup + " already existed").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (c *claimSLARepository) Update(tx application.Tx, sla *entity.ClaimSLA) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Model(sla).
+		Select("breached_at", "closed_at").
+		Updates(sla).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (c *claimSLARepository) FindOpenByClaimID(ctx context.Context, claimID uuid.UUID,
+) (*entity.ClaimSLA, error) {
+	var sla entity.ClaimSLA
+	if err := c.db.WithContext(ctx).
+		Where("claim_id = ? AND closed_at IS NULL", claimID).
+		First(&sla).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &sla, nil
+}
+
+func (c *claimSLARepository) FindOpenByClaimIDs(ctx context.Context, claimIDs []uuid.UUID,
+) ([]*entity.ClaimSLA, error) {
+	var slas []*entity.ClaimSLA
+	if err := c.db.WithContext(ctx).
+		Where("claim_id IN ? AND closed_at IS NULL", claimIDs).
+		Find(&slas).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return slas, nil
+}
+
+func (c *claimSLARepository) FindDueBreaches(tx application.Tx, now time.Time, limit int,
+) ([]*entity.ClaimSLA, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var slas []*entity.ClaimSLA
+	if err := db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("closed_at IS NULL AND breached_at IS NULL AND due_at <= ?", now).
+		Where("claim_id IN (?)", db.Session(&gorm.Session{NewDB: true}).
+			Model(&entity.Claim{}).Select("id")).
+		Order("due_at").
+		Limit(limit).
+		Find(&slas).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return slas, nil
+}
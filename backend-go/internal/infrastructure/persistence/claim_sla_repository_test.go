@@ -0,0 +1,202 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("ClaimSLARepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.ClaimSLARepository
+		ctx        context.Context
+		mockTx     *mocks.Tx
+		sla        *entity.ClaimSLA
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewClaimSLARepository(db)
+		ctx = context.Background()
+		mockTx = mocks.NewTx(GinkgoT())
+		now := time.Now()
+		sla = entity.NewClaimSLA(uuid.New(), entity.ClaimStatusSubmitted, now, now.Add(48*time.Hour))
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when the SLA is created successfully", func() {
+			It("should not return error", func() {
+				MockSuccessfulInsert(mock, "claim_slas", sla.ID)
+
+				err := repository.Create(mockTx, sla)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the claim already has an open SLA", func() {
+			It("should return DuplicateKeyError", func() {
+				MockDuplicateKeyError(mock, "claim_slas", "uq_claim_slas_open_claim_id")
+
+				err := repository.Create(mockTx, sla)
+
+				ExpectAppError(err, apperror.ErrDuplicateKey.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when the SLA is closed", func() {
+			It("should only update the breach and close times", func() {
+				sla.Close(time.Now())
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "claim_slas" SET "breached_at"=$1,"closed_at"=$2,`+
+					`"updated_at"=$3 WHERE "id" = $4`)).
+					WithArgs(nil, sla.ClosedAt, sqlmock.AnyArg(), sla.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := repository.Update(mockTx, sla)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockUpdateError(mock, "claim_slas")
+
+				err := repository.Update(mockTx, sla)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindOpenByClaimID", func() {
+		Context("when the claim has an open SLA", func() {
+			It("should return it", func() {
+				rows := sqlmock.NewRows([]string{"id", "claim_id", "status", "started_at", "due_at"}).
+					AddRow(sla.ID, sla.ClaimID, sla.Status, sla.StartedAt, sla.DueAt)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_slas" WHERE claim_id = $1 AND `+
+					`closed_at IS NULL`)).
+					WithArgs(sla.ClaimID, 1).
+					WillReturnRows(rows)
+
+				found, err := repository.FindOpenByClaimID(ctx, sla.ClaimID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.ID).To(Equal(sla.ID))
+				Expect(found.Status).To(Equal(entity.ClaimStatusSubmitted))
+			})
+		})
+
+		Context("when the claim has no open SLA", func() {
+			It("should return nil without error", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_slas" WHERE claim_id = $1`)).
+					WithArgs(sla.ClaimID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				found, err := repository.FindOpenByClaimID(ctx, sla.ClaimID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeNil())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				found, err := repository.FindOpenByClaimID(ctx, sla.ClaimID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindOpenByClaimIDs", func() {
+		It("should return the open SLAs of the claims", func() {
+			otherClaimID := uuid.New()
+			rows := sqlmock.NewRows([]string{"id", "claim_id", "status", "started_at", "due_at"}).
+				AddRow(sla.ID, sla.ClaimID, sla.Status, sla.StartedAt, sla.DueAt)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_slas" WHERE claim_id IN ($1,$2) AND `+
+				`closed_at IS NULL`)).
+				WithArgs(sla.ClaimID, otherClaimID).
+				WillReturnRows(rows)
+
+			slas, err := repository.FindOpenByClaimIDs(ctx, []uuid.UUID{sla.ClaimID, otherClaimID})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slas).To(HaveLen(1))
+			Expect(slas[0].ClaimID).To(Equal(sla.ClaimID))
+		})
+	})
+
+	Describe("FindDueBreaches", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when SLAs ran out", func() {
+			It("should lock and return those of claims not deleted", func() {
+				now := time.Now()
+				rows := sqlmock.NewRows([]string{"id", "claim_id", "status", "started_at", "due_at"}).
+					AddRow(sla.ID, sla.ClaimID, sla.Status, sla.StartedAt, now)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "claim_slas" WHERE (closed_at IS NULL AND `+
+					`breached_at IS NULL AND due_at <= $1) AND claim_id IN (SELECT "id" FROM "claims" WHERE `+
+					`"claims"."deleted_at" IS NULL) ORDER BY due_at LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(now, 10).
+					WillReturnRows(rows)
+
+				slas, err := repository.FindDueBreaches(mockTx, now, 10)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(slas).To(HaveLen(1))
+				Expect(slas[0].ID).To(Equal(sla.ID))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				slas, err := repository.FindDueBreaches(mockTx, time.Now(), 10)
+
+				Expect(slas).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"gorm.io/gorm"
+)
+
+type claimWorkflowRepository struct {
+	db *gorm.DB
+}
+
+func NewClaimWorkflowRepository(db *gorm.DB) repository.ClaimWorkflowRepository {
+	return &claimWorkflowRepository{db: db}
+}
+
+func (c *claimWorkflowRepository) FindActive(ctx context.Context) (*entity.ClaimWorkflow, error) {
+	var claimWorkflow entity.ClaimWorkflow
+	if err := c.db.WithContext(ctx).Where("is_active = ?", true).First(&claimWorkflow).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFoundError.WithMessage("Active claim workflow not found").WithError(err)
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &claimWorkflow, nil
+}
@@ -0,0 +1,80 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("ClaimWorkflowRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.ClaimWorkflowRepository
+		ctx        context.Context
+		query      string
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewClaimWorkflowRepository(db)
+		ctx = context.Background()
+		query = `SELECT * FROM "claim_workflows" WHERE is_active = $1`
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("FindActive", func() {
+		Context("when an active workflow exists", func() {
+			It("should return the workflow", func() {
+				id := uuid.New()
+				definition := `{"states": ["DRAFT"]}`
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(true, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "definition", "is_active"}).
+						AddRow(id, "default", definition, true))
+
+				claimWorkflow, err := repository.FindActive(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claimWorkflow.ID).To(Equal(id))
+				Expect(claimWorkflow.Definition).To(Equal(definition))
+			})
+		})
+
+		Context("when no workflow is active", func() {
+			It("should return NotFound error", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(true, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				claimWorkflow, err := repository.FindActive(ctx)
+
+				Expect(claimWorkflow).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, query)
+
+				claimWorkflow, err := repository.FindActive(ctx)
+
+				Expect(claimWorkflow).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type laborOperationRepository struct {
+	db *gorm.DB
+}
+
+func NewLaborOperationRepository(db *gorm.DB) repository.LaborOperationRepository {
+	return &laborOperationRepository{db: db}
+}
+
+func (l *laborOperationRepository) Create(ctx context.Context, operation *entity.LaborOperation) error {
+	if err := l.db.WithContext(ctx).Create(operation).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Labor operation with " + dup + " already existed").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (l *laborOperationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.LaborOperation, error) {
+	var operation entity.LaborOperation
+	if err := l.db.WithContext(ctx).Where("id = ?", id).First(&operation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFoundError.WithMessage("Labor operation not found").WithError(err)
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &operation, nil
+}
+
+func (l *laborOperationRepository) FindAll(ctx context.Context, partCategoryID *uuid.UUID,
+) ([]*entity.LaborOperation, error) {
+	db := l.db.WithContext(ctx)
+	if partCategoryID != nil {
+		db = db.Where("part_category_id = ? OR part_category_id IS NULL", *partCategoryID)
+	}
+
+	var operations []*entity.LaborOperation
+	if err := db.Order("code").Find(&operations).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return operations, nil
+}
+
+func (l *laborOperationRepository) Update(ctx context.Context, operation *entity.LaborOperation) error {
+	if err := l.db.WithContext(ctx).Model(operation).
+		Select("code", "description", "flat_rate_minutes", "part_category_id", "is_active").
+		Updates(operation).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Labor operation with " + dup + " already existed").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (l *laborOperationRepository) SoftDelete(ctx context.Context, id uuid.UUID) error {
+	if err := l.db.WithContext(ctx).Delete(&entity.LaborOperation{}, "id = ?", id).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
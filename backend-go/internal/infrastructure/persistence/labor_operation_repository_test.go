@@ -0,0 +1,175 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("LaborOperationRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.LaborOperationRepository
+		ctx        context.Context
+		operation  *entity.LaborOperation
+		columns    []string
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewLaborOperationRepository(db)
+		ctx = context.Background()
+		categoryID := uuid.New()
+		operation = entity.NewLaborOperation("BAT-REPLACE", "Replace battery module", 90, &categoryID, true)
+		columns = []string{
+			"id", "code", "description", "flat_rate_minutes", "part_category_id", "is_active",
+			"created_at", "updated_at", "deleted_at",
+		}
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		Context("when the operation is created successfully", func() {
+			It("should return nil error", func() {
+				MockSuccessfulInsert(mock, "labor_operations", operation.ID)
+
+				err := repository.Create(ctx, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the code already exists", func() {
+			It("should return DBDuplicateKeyError", func() {
+				MockDuplicateKeyError(mock, "labor_operations", "uq_labor_operations_code")
+
+				err := repository.Create(ctx, operation)
+
+				ExpectAppError(err, apperror.ErrDuplicateKey.ErrorCode)
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockInsertError(mock, "labor_operations")
+
+				err := repository.Create(ctx, operation)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByID", func() {
+		Context("when the operation is found", func() {
+			It("should return the operation", func() {
+				rows := sqlmock.NewRows(columns).AddRow(operation.ID, operation.Code, operation.Description,
+					operation.FlatRateMinutes, *operation.PartCategoryID, true, time.Now(), time.Now(), nil)
+				MockFindByID(mock, "labor_operations", operation.ID, rows)
+
+				found, err := repository.FindByID(ctx, operation.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.Code).To(Equal(operation.Code))
+				Expect(found.FlatRateMinutes).To(Equal(90))
+				Expect(found.PartCategoryID).To(Equal(operation.PartCategoryID))
+			})
+		})
+
+		Context("when the operation is not found", func() {
+			It("should return NotFound error", func() {
+				MockNotFound(mock, "labor_operations", operation.ID)
+
+				found, err := repository.FindByID(ctx, operation.ID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindAll", func() {
+		Context("when a part category is given", func() {
+			It("should also return the operations linked to no category", func() {
+				generic := entity.NewLaborOperation("DIAG", "Run diagnostics", 30, nil, true)
+				rows := sqlmock.NewRows(columns).
+					AddRow(operation.ID, operation.Code, operation.Description, operation.FlatRateMinutes,
+						*operation.PartCategoryID, true, time.Now(), time.Now(), nil).
+					AddRow(generic.ID, generic.Code, generic.Description, generic.FlatRateMinutes,
+						nil, true, time.Now(), time.Now(), nil)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "labor_operations" ` +
+					`WHERE (part_category_id = $1 OR part_category_id IS NULL) ` +
+					`AND "labor_operations"."deleted_at" IS NULL ORDER BY code`)).
+					WithArgs(*operation.PartCategoryID).
+					WillReturnRows(rows)
+
+				operations, err := repository.FindAll(ctx, operation.PartCategoryID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(operations).To(HaveLen(2))
+				Expect(operations[1].PartCategoryID).To(BeNil())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT * FROM "labor_operations"`)
+
+				operations, err := repository.FindAll(ctx, nil)
+
+				Expect(operations).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		Context("when the operation is updated successfully", func() {
+			It("should return nil error", func() {
+				MockSuccessfulUpdate(mock, "labor_operations")
+
+				err := repository.Update(ctx, operation)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockUpdateError(mock, "labor_operations")
+
+				err := repository.Update(ctx, operation)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("SoftDelete", func() {
+		Context("when the operation is soft deleted successfully", func() {
+			It("should return nil error", func() {
+				MockSoftDelete(mock, "labor_operations", operation.ID)
+
+				err := repository.SoftDelete(ctx, operation.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package persistence
+
+import (
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationEmailRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationEmailRepository(db *gorm.DB) repository.NotificationEmailRepository {
+	return &notificationEmailRepository{db: db}
+}
+
+func (n *notificationEmailRepository) Create(tx application.Tx, email *entity.NotificationEmail) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(email).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (n *notificationEmailRepository) Update(tx application.Tx, email *entity.NotificationEmail) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Model(email).
+		Select("status", "attempts", "last_error", "next_attempt_at", "sent_at").
+		Updates(email).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (n *notificationEmailRepository) FindDue(tx application.Tx, now time.Time, limit int,
+) ([]*entity.NotificationEmail, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var emails []*entity.NotificationEmail
+	if err := db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", entity.NotificationEmailStatusPending, now).
+		Order("created_at").
+		Limit(limit).
+		Find(&emails).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return emails, nil
+}
@@ -0,0 +1,116 @@
+package persistence_test
+
+import (
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("NotificationEmailRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.NotificationEmailRepository
+		mockTx     *mocks.Tx
+		email      *entity.NotificationEmail
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewNotificationEmailRepository(db)
+		mockTx = mocks.NewTx(GinkgoT())
+		mockTx.EXPECT().GetTx().Return(db)
+		email = entity.NewNotificationEmail(uuid.New(), uuid.New(), entity.NotificationClaimApproved,
+			"staff@example.com", "Claim approved", "text", "<p>html</p>")
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		Context("when the email is queued", func() {
+			It("should ignore a duplicate for the same user and event", func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "notification_emails"`) + `.*` +
+					regexp.QuoteMeta(`ON CONFLICT ("user_id","event_id") DO NOTHING`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(email.ID))
+				mock.ExpectCommit()
+
+				err := repository.Create(mockTx, email)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockInsertError(mock, "notification_emails")
+
+				err := repository.Create(mockTx, email)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		It("should only update the delivery columns", func() {
+			email.MarkSent(time.Now())
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "notification_emails" SET "status"=$1,"attempts"=$2,`+
+				`"last_error"=$3,"next_attempt_at"=$4,"sent_at"=$5 WHERE "id" = $6`)).
+				WithArgs(entity.NotificationEmailStatusSent, 1, nil, email.NextAttemptAt, email.SentAt, email.ID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			err := repository.Update(mockTx, email)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("FindDue", func() {
+		Context("when emails are due", func() {
+			It("should lock and return them", func() {
+				now := time.Now()
+				rows := sqlmock.NewRows([]string{"id", "user_id", "kind", "recipient", "status", "next_attempt_at"}).
+					AddRow(email.ID, email.UserID, email.Kind, email.Recipient, email.Status, now)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "notification_emails" WHERE status = $1 AND `+
+					`next_attempt_at <= $2 ORDER BY created_at LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(entity.NotificationEmailStatusPending, now, 10).
+					WillReturnRows(rows)
+
+				emails, err := repository.FindDue(mockTx, now, 10)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(emails).To(HaveLen(1))
+				Expect(emails[0].Recipient).To(Equal(email.Recipient))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				emails, err := repository.FindDue(mockTx, time.Now(), 10)
+
+				Expect(emails).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
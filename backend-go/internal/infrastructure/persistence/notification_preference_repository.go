@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) repository.NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+func (n *notificationPreferenceRepository) FindByUserID(ctx context.Context, userID uuid.UUID,
+) (*entity.NotificationPreference, error) {
+	var preference entity.NotificationPreference
+	if err := n.db.WithContext(ctx).Where("user_id = ?", userID).First(&preference).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &preference, nil
+}
+
+func (n *notificationPreferenceRepository) FindByUserIDs(ctx context.Context, userIDs []uuid.UUID,
+) ([]*entity.NotificationPreference, error) {
+	var preferences []*entity.NotificationPreference
+	if err := n.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&preferences).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return preferences, nil
+}
+
+func (n *notificationPreferenceRepository) Save(ctx context.Context,
+	preference *entity.NotificationPreference,
+) error {
+	if err := n.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"email_enabled", "muted_kinds", "updated_at"}),
+		}).
+		Create(preference).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("NotificationPreferenceRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.NotificationPreferenceRepository
+		ctx        context.Context
+		preference *entity.NotificationPreference
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewNotificationPreferenceRepository(db)
+		ctx = context.Background()
+		preference = entity.NewNotificationPreference(uuid.New())
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("FindByUserID", func() {
+		Context("when the user has a preference", func() {
+			It("should return it", func() {
+				rows := sqlmock.NewRows([]string{"user_id", "email_enabled", "muted_kinds"}).
+					AddRow(preference.UserID, false, []byte(`["CLAIM_SUBMITTED"]`))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "notification_preferences" WHERE user_id = $1`)).
+					WithArgs(preference.UserID, 1).
+					WillReturnRows(rows)
+
+				found, err := repository.FindByUserID(ctx, preference.UserID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.EmailEnabled).To(BeFalse())
+				Expect(found.MutedKinds).To(Equal([]string{entity.NotificationClaimSubmitted}))
+			})
+		})
+
+		Context("when the user has no preference", func() {
+			It("should return nil without error", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "notification_preferences" WHERE user_id = $1`)).
+					WithArgs(preference.UserID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				found, err := repository.FindByUserID(ctx, preference.UserID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeNil())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				found, err := repository.FindByUserID(ctx, preference.UserID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByUserIDs", func() {
+		It("should return the preferences of the users that have one", func() {
+			otherUserID := uuid.New()
+			rows := sqlmock.NewRows([]string{"user_id", "email_enabled", "muted_kinds"}).
+				AddRow(preference.UserID, true, []byte(`[]`))
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "notification_preferences" WHERE user_id IN ($1,$2)`)).
+				WithArgs(preference.UserID, otherUserID).
+				WillReturnRows(rows)
+
+			preferences, err := repository.FindByUserIDs(ctx, []uuid.UUID{preference.UserID, otherUserID})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(preferences).To(HaveLen(1))
+			Expect(preferences[0].UserID).To(Equal(preference.UserID))
+		})
+	})
+
+	Describe("Save", func() {
+		Context("when the preference is saved successfully", func() {
+			It("should replace the existing preference of the user", func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "notification_preferences"`) + `.*` +
+					regexp.QuoteMeta(`ON CONFLICT ("user_id") DO UPDATE SET `+
+						`"email_enabled"="excluded"."email_enabled","muted_kinds"="excluded"."muted_kinds",`+
+						`"updated_at"="excluded"."updated_at"`)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := repository.Save(ctx, preference)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "notification_preferences"`)).
+					WillReturnError(sqlmock.ErrCancelled)
+				mock.ExpectRollback()
+
+				err := repository.Save(ctx, preference)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (n *notificationRepository) Create(tx application.Tx, notification *entity.Notification) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(notification).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (n *notificationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Notification, error) {
+	var notification entity.Notification
+	if err := n.db.WithContext(ctx).Where("id = ?", id).First(&notification).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFoundError.WithMessage("Notification not found").WithError(err)
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &notification, nil
+}
+
+func (n *notificationRepository) FindByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool,
+	pagination repository.Pagination,
+) ([]*entity.Notification, int64, error) {
+	query := n.db.WithContext(ctx).Model(&entity.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperror.ErrDBOperation.WithError(err)
+	}
+
+	var notifications []*entity.Notification
+	if err := query.
+		Order("created_at DESC").
+		Limit(pagination.PageSize).
+		Offset(pagination.Offset()).
+		Find(&notifications).Error; err != nil {
+		return nil, 0, apperror.ErrDBOperation.WithError(err)
+	}
+	return notifications, total, nil
+}
+
+func (n *notificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	if err := n.db.WithContext(ctx).Model(&entity.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error; err != nil {
+		return 0, apperror.ErrDBOperation.WithError(err)
+	}
+	return count, nil
+}
+
+func (n *notificationRepository) MarkRead(ctx context.Context, notification *entity.Notification) error {
+	if err := n.db.WithContext(ctx).Model(notification).
+		Update("read_at", notification.ReadAt).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (n *notificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, now time.Time,
+) (int64, error) {
+	result := n.db.WithContext(ctx).Model(&entity.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", now)
+	if result.Error != nil {
+		return 0, apperror.ErrDBOperation.WithError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
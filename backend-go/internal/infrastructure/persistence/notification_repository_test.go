@@ -0,0 +1,200 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("NotificationRepository", func() {
+	var (
+		mock         sqlmock.Sqlmock
+		db           *gorm.DB
+		repo         repository.NotificationRepository
+		ctx          context.Context
+		notification *entity.Notification
+		columns      []string
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repo = persistence.NewNotificationRepository(db)
+		ctx = context.Background()
+		notification = entity.NewNotification(uuid.New(), uuid.New(), entity.NotificationClaimApproved, uuid.New(),
+			"Claim approved")
+		columns = []string{"id", "user_id", "event_id", "kind", "claim_id", "title", "read_at", "created_at"}
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		var mockTx *mocks.Tx
+
+		BeforeEach(func() {
+			mockTx = mocks.NewTx(GinkgoT())
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when the notification is stored", func() {
+			It("should ignore a duplicate for the same user and event", func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "notifications"`) + `.*` +
+					regexp.QuoteMeta(`ON CONFLICT ("user_id","event_id") DO NOTHING`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(notification.ID))
+				mock.ExpectCommit()
+
+				err := repo.Create(mockTx, notification)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockInsertError(mock, "notifications")
+
+				err := repo.Create(mockTx, notification)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByID", func() {
+		Context("when the notification is found", func() {
+			It("should return it", func() {
+				MockFindByID(mock, "notifications", notification.ID, sqlmock.NewRows(columns).
+					AddRow(notification.ID, notification.UserID, notification.EventID, notification.Kind,
+						notification.ClaimID, notification.Title, nil, notification.CreatedAt))
+
+				found, err := repo.FindByID(ctx, notification.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.Title).To(Equal("Claim approved"))
+				Expect(found.ReadAt).To(BeNil())
+			})
+		})
+
+		Context("when the notification is not found", func() {
+			It("should return NotFound error", func() {
+				MockNotFound(mock, "notifications", notification.ID)
+
+				found, err := repo.FindByID(ctx, notification.ID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByUserID", func() {
+		Context("when only unread notifications are asked for", func() {
+			It("should return a page of them, newest first, with their total", func() {
+				pagination := repository.Pagination{Page: 2, PageSize: 10}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "notifications" ` +
+					`WHERE user_id = $1 AND read_at IS NULL`)).
+					WithArgs(notification.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "notifications" `+
+					`WHERE user_id = $1 AND read_at IS NULL ORDER BY created_at DESC LIMIT $2 OFFSET $3`)).
+					WithArgs(notification.UserID, 10, 10).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(notification.ID, notification.UserID, notification.EventID, notification.Kind,
+							notification.ClaimID, notification.Title, nil, notification.CreatedAt))
+
+				notifications, total, err := repo.FindByUserID(ctx, notification.UserID, true, pagination)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(total).To(Equal(int64(11)))
+				Expect(notifications).To(HaveLen(1))
+				Expect(notifications[0].ID).To(Equal(notification.ID))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT count(*) FROM "notifications" WHERE user_id = $1`)
+
+				notifications, _, err := repo.FindByUserID(ctx, notification.UserID, false,
+					repository.Pagination{Page: 1, PageSize: 20})
+
+				Expect(notifications).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("CountUnread", func() {
+		It("should count the unread notifications of the user", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "notifications" ` +
+				`WHERE user_id = $1 AND read_at IS NULL`)).
+				WithArgs(notification.UserID).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+			count, err := repo.CountUnread(ctx, notification.UserID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(int64(4)))
+		})
+	})
+
+	Describe("MarkRead", func() {
+		It("should only update the read time", func() {
+			notification.MarkRead(time.Now())
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "notifications" SET "read_at"=$1 WHERE "id" = $2`)).
+				WithArgs(notification.ReadAt, notification.ID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			err := repo.MarkRead(ctx, notification)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("MarkAllRead", func() {
+		Context("when the user has unread notifications", func() {
+			It("should mark them read and return how many there were", func() {
+				now := time.Now()
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "notifications" SET "read_at"=$1 `+
+					`WHERE user_id = $2 AND read_at IS NULL`)).
+					WithArgs(now, notification.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+
+				updated, err := repo.MarkAllRead(ctx, notification.UserID, now)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated).To(Equal(int64(3)))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockUpdateError(mock, "notifications")
+
+				_, err := repo.MarkAllRead(ctx, notification.UserID, time.Now())
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
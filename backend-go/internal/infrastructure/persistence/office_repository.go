@@ -51,7 +51,7 @@ func (o *officeRepository) FindAll(ctx context.Context) ([]*entity.Office, error
 
 func (o *officeRepository) Update(ctx context.Context, office *entity.Office) error {
 	if err := o.db.WithContext(ctx).Model(office).
-		Select("office_name", "office_type", "address", "is_active").
+		Select("office_name", "office_type", "address", "is_active", "labor_rate").
 		Updates(office).Error; err != nil {
 		return apperror.ErrDBOperation.WithError(err)
 	}
@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxEventRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxEventRepository(db *gorm.DB) repository.OutboxEventRepository {
+	return &outboxEventRepository{db: db}
+}
+
+func (o *outboxEventRepository) Create(tx application.Tx, event *entity.OutboxEvent) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Create(event).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Outbox event with " + dup + " already existed").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (o *outboxEventRepository) Update(tx application.Tx, event *entity.OutboxEvent) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Model(event).
+		Select("status", "attempts", "last_error", "next_attempt_at", "delivered_at").
+		Updates(event).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (o *outboxEventRepository) FindDue(tx application.Tx, now time.Time, limit int,
+) ([]*entity.OutboxEvent, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var events []*entity.OutboxEvent
+	if err := db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", entity.OutboxStatusPending, now).
+		Order("occurred_at").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return events, nil
+}
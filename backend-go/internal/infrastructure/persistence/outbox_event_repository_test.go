@@ -0,0 +1,137 @@
+package persistence_test
+
+import (
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("OutboxEventRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.OutboxEventRepository
+		mockTx     *mocks.Tx
+		event      *entity.OutboxEvent
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewOutboxEventRepository(db)
+		mockTx = mocks.NewTx(GinkgoT())
+		mockTx.EXPECT().GetTx().Return(db)
+		event = entity.NewOutboxEvent(entity.AggregateTypeClaim, uuid.New(), entity.EventClaimCreated,
+			[]byte(`{"actor_id":"00000000-0000-0000-0000-000000000000"}`))
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		Context("when outbox event is created successfully", func() {
+			It("should return nil error", func() {
+				MockSuccessfulInsert(mock, "outbox_events", event.ID)
+
+				err := repository.Create(mockTx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockInsertError(mock, "outbox_events")
+
+				err := repository.Create(mockTx, event)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		Context("when the delivery state is saved", func() {
+			It("should only update the delivery columns", func() {
+				event.MarkFailed(errors.New("timeout"), time.Now().Add(time.Minute), 5)
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_events" SET "status"=$1,"attempts"=$2,`+
+					`"last_error"=$3,"next_attempt_at"=$4,"delivered_at"=$5 WHERE "id" = $6`)).
+					WithArgs(entity.OutboxStatusPending, 1, "timeout", event.NextAttemptAt, nil, event.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := repository.Update(mockTx, event)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockUpdateError(mock, "outbox_events")
+
+				err := repository.Update(mockTx, event)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindDue", func() {
+		var (
+			now   time.Time
+			query string
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			query = `SELECT * FROM "outbox_events" WHERE status = $1 AND next_attempt_at <= $2 ` +
+				`ORDER BY occurred_at LIMIT $3 FOR UPDATE SKIP LOCKED`
+		})
+
+		Context("when pending events are due", func() {
+			It("should lock and return them", func() {
+				rows := sqlmock.NewRows([]string{"id", "aggregate_type", "aggregate_id", "event_type", "payload",
+					"status", "attempts", "next_attempt_at", "occurred_at"}).
+					AddRow(event.ID, event.AggregateType, event.AggregateID, event.EventType, []byte(event.Payload),
+						entity.OutboxStatusPending, 0, now, now)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(entity.OutboxStatusPending, now, 20).
+					WillReturnRows(rows)
+
+				events, err := repository.FindDue(mockTx, now, 20)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].ID).To(Equal(event.ID))
+				Expect(string(events[0].Payload)).To(Equal(string(event.Payload)))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, query)
+
+				events, err := repository.FindDue(mockTx, now, 20)
+
+				Expect(events).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type partReservationRepository struct {
+	db *gorm.DB
+}
+
+func NewPartReservationRepository(db *gorm.DB) repository.PartReservationRepository {
+	return &partReservationRepository{db: db}
+}
+
+func (p *partReservationRepository) Create(tx application.Tx, reservation *entity.PartReservation) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Create(reservation).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Part reservation with " + dup + " already existed").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (p *partReservationRepository) Update(tx application.Tx, reservation *entity.PartReservation) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Model(reservation).
+		Select("status", "release_reason", "attempts", "last_error", "next_attempt_at", "released_at").
+		Updates(reservation).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (p *partReservationRepository) FindActiveByPartID(ctx context.Context, partID uuid.UUID,
+) (*entity.PartReservation, error) {
+	var reservation entity.PartReservation
+	if err := p.db.WithContext(ctx).
+		Where("part_id = ? AND status = ?", partID, entity.PartReservationStatusActive).
+		First(&reservation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &reservation, nil
+}
+
+func (p *partReservationRepository) FindUnsettledPartIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var partIDs []uuid.UUID
+	if err := p.db.WithContext(ctx).
+		Model(&entity.PartReservation{}).
+		Where("status IN ?", []string{entity.PartReservationStatusPending, entity.PartReservationStatusReleasing,
+			entity.PartReservationStatusFailed}).
+		Pluck("part_id", &partIDs).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return partIDs, nil
+}
+
+func (p *partReservationRepository) FindStalePending(tx application.Tx, createdBefore time.Time, limit int,
+) ([]*entity.PartReservation, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var reservations []*entity.PartReservation
+	if err := db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND created_at <= ?", entity.PartReservationStatusPending, createdBefore).
+		Order("created_at").
+		Limit(limit).
+		Find(&reservations).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return reservations, nil
+}
+
+func (p *partReservationRepository) FindDueReleases(tx application.Tx, now time.Time, limit int,
+) ([]*entity.PartReservation, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var reservations []*entity.PartReservation
+	if err := db.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND next_attempt_at <= ?", entity.PartReservationStatusReleasing, now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&reservations).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return reservations, nil
+}
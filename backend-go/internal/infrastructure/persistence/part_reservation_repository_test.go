@@ -0,0 +1,206 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("PartReservationRepository", func() {
+	var (
+		mock        sqlmock.Sqlmock
+		db          *gorm.DB
+		repository  repository.PartReservationRepository
+		ctx         context.Context
+		mockTx      *mocks.Tx
+		reservation *entity.PartReservation
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewPartReservationRepository(db)
+		ctx = context.Background()
+		mockTx = mocks.NewTx(GinkgoT())
+		claimID := uuid.New()
+		itemID := uuid.New()
+		reservation = entity.NewPartReservation(uuid.New(), &claimID, &itemID)
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when reservation is created successfully", func() {
+			It("should not return error", func() {
+				MockSuccessfulInsert(mock, "part_reservations", reservation.ID)
+
+				err := repository.Create(mockTx, reservation)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the part is already held", func() {
+			It("should return DuplicateKeyError", func() {
+				MockDuplicateKeyError(mock, "part_reservations", "uq_part_reservations_held_part")
+
+				err := repository.Create(mockTx, reservation)
+
+				ExpectAppError(err, apperror.ErrDuplicateKey.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when the reservation state is saved", func() {
+			It("should only update the release columns", func() {
+				reservation.ScheduleRelease(entity.ReleaseReasonRollback, time.Now())
+				reservation.MarkReleaseFailed(errors.New("inventory unavailable"), time.Now().Add(time.Minute), 5)
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "part_reservations" SET "status"=$1,"release_reason"=$2,`+
+					`"attempts"=$3,"last_error"=$4,"next_attempt_at"=$5,"updated_at"=$6,"released_at"=$7 `+
+					`WHERE "id" = $8`)).
+					WithArgs(entity.PartReservationStatusReleasing, entity.ReleaseReasonRollback, 1,
+						"inventory unavailable", reservation.NextAttemptAt, sqlmock.AnyArg(), nil, reservation.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := repository.Update(mockTx, reservation)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockUpdateError(mock, "part_reservations")
+
+				err := repository.Update(mockTx, reservation)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindActiveByPartID", func() {
+		Context("when the part has no active reservation", func() {
+			It("should return nil without error", func() {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "part_reservations" WHERE part_id = $1 AND status = $2`)).
+					WithArgs(reservation.PartID, entity.PartReservationStatusActive, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				found, err := repository.FindActiveByPartID(ctx, reservation.PartID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeNil())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				found, err := repository.FindActiveByPartID(ctx, reservation.PartID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindUnsettledPartIDs", func() {
+		It("should return the parts of reservations not settled yet", func() {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT "part_id" FROM "part_reservations" WHERE status IN ($1,$2,$3)`)).
+				WithArgs(entity.PartReservationStatusPending, entity.PartReservationStatusReleasing,
+					entity.PartReservationStatusFailed).
+				WillReturnRows(sqlmock.NewRows([]string{"part_id"}).AddRow(reservation.PartID))
+
+			partIDs, err := repository.FindUnsettledPartIDs(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(partIDs).To(Equal([]uuid.UUID{reservation.PartID}))
+		})
+	})
+
+	Describe("FindStalePending", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		It("should lock pending reservations created before the cutoff", func() {
+			cutoff := time.Now()
+			rows := sqlmock.NewRows([]string{"id", "part_id", "status", "attempts", "next_attempt_at", "created_at"}).
+				AddRow(reservation.ID, reservation.PartID, entity.PartReservationStatusPending, 0, cutoff, cutoff)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "part_reservations" WHERE status = $1 AND `+
+				`created_at <= $2 ORDER BY created_at LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+				WithArgs(entity.PartReservationStatusPending, cutoff, 10).
+				WillReturnRows(rows)
+
+			reservations, err := repository.FindStalePending(mockTx, cutoff, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reservations).To(HaveLen(1))
+			Expect(reservations[0].PartID).To(Equal(reservation.PartID))
+		})
+	})
+
+	Describe("FindDueReleases", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when releases are due", func() {
+			It("should lock and return them", func() {
+				now := time.Now()
+				rows := sqlmock.NewRows([]string{"id", "part_id", "status", "attempts", "next_attempt_at"}).
+					AddRow(reservation.ID, reservation.PartID, entity.PartReservationStatusReleasing, 1, now)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "part_reservations" WHERE status = $1 AND `+
+					`next_attempt_at <= $2 ORDER BY next_attempt_at LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(entity.PartReservationStatusReleasing, now, 10).
+					WillReturnRows(rows)
+
+				reservations, err := repository.FindDueReleases(mockTx, now, 10)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reservations).To(HaveLen(1))
+				Expect(reservations[0].Attempts).To(Equal(1))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				reservations, err := repository.FindDueReleases(mockTx, time.Now(), 10)
+
+				Expect(reservations).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package persistence
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"gorm.io/gorm"
+)
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) repository.ReportRepository {
+	return &reportRepository{db: db}
+}
+
+func (r *reportRepository) ClaimVolume(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.ClaimVolume, error) {
+	period := periodExpr(filters.Granularity, "claims.created_at")
+
+	var volumes []*repository.ClaimVolume
+	if err := r.claims(ctx, filters).
+		Select(period + " AS period, claim_offices.office_id, claims.status, COUNT(*) AS count").
+		Group("period, claim_offices.office_id, claims.status").
+		Order("period, claim_offices.office_id, claims.status").
+		Scan(&volumes).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return volumes, nil
+}
+
+func (r *reportRepository) DecisionRates(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.DecisionRate, error) {
+	period := periodExpr(filters.Granularity, "claim_histories.changed_at")
+	approved, partial, rejected := entity.ClaimStatusApproved, entity.ClaimStatusPartiallyApproved,
+		entity.ClaimStatusRejected
+
+	db := r.db.WithContext(ctx).
+		Model(&entity.ClaimHistory{}).
+		Joins("JOIN (?) AS claim_offices ON claim_offices.claim_id = claim_histories.claim_id", claimOffices(r.db)).
+		Where("claim_histories.claim_item_id IS NULL AND claim_histories.status IN ?",
+			[]string{approved, partial, rejected}).
+		Where("claim_histories.changed_at >= ? AND claim_histories.changed_at <= ?", filters.FromDate, filters.ToDate)
+	if filters.OfficeID != nil {
+		db = db.Where("claim_offices.office_id = ?", *filters.OfficeID)
+	}
+
+	var rates []*repository.DecisionRate
+	if err := db.
+		Select(period+" AS period, claim_offices.office_id, COUNT(*) AS total, "+
+			"COUNT(*) FILTER (WHERE claim_histories.status = ?) AS approved, "+
+			"COUNT(*) FILTER (WHERE claim_histories.status = ?) AS partially_approved, "+
+			"COUNT(*) FILTER (WHERE claim_histories.status = ?) AS rejected, "+
+			"ROUND(100.0 * COUNT(*) FILTER (WHERE claim_histories.status = ?) / COUNT(*), 2)::float8 AS approval_rate, "+
+			"ROUND(100.0 * COUNT(*) FILTER (WHERE claim_histories.status = ?) / COUNT(*), 2)::float8 "+
+			"AS partial_approval_rate, "+
+			"ROUND(100.0 * COUNT(*) FILTER (WHERE claim_histories.status = ?) / COUNT(*), 2)::float8 AS rejection_rate",
+			approved, partial, rejected, approved, partial, rejected).
+		Group("period, claim_offices.office_id").
+		Order("period, claim_offices.office_id").
+		Scan(&rates).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return rates, nil
+}
+
+func (r *reportRepository) TimeInStatus(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.StatusDuration, error) {
+	db := r.db.WithContext(ctx)
+	newDB := db.Session(&gorm.Session{NewDB: true})
+
+	// The next change is looked up over the whole history of the claim, before
+	// the range is applied, so spans ending after ToDate are complete.
+	spans := newDB.Model(&entity.ClaimHistory{}).
+		Select("claim_id, status, changed_at, " +
+			"LEAD(changed_at) OVER (PARTITION BY claim_id ORDER BY changed_at) AS next_changed_at").
+		Where("claim_item_id IS NULL")
+	if filters.OfficeID != nil {
+		spans = spans.Where("claim_id IN (?)", officeClaimIDs(newDB, *filters.OfficeID))
+	}
+
+	var durations []*repository.StatusDuration
+	if err := db.Table("(?) AS spans", spans).
+		Select(periodExpr(filters.Granularity, "spans.changed_at")+" AS period, spans.status, COUNT(*) AS count, "+
+			"AVG(EXTRACT(EPOCH FROM spans.next_changed_at - spans.changed_at))::float8 AS avg_seconds").
+		Where("spans.next_changed_at IS NOT NULL").
+		Where("spans.changed_at >= ? AND spans.changed_at <= ?", filters.FromDate, filters.ToDate).
+		Group("period, spans.status").
+		Order("period, spans.status").
+		Scan(&durations).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return durations, nil
+}
+
+func (r *reportRepository) PartFailures(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.PartFailure, error) {
+	db := r.db.WithContext(ctx).
+		Model(&entity.ClaimItem{}).
+		Joins("JOIN claims ON claims.id = claim_items.claim_id AND claims.deleted_at IS NULL").
+		Where("claim_items.created_at >= ? AND claim_items.created_at <= ?", filters.FromDate, filters.ToDate)
+	if filters.OfficeID != nil {
+		db = db.Where("claim_items.claim_id IN (?)", officeClaimIDs(r.db, *filters.OfficeID))
+	}
+
+	var failures []*repository.PartFailure
+	if err := db.
+		Select("claim_items.part_category_id, COUNT(*) AS items, COUNT(DISTINCT claim_items.claim_id) AS claims, " +
+			"claim_items.currency, SUM(claim_items.total_cost) AS total_cost").
+		Group("claim_items.part_category_id, claim_items.currency").
+		Order("items DESC, total_cost DESC, claim_items.part_category_id").
+		Limit(filters.Limit).
+		Scan(&failures).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return failures, nil
+}
+
+func (r *reportRepository) OfficeCosts(ctx context.Context, filters repository.ReportFilters,
+) ([]*repository.OfficeCost, error) {
+	period := periodExpr(filters.Granularity, "claims.created_at")
+
+	var costs []*repository.OfficeCost
+	if err := r.claims(ctx, filters).
+		Select(period + " AS period, claim_offices.office_id, claims.currency, COUNT(*) AS claims, " +
+			"SUM(claims.requested_total) AS requested_total, SUM(claims.approved_total) AS approved_total, " +
+			"SUM(claims.reimbursed_total) AS reimbursed_total").
+		Group("period, claim_offices.office_id, claims.currency").
+		Order("period, claim_offices.office_id, claims.currency").
+		Scan(&costs).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return costs, nil
+}
+
+// claims selects the claims created in the report range joined with the
+// offices they belong to.
+func (r *reportRepository) claims(ctx context.Context, filters repository.ReportFilters) *gorm.DB {
+	db := r.db.WithContext(ctx).
+		Model(&entity.Claim{}).
+		Joins("JOIN (?) AS claim_offices ON claim_offices.claim_id = claims.id", claimOffices(r.db)).
+		Where("claims.created_at >= ? AND claims.created_at <= ?", filters.FromDate, filters.ToDate)
+	if filters.OfficeID != nil {
+		db = db.Where("claim_offices.office_id = ?", *filters.OfficeID)
+	}
+	return db
+}
+
+// periodExpr truncates column to the start of its report period. The
+// granularity is validated by the service and never comes from the request
+// as is, so it can be inlined like the claim list sort field.
+func periodExpr(granularity, column string) string {
+	switch granularity {
+	case repository.GranularityWeek, repository.GranularityMonth:
+		return "date_trunc('" + granularity + "', " + column + ")"
+	default:
+		return "date_trunc('day', " + column + ")"
+	}
+}
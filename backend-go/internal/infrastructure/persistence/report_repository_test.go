@@ -0,0 +1,200 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+type reportFilters = repository.ReportFilters
+
+var _ = Describe("ReportRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.ReportRepository
+		ctx        context.Context
+		filters    reportFilters
+		officeID   uuid.UUID
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewReportRepository(db)
+		ctx = context.Background()
+
+		officeID = uuid.New()
+		toDate := time.Date(2026, 9, 30, 23, 59, 59, 0, time.UTC)
+		filters = reportFilters{
+			FromDate:    toDate.AddDate(0, 0, -30),
+			ToDate:      toDate,
+			Granularity: "week",
+			Limit:       10,
+		}
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("ClaimVolume", func() {
+		Context("when the office is filtered", func() {
+			It("should count the claims per period, office and status", func() {
+				filters.OfficeID = &officeID
+				period := filters.FromDate
+				rows := sqlmock.NewRows([]string{"period", "office_id", "status", "count"}).
+					AddRow(period, officeID, entity.ClaimStatusSubmitted, 3).
+					AddRow(period, officeID, entity.ClaimStatusApproved, 2)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT date_trunc('week', claims.created_at) AS period, `+
+					`claim_offices.office_id, claims.status, COUNT(*) AS count FROM "claims" `+
+					`JOIN (SELECT DISTINCT claims.id AS claim_id, users.office_id FROM "claims" `+
+					`JOIN users ON users.id IN (claims.staff_id, claims.technician_id) AND users.deleted_at IS NULL `+
+					`WHERE "claims"."deleted_at" IS NULL) AS claim_offices ON claim_offices.claim_id = claims.id `+
+					`WHERE (claims.created_at >= $1 AND claims.created_at <= $2) AND claim_offices.office_id = $3 `+
+					`AND "claims"."deleted_at" IS NULL `+
+					`GROUP BY period, claim_offices.office_id, claims.status`)).
+					WithArgs(filters.FromDate, filters.ToDate, officeID).
+					WillReturnRows(rows)
+
+				volumes, err := repository.ClaimVolume(ctx, filters)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(volumes).To(HaveLen(2))
+				Expect(volumes[0].Status).To(Equal(entity.ClaimStatusSubmitted))
+				Expect(volumes[0].Count).To(Equal(int64(3)))
+				Expect(volumes[1].OfficeID).To(Equal(officeID))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperation error", func() {
+				mock.ExpectQuery(`SELECT date_trunc`).WillReturnError(errors.New("database error"))
+
+				volumes, err := repository.ClaimVolume(ctx, filters)
+
+				Expect(volumes).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("DecisionRates", func() {
+		It("should count the decisions taken in the range", func() {
+			rows := sqlmock.NewRows([]string{
+				"period", "office_id", "total", "approved", "partially_approved", "rejected",
+				"approval_rate", "partial_approval_rate", "rejection_rate",
+			}).AddRow(filters.FromDate, officeID, 4, 2, 1, 1, 50.0, 25.0, 25.0)
+
+			approved, partial, rejected := entity.ClaimStatusApproved, entity.ClaimStatusPartiallyApproved,
+				entity.ClaimStatusRejected
+			mock.ExpectQuery(`SELECT date_trunc\('week', claim_histories.changed_at\) AS period, .*`+
+				`COUNT\(\*\) FILTER \(WHERE claim_histories.status = \$1\) AS approved, .*`+
+				`FROM "claim_histories" JOIN \(SELECT DISTINCT claims.id AS claim_id, users.office_id .*\) `+
+				`AS claim_offices ON claim_offices.claim_id = claim_histories.claim_id .*`+
+				`claim_histories.status IN \(\$7,\$8,\$9\).*GROUP BY period, claim_offices.office_id`).
+				WithArgs(approved, partial, rejected, approved, partial, rejected,
+					approved, partial, rejected, filters.FromDate, filters.ToDate).
+				WillReturnRows(rows)
+
+			rates, err := repository.DecisionRates(ctx, filters)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rates).To(HaveLen(1))
+			Expect(rates[0].Total).To(Equal(int64(4)))
+			Expect(rates[0].ApprovalRate).To(Equal(50.0))
+			Expect(rates[0].RejectionRate).To(Equal(25.0))
+		})
+	})
+
+	Describe("TimeInStatus", func() {
+		Context("when the office is filtered", func() {
+			It("should average the spans between status changes", func() {
+				filters.OfficeID = &officeID
+				rows := sqlmock.NewRows([]string{"period", "status", "count", "avg_seconds"}).
+					AddRow(filters.FromDate, entity.ClaimStatusReviewing, 5, 86400.5)
+
+				mock.ExpectQuery(`AVG\(EXTRACT\(EPOCH FROM spans.next_changed_at - spans.changed_at\)\).*`+
+					`FROM \(SELECT .*LEAD\(changed_at\) OVER \(PARTITION BY claim_id ORDER BY changed_at\).*`+
+					`claim_id IN \(SELECT claims.id FROM "claims" JOIN users ON users.id IN \(claims.staff_id, `+
+					`claims.technician_id\).*users.office_id = \$1.*\) AS spans `+
+					`WHERE spans.next_changed_at IS NOT NULL AND \(spans.changed_at >= \$2 AND spans.changed_at <= \$3\) `+
+					`GROUP BY period, spans.status`).
+					WithArgs(officeID, filters.FromDate, filters.ToDate).
+					WillReturnRows(rows)
+
+				durations, err := repository.TimeInStatus(ctx, filters)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(durations).To(HaveLen(1))
+				Expect(durations[0].Status).To(Equal(entity.ClaimStatusReviewing))
+				Expect(durations[0].AvgSeconds).To(Equal(86400.5))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperation error", func() {
+				mock.ExpectQuery(`AS spans`).WillReturnError(errors.New("database error"))
+
+				durations, err := repository.TimeInStatus(ctx, filters)
+
+				Expect(durations).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("PartFailures", func() {
+		It("should rank the part categories by claim items", func() {
+			categoryID := uuid.New()
+			rows := sqlmock.NewRows([]string{"part_category_id", "items", "claims", "currency", "total_cost"}).
+				AddRow(categoryID, 7, 5, entity.DefaultCurrency, 3500000)
+
+			mock.ExpectQuery(`SELECT claim_items.part_category_id, COUNT\(\*\) AS items, .*FROM "claim_items" .*`+
+				`GROUP BY claim_items.part_category_id, claim_items.currency `+
+				`ORDER BY items DESC, total_cost DESC, claim_items.part_category_id LIMIT \$3`).
+				WithArgs(filters.FromDate, filters.ToDate, filters.Limit).
+				WillReturnRows(rows)
+
+			failures, err := repository.PartFailures(ctx, filters)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0].PartCategoryID).To(Equal(categoryID))
+			Expect(failures[0].TotalCost).To(Equal(int64(3500000)))
+		})
+	})
+
+	Describe("OfficeCosts", func() {
+		It("should sum the claim totals per period, office and currency", func() {
+			rows := sqlmock.NewRows([]string{
+				"period", "office_id", "currency", "claims", "requested_total", "approved_total", "reimbursed_total",
+			}).AddRow(filters.FromDate, officeID, entity.DefaultCurrency, 2, 900000, 700000, 0)
+
+			mock.ExpectQuery(`SELECT date_trunc\('week', claims.created_at\) AS period, .*`+
+				`SUM\(claims.requested_total\) AS requested_total, .*FROM "claims" .*`+
+				`GROUP BY period, claim_offices.office_id, claims.currency`).
+				WithArgs(filters.FromDate, filters.ToDate).
+				WillReturnRows(rows)
+
+			costs, err := repository.OfficeCosts(ctx, filters)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(costs).To(HaveLen(1))
+			Expect(costs[0].RequestedTotal).To(Equal(int64(900000)))
+			Expect(costs[0].ApprovedTotal).To(Equal(int64(700000)))
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type settlementBatchRepository struct {
+	db *gorm.DB
+}
+
+func NewSettlementBatchRepository(db *gorm.DB) repository.SettlementBatchRepository {
+	return &settlementBatchRepository{db: db}
+}
+
+func (s *settlementBatchRepository) Create(tx application.Tx, batch *entity.SettlementBatch) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Create(batch).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Claim item is already in a settlement batch").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (s *settlementBatchRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.SettlementBatch, error) {
+	var batch entity.SettlementBatch
+	if err := s.db.WithContext(ctx).
+		Preload("Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("claim_id, created_at")
+		}).
+		Where("id = ?", id).First(&batch).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFoundError.WithMessage("Settlement batch not found").WithError(err)
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &batch, nil
+}
+
+func (s *settlementBatchRepository) FindAll(ctx context.Context, filters repository.SettlementBatchFilters,
+) ([]*entity.SettlementBatch, error) {
+	db := s.db.WithContext(ctx)
+	if filters.OfficeID != nil {
+		db = db.Where("office_id = ?", *filters.OfficeID)
+	}
+	if filters.Status != nil {
+		db = db.Where("status = ?", *filters.Status)
+	}
+
+	var batches []*entity.SettlementBatch
+	if err := db.Order("period_start DESC, created_at DESC").Find(&batches).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return batches, nil
+}
+
+func (s *settlementBatchRepository) Update(tx application.Tx, batch *entity.SettlementBatch) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Model(batch).
+		Select("status", "dispute_reason", "issued_at", "paid_at").
+		Updates(batch).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (s *settlementBatchRepository) HardDelete(tx application.Tx, id uuid.UUID) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Delete(&entity.SettlementLine{}, "batch_id = ?", id).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	if err := db.Unscoped().Delete(&entity.SettlementBatch{}, "id = ?", id).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (s *settlementBatchRepository) FindUnsettledItems(tx application.Tx, officeID uuid.UUID, from, to time.Time,
+	currency string,
+) ([]*entity.ClaimItem, error) {
+	db := tx.GetTx().(*gorm.DB)
+	newDB := db.Session(&gorm.Session{NewDB: true})
+
+	completed := newDB.Model(&entity.ClaimHistory{}).Select("claim_id").
+		Where("claim_item_id IS NULL AND status = ? AND changed_at >= ? AND changed_at < ?",
+			entity.ClaimStatusCompleted, from, to)
+	settled := newDB.Model(&entity.SettlementLine{}).Select("claim_item_id")
+
+	var items []*entity.ClaimItem
+	if err := db.Model(&entity.ClaimItem{}).
+		Joins("JOIN claims ON claims.id = claim_items.claim_id AND claims.deleted_at IS NULL").
+		Where("claims.status = ?", entity.ClaimStatusCompleted).
+		Where("claims.id IN (?)", officeClaimIDs(db, officeID)).
+		Where("claims.id IN (?)", completed).
+		Where("claim_items.status = ? AND claim_items.currency = ?", entity.ClaimItemStatusApproved, currency).
+		Where("claim_items.id NOT IN (?)", settled).
+		Order("claim_items.claim_id, claim_items.created_at").
+		Find(&items).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return items, nil
+}
@@ -0,0 +1,281 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+type settlementBatchFilters = repository.SettlementBatchFilters
+
+var _ = Describe("SettlementBatchRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.SettlementBatchRepository
+		ctx        context.Context
+		mockTx     *mocks.Tx
+		batch      *entity.SettlementBatch
+		item       *entity.ClaimItem
+		columns    []string
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewSettlementBatchRepository(db)
+		ctx = context.Background()
+		mockTx = mocks.NewTx(GinkgoT())
+
+		start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+		batch = entity.NewSettlementBatch(uuid.New(), start, start.AddDate(0, 1, 0), entity.DefaultCurrency,
+			uuid.New())
+		item = &entity.ClaimItem{
+			ID:               uuid.New(),
+			ClaimID:          uuid.New(),
+			IssueDescription: "Battery module replaced",
+			Status:           entity.ClaimItemStatusApproved,
+			Currency:         entity.DefaultCurrency,
+			TotalCost:        1500000,
+		}
+		batch.AddItem(item)
+		columns = []string{
+			"id", "office_id", "period_start", "period_end", "status", "currency", "total_amount",
+			"dispute_reason", "created_by", "issued_at", "paid_at", "created_at", "updated_at", "deleted_at",
+		}
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		Context("when the batch is created successfully", func() {
+			It("should insert the batch with its lines", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "settlement_batches"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(batch.ID))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "settlement_lines"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(batch.Lines[0].ID))
+				mock.ExpectCommit()
+
+				err := repository.Create(mockTx, batch)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when a claim item is already settled", func() {
+			It("should return DBDuplicateKeyError", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "settlement_batches"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(batch.ID))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "settlement_lines"`)).
+					WillReturnError(&pgconn.PgError{
+						Code:           "23505",
+						ConstraintName: "uq_settlement_lines_claim_item_id",
+					})
+				mock.ExpectRollback()
+
+				err := repository.Create(mockTx, batch)
+
+				ExpectAppError(err, apperror.ErrDuplicateKey.ErrorCode)
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				MockInsertError(mock, "settlement_batches")
+
+				err := repository.Create(mockTx, batch)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByID", func() {
+		Context("when the batch is found", func() {
+			It("should return the batch with its lines", func() {
+				line := batch.Lines[0]
+				rows := sqlmock.NewRows(columns).AddRow(batch.ID, batch.OfficeID, batch.PeriodStart, batch.PeriodEnd,
+					entity.SettlementStatusDraft, batch.Currency, batch.TotalAmount, nil, batch.CreatedBy, nil, nil,
+					time.Now(), time.Now(), nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "settlement_batches" WHERE id = $1`)).
+					WithArgs(batch.ID, 1).
+					WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "settlement_lines" WHERE "settlement_lines"."batch_id" = $1 ` +
+					`ORDER BY claim_id, created_at`)).
+					WithArgs(batch.ID).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "batch_id", "claim_id", "claim_item_id", "description", "amount", "created_at",
+					}).AddRow(line.ID, batch.ID, line.ClaimID, line.ClaimItemID, line.Description, line.Amount,
+						time.Now()))
+
+				found, err := repository.FindByID(ctx, batch.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.TotalAmount).To(Equal(int64(1500000)))
+				Expect(found.Lines).To(HaveLen(1))
+				Expect(found.Lines[0].ClaimItemID).To(Equal(item.ID))
+			})
+		})
+
+		Context("when the batch is not found", func() {
+			It("should return NotFound error", func() {
+				MockNotFound(mock, "settlement_batches", batch.ID)
+
+				found, err := repository.FindByID(ctx, batch.ID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindAll", func() {
+		Context("when filtered by office and status", func() {
+			It("should return the matching batches", func() {
+				status := entity.SettlementStatusIssued
+				rows := sqlmock.NewRows(columns).AddRow(batch.ID, batch.OfficeID, batch.PeriodStart, batch.PeriodEnd,
+					status, batch.Currency, batch.TotalAmount, nil, batch.CreatedBy, time.Now(), nil,
+					time.Now(), time.Now(), nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "settlement_batches" WHERE office_id = $1 `+
+					`AND status = $2 AND "settlement_batches"."deleted_at" IS NULL `+
+					`ORDER BY period_start DESC, created_at DESC`)).
+					WithArgs(batch.OfficeID, status).
+					WillReturnRows(rows)
+
+				batches, err := repository.FindAll(ctx, settlementBatchFilters{
+					OfficeID: &batch.OfficeID,
+					Status:   &status,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(batches).To(HaveLen(1))
+				Expect(batches[0].Status).To(Equal(status))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT * FROM "settlement_batches"`)
+
+				batches, err := repository.FindAll(ctx, settlementBatchFilters{})
+
+				Expect(batches).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		Context("when the batch is updated successfully", func() {
+			It("should return nil error", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				MockSuccessfulUpdate(mock, "settlement_batches")
+
+				batch.Status = entity.SettlementStatusIssued
+				err := repository.Update(mockTx, batch)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				MockUpdateError(mock, "settlement_batches")
+
+				err := repository.Update(mockTx, batch)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("HardDelete", func() {
+		Context("when the batch is deleted successfully", func() {
+			It("should delete the lines and the batch", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "settlement_lines" WHERE batch_id = $1`)).
+					WithArgs(batch.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "settlement_batches" WHERE id = $1`)).
+					WithArgs(batch.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+
+				err := repository.HardDelete(mockTx, batch.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "settlement_lines"`)).
+					WillReturnError(errors.New("database connection failed"))
+				mock.ExpectRollback()
+
+				err := repository.HardDelete(mockTx, batch.ID)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindUnsettledItems", func() {
+		Context("when there are approved items left to settle", func() {
+			It("should return them", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT "claim_items"."id"`)).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "claim_id", "issue_description", "status", "currency", "total_cost",
+					}).AddRow(item.ID, item.ClaimID, item.IssueDescription, item.Status, item.Currency,
+						item.TotalCost))
+
+				items, err := repository.FindUnsettledItems(mockTx, batch.OfficeID, batch.PeriodStart,
+					batch.PeriodEnd, entity.DefaultCurrency)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(items).To(HaveLen(1))
+				Expect(items[0].TotalCost).To(Equal(int64(1500000)))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				mockTx.EXPECT().GetTx().Return(db)
+				MockQueryError(mock, `SELECT "claim_items"."id"`)
+
+				items, err := repository.FindUnsettledItems(mockTx, batch.OfficeID, batch.PeriodStart,
+					batch.PeriodEnd, entity.DefaultCurrency)
+
+				Expect(items).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
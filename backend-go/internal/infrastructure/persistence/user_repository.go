@@ -90,6 +90,31 @@ func (u *userRepository) FindByOAuth(ctx context.Context, provider, oauthID stri
 	return &user, nil
 }
 
+func (u *userRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.User, error) {
+	var users []*entity.User
+	if err := u.db.WithContext(ctx).Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return users, nil
+}
+
+func (u *userRepository) FindActiveByRole(ctx context.Context, role string) ([]*entity.User, error) {
+	var users []*entity.User
+	if err := u.db.WithContext(ctx).Where("role = ? AND is_active = ?", role, true).
+		Find(&users).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return users, nil
+}
+
+func (u *userRepository) FindByOfficeID(ctx context.Context, officeID uuid.UUID) ([]*entity.User, error) {
+	var users []*entity.User
+	if err := u.db.WithContext(ctx).Where("office_id = ?", officeID).Find(&users).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return users, nil
+}
+
 func getDuplicateKeyConstraint(err error) string {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -437,6 +437,88 @@ var _ = Describe("UserRepository", func() {
 			})
 		})
 	})
+
+	Describe("FindByIDs", func() {
+		It("should return the users that still exist", func() {
+			user := newUser()
+			missingID := uuid.New()
+			rows := sqlmock.NewRows([]string{"id", "name", "email", "role", "is_active", "office_id"}).
+				AddRow(user.ID, user.Name, user.Email, user.Role, user.IsActive, user.OfficeID)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id IN ($1,$2) AND "users"."deleted_at" IS NULL`)).
+				WithArgs(user.ID, missingID).
+				WillReturnRows(rows)
+
+			users, err := repository.FindByIDs(ctx, []uuid.UUID{user.ID, missingID})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(HaveLen(1))
+			Expect(users[0].Email).To(Equal(user.Email))
+		})
+
+		It("should return DBOperationError on database error", func() {
+			MockQueryError(mock, `SELECT * FROM "users"`)
+
+			users, err := repository.FindByIDs(ctx, []uuid.UUID{uuid.New()})
+
+			Expect(users).To(BeNil())
+			ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+		})
+	})
+
+	Describe("FindActiveByRole", func() {
+		It("should return the active users of the role", func() {
+			user := newUser()
+			rows := sqlmock.NewRows([]string{"id", "name", "email", "role", "is_active", "office_id"}).
+				AddRow(user.ID, user.Name, user.Email, entity.UserRoleEvmStaff, true, user.OfficeID)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE (role = $1 AND is_active = $2) AND "users"."deleted_at" IS NULL`)).
+				WithArgs(entity.UserRoleEvmStaff, true).
+				WillReturnRows(rows)
+
+			users, err := repository.FindActiveByRole(ctx, entity.UserRoleEvmStaff)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(HaveLen(1))
+			Expect(users[0].Role).To(Equal(entity.UserRoleEvmStaff))
+		})
+
+		It("should return DBOperationError on database error", func() {
+			MockQueryError(mock, `SELECT * FROM "users"`)
+
+			users, err := repository.FindActiveByRole(ctx, entity.UserRoleEvmStaff)
+
+			Expect(users).To(BeNil())
+			ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+		})
+	})
+
+	Describe("FindByOfficeID", func() {
+		It("should return the users of the office", func() {
+			user := newUser()
+			rows := sqlmock.NewRows([]string{"id", "name", "email", "role", "is_active", "office_id"}).
+				AddRow(user.ID, user.Name, user.Email, entity.UserRoleScStaff, false, user.OfficeID)
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE office_id = $1 AND "users"."deleted_at" IS NULL`)).
+				WithArgs(user.OfficeID).
+				WillReturnRows(rows)
+
+			users, err := repository.FindByOfficeID(ctx, user.OfficeID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(HaveLen(1))
+			Expect(users[0].ID).To(Equal(user.ID))
+		})
+
+		It("should return DBOperationError on database error", func() {
+			MockQueryError(mock, `SELECT * FROM "users"`)
+
+			users, err := repository.FindByOfficeID(ctx, uuid.New())
+
+			Expect(users).To(BeNil())
+			ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+		})
+	})
 })
 
 func newUser() *entity.User {
@@ -0,0 +1,93 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type webhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+func (w *webhookDeliveryRepository) Create(tx application.Tx, delivery *entity.WebhookDelivery) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(delivery).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (w *webhookDeliveryRepository) Update(tx application.Tx, delivery *entity.WebhookDelivery) error {
+	db := tx.GetTx().(*gorm.DB)
+	if err := db.Model(delivery).
+		Select("status", "attempts", "last_error", "response_status", "next_attempt_at", "delivered_at").
+		Updates(delivery).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (w *webhookDeliveryRepository) FindByID(ctx context.Context, id uuid.UUID,
+) (*entity.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+	if err := w.db.WithContext(ctx).Where("id = ?", id).First(&delivery).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFoundError.WithMessage("Webhook delivery not found").WithError(err)
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &delivery, nil
+}
+
+func (w *webhookDeliveryRepository) FindBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID,
+	limit int,
+) ([]*entity.WebhookDelivery, error) {
+	var deliveries []*entity.WebhookDelivery
+	if err := w.db.WithContext(ctx).
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return deliveries, nil
+}
+
+func (w *webhookDeliveryRepository) FindDue(tx application.Tx, now time.Time, limit int,
+) ([]*entity.WebhookDelivery, error) {
+	db := tx.GetTx().(*gorm.DB)
+	var deliveries []*entity.WebhookDelivery
+	if err := db.
+		Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Table:    clause.Table{Name: clause.CurrentTable},
+			Options:  "SKIP LOCKED",
+		}).
+		InnerJoins("Subscription", db.Where(&entity.WebhookSubscription{IsActive: true})).
+		Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?",
+			entity.WebhookDeliveryStatusPending, now).
+		Order("webhook_deliveries.created_at").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,185 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("WebhookDeliveryRepository", func() {
+	var (
+		mock       sqlmock.Sqlmock
+		db         *gorm.DB
+		repository repository.WebhookDeliveryRepository
+		ctx        context.Context
+		mockTx     *mocks.Tx
+		delivery   *entity.WebhookDelivery
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewWebhookDeliveryRepository(db)
+		ctx = context.Background()
+		mockTx = mocks.NewTx(GinkgoT())
+		delivery = entity.NewWebhookDelivery(uuid.New(), uuid.New(), entity.EventClaimApproved,
+			[]byte(`{"event_type":"ClaimApproved"}`))
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when delivery is created successfully", func() {
+			It("should ignore an existing delivery of the same event", func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhook_deliveries"`) +
+					`.*` + regexp.QuoteMeta(`ON CONFLICT ("subscription_id","event_id") DO NOTHING`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(delivery.ID))
+				mock.ExpectCommit()
+
+				err := repository.Create(mockTx, delivery)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockInsertError(mock, "webhook_deliveries")
+
+				err := repository.Create(mockTx, delivery)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		BeforeEach(func() {
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when the delivery state is saved", func() {
+			It("should only update the delivery columns", func() {
+				delivery.MarkFailed(errors.New("unexpected status code 500"), 500, time.Now().Add(time.Minute), 5)
+
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "status"=$1,"attempts"=$2,`+
+					`"last_error"=$3,"response_status"=$4,"next_attempt_at"=$5,"delivered_at"=$6 WHERE "id" = $7`)).
+					WithArgs(entity.WebhookDeliveryStatusPending, 1, "unexpected status code 500", 500,
+						delivery.NextAttemptAt, nil, delivery.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := repository.Update(mockTx, delivery)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockUpdateError(mock, "webhook_deliveries")
+
+				err := repository.Update(mockTx, delivery)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByID", func() {
+		Context("when delivery is not found", func() {
+			It("should return NotFoundError", func() {
+				MockNotFound(mock, "webhook_deliveries", delivery.ID)
+
+				found, err := repository.FindByID(ctx, delivery.ID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindBySubscriptionID", func() {
+		Context("when the subscription has deliveries", func() {
+			It("should return the latest first", func() {
+				rows := sqlmock.NewRows([]string{"id", "subscription_id", "event_id", "event_type", "payload",
+					"status", "attempts", "next_attempt_at", "created_at"}).
+					AddRow(delivery.ID, delivery.SubscriptionID, delivery.EventID, delivery.EventType,
+						[]byte(delivery.Payload), entity.WebhookDeliveryStatusDead, 5, time.Now(), time.Now())
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE subscription_id = $1 `+
+					`ORDER BY created_at DESC LIMIT $2`)).
+					WithArgs(delivery.SubscriptionID, 50).
+					WillReturnRows(rows)
+
+				deliveries, err := repository.FindBySubscriptionID(ctx, delivery.SubscriptionID, 50)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+				Expect(deliveries[0].Status).To(Equal(entity.WebhookDeliveryStatusDead))
+			})
+		})
+	})
+
+	Describe("FindDue", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Now()
+			mockTx.EXPECT().GetTx().Return(db)
+		})
+
+		Context("when pending deliveries are due", func() {
+			It("should lock and return them with their subscription", func() {
+				rows := sqlmock.NewRows([]string{"id", "subscription_id", "event_id", "event_type", "payload",
+					"status", "attempts", "next_attempt_at", "created_at",
+					"Subscription__id", "Subscription__url", "Subscription__secret", "Subscription__is_active"}).
+					AddRow(delivery.ID, delivery.SubscriptionID, delivery.EventID, delivery.EventType,
+						[]byte(delivery.Payload), entity.WebhookDeliveryStatusPending, 0, now, now,
+						delivery.SubscriptionID, "https://dealer.example.com/hooks", "secret", true)
+
+				mock.ExpectQuery(`FOR UPDATE OF "webhook_deliveries" SKIP LOCKED`).
+					WithArgs(true, entity.WebhookDeliveryStatusPending, now, 20).
+					WillReturnRows(rows)
+
+				deliveries, err := repository.FindDue(mockTx, now, 20)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+				Expect(deliveries[0].Subscription).NotTo(BeNil())
+				Expect(deliveries[0].Subscription.Secret).To(Equal("secret"))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT`)
+
+				deliveries, err := repository.FindDue(mockTx, now, 20)
+
+				Expect(deliveries).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
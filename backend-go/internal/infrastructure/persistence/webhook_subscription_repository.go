@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/apperror"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type webhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) repository.WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{db: db}
+}
+
+func (w *webhookSubscriptionRepository) Create(ctx context.Context, subscription *entity.WebhookSubscription,
+) error {
+	if err := w.db.WithContext(ctx).Create(subscription).Error; err != nil {
+		if dup := getDuplicateKeyConstraint(err); dup != "" {
+			return apperror.ErrDuplicateKey.WithMessage("Webhook subscription with " + dup + " already existed").
+				WithError(err)
+		}
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (w *webhookSubscriptionRepository) FindByID(ctx context.Context, id uuid.UUID,
+) (*entity.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+	if err := w.db.WithContext(ctx).Where("id = ?", id).First(&subscription).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.ErrNotFoundError.WithMessage("Webhook subscription not found").WithError(err)
+		}
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return &subscription, nil
+}
+
+func (w *webhookSubscriptionRepository) FindAll(ctx context.Context, officeID *uuid.UUID,
+) ([]*entity.WebhookSubscription, error) {
+	db := w.db.WithContext(ctx)
+	if officeID != nil {
+		db = db.Where("office_id = ?", *officeID)
+	}
+
+	var subscriptions []*entity.WebhookSubscription
+	if err := db.Order("created_at").Find(&subscriptions).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return subscriptions, nil
+}
+
+func (w *webhookSubscriptionRepository) FindMatching(ctx context.Context, claimID uuid.UUID, eventType string,
+) ([]*entity.WebhookSubscription, error) {
+	claimOffices := w.db.Session(&gorm.Session{NewDB: true}).
+		Table("users").
+		Select("users.office_id").
+		Joins("JOIN claims ON users.id = claims.staff_id OR users.id = claims.technician_id").
+		Where("claims.id = ?", claimID)
+
+	var subscriptions []*entity.WebhookSubscription
+	if err := w.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("cardinality(event_types) = 0 OR ? = ANY(event_types)", eventType).
+		Where("office_id IN (?)", claimOffices).
+		Find(&subscriptions).Error; err != nil {
+		return nil, apperror.ErrDBOperation.WithError(err)
+	}
+	return subscriptions, nil
+}
+
+func (w *webhookSubscriptionRepository) Update(ctx context.Context, subscription *entity.WebhookSubscription,
+) error {
+	if err := w.db.WithContext(ctx).Model(subscription).
+		Select("url", "event_types", "is_active").
+		Updates(subscription).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
+
+func (w *webhookSubscriptionRepository) SoftDelete(ctx context.Context, id uuid.UUID) error {
+	if err := w.db.WithContext(ctx).Delete(&entity.WebhookSubscription{}, "id = ?", id).Error; err != nil {
+		return apperror.ErrDBOperation.WithError(err)
+	}
+	return nil
+}
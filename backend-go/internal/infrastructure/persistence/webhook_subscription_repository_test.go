@@ -0,0 +1,219 @@
+package persistence_test
+
+import (
+	"context"
+	"ev-warranty-go/pkg/apperror"
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/persistence"
+)
+
+var _ = Describe("WebhookSubscriptionRepository", func() {
+	var (
+		mock         sqlmock.Sqlmock
+		db           *gorm.DB
+		repository   repository.WebhookSubscriptionRepository
+		ctx          context.Context
+		subscription *entity.WebhookSubscription
+		columns      []string
+	)
+
+	BeforeEach(func() {
+		mock, db = SetupMockDB()
+		repository = persistence.NewWebhookSubscriptionRepository(db)
+		ctx = context.Background()
+		subscription = entity.NewWebhookSubscription(uuid.New(), "https://dealer.example.com/hooks",
+			[]string{entity.EventClaimApproved}, "secret", true)
+		columns = []string{"id", "office_id", "url", "event_types", "secret", "is_active",
+			"created_at", "updated_at", "deleted_at"}
+	})
+
+	AfterEach(func() {
+		CleanupMockDB(mock)
+	})
+
+	Describe("Create", func() {
+		Context("when subscription is created successfully", func() {
+			It("should return nil error", func() {
+				MockSuccessfulInsert(mock, "webhook_subscriptions", subscription.ID)
+
+				err := repository.Create(ctx, subscription)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockInsertError(mock, "webhook_subscriptions")
+
+				err := repository.Create(ctx, subscription)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindByID", func() {
+		Context("when subscription is found", func() {
+			It("should return the subscription with its event types", func() {
+				rows := sqlmock.NewRows(columns).AddRow(subscription.ID, subscription.OfficeID, subscription.URL,
+					`{ClaimApproved,ClaimRejected}`, subscription.Secret, true, time.Now(), time.Now(), nil)
+
+				MockFindByID(mock, "webhook_subscriptions", subscription.ID, rows)
+
+				found, err := repository.FindByID(ctx, subscription.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.ID).To(Equal(subscription.ID))
+				Expect(found.Secret).To(Equal(subscription.Secret))
+				Expect([]string(found.EventTypes)).To(Equal(
+					[]string{entity.EventClaimApproved, entity.EventClaimRejected}))
+			})
+		})
+
+		Context("when subscription is not found", func() {
+			It("should return NotFoundError", func() {
+				MockNotFound(mock, "webhook_subscriptions", subscription.ID)
+
+				found, err := repository.FindByID(ctx, subscription.ID)
+
+				Expect(found).To(BeNil())
+				ExpectAppError(err, apperror.ErrNotFoundError.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindAll", func() {
+		Context("when an office is given", func() {
+			It("should only return the subscriptions of that office", func() {
+				rows := sqlmock.NewRows(columns).AddRow(subscription.ID, subscription.OfficeID, subscription.URL,
+					`{}`, subscription.Secret, true, time.Now(), time.Now(), nil)
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_subscriptions" WHERE office_id = $1 ` +
+					`AND "webhook_subscriptions"."deleted_at" IS NULL ORDER BY created_at`)).
+					WithArgs(subscription.OfficeID).
+					WillReturnRows(rows)
+
+				subscriptions, err := repository.FindAll(ctx, &subscription.OfficeID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subscriptions).To(HaveLen(1))
+				Expect(subscriptions[0].EventTypes).To(BeEmpty())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, `SELECT * FROM "webhook_subscriptions"`)
+
+				subscriptions, err := repository.FindAll(ctx, nil)
+
+				Expect(subscriptions).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("FindMatching", func() {
+		var (
+			claimID uuid.UUID
+			query   string
+		)
+
+		BeforeEach(func() {
+			claimID = uuid.New()
+			query = `SELECT * FROM "webhook_subscriptions" WHERE is_active = $1 ` +
+				`AND (cardinality(event_types) = 0 OR $2 = ANY(event_types)) ` +
+				`AND office_id IN (SELECT users.office_id FROM "users" ` +
+				`JOIN claims ON users.id = claims.staff_id OR users.id = claims.technician_id ` +
+				`WHERE claims.id = $3) AND "webhook_subscriptions"."deleted_at" IS NULL`
+		})
+
+		Context("when subscriptions listen to the event", func() {
+			It("should return them", func() {
+				rows := sqlmock.NewRows(columns).AddRow(subscription.ID, subscription.OfficeID, subscription.URL,
+					`{ClaimApproved}`, subscription.Secret, true, time.Now(), time.Now(), nil)
+
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(true, entity.EventClaimApproved, claimID).
+					WillReturnRows(rows)
+
+				subscriptions, err := repository.FindMatching(ctx, claimID, entity.EventClaimApproved)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subscriptions).To(HaveLen(1))
+				Expect(subscriptions[0].ID).To(Equal(subscription.ID))
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockQueryError(mock, query)
+
+				subscriptions, err := repository.FindMatching(ctx, claimID, entity.EventClaimApproved)
+
+				Expect(subscriptions).To(BeNil())
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		Context("when subscription is updated successfully", func() {
+			It("should only update the editable columns", func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_subscriptions" SET "url"=$1,"event_types"=$2,`+
+					`"is_active"=$3,"updated_at"=$4 WHERE "webhook_subscriptions"."deleted_at" IS NULL AND "id" = $5`)).
+					WithArgs(subscription.URL, sqlmock.AnyArg(), true, sqlmock.AnyArg(), subscription.ID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := repository.Update(ctx, subscription)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockUpdateError(mock, "webhook_subscriptions")
+
+				err := repository.Update(ctx, subscription)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+
+	Describe("SoftDelete", func() {
+		Context("when subscription is deleted successfully", func() {
+			It("should return nil error", func() {
+				MockSoftDelete(mock, "webhook_subscriptions", subscription.ID)
+
+				err := repository.SoftDelete(ctx, subscription.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is a database error", func() {
+			It("should return DBOperationError", func() {
+				MockDeleteError(mock, "webhook_subscriptions")
+
+				err := repository.SoftDelete(ctx, subscription.ID)
+
+				ExpectAppError(err, apperror.ErrDBOperation.ErrorCode)
+			})
+		})
+	})
+})
@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/infrastructure/config"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+const (
+	cloudinaryDownloadTimeout = 5 * time.Minute
+	// cloudinaryDownloadURLTTL is how long the private URL a file is
+	// downloaded from for processing is valid.
+	cloudinaryDownloadURLTTL = time.Minute
+)
+
+type cloudinaryStorage struct {
+	cld          *cloudinary.Cloudinary
+	uploadFolder string
+	client       *http.Client
+}
+
+// NewCloudinaryStorage returns a storage that uploads files to Cloudinary as
+// authenticated assets, which are only delivered through private download
+// URLs. Cloudinary detects the content type. Keys are
+// <resource type>/<delivery type>/<public ID>.<format>.
+func NewCloudinaryStorage(cfg *config.CloudinaryConfig) (AttachmentStorage, error) {
+	cld, err := cloudinary.NewFromURL(cfg.URL)
+	if err != nil {
+		return nil, apperror.ErrFailedInitializeCloudinary.WithError(err)
+	}
+	cld.Config.URL.Secure = true
+
+	return &cloudinaryStorage{
+		cld:          cld,
+		uploadFolder: cfg.UploadFolder,
+		client:       &http.Client{Timeout: cloudinaryDownloadTimeout},
+	}, nil
+}
+
+func (s *cloudinaryStorage) UploadFile(ctx context.Context, file multipart.File, resourceType, _ string,
+) (string, error) {
+	uploadParams := uploader.UploadParams{
+		ResourceType: resourceType,
+		Type:         api.Authenticated,
+	}
+
+	if s.uploadFolder != "" {
+		uploadParams.Folder = s.uploadFolder
+	}
+
+	resp, err := s.cld.Upload.Upload(ctx, file, uploadParams)
+	if err == nil && resp.Error.Message != "" {
+		err = errors.New(resp.Error.Message)
+	}
+	if err != nil {
+		return "", apperror.ErrFailedUploadCloudinary.WithError(err)
+	}
+
+	key := resp.ResourceType + "/" + string(api.Authenticated) + "/" + resp.PublicID
+	if resp.Format != "" {
+		key += "." + resp.Format
+	}
+	return key, nil
+}
+
+func (s *cloudinaryStorage) SignedURL(_ context.Context, key string, expiresAt time.Time) (string, error) {
+	asset, err := parseCloudinaryKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	signedURL, err := s.cld.Upload.PrivateDownloadURL(uploader.PrivateDownloadURLParams{
+		PublicID:     asset.publicID,
+		Format:       asset.format,
+		DeliveryType: asset.deliveryType,
+		ExpiresAt:    &expiresAt,
+		ResourceType: api.AssetType(asset.resourceType),
+	})
+	if err != nil {
+		return "", apperror.ErrInvalidStorageKey.WithError(err)
+	}
+	return signedURL, nil
+}
+
+// DownloadFile fetches the file through a short lived private download URL.
+func (s *cloudinaryStorage) DownloadFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	downloadURL, err := s.SignedURL(ctx, key, time.Now().Add(cloudinaryDownloadURLTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, apperror.ErrFailedDownloadStorage.WithError(err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, apperror.ErrFailedDownloadStorage.WithError(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, apperror.ErrFailedDownloadStorage.WithError(fmt.Errorf("cloudinary returned %s", resp.Status))
+	}
+	return resp.Body, nil
+}
+
+func (s *cloudinaryStorage) DeleteFile(ctx context.Context, key string) error {
+	asset, err := parseCloudinaryKey(key)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.cld.Upload.Destroy(ctx, uploader.DestroyParams{
+		PublicID:     asset.publicID,
+		Type:         asset.deliveryType,
+		ResourceType: asset.resourceType,
+	})
+	if err == nil && resp.Error.Message != "" {
+		err = errors.New(resp.Error.Message)
+	}
+	if err != nil {
+		return apperror.ErrFailedDeleteCloudinary.WithError(err)
+	}
+	return nil
+}
+
+// MakePrivate moves a file uploaded as a public asset, before files were
+// uploaded as authenticated assets, to the authenticated delivery type. Its
+// public URLs stop working and their cached copies are invalidated.
+func (s *cloudinaryStorage) MakePrivate(ctx context.Context, key string) (string, error) {
+	asset, err := parseCloudinaryKey(key)
+	if err != nil {
+		return "", err
+	}
+	if asset.deliveryType != string(api.Upload) {
+		return key, nil
+	}
+
+	invalidate := true
+	resp, err := s.cld.Upload.Rename(ctx, uploader.RenameParams{
+		FromPublicID: asset.publicID,
+		ToPublicID:   asset.publicID,
+		Type:         string(api.Upload),
+		ToType:       string(api.Authenticated),
+		ResourceType: asset.resourceType,
+		Invalidate:   &invalidate,
+	})
+	if err == nil && resp.Error != nil {
+		err = fmt.Errorf("%v", resp.Error)
+	}
+	if err != nil {
+		return "", apperror.ErrFailedUploadCloudinary.WithError(err)
+	}
+
+	return asset.resourceType + "/" + string(api.Authenticated) + "/" + strings.TrimPrefix(key,
+		asset.resourceType+"/"+asset.deliveryType+"/"), nil
+}
+
+type cloudinaryAsset struct {
+	resourceType string
+	deliveryType string
+	publicID     string
+	format       string
+}
+
+func parseCloudinaryKey(key string) (*cloudinaryAsset, error) {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, apperror.ErrInvalidStorageKey
+	}
+
+	format := strings.TrimPrefix(path.Ext(parts[2]), ".")
+	return &cloudinaryAsset{
+		resourceType: parts[0],
+		deliveryType: parts[1],
+		publicID:     strings.TrimSuffix(parts[2], path.Ext(parts[2])),
+		format:       format,
+	}, nil
+}
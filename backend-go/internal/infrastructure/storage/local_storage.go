@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"ev-warranty-go/pkg/apperror"
+	"io"
+	"io/fs"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilesPath is where the server serves the files of the local storage,
+// followed by their key.
+const FilesPath = "/files/"
+
+// FileServer resolves the signed URLs of the local storage to the file they
+// point to.
+type FileServer interface {
+	Resolve(key string, expiresAt time.Time, signature string) (string, error)
+}
+
+type localStorage struct {
+	dir        string
+	baseURL    string
+	signingKey []byte
+}
+
+// NewLocalStorage returns a storage that writes files under dir and serves
+// them from baseURL, the public URL of this server, under FilesPath. Its
+// signed URLs carry their expiry and an HMAC of the key and expiry made with
+// signingKey, which the server checks with Resolve before sending the file.
+func NewLocalStorage(dir, baseURL, signingKey string) (AttachmentStorage, error) {
+	parsed, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, apperror.ErrFailedInitializeStorage.WithMessage("Invalid local storage base URL")
+	}
+	if signingKey == "" {
+		return nil, apperror.ErrFailedInitializeStorage.WithMessage("Missing local storage signing key")
+	}
+
+	return &localStorage{
+		dir:        dir,
+		baseURL:    parsed.String(),
+		signingKey: []byte(signingKey),
+	}, nil
+}
+
+func (s *localStorage) UploadFile(_ context.Context, file multipart.File, resourceType, contentType string,
+) (string, error) {
+	key := newObjectKey(resourceType, contentType)
+	filePath := s.filePath(key)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return "", apperror.ErrFailedUploadStorage.WithError(err)
+	}
+
+	out, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", apperror.ErrFailedUploadStorage.WithError(err)
+	}
+	_, err = io.Copy(out, file)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(filePath)
+		return "", apperror.ErrFailedUploadStorage.WithError(err)
+	}
+
+	return key, nil
+}
+
+func (s *localStorage) SignedURL(_ context.Context, key string, expiresAt time.Time) (string, error) {
+	if !isValidKey(key) {
+		return "", apperror.ErrInvalidStorageKey
+	}
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("signature", s.sign(key, expiresAt))
+	return s.baseURL + FilesPath + key + "?" + query.Encode(), nil
+}
+
+func (s *localStorage) DownloadFile(_ context.Context, key string) (io.ReadCloser, error) {
+	if !isValidKey(key) {
+		return nil, apperror.ErrInvalidStorageKey
+	}
+
+	file, err := os.Open(s.filePath(key))
+	if err != nil {
+		return nil, apperror.ErrFailedDownloadStorage.WithError(err)
+	}
+	return file, nil
+}
+
+func (s *localStorage) DeleteFile(_ context.Context, key string) error {
+	if !isValidKey(key) {
+		return apperror.ErrInvalidStorageKey
+	}
+
+	err := os.Remove(s.filePath(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return apperror.ErrFailedDeleteStorage.WithError(err)
+	}
+	return nil
+}
+
+func (s *localStorage) Resolve(key string, expiresAt time.Time, signature string) (string, error) {
+	if !isValidKey(key) || !hmac.Equal([]byte(signature), []byte(s.sign(key, expiresAt))) ||
+		time.Now().After(expiresAt) {
+		return "", apperror.ErrInvalidFileSignature
+	}
+
+	filePath := s.filePath(key)
+	info, err := os.Stat(filePath)
+	if err != nil || info.IsDir() {
+		return "", apperror.ErrNotFoundError.WithMessage("File not found")
+	}
+	return filePath, nil
+}
+
+func (s *localStorage) filePath(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
+
+func (s *localStorage) sign(key string, expiresAt time.Time) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expiresAt.Unix(), 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// isValidKey rejects keys that would resolve outside the storage directory.
+func isValidKey(key string) bool {
+	return key != "" && path.Clean(key) == key && !path.IsAbs(key) && key != ".." &&
+		!strings.HasPrefix(key, "../")
+}
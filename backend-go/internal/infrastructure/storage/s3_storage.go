@@ -0,0 +1,285 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"ev-warranty-go/pkg/apperror"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service        = "s3"
+	s3Algorithm      = "AWS4-HMAC-SHA256"
+	s3RequestTimeout = 5 * time.Minute
+	// s3MaxExpiry is the longest validity S3 accepts for a presigned URL.
+	s3MaxExpiry = 7 * 24 * time.Hour
+	// emptyPayloadHash is the SHA-256 of an empty body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+)
+
+// S3Config locates a bucket of an S3 compatible service such as MinIO.
+// PathStyle addresses the bucket in the path rather than the host name, as
+// MinIO expects. PublicEndpoint is the endpoint clients download from, when
+// it differs from the one this server reaches, and defaults to Endpoint.
+type S3Config struct {
+	Endpoint       string
+	PublicEndpoint string
+	Region         string
+	Bucket         string
+	AccessKey      string
+	SecretKey      string
+	PathStyle      bool
+}
+
+type s3Storage struct {
+	cfg             S3Config
+	bucketURL       *url.URL
+	publicBucketURL *url.URL
+	client          *http.Client
+}
+
+// NewS3Storage returns a storage that puts files in a private S3 compatible
+// bucket, signing its requests with AWS Signature Version 4, and serves them
+// through presigned URLs.
+func NewS3Storage(cfg S3Config) (AttachmentStorage, error) {
+	if cfg.Bucket == "" {
+		return nil, apperror.ErrFailedInitializeStorage.WithMessage("Missing S3 bucket")
+	}
+	bucketURL, err := s3BucketURL(cfg.Endpoint, cfg.Bucket, cfg.PathStyle)
+	if err != nil {
+		return nil, apperror.ErrFailedInitializeStorage.WithMessage("Invalid S3 endpoint")
+	}
+	publicBucketURL := bucketURL
+	if cfg.PublicEndpoint != "" {
+		publicBucketURL, err = s3BucketURL(cfg.PublicEndpoint, cfg.Bucket, cfg.PathStyle)
+		if err != nil {
+			return nil, apperror.ErrFailedInitializeStorage.WithMessage("Invalid S3 public endpoint")
+		}
+	}
+
+	return &s3Storage{
+		cfg:             cfg,
+		bucketURL:       bucketURL,
+		publicBucketURL: publicBucketURL,
+		client:          &http.Client{Timeout: s3RequestTimeout},
+	}, nil
+}
+
+func s3BucketURL(endpoint, bucket string, pathStyle bool) (*url.URL, error) {
+	bucketURL, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	if bucketURL.Scheme == "" || bucketURL.Host == "" {
+		return nil, fmt.Errorf("endpoint %q has no scheme or host", endpoint)
+	}
+	if pathStyle {
+		bucketURL.Path += "/" + bucket
+	} else {
+		bucketURL.Host = bucket + "." + bucketURL.Host
+	}
+	return bucketURL, nil
+}
+
+func (s *s3Storage) UploadFile(ctx context.Context, file multipart.File, resourceType, contentType string,
+) (string, error) {
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		return "", apperror.ErrFailedUploadStorage.WithError(err)
+	}
+
+	key := newObjectKey(resourceType, contentType)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(s.bucketURL, key),
+		io.NopCloser(file))
+	if err != nil {
+		return "", apperror.ErrFailedUploadStorage.WithError(err)
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	if err = s.do(req, hex.EncodeToString(hash.Sum(nil))); err != nil {
+		return "", apperror.ErrFailedUploadStorage.WithError(err)
+	}
+	return key, nil
+}
+
+// SignedURL presigns a GET of the object, see
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+func (s *s3Storage) SignedURL(_ context.Context, key string, expiresAt time.Time) (string, error) {
+	if !isValidKey(key) {
+		return "", apperror.ErrInvalidStorageKey
+	}
+
+	now := time.Now()
+	expiry := expiresAt.Sub(now).Round(time.Second)
+	expiry = min(max(expiry, time.Second), s3MaxExpiry)
+
+	objectURL, err := url.Parse(s.objectURL(s.publicBucketURL, key))
+	if err != nil {
+		return "", apperror.ErrInvalidStorageKey.WithError(err)
+	}
+	amzDate, scope := s.scope(now)
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", s.cfg.AccessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expiry.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	objectURL.RawQuery = query.Encode()
+
+	signature := s.signature(http.MethodGet, objectURL, map[string]string{"host": objectURL.Host},
+		unsignedPayload, amzDate, scope)
+	objectURL.RawQuery += "&X-Amz-Signature=" + signature
+	return objectURL.String(), nil
+}
+
+func (s *s3Storage) DownloadFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	if !isValidKey(key) {
+		return nil, apperror.ErrInvalidStorageKey
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(s.bucketURL, key), nil)
+	if err != nil {
+		return nil, apperror.ErrFailedDownloadStorage.WithError(err)
+	}
+	resp, err := s.send(req, emptyPayloadHash)
+	if err != nil {
+		return nil, apperror.ErrFailedDownloadStorage.WithError(err)
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Storage) DeleteFile(ctx context.Context, key string) error {
+	if !isValidKey(key) {
+		return apperror.ErrInvalidStorageKey
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(s.bucketURL, key), nil)
+	if err != nil {
+		return apperror.ErrFailedDeleteStorage.WithError(err)
+	}
+	if err = s.do(req, emptyPayloadHash); err != nil {
+		return apperror.ErrFailedDeleteStorage.WithError(err)
+	}
+	return nil
+}
+
+func (s *s3Storage) objectURL(bucketURL *url.URL, key string) string {
+	return bucketURL.String() + "/" + key
+}
+
+// do signs and sends req, failing unless the response is a success.
+func (s *s3Storage) do(req *http.Request, payloadHash string) error {
+	resp, err := s.send(req, payloadHash)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// send signs and sends req, returning the response of a success with its body
+// to close.
+func (s *s3Storage) send(req *http.Request, payloadHash string) (*http.Response, error) {
+	s.sign(req, payloadHash, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("s3 returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
+
+// sign adds the AWS Signature Version 4 Authorization header to req, see
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *s3Storage) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate, scope := s.scope(now)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name == "content-type" || strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	signature := s.signature(req.Method, req.URL, headers, payloadHash, amzDate, scope)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.cfg.AccessKey, scope, signedHeaderNames(headers), signature))
+}
+
+// scope returns the request date and credential scope of a request made now.
+func (s *s3Storage) scope(now time.Time) (string, string) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	return amzDate, amzDate[:8] + "/" + s.cfg.Region + "/" + s3Service + "/aws4_request"
+}
+
+// signature signs the canonical request made of method, u and the signed
+// headers. The query of u must already be canonical, sorted and escaped.
+func (s *s3Storage) signature(method string, u *url.URL, headers map[string]string, payloadHash, amzDate,
+	scope string,
+) string {
+	var canonicalHeaders strings.Builder
+	for _, name := range sortedHeaderNames(headers) {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		u.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaderNames(headers),
+		payloadHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), amzDate[:8])
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+}
+
+func sortedHeaderNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func signedHeaderNames(headers map[string]string) string {
+	return strings.Join(sortedHeaderNames(headers), ";")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentStorage stores the files of claim attachments privately.
+// UploadFile returns the key of the stored file, which is never served as is:
+// SignedURL returns a URL that serves it until expiresAt. DownloadFile reads a
+// stored file back for processing, the caller closes it.
+type AttachmentStorage interface {
+	UploadFile(ctx context.Context, file multipart.File, resourceType, contentType string) (string, error)
+	SignedURL(ctx context.Context, key string, expiresAt time.Time) (string, error)
+	DownloadFile(ctx context.Context, key string) (io.ReadCloser, error)
+	DeleteFile(ctx context.Context, key string) error
+}
+
+// PrivateMover is implemented by the storages that may hold files served
+// publicly, uploaded before attachment files were private. MakePrivate moves
+// such a file out of public delivery and returns its new key, or key itself
+// when the file already is private.
+type PrivateMover interface {
+	MakePrivate(ctx context.Context, key string) (string, error)
+}
+
+func DetermineResourceType(mimeType string) string {
+	if strings.HasPrefix(mimeType, "image/") {
+		return "image"
+	}
+	if strings.HasPrefix(mimeType, "video/") {
+		return "video"
+	}
+	return "auto"
+}
+
+// newObjectKey names a new file after its resource type and a random ID, with
+// the usual extension of its content type.
+func newObjectKey(resourceType, contentType string) string {
+	return resourceType + "/" + uuid.New().String() + fileExtension(contentType)
+}
+
+func fileExtension(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	switch mediaType {
+	case "image/jpeg":
+		return ".jpg"
+	case "video/mp4":
+		return ".mp4"
+	}
+	extensions, err := mime.ExtensionsByType(mediaType)
+	if err != nil || len(extensions) == 0 {
+		return ""
+	}
+	return extensions[0]
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	HeaderDeliveryID = "X-Webhook-Delivery-ID"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderSignature  = "X-Webhook-Signature"
+
+	SignaturePrefix = "sha256="
+)
+
+type sender struct {
+	httpClient *http.Client
+}
+
+// NewSender returns a webhook sender that POSTs the frozen payload of a
+// delivery to its subscription URL, signed with the subscription secret.
+// Subscription URLs are registered by office staff, so the sender only
+// connects to public addresses, checked on the resolved address of every
+// connection so a host name can not be rebound to an internal one, and does
+// not follow redirects.
+func NewSender(timeout time.Duration) outbox.WebhookSender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: refusePrivateAddr,
+	}
+	return &sender{
+		httpClient: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// refusePrivateAddr is a dialer control refusing connections to addresses
+// webhooks may not be delivered to.
+func refusePrivateAddr(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address %s: %w", address, err)
+	}
+	if !entity.IsPublicWebhookAddr(addrPort.Addr()) {
+		return fmt.Errorf("webhook address %s is not public", addrPort.Addr())
+	}
+	return nil
+}
+
+func (s *sender) Send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error) {
+	if delivery.Subscription == nil {
+		return 0, errors.New("delivery has no subscription loaded")
+	}
+
+	timestamp := time.Now().Unix()
+	return post(ctx, s.httpClient, delivery.Subscription.URL, delivery.Payload, map[string]string{
+		HeaderEventID:    delivery.EventID.String(),
+		HeaderEventType:  delivery.EventType,
+		HeaderDeliveryID: delivery.ID.String(),
+		HeaderTimestamp:  strconv.FormatInt(timestamp, 10),
+		HeaderSignature:  Sign(delivery.Subscription.Secret, timestamp, delivery.Payload),
+	})
+}
+
+// Sign returns the signature header value of a payload: the hex encoded
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret.
+// Receivers recompute it to authenticate the sender and reject stale
+// timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,73 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/webhook"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sender", func() {
+	var (
+		server   *httptest.Server
+		received atomic.Int32
+		delivery *entity.WebhookDelivery
+	)
+
+	BeforeEach(func() {
+		received.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			received.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		DeferCleanup(server.Close)
+
+		delivery = entity.NewWebhookDelivery(uuid.New(), uuid.New(), entity.EventClaimApproved,
+			json.RawMessage(`{}`))
+		delivery.Subscription = &entity.WebhookSubscription{Secret: "whsec_test"}
+	})
+
+	DescribeTable("when the subscription host resolves to an internal address",
+		func(host func() string) {
+			delivery.Subscription.URL = "http://" + host() + "/hooks"
+
+			status, err := webhook.NewSender(time.Second).Send(context.Background(), delivery)
+
+			Expect(err).To(MatchError(ContainSubstring("is not public")))
+			Expect(status).To(BeZero())
+			Expect(received.Load()).To(BeZero())
+		},
+		Entry("loopback address", func() string { return server.Listener.Addr().String() }),
+		Entry("host name", func() string {
+			return strings.Replace(server.Listener.Addr().String(), "127.0.0.1", "localhost", 1)
+		}),
+	)
+})
+
+var _ = Describe("Sink", func() {
+	Context("when the endpoint answers with an error", func() {
+		It("should record the status code without the response body", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("internal credentials"))
+			}))
+			DeferCleanup(server.Close)
+
+			err := webhook.NewSink(server.URL, time.Second).Publish(context.Background(), &entity.OutboxEvent{
+				ID:        uuid.New(),
+				EventType: entity.EventClaimApproved,
+			})
+
+			Expect(err).To(MatchError("unexpected status code 403"))
+		})
+	})
+})
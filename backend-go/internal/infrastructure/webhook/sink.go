@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"ev-warranty-go/internal/application/outbox"
+	"ev-warranty-go/internal/domain/entity"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	HeaderEventID   = "X-Event-ID"
+	HeaderEventType = "X-Event-Type"
+)
+
+type sink struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewSink returns an outbox sink that POSTs every event as JSON to url. Any
+// response outside the 2xx range counts as a failed delivery.
+func NewSink(url string, timeout time.Duration) outbox.Sink {
+	return &sink{
+		url: url,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (s *sink) Name() string {
+	return "webhook"
+}
+
+func (s *sink) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	_, err = post(ctx, s.httpClient, s.url, body, map[string]string{
+		HeaderEventID:   event.ID.String(),
+		HeaderEventType: event.EventType,
+	})
+	return err
+}
+
+// post sends body as JSON to url and returns the response status code, zero
+// when no response was received. Any status outside the 2xx range is an error.
+func post(ctx context.Context, httpClient *http.Client, url string, body []byte, headers map[string]string,
+) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// The response body is not recorded, the error is shown to the owners of
+	// the subscription and the endpoint is not trusted.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
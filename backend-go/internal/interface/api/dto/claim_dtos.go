@@ -14,15 +14,76 @@ type CreateClaimRequest struct {
 	Description  string    `json:"description" binding:"required,min=10,max=1000"`
 }
 
+type ListClaimsQuery struct {
+	Status       string `form:"status"`
+	CustomerID   string `form:"customer_id"`
+	VehicleID    string `form:"vehicle_id"`
+	TechnicianID string `form:"technician_id"`
+	StaffID      string `form:"staff_id"`
+	OfficeID     string `form:"office_id"`
+	FromDate     string `form:"from_date"`
+	ToDate       string `form:"to_date"`
+	SLAState     string `form:"sla_state"`
+	Page         int    `form:"page"`
+	PageSize     int    `form:"page_size"`
+	SortBy       string `form:"sort_by"`
+	SortDir      string `form:"sort_dir"`
+}
+
+// ExportClaimsQuery takes the claim list filters. Its pagination and sorting
+// are ignored.
+type ExportClaimsQuery struct {
+	ListClaimsQuery
+	Format string `form:"format"`
+}
+
+type ClaimListResponse struct {
+	Claims     []*entity.Claim `json:"claims"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"page_size"`
+	TotalPages int             `json:"total_pages"`
+}
+
+type ClaimActionsResponse struct {
+	ClaimID uuid.UUID `json:"claim_id"`
+	Actions []string  `json:"actions"`
+}
+
+type ReasonRequest struct {
+	ReasonCode string `json:"reason_code"`
+	Note       string `json:"note" binding:"max=1000"`
+}
+
+type NoteRequest struct {
+	Note string `json:"note" binding:"max=1000"`
+}
+
+type RejectionReasonsQuery struct {
+	OfficeID string `form:"office_id"`
+	FromDate string `form:"from_date"`
+	ToDate   string `form:"to_date"`
+	Limit    int    `form:"limit"`
+}
+
 type UpdateClaimRequest struct {
 	Description string `json:"description" binding:"required,min=10,max=1000"`
 }
 
 type CreateClaimItemRequest struct {
-	PartCategoryID   uuid.UUID `json:"part_category_id" binding:"required"`
-	FaultyPartSerial string    `json:"faulty_part_serial" binding:"required"`
-	IssueDescription string    `json:"issue_description" binding:"required,min=10,max=1000"`
-	Type             string    `json:"type" binding:"required"`
+	PartCategoryID   uuid.UUID  `json:"part_category_id" binding:"required"`
+	FaultyPartSerial string     `json:"faulty_part_serial" binding:"required"`
+	IssueDescription string     `json:"issue_description" binding:"required,min=10,max=1000"`
+	Type             string     `json:"type" binding:"required"`
+	LaborOperationID *uuid.UUID `json:"labor_operation_id"`
+	DiagnosticFee    int64      `json:"diagnostic_fee" binding:"min=0"`
+}
+
+// ApproveClaimItemRequest approves an item. LaborMinutes overrides the
+// flat-rate time of the item's labor operation and then requires a note.
+type ApproveClaimItemRequest struct {
+	Note         string `json:"note" binding:"max=1000"`
+	LaborMinutes *int   `json:"labor_minutes" binding:"omitempty,min=0"`
 }
 
 type ClaimItemListResponse struct {
@@ -0,0 +1,21 @@
+package dto
+
+type CreateLaborOperationRequest struct {
+	Code            string `json:"code" binding:"required,max=50"`
+	Description     string `json:"description" binding:"required,max=1000"`
+	FlatRateMinutes int    `json:"flat_rate_minutes" binding:"required,min=1"`
+	PartCategoryID  string `json:"part_category_id"`
+	IsActive        *bool  `json:"is_active"`
+}
+
+type UpdateLaborOperationRequest struct {
+	Code            string `json:"code" binding:"required,max=50"`
+	Description     string `json:"description" binding:"required,max=1000"`
+	FlatRateMinutes int    `json:"flat_rate_minutes" binding:"required,min=1"`
+	PartCategoryID  string `json:"part_category_id"`
+	IsActive        bool   `json:"is_active"`
+}
+
+type ListLaborOperationsQuery struct {
+	PartCategoryID string `form:"part_category_id"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "ev-warranty-go/internal/domain/entity"
+
+type UpdateNotificationPreferenceRequest struct {
+	EmailEnabled *bool    `json:"email_enabled" binding:"required"`
+	MutedKinds   []string `json:"muted_kinds"`
+}
+
+type ListNotificationsQuery struct {
+	Unread   bool `form:"unread"`
+	Page     int  `form:"page"`
+	PageSize int  `form:"page_size"`
+}
+
+type NotificationListResponse struct {
+	Notifications []*entity.Notification `json:"notifications"`
+	Total         int64                  `json:"total"`
+	UnreadCount   int64                  `json:"unread_count"`
+	Page          int                    `json:"page"`
+	PageSize      int                    `json:"page_size"`
+	TotalPages    int                    `json:"total_pages"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCount int64 `json:"unread_count"`
+}
+
+type MarkAllReadResponse struct {
+	Updated int64 `json:"updated"`
+}
@@ -5,6 +5,7 @@ type CreateOfficeRequest struct {
 	OfficeType string `json:"office_type" binding:"required"`
 	IsActive   bool   `json:"is_active"`
 	Address    string `json:"address" binding:"required"`
+	LaborRate  int64  `json:"labor_rate" binding:"min=0"`
 }
 
 type UpdateOfficeRequest struct {
@@ -12,4 +13,13 @@ type UpdateOfficeRequest struct {
 	OfficeType string `json:"office_type"`
 	Address    string `json:"address"`
 	IsActive   bool   `json:"is_active"`
+	LaborRate  int64  `json:"labor_rate" binding:"min=0"`
+}
+
+// UpdateBusinessCalendarRequest sets the days an office works. Working days
+// are weekday numbers, 0 for Sunday, and holidays YYYY-MM-DD dates.
+type UpdateBusinessCalendarRequest struct {
+	Timezone    string   `json:"timezone" binding:"required"`
+	WorkingDays []int    `json:"working_days" binding:"required"`
+	Holidays    []string `json:"holidays"`
 }
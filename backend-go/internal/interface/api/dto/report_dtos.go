@@ -0,0 +1,9 @@
+package dto
+
+type ReportQuery struct {
+	OfficeID    string `form:"office_id"`
+	FromDate    string `form:"from_date"`
+	ToDate      string `form:"to_date"`
+	Granularity string `form:"granularity"`
+	Limit       int    `form:"limit"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "github.com/google/uuid"
+
+type CreateSettlementBatchRequest struct {
+	OfficeID    uuid.UUID `json:"office_id" binding:"required"`
+	PeriodStart string    `json:"period_start" binding:"required"`
+	PeriodEnd   string    `json:"period_end" binding:"required"`
+}
+
+type DisputeSettlementBatchRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"`
+}
+
+type ListSettlementBatchesQuery struct {
+	OfficeID string `form:"office_id"`
+	Status   string `form:"status"`
+}
+
+type SettlementStatementQuery struct {
+	Format string `form:"format"`
+}
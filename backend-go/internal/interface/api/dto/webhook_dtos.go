@@ -0,0 +1,27 @@
+package dto
+
+import "ev-warranty-go/internal/domain/entity"
+
+type CreateWebhookSubscriptionRequest struct {
+	OfficeID   string   `json:"office_id"`
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types"`
+	IsActive   *bool    `json:"is_active"`
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types"`
+	IsActive   bool     `json:"is_active"`
+}
+
+type ListWebhookSubscriptionsQuery struct {
+	OfficeID string `form:"office_id"`
+}
+
+// CreatedWebhookSubscriptionResponse is the only response that reveals the
+// signing secret of a subscription.
+type CreatedWebhookSubscriptionResponse struct {
+	entity.WebhookSubscription
+	Secret string `json:"secret"`
+}
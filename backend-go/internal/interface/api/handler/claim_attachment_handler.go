@@ -38,7 +38,7 @@ func NewClaimAttachmentHandler(log logger.Logger, txManager application.TxManage
 
 // GetByID godoc
 // @Summary Get claim attachment by ID
-// @Description Retrieve a specific claim attachment by its ID
+// @Description Retrieve a specific claim attachment by its ID with signed download URLs of the file and its thumbnail that expire shortly, once it is processed
 // @Tags claim-attachments
 // @Accept json
 // @Produce json
@@ -55,13 +55,19 @@ func (h *claimAttachmentHandler) GetByID(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
 	defer cancel()
 
+	claimID, err := parseClaimIDParam(c)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
 	attachmentID, err := parseAttachmentIDParam(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
 	}
 
-	attachment, err := h.service.GetByID(ctx, attachmentID)
+	attachment, err := h.service.GetByID(ctx, claimID, attachmentID)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
@@ -72,12 +78,13 @@ func (h *claimAttachmentHandler) GetByID(c *gin.Context) {
 
 // GetByClaimID godoc
 // @Summary Get claim attachments by claim ID
-// @Description Retrieve all attachments for a specific claim
+// @Description Retrieve all attachments for a specific claim, optionally only those of one claim item
 // @Tags claim-attachments
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id path string true "Claim ID"
+// @Param claim_item_id query string false "Filter by claim item ID"
 // @Success 200 {object} dto.APIResponse{data=dto.ClaimAttachmentListResponse} "Claim attachments retrieved successfully"
 // @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
@@ -94,7 +101,13 @@ func (h *claimAttachmentHandler) GetByClaimID(c *gin.Context) {
 		return
 	}
 
-	attachments, err := h.service.GetByClaimID(ctx, claimID)
+	claimItemID, err := parseOptionalUUID(c.Query("claim_item_id"), "claim item id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	attachments, err := h.service.GetByClaimID(ctx, claimID, claimItemID)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
@@ -105,18 +118,22 @@ func (h *claimAttachmentHandler) GetByClaimID(c *gin.Context) {
 
 // Create godoc
 // @Summary Upload claim attachments
-// @Description Upload files as attachments to a claim (SC Technician only)
+// @Description Upload files as attachments to a claim, with a caption and the claim item they document applied to every file. A file the claim already has or larger than the limit of its type is rejected. Attachments are processed in the background and can be downloaded once their processing status is READY (SC Technician only)
 // @Tags claim-attachments
 // @Accept multipart/form-data
 // @Produce json
 // @Security Bearer
 // @Param id path string true "Claim ID"
 // @Param files formData file true "Files to upload"
+// @Param caption formData string false "Caption of the files"
+// @Param claim_item_id formData string false "Claim item the files document"
 // @Success 201 {object} dto.APIResponse{data=[]entity.ClaimAttachment} "Claim attachments uploaded successfully"
 // @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
 // @Failure 403 {object} dto.APIResponse "Forbidden"
-// @Failure 404 {object} dto.APIResponse "Claim not found"
+// @Failure 404 {object} dto.APIResponse "Claim or claim item not found"
+// @Failure 409 {object} dto.APIResponse "Duplicate attachment"
+// @Failure 413 {object} dto.APIResponse "Attachment too large"
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims/{id}/attachments [post]
 func (h *claimAttachmentHandler) Create(c *gin.Context) {
@@ -125,7 +142,7 @@ func (h *claimAttachmentHandler) Create(c *gin.Context) {
 		return
 	}
 
-	userID, err := getUserIDFromHeader(c)
+	userID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
@@ -149,6 +166,16 @@ func (h *claimAttachmentHandler) Create(c *gin.Context) {
 		return
 	}
 
+	claimItemID, err := parseOptionalUUID(c.PostForm("claim_item_id"), "claim item id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+	var caption *string
+	if value, ok := c.GetPostForm("caption"); ok {
+		caption = &value
+	}
+
 	var attachments []*entity.ClaimAttachment
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
 		for _, fileHeader := range files {
@@ -156,7 +183,12 @@ func (h *claimAttachmentHandler) Create(c *gin.Context) {
 			if err != nil {
 				return apperror.ErrInvalidMultipartForm
 			}
-			attachment, err := h.service.Create(tx, userID, claimID, file)
+			attachment, err := h.service.Create(tx, userID, claimID, &service.CreateClaimAttachmentCommand{
+				File:        file,
+				FileName:    fileHeader.Filename,
+				Caption:     caption,
+				ClaimItemID: claimItemID,
+			})
 			if err != nil {
 				return err
 			}
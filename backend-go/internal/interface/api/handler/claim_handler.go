@@ -3,13 +3,20 @@ package handler
 import (
 	"context"
 	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/claimstream"
+	"ev-warranty-go/internal/application/repository"
 	"ev-warranty-go/internal/application/service"
 	"ev-warranty-go/internal/domain/entity"
 	"ev-warranty-go/internal/interface/api/dto"
 	"ev-warranty-go/pkg/apperror"
 	"ev-warranty-go/pkg/logger"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/gin-contrib/sse"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -17,6 +24,8 @@ import (
 type ClaimHandler interface {
 	GetByID(c *gin.Context)
 	GetAll(c *gin.Context)
+	Export(c *gin.Context)
+	Stream(c *gin.Context)
 
 	Create(c *gin.Context)
 	Update(c *gin.Context)
@@ -28,7 +37,10 @@ type ClaimHandler interface {
 	DoneReview(c *gin.Context)
 	Complete(c *gin.Context)
 
+	Actions(c *gin.Context)
 	History(c *gin.Context)
+	Audit(c *gin.Context)
+	RejectionReasons(c *gin.Context)
 }
 
 type claimHandler struct {
@@ -82,12 +94,26 @@ func (h *claimHandler) GetByID(c *gin.Context) {
 
 // GetAll godoc
 // @Summary Get all claims
-// @Description Retrieve all warranty claims
+// @Description Retrieve warranty claims with filtering, sorting and pagination
 // @Tags claims
 // @Accept json
 // @Produce json
 // @Security Bearer
-// @Success 200 {object} dto.APIResponse{data=[]entity.Claim} "Claims retrieved successfully"
+// @Param status query string false "Claim status"
+// @Param customer_id query string false "Customer ID"
+// @Param vehicle_id query string false "Vehicle ID"
+// @Param technician_id query string false "Technician ID"
+// @Param staff_id query string false "Staff ID"
+// @Param office_id query string false "Office ID of the claim staff or technician"
+// @Param from_date query string false "Created at lower bound (YYYY-MM-DD or RFC3339)"
+// @Param to_date query string false "Created at upper bound (YYYY-MM-DD or RFC3339)"
+// @Param sla_state query string false "State of the SLA of the claim's current status" Enums(ON_TRACK, BREACHED)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Param sort_by query string false "Sort field" Enums(created_at, updated_at, status, kilometers, requested_total, approved_total)
+// @Param sort_dir query string false "Sort direction" Enums(asc, desc)
+// @Success 200 {object} dto.APIResponse{data=dto.ClaimListResponse} "Claims retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims [get]
@@ -95,18 +121,149 @@ func (h *claimHandler) GetAll(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
 	defer cancel()
 
-	claims, err := h.service.GetAll(ctx)
+	var query dto.ListClaimsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid query parameters"))
+		return
+	}
+
+	filters, pagination, err := parseClaimListQuery(&query)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	claims, total, err := h.service.GetAll(ctx, filters, pagination)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, dto.ClaimListResponse{
+		Claims:     claims,
+		Total:      total,
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+		TotalPages: pagination.TotalPages(total),
+	})
+}
+
+// Export godoc
+// @Summary Export claims
+// @Description Stream the claims matching the claim list filters as CSV or XLSX, one row per claim item with the claim fields, its attachment count and status timestamps. SC Staff and Technicians only export the claims of their office
+// @Tags claims
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security Bearer
+// @Param format query string false "Export format" Enums(csv, xlsx) default(csv)
+// @Param status query string false "Claim status"
+// @Param customer_id query string false "Customer ID"
+// @Param vehicle_id query string false "Vehicle ID"
+// @Param technician_id query string false "Technician ID"
+// @Param staff_id query string false "Staff ID"
+// @Param office_id query string false "Office ID of the claim staff or technician"
+// @Param from_date query string false "Created at lower bound (YYYY-MM-DD or RFC3339)"
+// @Param to_date query string false "Created at upper bound (YYYY-MM-DD or RFC3339)"
+// @Param sla_state query string false "State of the SLA of the claim's current status" Enums(ON_TRACK, BREACHED)
+// @Success 200 {file} file "Claims export"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /claims/export [get]
+func (h *claimHandler) Export(c *gin.Context) {
+	var query dto.ExportClaimsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid query parameters"))
+		return
+	}
+
+	filters, _, err := parseClaimListQuery(&query.ListClaimsQuery)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
 	}
+	if query.Format == "" {
+		query.Format = service.ExportFormatCSV
+	}
+
+	// No request timeout: the export is streamed for as long as the client
+	// keeps reading it.
+	export, err := h.service.Export(c.Request.Context(), filters, strings.ToLower(query.Format))
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+export.Filename+`"`)
+	c.Header("Content-Type", export.ContentType)
+	c.Status(http.StatusOK)
+	if err = export.Stream(c.Writer); err != nil {
+		// The status is already sent, the client gets a truncated file.
+		h.log.Error("Failed to stream claims export", "error", err)
+	}
+}
+
+// Stream godoc
+// @Summary Stream claim updates
+// @Description Push claim status changes, item decisions and new attachments as server-sent "claim" events while the connection is open. SC Staff and Technicians only receive the updates of their office's claims. Clients resuming with Last-Event-ID first get the updates they missed from a short replay buffer, or a "reset" event when those are no longer buffered and they should reload. Comments are sent as heartbeats
+// @Tags claims
+// @Produce text/event-stream
+// @Security Bearer
+// @Param Last-Event-ID header string false "ID of the last update received"
+// @Success 200 {object} claimstream.Update "Claim update events"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /claims/stream [get]
+func (h *claimHandler) Stream(c *gin.Context) {
+	var lastEventID uint64
+	if header := c.GetHeader("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid Last-Event-ID"))
+			return
+		}
+		lastEventID = id
+	}
+
+	subscription, err := h.service.Stream(c.Request.Context(), lastEventID)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+	defer subscription.Cancel()
+
+	writeStreamHeaders(c)
+	if subscription.Missed {
+		c.Render(-1, sse.Event{Event: "reset", Data: "missed"})
+	}
+	for _, update := range subscription.Replay {
+		renderClaimUpdate(c, update)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
 
-	writeSuccessResponse(c, http.StatusOK, claims)
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case update, ok := <-subscription.Updates:
+			if !ok {
+				return false
+			}
+			renderClaimUpdate(c, update)
+			return true
+		case <-heartbeat.C:
+			return writeHeartbeat(w) == nil
+		}
+	})
 }
 
 // Create godoc
 // @Summary Create a new claim
-// @Description Create a new warranty claim (SC Technician/Staff only)
+// @Description Create a new warranty claim (SC Technician/Staff only). The vehicle's warranty eligibility is checked against its policy and stored on the claim
 // @Tags claims
 // @Accept json
 // @Produce json
@@ -116,6 +273,8 @@ func (h *claimHandler) GetAll(c *gin.Context) {
 // @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
 // @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Vehicle not found"
+// @Failure 422 {object} dto.APIResponse "Vehicle not eligible for warranty"
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims [post]
 func (h *claimHandler) Create(c *gin.Context) {
@@ -130,7 +289,7 @@ func (h *claimHandler) Create(c *gin.Context) {
 		return
 	}
 
-	userID, err := getUserIDFromHeader(c)
+	userID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
@@ -146,9 +305,10 @@ func (h *claimHandler) Create(c *gin.Context) {
 	}
 
 	var claim *entity.Claim
+	authToken := c.Request.Header.Get("Authorization")
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
 		var txErr error
-		claim, txErr = h.service.Create(tx, cmd)
+		claim, txErr = h.service.Create(tx, cmd, authToken)
 		return txErr
 	})
 
@@ -240,7 +400,7 @@ func (h *claimHandler) Delete(c *gin.Context) {
 	}
 
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
-		role, _ := getUserRoleFromHeader(c)
+		role, _ := getUserRole(c)
 		if role == entity.UserRoleScStaff {
 			return h.service.HardDelete(tx, id)
 		}
@@ -257,7 +417,7 @@ func (h *claimHandler) Delete(c *gin.Context) {
 
 // Submit godoc
 // @Summary Submit a claim for review
-// @Description Submit a claim to EVM for review (SC Staff only)
+// @Description Submit a claim to EVM for review. Allowed roles are defined by the claim workflow
 // @Tags claims
 // @Accept json
 // @Produce json
@@ -271,11 +431,6 @@ func (h *claimHandler) Delete(c *gin.Context) {
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims/{id}/submit [post]
 func (h *claimHandler) Submit(c *gin.Context) {
-	if err := allowedRoles(c, entity.UserRoleScStaff); err != nil {
-		writeErrorResponse(h.log, c, err)
-		return
-	}
-
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
@@ -283,7 +438,7 @@ func (h *claimHandler) Submit(c *gin.Context) {
 		return
 	}
 
-	userID, err := getUserIDFromHeader(c)
+	userID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
@@ -303,7 +458,7 @@ func (h *claimHandler) Submit(c *gin.Context) {
 
 // Review godoc
 // @Summary Review a claim
-// @Description Review and approve/reject a submitted claim (EVM Staff only)
+// @Description Start reviewing a submitted claim. Allowed roles are defined by the claim workflow
 // @Tags claims
 // @Accept json
 // @Produce json
@@ -317,11 +472,6 @@ func (h *claimHandler) Submit(c *gin.Context) {
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims/{id}/review [post]
 func (h *claimHandler) Review(c *gin.Context) {
-	if err := allowedRoles(c, entity.UserRoleEvmStaff); err != nil {
-		writeErrorResponse(h.log, c, err)
-		return
-	}
-
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
@@ -329,14 +479,14 @@ func (h *claimHandler) Review(c *gin.Context) {
 		return
 	}
 
-	userID, err := getUserIDFromHeader(c)
+	userID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
 	}
 
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
-		return h.service.UpdateStatus(tx, id, entity.ClaimStatusReviewing, userID)
+		return h.service.Review(tx, id, userID)
 	})
 
 	if err != nil {
@@ -349,12 +499,13 @@ func (h *claimHandler) Review(c *gin.Context) {
 
 // Cancel godoc
 // @Summary Cancel a claim
-// @Description Cancel a claim and update its status (SC Staff only)
+// @Description Cancel a submitted claim with a reason code and note. Allowed roles are defined by the claim workflow
 // @Tags claims
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id path string true "Claim ID"
+// @Param request body dto.ReasonRequest true "Cancellation reason"
 // @Success 204 "Claim cancelled successfully"
 // @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
@@ -363,11 +514,6 @@ func (h *claimHandler) Review(c *gin.Context) {
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims/{id}/cancel [post]
 func (h *claimHandler) Cancel(c *gin.Context) {
-	if err := allowedRoles(c, entity.UserRoleScStaff); err != nil {
-		writeErrorResponse(h.log, c, err)
-		return
-	}
-
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
@@ -375,14 +521,20 @@ func (h *claimHandler) Cancel(c *gin.Context) {
 		return
 	}
 
-	userID, err := getUserIDFromHeader(c)
+	userID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
 	}
 
+	var req dto.ReasonRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
-		return h.service.UpdateStatus(tx, id, entity.ClaimStatusCancelled, userID)
+		return h.service.Cancel(tx, id, userID, toReasonCommand(&req))
 	})
 
 	if err != nil {
@@ -394,26 +546,22 @@ func (h *claimHandler) Cancel(c *gin.Context) {
 }
 
 // DoneReview godoc
-// @Summary Complete a claim
-// @Description Mark a claim as completed (EVM Staff only)
+// @Summary Finish reviewing a claim
+// @Description Approve, partially approve or reject a claim from its reviewed items and return the item counts it was decided on, including items the warranty policy does not cover. A reason code and note are required when the claim is rejected. Allowed roles are defined by the claim workflow
 // @Tags claims
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id path string true "Claim ID"
-// @Success 204 "Claim completed successfully"
+// @Param request body dto.ReasonRequest false "Review reason"
+// @Success 200 {object} dto.APIResponse{data=service.ClaimReviewStats} "Claim review done successfully"
 // @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
 // @Failure 403 {object} dto.APIResponse "Forbidden"
 // @Failure 404 {object} dto.APIResponse "Claim not found"
 // @Failure 500 {object} dto.APIResponse "Internal server error"
-// @Router /claims/{id}/complete [post]
+// @Router /claims/{id}/done-review [post]
 func (h *claimHandler) DoneReview(c *gin.Context) {
-	if err := allowedRoles(c, entity.UserRoleEvmStaff); err != nil {
-		writeErrorResponse(h.log, c, err)
-		return
-	}
-
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
@@ -421,14 +569,23 @@ func (h *claimHandler) DoneReview(c *gin.Context) {
 		return
 	}
 
-	userID, err := getUserIDFromHeader(c)
+	userID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
 	}
 
+	var req dto.ReasonRequest
+	if err = bindOptionalJSON(c, &req); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	var stats *service.ClaimReviewStats
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
-		return h.service.DoneReview(tx, id, userID)
+		var txErr error
+		stats, txErr = h.service.DoneReview(tx, id, userID, toReasonCommand(&req))
+		return txErr
 	})
 
 	if err != nil {
@@ -436,12 +593,12 @@ func (h *claimHandler) DoneReview(c *gin.Context) {
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	writeSuccessResponse(c, http.StatusOK, stats)
 }
 
 // Complete godoc
 // @Summary Complete a claim
-// @Description Mark a claim as completed (EVM Staff only)
+// @Description Mark an approved claim as completed. Allowed roles are defined by the claim workflow
 // @Tags claims
 // @Accept json
 // @Produce json
@@ -455,11 +612,6 @@ func (h *claimHandler) DoneReview(c *gin.Context) {
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims/{id}/complete [post]
 func (h *claimHandler) Complete(c *gin.Context) {
-	if err := allowedRoles(c, entity.UserRoleScStaff); err != nil {
-		writeErrorResponse(h.log, c, err)
-		return
-	}
-
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
@@ -467,7 +619,7 @@ func (h *claimHandler) Complete(c *gin.Context) {
 		return
 	}
 
-	userID, err := getUserIDFromHeader(c)
+	userID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
@@ -485,6 +637,94 @@ func (h *claimHandler) Complete(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// Actions godoc
+// @Summary Get available claim actions
+// @Description List the workflow actions the current user can perform on a claim
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Claim ID"
+// @Success 200 {object} dto.APIResponse{data=dto.ClaimActionsResponse} "Claim actions retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 404 {object} dto.APIResponse "Claim not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /claims/{id}/actions [get]
+func (h *claimHandler) Actions(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid claim ID"))
+		return
+	}
+
+	actions, err := h.service.GetAvailableActions(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, dto.ClaimActionsResponse{ClaimID: id, Actions: actions})
+}
+
+// RejectionReasons godoc
+// @Summary Get top rejection reasons
+// @Description Count rejected and cancelled claims and claim items by reason code, most frequent first
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Filter by office of the claim staff or technician"
+// @Param from_date query string false "Decisions made on or after this date (YYYY-MM-DD or RFC3339)"
+// @Param to_date query string false "Decisions made on or before this date (YYYY-MM-DD or RFC3339)"
+// @Param limit query int false "Number of reason codes to return" default(10)
+// @Success 200 {object} dto.APIResponse{data=[]repository.ReasonCodeCount} "Rejection reasons retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /claims/rejection-reasons [get]
+func (h *claimHandler) RejectionReasons(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var query dto.RejectionReasonsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid query parameters"))
+		return
+	}
+
+	var filters repository.RejectionReasonFilters
+	var err error
+	if filters.OfficeID, err = parseOptionalUUID(query.OfficeID, "office id"); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+	if filters.FromDate, err = parseOptionalTime(query.FromDate, "from date", false); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+	if filters.ToDate, err = parseOptionalTime(query.ToDate, "to date", true); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+	filters.Limit = query.Limit
+	if filters.Limit == 0 {
+		filters.Limit = repository.DefaultRejectionReasonLimit
+	}
+
+	reasons, err := h.service.GetRejectionReasons(ctx, filters)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, reasons)
+}
+
 // History godoc
 // @Summary Get claim history
 // @Description Retrieve the history of status changes for a specific claim
@@ -518,3 +758,101 @@ func (h *claimHandler) History(c *gin.Context) {
 
 	writeSuccessResponse(c, http.StatusOK, history)
 }
+
+// Audit godoc
+// @Summary Get claim audit log
+// @Description Retrieve every change made to a claim, its items and attachments, with before and after values
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Claim ID"
+// @Success 200 {object} dto.APIResponse{data=[]entity.ClaimAuditLog} "Claim audit log retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 404 {object} dto.APIResponse "Claim not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /claims/{id}/audit [get]
+func (h *claimHandler) Audit(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid claim ID"))
+		return
+	}
+
+	auditLogs, err := h.service.GetAuditLog(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, auditLogs)
+}
+
+func parseClaimListQuery(query *dto.ListClaimsQuery) (repository.ClaimFilters, repository.Pagination, error) {
+	var filters repository.ClaimFilters
+	var err error
+
+	if filters.CustomerID, err = parseOptionalUUID(query.CustomerID, "customer id"); err != nil {
+		return filters, repository.Pagination{}, err
+	}
+	if filters.VehicleID, err = parseOptionalUUID(query.VehicleID, "vehicle id"); err != nil {
+		return filters, repository.Pagination{}, err
+	}
+	if filters.TechnicianID, err = parseOptionalUUID(query.TechnicianID, "technician id"); err != nil {
+		return filters, repository.Pagination{}, err
+	}
+	if filters.StaffID, err = parseOptionalUUID(query.StaffID, "staff id"); err != nil {
+		return filters, repository.Pagination{}, err
+	}
+	if filters.OfficeID, err = parseOptionalUUID(query.OfficeID, "office id"); err != nil {
+		return filters, repository.Pagination{}, err
+	}
+	if filters.FromDate, err = parseOptionalTime(query.FromDate, "from date", false); err != nil {
+		return filters, repository.Pagination{}, err
+	}
+	if filters.ToDate, err = parseOptionalTime(query.ToDate, "to date", true); err != nil {
+		return filters, repository.Pagination{}, err
+	}
+	if query.Status != "" {
+		status := strings.ToUpper(query.Status)
+		filters.Status = &status
+	}
+	if query.SLAState != "" {
+		slaState := strings.ToUpper(query.SLAState)
+		filters.SLAState = &slaState
+	}
+
+	pagination := repository.Pagination{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		SortBy:   query.SortBy,
+		SortDir:  strings.ToLower(query.SortDir),
+	}
+	if pagination.Page == 0 {
+		pagination.Page = repository.DefaultPage
+	}
+	if pagination.PageSize == 0 {
+		pagination.PageSize = repository.DefaultPageSize
+	}
+	if pagination.SortBy == "" {
+		pagination.SortBy = repository.ClaimSortByCreatedAt
+	}
+	if pagination.SortDir == "" {
+		pagination.SortDir = repository.SortDirDesc
+	}
+
+	return filters, pagination, nil
+}
+
+func renderClaimUpdate(c *gin.Context, update *claimstream.Update) {
+	c.Render(-1, sse.Event{
+		Id:    strconv.FormatUint(update.ID, 10),
+		Event: "claim",
+		Data:  update,
+	})
+}
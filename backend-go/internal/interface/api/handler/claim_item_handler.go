@@ -57,13 +57,19 @@ func (h *claimItemHandler) GetByID(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
 	defer cancel()
 
+	claimID, err := parseClaimIDParam(c)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
 	itemID, err := parseItemIDParam(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
 	}
 
-	item, err := h.service.GetByID(ctx, itemID)
+	item, err := h.service.GetByID(ctx, claimID, itemID)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
@@ -107,7 +113,7 @@ func (h *claimItemHandler) GetByClaimID(c *gin.Context) {
 
 // Create godoc
 // @Summary Create a new claim item
-// @Description Add a new item to a claim (SC Staff only)
+// @Description Add a new item to a claim (SC Staff only). Whether the warranty policy covers the part category is recorded in the item's coverage. Repair items must name a labor operation applying to their part category, whose flat-rate time is charged at the office labor rate
 // @Tags claim-items
 // @Accept json
 // @Produce json
@@ -119,6 +125,7 @@ func (h *claimItemHandler) GetByClaimID(c *gin.Context) {
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
 // @Failure 403 {object} dto.APIResponse "Forbidden"
 // @Failure 404 {object} dto.APIResponse "Claim not found"
+// @Failure 422 {object} dto.APIResponse "Part category not covered by the warranty policy"
 // @Failure 500 {object} dto.APIResponse "Internal server error"
 // @Router /claims/{id}/items [post]
 func (h *claimItemHandler) Create(c *gin.Context) {
@@ -150,6 +157,8 @@ func (h *claimItemHandler) Create(c *gin.Context) {
 		IssueDescription: req.IssueDescription,
 		Status:           entity.ClaimItemStatusPending,
 		Type:             req.Type,
+		LaborOperationID: req.LaborOperationID,
+		DiagnosticFee:    req.DiagnosticFee,
 	}
 
 	var item *entity.ClaimItem
@@ -217,13 +226,14 @@ func (h *claimItemHandler) Delete(c *gin.Context) {
 
 // Approve godoc
 // @Summary Approve a claim item
-// @Description Approve a claim item for processing (EVM Staff only)
+// @Description Approve a claim item for processing with an optional note. Labor minutes that differ from the item's flat-rate time re-price it and require a note (EVM Staff only)
 // @Tags claim-items
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id path string true "Claim ID"
 // @Param itemID path string true "Claim Item ID"
+// @Param request body dto.ApproveClaimItemRequest false "Approval note and adjusted labor time"
 // @Success 204 "Claim item approved successfully"
 // @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
@@ -249,8 +259,24 @@ func (h *claimItemHandler) Approve(c *gin.Context) {
 		return
 	}
 
+	userID, err := getUserID(c)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	var req dto.ApproveClaimItemRequest
+	if err = bindOptionalJSON(c, &req); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	cmd := &service.ApproveClaimItemCommand{
+		Note:         req.Note,
+		LaborMinutes: req.LaborMinutes,
+	}
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
-		return h.service.Approve(tx, claimID, itemID)
+		return h.service.Approve(tx, claimID, itemID, userID, cmd)
 	})
 
 	if err != nil {
@@ -263,13 +289,14 @@ func (h *claimItemHandler) Approve(c *gin.Context) {
 
 // Reject godoc
 // @Summary Reject a claim item
-// @Description Reject a claim item (EVM Staff only)
+// @Description Reject a claim item with a reason code and note (EVM Staff only)
 // @Tags claim-items
 // @Accept json
 // @Produce json
 // @Security Bearer
 // @Param id path string true "Claim ID"
 // @Param itemID path string true "Claim Item ID"
+// @Param request body dto.ReasonRequest true "Rejection reason"
 // @Success 204 "Claim item rejected successfully"
 // @Failure 400 {object} dto.APIResponse "Bad request"
 // @Failure 401 {object} dto.APIResponse "Unauthorized"
@@ -295,9 +322,21 @@ func (h *claimItemHandler) Reject(c *gin.Context) {
 		return
 	}
 
+	userID, err := getUserID(c)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	var req dto.ReasonRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
 	authToken := c.Request.Header.Get("Authorization")
 	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
-		return h.service.Reject(tx, claimID, itemID, authToken)
+		return h.service.Reject(tx, claimID, itemID, userID, toReasonCommand(&req), authToken)
 	})
 
 	if err != nil {
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/pkg/logger"
+	"expvar"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DebugHandler interface {
+	Vars(c *gin.Context)
+}
+
+type debugHandler struct {
+	log logger.Logger
+}
+
+// NewDebugHandler returns the handler publishing the process variables of
+// the expvar package, such as the .NET client metrics, to administrators.
+func NewDebugHandler(log logger.Logger) DebugHandler {
+	return &debugHandler{
+		log: log,
+	}
+}
+
+// Vars serves the expvar variables as JSON. They include the command line of
+// the process and its memory statistics, so they are restricted to ADMIN.
+func (h *debugHandler) Vars(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	expvar.Handler().ServeHTTP(c.Writer, c.Request)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"ev-warranty-go/internal/infrastructure/storage"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FileHandler interface {
+	Download(c *gin.Context)
+}
+
+type fileHandler struct {
+	log   logger.Logger
+	files storage.FileServer
+}
+
+// NewFileHandler returns the handler serving the files of the local
+// attachment storage. files is nil with the other storages, which serve their
+// files themselves.
+func NewFileHandler(log logger.Logger, files storage.FileServer) FileHandler {
+	return &fileHandler{
+		log:   log,
+		files: files,
+	}
+}
+
+// Download godoc
+// @Summary Download an attachment file
+// @Description Download a claim attachment file stored on the server with a signed URL returned by the claim attachment endpoints, until it expires. Only available with the local attachment storage
+// @Tags files
+// @Produce octet-stream
+// @Param key path string true "File key"
+// @Param expires query int true "Expiry of the URL as a Unix time"
+// @Param signature query string true "URL signature"
+// @Success 200 {file} file "File content"
+// @Failure 403 {object} dto.APIResponse "Invalid or expired signature"
+// @Failure 404 {object} dto.APIResponse "File not found"
+// @Router /files/{key} [get]
+func (h *fileHandler) Download(c *gin.Context) {
+	if h.files == nil {
+		writeErrorResponse(h.log, c, apperror.ErrNotFoundError.WithMessage("File not found"))
+		return
+	}
+
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidFileSignature)
+		return
+	}
+
+	filePath, err := h.files.Resolve(strings.TrimPrefix(c.Param("key"), "/"), time.Unix(expires, 0),
+		c.Query("signature"))
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.File(filePath)
+}
@@ -2,9 +2,14 @@ package handler
 
 import (
 	"errors"
+	"ev-warranty-go/internal/application/service"
 	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/internal/interface/api/middleware"
 	"ev-warranty-go/pkg/apperror"
 	"ev-warranty-go/pkg/logger"
+	"io"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -12,10 +17,12 @@ import (
 )
 
 const (
-	requestTimeout  = 30 * time.Second
-	bearerPrefix    = "Bearer "
-	headerUserIDKey = "X-User-ID"
-	headerUserRole  = "X-User-Role"
+	dateLayout     = "2006-01-02"
+	requestTimeout = 30 * time.Second
+	bearerPrefix   = "Bearer "
+	// streamHeartbeat is how often an idle server-sent event stream sends a
+	// comment, so that proxies do not close it.
+	streamHeartbeat = 25 * time.Second
 )
 
 func writeErrorResponse(log logger.Logger, c *gin.Context, err error) {
@@ -42,22 +49,22 @@ func writeSuccessResponse(c *gin.Context, statusCode int, data any) {
 	})
 }
 
-func getUserIDFromHeader(c *gin.Context) (uuid.UUID, error) {
-	userIDStr := c.GetHeader(headerUserIDKey)
-	if userIDStr == "" {
+func getUserID(c *gin.Context) (uuid.UUID, error) {
+	value, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
 		return uuid.Nil, apperror.ErrMissingUserID
 	}
 
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
+	userID, ok := value.(uuid.UUID)
+	if !ok || userID == uuid.Nil {
 		return uuid.Nil, apperror.ErrInvalidUserID
 	}
 
 	return userID, nil
 }
 
-func getUserRoleFromHeader(c *gin.Context) (string, error) {
-	role := c.GetHeader(headerUserRole)
+func getUserRole(c *gin.Context) (string, error) {
+	role := c.GetString(middleware.ContextUserRoleKey)
 	if role == "" {
 		return "", apperror.ErrMissingUserRole
 	}
@@ -66,7 +73,7 @@ func getUserRoleFromHeader(c *gin.Context) (string, error) {
 }
 
 func allowedRoles(c *gin.Context, allowedRoles ...string) error {
-	userRole, err := getUserRoleFromHeader(c)
+	userRole, err := getUserRole(c)
 	if err != nil {
 		return err
 	}
@@ -79,3 +86,65 @@ func allowedRoles(c *gin.Context, allowedRoles ...string) error {
 
 	return apperror.ErrUnauthorizedRole
 }
+
+func parseOptionalUUID(value, field string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, apperror.ErrInvalidParams.WithMessage("Invalid " + field)
+	}
+
+	return &id, nil
+}
+
+func parseOptionalTime(value, field string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil, apperror.ErrInvalidParams.WithMessage("Invalid " + field)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	return &t, nil
+}
+
+// bindOptionalJSON binds the request body into obj when there is one. An
+// empty body leaves obj untouched.
+func bindOptionalJSON(c *gin.Context, obj any) error {
+	if err := c.ShouldBindJSON(obj); err != nil && !errors.Is(err, io.EOF) {
+		return apperror.ErrInvalidJsonRequest
+	}
+	return nil
+}
+
+func toReasonCommand(req *dto.ReasonRequest) *service.ReasonCommand {
+	return &service.ReasonCommand{
+		ReasonCode: strings.ToUpper(strings.TrimSpace(req.ReasonCode)),
+		Note:       req.Note,
+	}
+}
+
+// writeStreamHeaders starts a server-sent event stream, unbuffered by nginx.
+func writeStreamHeaders(c *gin.Context) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+}
+
+func writeHeartbeat(w io.Writer) error {
+	_, err := io.WriteString(w, ": heartbeat\n\n")
+	return err
+}
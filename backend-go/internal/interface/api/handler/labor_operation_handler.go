@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LaborOperationHandler interface {
+	Create(c *gin.Context)
+	GetByID(c *gin.Context)
+	GetAll(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type laborOperationHandler struct {
+	log     logger.Logger
+	service service.LaborOperationService
+}
+
+func NewLaborOperationHandler(log logger.Logger, service service.LaborOperationService) LaborOperationHandler {
+	return &laborOperationHandler{
+		log:     log,
+		service: service,
+	}
+}
+
+// Create godoc
+// @Summary Create a labor operation
+// @Description Add an operation to the labor catalog with its flat-rate time in minutes, optionally for one part category only (Admin and EVM Staff only)
+// @Tags labor-operations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreateLaborOperationRequest true "Labor operation data"
+// @Success 201 {object} dto.APIResponse{data=entity.LaborOperation} "Labor operation created successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 409 {object} dto.APIResponse "Labor operation code already exists"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /labor-operations [post]
+func (h *laborOperationHandler) Create(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var req dto.CreateLaborOperationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	partCategoryID, err := parseOptionalUUID(req.PartCategoryID, "part category id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	cmd := &service.CreateLaborOperationCommand{
+		Code:            req.Code,
+		Description:     req.Description,
+		FlatRateMinutes: req.FlatRateMinutes,
+		PartCategoryID:  partCategoryID,
+		IsActive:        req.IsActive == nil || *req.IsActive,
+	}
+
+	operation, err := h.service.Create(ctx, cmd)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("labor operation created", "labor_operation_id", operation.ID, "code", operation.Code)
+	writeSuccessResponse(c, http.StatusCreated, operation)
+}
+
+// GetByID godoc
+// @Summary Get labor operation by ID
+// @Description Retrieve an operation of the labor catalog
+// @Tags labor-operations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Labor operation ID"
+// @Success 200 {object} dto.APIResponse{data=entity.LaborOperation} "Labor operation retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 404 {object} dto.APIResponse "Labor operation not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /labor-operations/{id} [get]
+func (h *laborOperationHandler) GetByID(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid labor operation ID"))
+		return
+	}
+
+	operation, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, operation)
+}
+
+// GetAll godoc
+// @Summary List labor operations
+// @Description Retrieve the labor catalog, optionally only the operations usable on items of a part category
+// @Tags labor-operations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param part_category_id query string false "Filter by part category ID"
+// @Success 200 {object} dto.APIResponse{data=[]entity.LaborOperation} "Labor operations retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /labor-operations [get]
+func (h *laborOperationHandler) GetAll(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var query dto.ListLaborOperationsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams)
+		return
+	}
+
+	partCategoryID, err := parseOptionalUUID(query.PartCategoryID, "part category id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	operations, err := h.service.GetAll(ctx, partCategoryID)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, operations)
+}
+
+// Update godoc
+// @Summary Update a labor operation
+// @Description Change an operation of the labor catalog. Claim items already priced with it keep their flat-rate time (Admin and EVM Staff only)
+// @Tags labor-operations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Labor operation ID"
+// @Param request body dto.UpdateLaborOperationRequest true "Labor operation update data"
+// @Success 204 "Labor operation updated successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Labor operation not found"
+// @Failure 409 {object} dto.APIResponse "Labor operation code already exists"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /labor-operations/{id} [put]
+func (h *laborOperationHandler) Update(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid labor operation ID"))
+		return
+	}
+
+	var req dto.UpdateLaborOperationRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	partCategoryID, err := parseOptionalUUID(req.PartCategoryID, "part category id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	cmd := &service.UpdateLaborOperationCommand{
+		Code:            req.Code,
+		Description:     req.Description,
+		FlatRateMinutes: req.FlatRateMinutes,
+		PartCategoryID:  partCategoryID,
+		IsActive:        req.IsActive,
+	}
+
+	if err = h.service.Update(ctx, id, cmd); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("labor operation updated", "labor_operation_id", id)
+	c.Status(http.StatusNoContent)
+}
+
+// Delete godoc
+// @Summary Delete a labor operation
+// @Description Remove an operation from the labor catalog (Admin and EVM Staff only)
+// @Tags labor-operations
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Labor operation ID"
+// @Success 204 "Labor operation deleted successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Labor operation not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /labor-operations/{id} [delete]
+func (h *laborOperationHandler) Delete(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid labor operation ID"))
+		return
+	}
+
+	if err = h.service.Delete(ctx, id); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("labor operation deleted", "labor_operation_id", id)
+	c.Status(http.StatusNoContent)
+}
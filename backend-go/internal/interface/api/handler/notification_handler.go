@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type NotificationHandler interface {
+	GetPreference(c *gin.Context)
+	UpdatePreference(c *gin.Context)
+	GetAll(c *gin.Context)
+	UnreadCount(c *gin.Context)
+	MarkRead(c *gin.Context)
+	MarkAllRead(c *gin.Context)
+	Stream(c *gin.Context)
+}
+
+type notificationHandler struct {
+	log     logger.Logger
+	service service.NotificationService
+}
+
+func NewNotificationHandler(log logger.Logger, service service.NotificationService) NotificationHandler {
+	return &notificationHandler{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetPreference godoc
+// @Summary Get my notification preferences
+// @Description Get whether the current user receives notification emails and which kinds they muted. Users who never set them get every notification
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.APIResponse{data=entity.NotificationPreference} "Notification preferences retrieved successfully"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /me/notification-preferences [get]
+func (h *notificationHandler) GetPreference(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff,
+		entity.UserRoleScTechnician); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	preference, err := h.service.GetPreference(ctx)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, preference)
+}
+
+// UpdatePreference godoc
+// @Summary Update my notification preferences
+// @Description Turn notification emails of the current user on or off and mute kinds of notifications: CLAIM_SUBMITTED, CLAIM_ASSIGNED, CLAIM_APPROVED, CLAIM_REJECTED or CLAIM_COMPLETED
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param updateNotificationPreferenceRequest body dto.UpdateNotificationPreferenceRequest true "Notification preferences"
+// @Success 200 {object} dto.APIResponse{data=entity.NotificationPreference} "Notification preferences updated successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /me/notification-preferences [put]
+func (h *notificationHandler) UpdatePreference(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff,
+		entity.UserRoleScTechnician); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var req dto.UpdateNotificationPreferenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	mutedKinds := make([]string, len(req.MutedKinds))
+	for i, kind := range req.MutedKinds {
+		mutedKinds[i] = strings.ToUpper(strings.TrimSpace(kind))
+	}
+	preference, err := h.service.UpdatePreference(ctx, &service.UpdateNotificationPreferenceCommand{
+		EmailEnabled: *req.EmailEnabled,
+		MutedKinds:   mutedKinds,
+	})
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, preference)
+}
+
+// GetAll godoc
+// @Summary List my notifications
+// @Description List the in-app notifications of the current user, newest first, with how many are unread
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param unread query bool false "Only list unread notifications"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} dto.APIResponse{data=dto.NotificationListResponse} "Notifications retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /me/notifications [get]
+func (h *notificationHandler) GetAll(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff,
+		entity.UserRoleScTechnician); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var query dto.ListNotificationsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid query parameters"))
+		return
+	}
+
+	pagination := repository.Pagination{Page: query.Page, PageSize: query.PageSize}
+	if pagination.Page == 0 {
+		pagination.Page = repository.DefaultPage
+	}
+	if pagination.PageSize == 0 {
+		pagination.PageSize = repository.DefaultPageSize
+	}
+
+	notifications, total, err := h.service.GetNotifications(ctx, query.Unread, pagination)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+	unreadCount, err := h.service.CountUnread(ctx)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, dto.NotificationListResponse{
+		Notifications: notifications,
+		Total:         total,
+		UnreadCount:   unreadCount,
+		Page:          pagination.Page,
+		PageSize:      pagination.PageSize,
+		TotalPages:    pagination.TotalPages(total),
+	})
+}
+
+// UnreadCount godoc
+// @Summary Count my unread notifications
+// @Description Count the unread in-app notifications of the current user
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.APIResponse{data=dto.UnreadCountResponse} "Unread notifications counted successfully"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /me/notifications/unread-count [get]
+func (h *notificationHandler) UnreadCount(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff,
+		entity.UserRoleScTechnician); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	count, err := h.service.CountUnread(ctx)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, dto.UnreadCountResponse{UnreadCount: count})
+}
+
+// MarkRead godoc
+// @Summary Mark a notification read
+// @Description Mark one in-app notification of the current user read. Marking a read notification again keeps its first read time
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Notification ID"
+// @Success 200 {object} dto.APIResponse{data=entity.Notification} "Notification marked read successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Notification not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /me/notifications/{id}/read [post]
+func (h *notificationHandler) MarkRead(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff,
+		entity.UserRoleScTechnician); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid notification ID"))
+		return
+	}
+
+	notification, err := h.service.MarkRead(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, notification)
+}
+
+// MarkAllRead godoc
+// @Summary Mark all my notifications read
+// @Description Mark every unread in-app notification of the current user read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.APIResponse{data=dto.MarkAllReadResponse} "Notifications marked read successfully"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /me/notifications/read-all [post]
+func (h *notificationHandler) MarkAllRead(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff,
+		entity.UserRoleScTechnician); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	updated, err := h.service.MarkAllRead(ctx)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, dto.MarkAllReadResponse{Updated: updated})
+}
+
+// Stream godoc
+// @Summary Stream my notifications
+// @Description Push the in-app notifications of the current user as server-sent events while the connection is open. An "unread" event with the unread count is sent first, then a "notification" event per new notification. Comments are sent as heartbeats
+// @Tags notifications
+// @Produce text/event-stream
+// @Security Bearer
+// @Success 200 {object} entity.Notification "Notification events"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /me/notifications/stream [get]
+func (h *notificationHandler) Stream(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff,
+		entity.UserRoleScTechnician); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	// Subscribe before counting, so no notification falls between the two.
+	notifications, unsubscribe, err := h.service.Subscribe(c.Request.Context())
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+	defer unsubscribe()
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	count, err := h.service.CountUnread(ctx)
+	cancel()
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeStreamHeaders(c)
+	c.SSEvent("unread", dto.UnreadCountResponse{UnreadCount: count})
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case notification, ok := <-notifications:
+			if !ok {
+				return false
+			}
+			c.SSEvent("notification", notification)
+			return true
+		case <-heartbeat.C:
+			return writeHeartbeat(w) == nil
+		}
+	})
+}
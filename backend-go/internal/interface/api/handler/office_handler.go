@@ -77,6 +77,7 @@ func (h *officeHandler) Create(c *gin.Context) {
 		OfficeType: strings.TrimSpace(req.OfficeType),
 		Address:    strings.TrimSpace(req.Address),
 		IsActive:   req.IsActive,
+		LaborRate:  req.LaborRate,
 	}
 
 	office, err := h.service.Create(ctx, cmd)
@@ -200,6 +201,7 @@ func (h *officeHandler) Update(c *gin.Context) {
 		OfficeType: strings.TrimSpace(req.OfficeType),
 		Address:    strings.TrimSpace(req.Address),
 		IsActive:   req.IsActive,
+		LaborRate:  req.LaborRate,
 	}
 
 	err = h.service.Update(ctx, id, cmd)
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportHandler interface {
+	ClaimVolume(c *gin.Context)
+	DecisionRates(c *gin.Context)
+	TimeInStatus(c *gin.Context)
+	PartFailures(c *gin.Context)
+	OfficeCosts(c *gin.Context)
+}
+
+type reportHandler struct {
+	log     logger.Logger
+	service service.ReportService
+}
+
+func NewReportHandler(log logger.Logger, service service.ReportService) ReportHandler {
+	return &reportHandler{
+		log:     log,
+		service: service,
+	}
+}
+
+// ClaimVolume godoc
+// @Summary Claim volume report
+// @Description Count the claims created in each period per office and current status. SC Staff only get their office
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Office ID"
+// @Param from_date query string false "Range start (YYYY-MM-DD or RFC3339), 30 days before to_date by default"
+// @Param to_date query string false "Range end (YYYY-MM-DD or RFC3339), today by default"
+// @Param granularity query string false "Period length" Enums(day, week, month) default(day)
+// @Success 200 {object} dto.APIResponse{data=[]repository.ClaimVolume} "Report computed successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /reports/claim-volume [get]
+func (h *reportHandler) ClaimVolume(c *gin.Context) {
+	serveReport(h, c, h.service.ClaimVolume)
+}
+
+// DecisionRates godoc
+// @Summary Review decision rates report
+// @Description Count the approvals, partial approvals and rejections of claims in each period per office, with their share of all decisions in percent. SC Staff only get their office
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Office ID"
+// @Param from_date query string false "Range start (YYYY-MM-DD or RFC3339), 30 days before to_date by default"
+// @Param to_date query string false "Range end (YYYY-MM-DD or RFC3339), today by default"
+// @Param granularity query string false "Period length" Enums(day, week, month) default(day)
+// @Success 200 {object} dto.APIResponse{data=[]repository.DecisionRate} "Report computed successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /reports/decision-rates [get]
+func (h *reportHandler) DecisionRates(c *gin.Context) {
+	serveReport(h, c, h.service.DecisionRates)
+}
+
+// TimeInStatus godoc
+// @Summary Time in status report
+// @Description Average the time claims that entered each status in a period spent in it before moving on, from the claim history. SC Staff only get their office
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Office ID"
+// @Param from_date query string false "Range start (YYYY-MM-DD or RFC3339), 30 days before to_date by default"
+// @Param to_date query string false "Range end (YYYY-MM-DD or RFC3339), today by default"
+// @Param granularity query string false "Period length" Enums(day, week, month) default(day)
+// @Success 200 {object} dto.APIResponse{data=[]repository.StatusDuration} "Report computed successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /reports/time-in-status [get]
+func (h *reportHandler) TimeInStatus(c *gin.Context) {
+	serveReport(h, c, h.service.TimeInStatus)
+}
+
+// PartFailures godoc
+// @Summary Top failing part categories report
+// @Description Rank the part categories by the number of claim items raised for them in the range, with their cost. SC Staff only get their office
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Office ID"
+// @Param from_date query string false "Range start (YYYY-MM-DD or RFC3339), 30 days before to_date by default"
+// @Param to_date query string false "Range end (YYYY-MM-DD or RFC3339), today by default"
+// @Param limit query int false "Number of part categories" default(10)
+// @Success 200 {object} dto.APIResponse{data=[]repository.PartFailure} "Report computed successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /reports/part-failures [get]
+func (h *reportHandler) PartFailures(c *gin.Context) {
+	serveReport(h, c, h.service.PartFailures)
+}
+
+// OfficeCosts godoc
+// @Summary Cost per office report
+// @Description Sum the requested, approved and reimbursed totals of the claims created in each period per office, in minor units of their currency. SC Staff only get their office
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Office ID"
+// @Param from_date query string false "Range start (YYYY-MM-DD or RFC3339), 30 days before to_date by default"
+// @Param to_date query string false "Range end (YYYY-MM-DD or RFC3339), today by default"
+// @Param granularity query string false "Period length" Enums(day, week, month) default(day)
+// @Success 200 {object} dto.APIResponse{data=[]repository.OfficeCost} "Report computed successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /reports/office-costs [get]
+func (h *reportHandler) OfficeCosts(c *gin.Context) {
+	serveReport(h, c, h.service.OfficeCosts)
+}
+
+// serveReport parses the report query, runs report and writes its rows.
+func serveReport[T any](h *reportHandler, c *gin.Context,
+	report func(ctx context.Context, filters repository.ReportFilters) ([]T, error),
+) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var query dto.ReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid query parameters"))
+		return
+	}
+
+	filters, err := parseReportQuery(&query)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	rows, err := report(ctx, filters)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, rows)
+}
+
+func parseReportQuery(query *dto.ReportQuery) (repository.ReportFilters, error) {
+	filters := repository.ReportFilters{
+		Granularity: strings.ToLower(query.Granularity),
+		Limit:       query.Limit,
+	}
+
+	var err error
+	if filters.OfficeID, err = parseOptionalUUID(query.OfficeID, "office id"); err != nil {
+		return filters, err
+	}
+
+	toDate, err := parseOptionalTime(query.ToDate, "to date", true)
+	if err != nil {
+		return filters, err
+	}
+	if toDate == nil {
+		endOfToday := time.Now().Truncate(24 * time.Hour).Add(24*time.Hour - time.Nanosecond)
+		toDate = &endOfToday
+	}
+	filters.ToDate = *toDate
+
+	fromDate, err := parseOptionalTime(query.FromDate, "from date", false)
+	if err != nil {
+		return filters, err
+	}
+	if fromDate == nil {
+		from := filters.ToDate.Truncate(24*time.Hour).AddDate(0, 0, -repository.DefaultReportDays)
+		fromDate = &from
+	}
+	filters.FromDate = *fromDate
+
+	if filters.Granularity == "" {
+		filters.Granularity = repository.GranularityDay
+	}
+	if filters.Limit == 0 {
+		filters.Limit = repository.DefaultPartFailuresLimit
+	}
+	return filters, nil
+}
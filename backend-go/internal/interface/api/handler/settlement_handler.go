@@ -0,0 +1,394 @@
+package handler
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/repository"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SettlementHandler interface {
+	Create(c *gin.Context)
+	GetByID(c *gin.Context)
+	GetAll(c *gin.Context)
+	Issue(c *gin.Context)
+	MarkPaid(c *gin.Context)
+	Dispute(c *gin.Context)
+	Delete(c *gin.Context)
+	Statement(c *gin.Context)
+}
+
+type settlementHandler struct {
+	log       logger.Logger
+	txManager application.TxManager
+	service   service.SettlementService
+}
+
+func NewSettlementHandler(log logger.Logger, txManager application.TxManager,
+	service service.SettlementService,
+) SettlementHandler {
+	return &settlementHandler{
+		log:       log,
+		txManager: txManager,
+		service:   service,
+	}
+}
+
+// Create godoc
+// @Summary Create a settlement batch
+// @Description Draft a reimbursement batch with every approved item of the claims an office completed between period_start and period_end, both inclusive, that is not settled yet (Admin and EVM Staff only)
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreateSettlementBatchRequest true "Office and period, dates as YYYY-MM-DD"
+// @Success 201 {object} dto.APIResponse{data=entity.SettlementBatch} "Settlement batch created successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Office not found"
+// @Failure 422 {object} dto.APIResponse "No completed claims to settle"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements [post]
+func (h *settlementHandler) Create(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	var req dto.CreateSettlementBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	periodStart, err := time.Parse(dateLayout, req.PeriodStart)
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidInput.WithMessage("Invalid period start"))
+		return
+	}
+	periodEnd, err := time.Parse(dateLayout, req.PeriodEnd)
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidInput.WithMessage("Invalid period end"))
+		return
+	}
+
+	cmd := &service.CreateSettlementBatchCommand{
+		OfficeID:    req.OfficeID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd.AddDate(0, 0, 1),
+	}
+
+	var batch *entity.SettlementBatch
+	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
+		var txErr error
+		batch, txErr = h.service.Create(tx, cmd)
+		return txErr
+	})
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("settlement batch created", "settlement_batch_id", batch.ID, "office_id", batch.OfficeID,
+		"lines", len(batch.Lines))
+	writeSuccessResponse(c, http.StatusCreated, batch)
+}
+
+// GetByID godoc
+// @Summary Get settlement batch by ID
+// @Description Retrieve a settlement batch with its lines. SC Staff only see the batches of their office
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Settlement batch ID"
+// @Success 200 {object} dto.APIResponse{data=entity.SettlementBatch} "Settlement batch retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Settlement batch not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements/{id} [get]
+func (h *settlementHandler) GetByID(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid settlement batch ID"))
+		return
+	}
+
+	batch, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, batch)
+}
+
+// GetAll godoc
+// @Summary List settlement batches
+// @Description Retrieve settlement batches, newest period first. SC Staff only see the batches of their office
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Filter by office ID"
+// @Param status query string false "Filter by status (DRAFT, ISSUED, PAID, DISPUTED)"
+// @Success 200 {object} dto.APIResponse{data=[]entity.SettlementBatch} "Settlement batches retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements [get]
+func (h *settlementHandler) GetAll(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var query dto.ListSettlementBatchesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams)
+		return
+	}
+
+	officeID, err := parseOptionalUUID(query.OfficeID, "office id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	filters := repository.SettlementBatchFilters{OfficeID: officeID}
+	if query.Status != "" {
+		filters.Status = &query.Status
+	}
+
+	batches, err := h.service.GetAll(ctx, filters)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, batches)
+}
+
+// Issue godoc
+// @Summary Issue a settlement batch
+// @Description Send a draft or disputed batch to the office. Its claims are locked against further changes (Admin and EVM Staff only)
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Settlement batch ID"
+// @Success 200 {object} dto.APIResponse{data=entity.SettlementBatch} "Settlement batch issued successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Settlement batch not found"
+// @Failure 409 {object} dto.APIResponse "Invalid settlement batch action"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements/{id}/issue [post]
+func (h *settlementHandler) Issue(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.transition(c, "issued", h.service.Issue)
+}
+
+// MarkPaid godoc
+// @Summary Mark a settlement batch as paid
+// @Description Record the payment of an issued or disputed batch on its claims' reimbursed totals (Admin and EVM Staff only)
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Settlement batch ID"
+// @Success 200 {object} dto.APIResponse{data=entity.SettlementBatch} "Settlement batch paid successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Settlement batch not found"
+// @Failure 409 {object} dto.APIResponse "Invalid settlement batch action"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements/{id}/pay [post]
+func (h *settlementHandler) MarkPaid(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.transition(c, "paid", h.service.MarkPaid)
+}
+
+// Dispute godoc
+// @Summary Dispute a settlement batch
+// @Description Contest an issued batch of the office with a reason (SC Staff and Admin only)
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Settlement batch ID"
+// @Param request body dto.DisputeSettlementBatchRequest true "Dispute reason"
+// @Success 200 {object} dto.APIResponse{data=entity.SettlementBatch} "Settlement batch disputed successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Settlement batch not found"
+// @Failure 409 {object} dto.APIResponse "Invalid settlement batch action"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements/{id}/dispute [post]
+func (h *settlementHandler) Dispute(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	var req dto.DisputeSettlementBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	h.transition(c, "disputed", func(tx application.Tx, id uuid.UUID) (*entity.SettlementBatch, error) {
+		return h.service.Dispute(tx, id, req.Reason)
+	})
+}
+
+// Delete godoc
+// @Summary Delete a settlement batch
+// @Description Discard a draft batch, freeing its items for another batch (Admin and EVM Staff only)
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Settlement batch ID"
+// @Success 204 "Settlement batch deleted successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Settlement batch not found"
+// @Failure 409 {object} dto.APIResponse "Invalid settlement batch action"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements/{id} [delete]
+func (h *settlementHandler) Delete(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid settlement batch ID"))
+		return
+	}
+
+	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
+		return h.service.Delete(tx, id)
+	})
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("settlement batch deleted", "settlement_batch_id", id)
+	c.Status(http.StatusNoContent)
+}
+
+// Statement godoc
+// @Summary Download a settlement statement
+// @Description Export a settlement batch with its lines as a CSV or PDF statement. SC Staff only see the batches of their office
+// @Tags settlements
+// @Produce text/csv
+// @Produce application/pdf
+// @Security Bearer
+// @Param id path string true "Settlement batch ID"
+// @Param format query string false "Statement format, csv (default) or pdf"
+// @Success 200 {file} file "Settlement statement"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Settlement batch not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /settlements/{id}/statement [get]
+func (h *settlementHandler) Statement(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid settlement batch ID"))
+		return
+	}
+
+	var query dto.SettlementStatementQuery
+	if err = c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams)
+		return
+	}
+	if query.Format == "" {
+		query.Format = service.StatementFormatCSV
+	}
+
+	statement, err := h.service.Statement(ctx, id, query.Format)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+statement.Filename+`"`)
+	c.Data(http.StatusOK, statement.ContentType, statement.Content)
+}
+
+// transition runs a lifecycle action of the batch in the path inside a
+// transaction and responds with the updated batch.
+func (h *settlementHandler) transition(c *gin.Context, action string,
+	run func(tx application.Tx, id uuid.UUID) (*entity.SettlementBatch, error),
+) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid settlement batch ID"))
+		return
+	}
+
+	var batch *entity.SettlementBatch
+	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
+		var txErr error
+		batch, txErr = run(tx, id)
+		return txErr
+	})
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("settlement batch "+action, "settlement_batch_id", batch.ID)
+	writeSuccessResponse(c, http.StatusOK, batch)
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SLAHandler interface {
+	GetCalendar(c *gin.Context)
+	UpdateCalendar(c *gin.Context)
+}
+
+type slaHandler struct {
+	log     logger.Logger
+	service service.SLAService
+}
+
+func NewSLAHandler(log logger.Logger, service service.SLAService) SLAHandler {
+	return &slaHandler{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetCalendar godoc
+// @Summary Get an office business calendar
+// @Description Get the working days, holidays and time zone SLAs in business days are counted with for an office. Offices without a calendar work Monday to Friday in UTC. SC Staff only get their office
+// @Tags offices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Office ID"
+// @Success 200 {object} dto.APIResponse{data=entity.BusinessCalendar} "Business calendar retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Office not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /offices/{id}/calendar [get]
+func (h *slaHandler) GetCalendar(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleEvmStaff, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid office id"))
+		return
+	}
+
+	calendar, err := h.service.GetCalendar(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, calendar)
+}
+
+// UpdateCalendar godoc
+// @Summary Update an office business calendar
+// @Description Replace the business calendar of an office (Admin only). SLAs already running keep their due time
+// @Tags offices
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Office ID"
+// @Param updateBusinessCalendarRequest body dto.UpdateBusinessCalendarRequest true "Business calendar"
+// @Success 200 {object} dto.APIResponse{data=entity.BusinessCalendar} "Business calendar updated successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Office not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /offices/{id}/calendar [put]
+func (h *slaHandler) UpdateCalendar(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid office id"))
+		return
+	}
+
+	var req dto.UpdateBusinessCalendarRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	calendar, err := h.service.UpdateCalendar(ctx, id, &service.UpdateBusinessCalendarCommand{
+		Timezone:    strings.TrimSpace(req.Timezone),
+		WorkingDays: req.WorkingDays,
+		Holidays:    req.Holidays,
+	})
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, calendar)
+}
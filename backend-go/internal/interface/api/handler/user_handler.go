@@ -235,7 +235,7 @@ func (h userHandler) GetAvailableTechnician(c *gin.Context) {
 
 	h.log.Info("get all available technicians request", "remote_addr", c.ClientIP())
 
-	staffID, err := getUserIDFromHeader(c)
+	staffID, err := getUserID(c)
 	if err != nil {
 		writeErrorResponse(h.log, c, err)
 		return
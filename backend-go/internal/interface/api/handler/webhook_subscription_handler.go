@@ -0,0 +1,362 @@
+package handler
+
+import (
+	"context"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WebhookSubscriptionHandler interface {
+	Create(c *gin.Context)
+	GetByID(c *gin.Context)
+	GetAll(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	Deliveries(c *gin.Context)
+	Redeliver(c *gin.Context)
+}
+
+type webhookSubscriptionHandler struct {
+	log       logger.Logger
+	txManager application.TxManager
+	service   service.WebhookSubscriptionService
+}
+
+func NewWebhookSubscriptionHandler(log logger.Logger, txManager application.TxManager,
+	service service.WebhookSubscriptionService,
+) WebhookSubscriptionHandler {
+	return &webhookSubscriptionHandler{
+		log:       log,
+		txManager: txManager,
+		service:   service,
+	}
+}
+
+// Create godoc
+// @Summary Create a webhook subscription
+// @Description Subscribe an endpoint to the claim events of an office. SC staff can only subscribe their own office, admins must give the office. An empty event type list subscribes to every event. The signing secret is only returned by this call
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreateWebhookSubscriptionRequest true "Webhook subscription data"
+// @Success 201 {object} dto.APIResponse{data=dto.CreatedWebhookSubscriptionResponse} "Webhook subscription created successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Office not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /webhooks [post]
+func (h *webhookSubscriptionHandler) Create(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var req dto.CreateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	officeID, err := parseOptionalUUID(req.OfficeID, "office id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	cmd := &service.CreateWebhookSubscriptionCommand{
+		URL:        strings.TrimSpace(req.URL),
+		EventTypes: req.EventTypes,
+		IsActive:   req.IsActive == nil || *req.IsActive,
+	}
+	if officeID != nil {
+		cmd.OfficeID = *officeID
+	}
+
+	subscription, err := h.service.Create(ctx, cmd)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("webhook subscription created", "subscription_id", subscription.ID,
+		"office_id", subscription.OfficeID)
+	writeSuccessResponse(c, http.StatusCreated, dto.CreatedWebhookSubscriptionResponse{
+		WebhookSubscription: *subscription,
+		Secret:              subscription.Secret,
+	})
+}
+
+// GetByID godoc
+// @Summary Get webhook subscription by ID
+// @Description Retrieve a webhook subscription. SC staff can only see the subscriptions of their own office
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Webhook subscription ID"
+// @Success 200 {object} dto.APIResponse{data=entity.WebhookSubscription} "Webhook subscription retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Webhook subscription not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /webhooks/{id} [get]
+func (h *webhookSubscriptionHandler) GetByID(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid webhook subscription ID"))
+		return
+	}
+
+	subscription, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, subscription)
+}
+
+// GetAll godoc
+// @Summary List webhook subscriptions
+// @Description Retrieve the webhook subscriptions, optionally of one office. SC staff only see the subscriptions of their own office
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param office_id query string false "Filter by office ID"
+// @Success 200 {object} dto.APIResponse{data=[]entity.WebhookSubscription} "Webhook subscriptions retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /webhooks [get]
+func (h *webhookSubscriptionHandler) GetAll(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	var query dto.ListWebhookSubscriptionsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams)
+		return
+	}
+
+	officeID, err := parseOptionalUUID(query.OfficeID, "office id")
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	subscriptions, err := h.service.GetAll(ctx, officeID)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, subscriptions)
+}
+
+// Update godoc
+// @Summary Update a webhook subscription
+// @Description Change the URL, event types or active flag of a webhook subscription. The secret is kept
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Webhook subscription ID"
+// @Param request body dto.UpdateWebhookSubscriptionRequest true "Webhook subscription update data"
+// @Success 204 "Webhook subscription updated successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Webhook subscription not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /webhooks/{id} [put]
+func (h *webhookSubscriptionHandler) Update(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid webhook subscription ID"))
+		return
+	}
+
+	var req dto.UpdateWebhookSubscriptionRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidJsonRequest)
+		return
+	}
+
+	cmd := &service.UpdateWebhookSubscriptionCommand{
+		URL:        strings.TrimSpace(req.URL),
+		EventTypes: req.EventTypes,
+		IsActive:   req.IsActive,
+	}
+	if err = h.service.Update(ctx, id, cmd); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("webhook subscription updated", "subscription_id", id)
+	c.Status(http.StatusNoContent)
+}
+
+// Delete godoc
+// @Summary Delete a webhook subscription
+// @Description Delete a webhook subscription. Its pending deliveries are no longer sent
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Webhook subscription ID"
+// @Success 204 "Webhook subscription deleted successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Webhook subscription not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /webhooks/{id} [delete]
+func (h *webhookSubscriptionHandler) Delete(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid webhook subscription ID"))
+		return
+	}
+
+	if err = h.service.Delete(ctx, id); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("webhook subscription deleted", "subscription_id", id)
+	c.Status(http.StatusNoContent)
+}
+
+// Deliveries godoc
+// @Summary List webhook deliveries
+// @Description Retrieve the latest deliveries of a webhook subscription with their attempts, newest first
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Webhook subscription ID"
+// @Success 200 {object} dto.APIResponse{data=[]entity.WebhookDelivery} "Webhook deliveries retrieved successfully"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Webhook subscription not found"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /webhooks/{id}/deliveries [get]
+func (h *webhookSubscriptionHandler) Deliveries(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), requestTimeout)
+	defer cancel()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid webhook subscription ID"))
+		return
+	}
+
+	deliveries, err := h.service.GetDeliveries(ctx, id)
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	writeSuccessResponse(c, http.StatusOK, deliveries)
+}
+
+// Redeliver godoc
+// @Summary Redeliver a webhook delivery
+// @Description Queue a delivery again with a fresh attempt budget, whether it was delivered, dead or still pending
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Webhook subscription ID"
+// @Param deliveryID path string true "Webhook delivery ID"
+// @Success 202 {object} dto.APIResponse{data=entity.WebhookDelivery} "Webhook delivery queued"
+// @Failure 400 {object} dto.APIResponse "Bad request"
+// @Failure 401 {object} dto.APIResponse "Unauthorized"
+// @Failure 403 {object} dto.APIResponse "Forbidden"
+// @Failure 404 {object} dto.APIResponse "Webhook subscription or delivery not found"
+// @Failure 409 {object} dto.APIResponse "Webhook subscription is inactive"
+// @Failure 500 {object} dto.APIResponse "Internal server error"
+// @Router /webhooks/{id}/deliveries/{deliveryID}/redeliver [post]
+func (h *webhookSubscriptionHandler) Redeliver(c *gin.Context) {
+	if err := allowedRoles(c, entity.UserRoleAdmin, entity.UserRoleScStaff); err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid webhook subscription ID"))
+		return
+	}
+
+	deliveryID, err := uuid.Parse(c.Param("deliveryID"))
+	if err != nil {
+		writeErrorResponse(h.log, c, apperror.ErrInvalidParams.WithMessage("Invalid webhook delivery ID"))
+		return
+	}
+
+	var delivery *entity.WebhookDelivery
+	err = h.txManager.Do(c.Request.Context(), func(tx application.Tx) error {
+		delivery, err = h.service.Redeliver(tx, id, deliveryID)
+		return err
+	})
+	if err != nil {
+		writeErrorResponse(h.log, c, err)
+		return
+	}
+
+	h.log.Info("webhook delivery queued for redelivery", "subscription_id", id, "delivery_id", deliveryID)
+	writeSuccessResponse(c, http.StatusAccepted, delivery)
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/infrastructure/config"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/logger"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	ContextUserIDKey   = "auth.user_id"
+	ContextUserRoleKey = "auth.user_role"
+
+	authTimeout     = 10 * time.Second
+	bearerPrefix    = "Bearer "
+	headerUserIDKey = "X-User-ID"
+)
+
+type AuthMiddleware interface {
+	Authenticate(c *gin.Context)
+}
+
+type authMiddleware struct {
+	log          logger.Logger
+	mode         string
+	tokenService service.TokenService
+	userService  service.UserService
+}
+
+func NewAuthMiddleware(log logger.Logger, mode string, tokenService service.TokenService,
+	userService service.UserService,
+) AuthMiddleware {
+	return &authMiddleware{
+		log:          log,
+		mode:         mode,
+		tokenService: tokenService,
+		userService:  userService,
+	}
+}
+
+// Authenticate resolves the caller identity and stores it in the gin context.
+// In header mode the X-User-ID header set by the nginx auth subrequest is
+// trusted and the role and office are loaded from the user record. In jwt
+// mode the bearer token is validated here and any identity headers sent by
+// the client are ignored.
+func (m *authMiddleware) Authenticate(c *gin.Context) {
+	if m.mode == config.AuthModeJWT {
+		m.authenticateJWT(c)
+		return
+	}
+	m.authenticateHeader(c)
+}
+
+func (m *authMiddleware) authenticateHeader(c *gin.Context) {
+	userIDStr := c.GetHeader(headerUserIDKey)
+	if userIDStr == "" {
+		c.Next()
+		return
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		m.abort(c, apperror.ErrInvalidUserID)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), authTimeout)
+	defer cancel()
+
+	if err = m.setActor(ctx, c, userID); err != nil {
+		m.abort(c, err)
+		return
+	}
+
+	c.Next()
+}
+
+func (m *authMiddleware) authenticateJWT(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), authTimeout)
+	defer cancel()
+
+	authHeader := c.GetHeader("Authorization")
+	if !strings.HasPrefix(authHeader, bearerPrefix) {
+		m.abort(c, apperror.ErrInvalidAuthHeader)
+		return
+	}
+
+	claims, err := m.tokenService.ValidateAccessToken(ctx, strings.TrimPrefix(authHeader, bearerPrefix))
+	if err != nil {
+		m.abort(c, err)
+		return
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		m.abort(c, apperror.ErrInvalidUserID)
+		return
+	}
+
+	if err = m.setActor(ctx, c, userID); err != nil {
+		m.abort(c, err)
+		return
+	}
+
+	c.Next()
+}
+
+// setActor loads the caller and publishes it both in the gin context for the
+// handlers and in the request context for the services, which scope data
+// access by the actor's role and office.
+func (m *authMiddleware) setActor(ctx context.Context, c *gin.Context, userID uuid.UUID) error {
+	user, err := m.userService.GetByID(ctx, userID)
+	if err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) && appErr.ErrorCode == apperror.ErrNotFoundError.ErrorCode {
+			return apperror.ErrInvalidUserID.WithError(err)
+		}
+		return err
+	}
+	if !user.IsActive {
+		return apperror.ErrUserInactive
+	}
+
+	c.Set(ContextUserIDKey, user.ID)
+	c.Set(ContextUserRoleKey, user.Role)
+	c.Request = c.Request.WithContext(application.WithActor(c.Request.Context(), application.NewActor(user)))
+
+	return nil
+}
+
+func (m *authMiddleware) abort(c *gin.Context, err error) {
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) {
+		appErr = apperror.ErrInternalServerError.WithError(err)
+	}
+
+	m.log.Error(appErr.ErrorCode, "method", c.Request.Method, "path", c.FullPath(), "error", err)
+
+	c.AbortWithStatusJSON(appErr.HttpCode, dto.APIResponse{
+		IsSuccess: false,
+		Error:     appErr.ErrorCode,
+		Message:   appErr.Message,
+	})
+}
@@ -0,0 +1,223 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"ev-warranty-go/internal/application"
+	"ev-warranty-go/internal/application/service"
+	"ev-warranty-go/internal/domain/entity"
+	"ev-warranty-go/internal/infrastructure/config"
+	"ev-warranty-go/internal/interface/api/dto"
+	"ev-warranty-go/internal/interface/api/handler"
+	"ev-warranty-go/internal/interface/api/middleware"
+	"ev-warranty-go/pkg/apperror"
+	"ev-warranty-go/pkg/mocks"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("AuthMiddleware", func() {
+	var (
+		mockLogger       *mocks.Logger
+		mockTokenService *mocks.TokenService
+		mockUserService  *mocks.UserService
+		router           *gin.Engine
+		actor            *application.Actor
+		reached          bool
+		user             *entity.User
+	)
+
+	newRouter := func(mode string) {
+		authMiddleware := middleware.NewAuthMiddleware(mockLogger, mode, mockTokenService, mockUserService)
+		router = gin.New()
+		router.GET("/claims", authMiddleware.Authenticate, func(c *gin.Context) {
+			reached = true
+			actor, _ = application.ActorFromContext(c.Request.Context())
+			c.Status(http.StatusOK)
+		})
+		router.GET("/debug/vars", authMiddleware.Authenticate, handler.NewDebugHandler(mockLogger).Vars)
+	}
+
+	serve := func(path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	expectError := func(w *httptest.ResponseRecorder, appErr *apperror.AppError) {
+		GinkgoHelper()
+		Expect(w.Code).To(Equal(appErr.HttpCode))
+		var response dto.APIResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+		Expect(response.Error).To(Equal(appErr.ErrorCode))
+	}
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockLogger = mocks.NewLogger(GinkgoT())
+		mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything).Maybe()
+		mockLogger.EXPECT().Error(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything).Maybe()
+		mockTokenService = mocks.NewTokenService(GinkgoT())
+		mockUserService = mocks.NewUserService(GinkgoT())
+		actor = nil
+		reached = false
+		user = &entity.User{
+			ID:       uuid.New(),
+			Role:     entity.UserRoleScStaff,
+			OfficeID: uuid.New(),
+			IsActive: true,
+		}
+	})
+
+	Describe("jwt mode", func() {
+		BeforeEach(func() {
+			newRouter(config.AuthModeJWT)
+		})
+
+		Context("when the bearer token is missing", func() {
+			It("should return 401", func() {
+				w := serve("/claims", nil)
+
+				expectError(w, apperror.ErrInvalidAuthHeader)
+				Expect(reached).To(BeFalse())
+			})
+		})
+
+		Context("when the authorization header is not a bearer token", func() {
+			It("should return 401", func() {
+				w := serve("/claims", map[string]string{"Authorization": "Basic dXNlcjpwYXNz"})
+
+				expectError(w, apperror.ErrInvalidAuthHeader)
+				Expect(reached).To(BeFalse())
+			})
+		})
+
+		Context("when the bearer token is invalid", func() {
+			It("should return 401", func() {
+				mockTokenService.EXPECT().ValidateAccessToken(mock.Anything, "forged").
+					Return(nil, apperror.ErrInvalidAccessToken).Once()
+
+				w := serve("/claims", map[string]string{"Authorization": "Bearer forged"})
+
+				expectError(w, apperror.ErrInvalidAccessToken)
+				Expect(reached).To(BeFalse())
+			})
+		})
+
+		Context("when the user is deactivated", func() {
+			It("should refuse the request", func() {
+				user.IsActive = false
+				mockTokenService.EXPECT().ValidateAccessToken(mock.Anything, "valid").
+					Return(&service.CustomClaims{UserID: user.ID.String()}, nil).Once()
+				mockUserService.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+
+				w := serve("/claims", map[string]string{"Authorization": "Bearer valid"})
+
+				expectError(w, apperror.ErrUserInactive)
+				Expect(reached).To(BeFalse())
+			})
+		})
+
+		Context("when the user no longer exists", func() {
+			It("should return 401", func() {
+				mockTokenService.EXPECT().ValidateAccessToken(mock.Anything, "valid").
+					Return(&service.CustomClaims{UserID: user.ID.String()}, nil).Once()
+				mockUserService.EXPECT().GetByID(mock.Anything, user.ID).Return(nil, apperror.ErrNotFoundError).Once()
+
+				w := serve("/claims", map[string]string{"Authorization": "Bearer valid"})
+
+				expectError(w, apperror.ErrInvalidUserID)
+			})
+		})
+
+		Context("when the client sends identity headers", func() {
+			It("should ignore them and use the token's user", func() {
+				mockTokenService.EXPECT().ValidateAccessToken(mock.Anything, "valid").
+					Return(&service.CustomClaims{UserID: user.ID.String()}, nil).Once()
+				mockUserService.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+
+				w := serve("/claims", map[string]string{
+					"Authorization": "Bearer valid",
+					"X-User-ID":     uuid.NewString(),
+					"X-User-Role":   entity.UserRoleAdmin,
+				})
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(actor).NotTo(BeNil())
+				Expect(actor.UserID).To(Equal(user.ID))
+				Expect(actor.Role).To(Equal(entity.UserRoleScStaff))
+				Expect(actor.OfficeID).To(Equal(user.OfficeID))
+			})
+		})
+	})
+
+	Describe("header mode", func() {
+		BeforeEach(func() {
+			newRouter(config.AuthModeHeader)
+		})
+
+		Context("when X-User-ID is set", func() {
+			It("should load the role and office from the user record", func() {
+				mockUserService.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+
+				w := serve("/claims", map[string]string{
+					"X-User-ID":   user.ID.String(),
+					"X-User-Role": entity.UserRoleAdmin,
+				})
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(actor).NotTo(BeNil())
+				Expect(actor.UserID).To(Equal(user.ID))
+				Expect(actor.Role).To(Equal(entity.UserRoleScStaff))
+				Expect(actor.OfficeID).To(Equal(user.OfficeID))
+			})
+		})
+
+		Context("when X-User-ID is not a UUID", func() {
+			It("should return 401", func() {
+				w := serve("/claims", map[string]string{"X-User-ID": "admin"})
+
+				expectError(w, apperror.ErrInvalidUserID)
+				Expect(reached).To(BeFalse())
+			})
+		})
+
+		Context("when the user is deactivated", func() {
+			It("should refuse the request", func() {
+				user.IsActive = false
+				mockUserService.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+
+				w := serve("/claims", map[string]string{"X-User-ID": user.ID.String()})
+
+				expectError(w, apperror.ErrUserInactive)
+				Expect(reached).To(BeFalse())
+			})
+		})
+
+		Context("when X-User-ID is missing", func() {
+			It("should leave no actor", func() {
+				w := serve("/claims", map[string]string{"X-User-Role": entity.UserRoleAdmin})
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(reached).To(BeTrue())
+				Expect(actor).To(BeNil())
+			})
+
+			It("should have protected handlers refuse the request", func() {
+				w := serve("/debug/vars", map[string]string{"X-User-Role": entity.UserRoleAdmin})
+
+				expectError(w, apperror.ErrMissingUserRole)
+			})
+		})
+	})
+})
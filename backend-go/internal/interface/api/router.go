@@ -2,7 +2,9 @@ package api
 
 import (
 	"ev-warranty-go/internal/infrastructure/database"
+	"ev-warranty-go/internal/infrastructure/storage"
 	"ev-warranty-go/internal/interface/api/handler"
+	"ev-warranty-go/internal/interface/api/middleware"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -14,10 +16,14 @@ type HealthHandler struct {
 	DB *database.Database
 }
 
-func NewRouter(db *database.Database, authHandler handler.AuthHandler,
+func NewRouter(db *database.Database, authMiddleware middleware.AuthMiddleware, authHandler handler.AuthHandler,
 	oauthHandler handler.OAuthHandler, officeHandler handler.OfficeHandler,
 	userHandler handler.UserHandler, claimHandler handler.ClaimHandler,
 	itemHandler handler.ClaimItemHandler, attachmentHandler handler.ClaimAttachmentHandler,
+	webhookHandler handler.WebhookSubscriptionHandler, laborOperationHandler handler.LaborOperationHandler,
+	settlementHandler handler.SettlementHandler, reportHandler handler.ReportHandler,
+	slaHandler handler.SLAHandler, notificationHandler handler.NotificationHandler,
+	fileHandler handler.FileHandler, debugHandler handler.DebugHandler,
 ) *gin.Engine {
 
 	router := gin.New()
@@ -43,7 +49,13 @@ func NewRouter(db *database.Database, authHandler handler.AuthHandler,
 		auth.GET("/google/callback", oauthHandler.HandleCallback)
 	}
 
-	users := router.Group("/users")
+	router.GET(storage.FilesPath+"*key", fileHandler.Download)
+
+	protected := router.Group("/", authMiddleware.Authenticate)
+
+	protected.GET("/debug/vars", debugHandler.Vars)
+
+	users := protected.Group("/users")
 	{
 		users.POST("", userHandler.Create)
 		users.GET("", userHandler.GetAll)
@@ -53,18 +65,64 @@ func NewRouter(db *database.Database, authHandler handler.AuthHandler,
 		users.DELETE("/:id", userHandler.Delete)
 	}
 
-	office := router.Group("/offices")
+	me := protected.Group("/me")
+	{
+		me.GET("/notification-preferences", notificationHandler.GetPreference)
+		me.PUT("/notification-preferences", notificationHandler.UpdatePreference)
+		me.GET("/notifications", notificationHandler.GetAll)
+		me.GET("/notifications/unread-count", notificationHandler.UnreadCount)
+		me.GET("/notifications/stream", notificationHandler.Stream)
+		me.POST("/notifications/read-all", notificationHandler.MarkAllRead)
+		me.POST("/notifications/:id/read", notificationHandler.MarkRead)
+	}
+
+	office := protected.Group("/offices")
 	{
 		office.POST("", officeHandler.Create)
 		office.GET("", officeHandler.GetAll)
 		office.GET("/:id", officeHandler.GetByID)
 		office.PUT("/:id", officeHandler.Update)
 		office.DELETE("/:id", officeHandler.Delete)
+		office.GET("/:id/calendar", slaHandler.GetCalendar)
+		office.PUT("/:id/calendar", slaHandler.UpdateCalendar)
+	}
+
+	laborOperation := protected.Group("/labor-operations")
+	{
+		laborOperation.POST("", laborOperationHandler.Create)
+		laborOperation.GET("", laborOperationHandler.GetAll)
+		laborOperation.GET("/:id", laborOperationHandler.GetByID)
+		laborOperation.PUT("/:id", laborOperationHandler.Update)
+		laborOperation.DELETE("/:id", laborOperationHandler.Delete)
 	}
 
-	claim := router.Group("/claims")
+	report := protected.Group("/reports")
+	{
+		report.GET("/claim-volume", reportHandler.ClaimVolume)
+		report.GET("/decision-rates", reportHandler.DecisionRates)
+		report.GET("/time-in-status", reportHandler.TimeInStatus)
+		report.GET("/part-failures", reportHandler.PartFailures)
+		report.GET("/office-costs", reportHandler.OfficeCosts)
+	}
+
+	settlement := protected.Group("/settlements")
+	{
+		settlement.POST("", settlementHandler.Create)
+		settlement.GET("", settlementHandler.GetAll)
+		settlement.GET("/:id", settlementHandler.GetByID)
+		settlement.DELETE("/:id", settlementHandler.Delete)
+		settlement.POST("/:id/issue", settlementHandler.Issue)
+		settlement.POST("/:id/pay", settlementHandler.MarkPaid)
+		settlement.POST("/:id/dispute", settlementHandler.Dispute)
+		settlement.GET("/:id/statement", settlementHandler.Statement)
+	}
+
+	claim := protected.Group("/claims")
 	{
 		claim.GET("", claimHandler.GetAll)
+		claim.GET("/rejection-reasons", claimHandler.RejectionReasons)
+		claim.GET("/export", claimHandler.Export)
+		claim.GET("/stream", claimHandler.Stream)
 		claim.POST("", claimHandler.Create)
 		claim.GET("/:id", claimHandler.GetByID)
 		claim.PUT("/:id", claimHandler.Update)
@@ -75,10 +133,12 @@ func NewRouter(db *database.Database, authHandler handler.AuthHandler,
 		claim.POST("/:id/cancel", claimHandler.Cancel)
 		claim.POST("/:id/done-review", claimHandler.DoneReview)
 		claim.POST("/:id/complete", claimHandler.Complete)
+		claim.GET("/:id/actions", claimHandler.Actions)
 		claim.GET("/:id/history", claimHandler.History)
+		claim.GET("/:id/audit", claimHandler.Audit)
 	}
 
-	claimItem := router.Group("/claims/:id/items")
+	claimItem := protected.Group("/claims/:id/items")
 	{
 		claimItem.GET("", itemHandler.GetByClaimID)
 		claimItem.POST("", itemHandler.Create)
@@ -88,7 +148,7 @@ func NewRouter(db *database.Database, authHandler handler.AuthHandler,
 		claimItem.POST("/:itemID/reject", itemHandler.Reject)
 	}
 
-	claimAttachment := router.Group("/claims/:id/attachments")
+	claimAttachment := protected.Group("/claims/:id/attachments")
 	{
 		claimAttachment.GET("", attachmentHandler.GetByClaimID)
 		claimAttachment.POST("", attachmentHandler.Create)
@@ -96,6 +156,17 @@ func NewRouter(db *database.Database, authHandler handler.AuthHandler,
 		claimAttachment.DELETE("/:attachmentID", attachmentHandler.Delete)
 	}
 
+	webhook := protected.Group("/webhooks")
+	{
+		webhook.POST("", webhookHandler.Create)
+		webhook.GET("", webhookHandler.GetAll)
+		webhook.GET("/:id", webhookHandler.GetByID)
+		webhook.PUT("/:id", webhookHandler.Update)
+		webhook.DELETE("/:id", webhookHandler.Delete)
+		webhook.GET("/:id/deliveries", webhookHandler.Deliveries)
+		webhook.POST("/:id/deliveries/:deliveryID/redeliver", webhookHandler.Redeliver)
+	}
+
 	return router
 }
 
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_users_office_id;
+DROP INDEX IF EXISTS idx_claims_technician_id;
+DROP INDEX IF EXISTS idx_claims_staff_id;
+DROP INDEX IF EXISTS idx_claims_created_at;
+DROP INDEX IF EXISTS idx_claims_status;
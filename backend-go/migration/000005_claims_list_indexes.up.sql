@@ -0,0 +1,9 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS idx_claims_status ON claims(status);
+CREATE INDEX IF NOT EXISTS idx_claims_created_at ON claims(created_at);
+CREATE INDEX IF NOT EXISTS idx_claims_staff_id ON claims(staff_id);
+CREATE INDEX IF NOT EXISTS idx_claims_technician_id ON claims(technician_id);
+CREATE INDEX IF NOT EXISTS idx_users_office_id ON users(office_id);
+
+COMMIT;
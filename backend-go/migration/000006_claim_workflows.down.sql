@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_claim_workflows_active;
+
+DROP TABLE IF EXISTS claim_workflows CASCADE;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_claim_histories_reason_code;
+DROP INDEX IF EXISTS idx_claim_histories_claim_item_id;
+
+ALTER TABLE claim_histories DROP COLUMN IF EXISTS note;
+ALTER TABLE claim_histories DROP COLUMN IF EXISTS reason_code;
+ALTER TABLE claim_histories DROP COLUMN IF EXISTS claim_item_id;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE claim_histories ADD COLUMN IF NOT EXISTS claim_item_id UUID;
+ALTER TABLE claim_histories ADD COLUMN IF NOT EXISTS reason_code TEXT;
+ALTER TABLE claim_histories ADD COLUMN IF NOT EXISTS note TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_claim_histories_claim_item_id ON claim_histories(claim_item_id);
+CREATE INDEX IF NOT EXISTS idx_claim_histories_reason_code ON claim_histories(reason_code);
+
+COMMIT;
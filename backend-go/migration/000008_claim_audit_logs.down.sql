@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_claim_audit_logs_claim_id;
+
+DROP TABLE IF EXISTS claim_audit_logs CASCADE;
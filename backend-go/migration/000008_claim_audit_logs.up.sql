@@ -0,0 +1,19 @@
+BEGIN;
+
+-- Audit entries outlive the rows they describe, so claim_id is deliberately
+-- not a foreign key: hard deleting a draft claim must not erase its trail.
+CREATE TABLE IF NOT EXISTS claim_audit_logs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    claim_id UUID NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    before JSONB,
+    after JSONB,
+    changed_by UUID NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_claim_audit_logs_claim_id ON claim_audit_logs(claim_id, changed_at DESC);
+
+COMMIT;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription;
+DROP INDEX IF EXISTS idx_webhook_deliveries_pending;
+
+DROP TABLE IF EXISTS webhook_deliveries CASCADE;
+
+DROP INDEX IF EXISTS idx_webhook_subscriptions_deleted_at;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_office_id;
+
+DROP TABLE IF EXISTS webhook_subscriptions CASCADE;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_part_reservations_releasing;
+DROP INDEX IF EXISTS idx_part_reservations_pending;
+DROP INDEX IF EXISTS uq_part_reservations_held_part;
+
+DROP TABLE IF EXISTS part_reservations CASCADE;
@@ -0,0 +1,26 @@
+BEGIN;
+
+-- A part is held by at most one pending or active reservation. Released and
+-- failed rows are kept as a trail of what was done in the inventory.
+CREATE TABLE IF NOT EXISTS part_reservations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    part_id UUID NOT NULL,
+    claim_id UUID,
+    claim_item_id UUID,
+    status TEXT NOT NULL,
+    release_reason TEXT,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    released_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_part_reservations_held_part ON part_reservations(part_id)
+    WHERE status IN ('PENDING', 'ACTIVE');
+CREATE INDEX IF NOT EXISTS idx_part_reservations_pending ON part_reservations(created_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_part_reservations_releasing ON part_reservations(next_attempt_at)
+    WHERE status = 'RELEASING';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Warranty eligibility decided when the claim was created. Claims created
+-- before the check existed have none.
+ALTER TABLE claims ADD COLUMN IF NOT EXISTS warranty JSONB;
+
+COMMIT;
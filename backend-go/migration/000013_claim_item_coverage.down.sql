@@ -0,0 +1 @@
+ALTER TABLE claim_items DROP COLUMN IF EXISTS coverage;
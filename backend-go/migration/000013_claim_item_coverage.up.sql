@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Warranty coverage of the item's part category, decided when the item was
+-- added.
+ALTER TABLE claim_items ADD COLUMN IF NOT EXISTS coverage JSONB;
+
+COMMIT;
@@ -0,0 +1,22 @@
+ALTER TABLE claims ADD COLUMN IF NOT EXISTS total_cost DECIMAL(15, 2) DEFAULT 0;
+UPDATE claims SET total_cost = approved_total / CASE WHEN currency IN ('USD', 'EUR') THEN 100.0 ELSE 1 END;
+ALTER TABLE claims
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS requested_total,
+    DROP COLUMN IF EXISTS approved_total,
+    DROP COLUMN IF EXISTS reimbursed_total;
+
+ALTER TABLE claim_items ADD COLUMN IF NOT EXISTS cost DECIMAL(15, 2) DEFAULT 0;
+UPDATE claim_items SET cost = total_cost / CASE WHEN currency IN ('USD', 'EUR') THEN 100.0 ELSE 1 END;
+ALTER TABLE claim_items
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS labor_minutes,
+    DROP COLUMN IF EXISTS labor_rate,
+    DROP COLUMN IF EXISTS labor_cost,
+    DROP COLUMN IF EXISTS parts_cost,
+    DROP COLUMN IF EXISTS diagnostic_fee,
+    DROP COLUMN IF EXISTS tax_rate,
+    DROP COLUMN IF EXISTS tax_amount,
+    DROP COLUMN IF EXISTS total_cost;
+
+ALTER TABLE offices DROP COLUMN IF EXISTS labor_rate;
@@ -0,0 +1,66 @@
+BEGIN;
+
+-- Hourly labor rate of a service center, in minor units of the configured
+-- currency.
+ALTER TABLE offices ADD COLUMN IF NOT EXISTS labor_rate BIGINT NOT NULL DEFAULT 0;
+
+-- Item costs are broken down and stored as integers in minor units. The old
+-- single cost becomes the parts cost of existing items.
+ALTER TABLE claim_items
+    ADD COLUMN IF NOT EXISTS labor_minutes INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS labor_rate BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS labor_cost BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS parts_cost BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS diagnostic_fee BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax_rate INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS total_cost BIGINT NOT NULL DEFAULT 0;
+
+-- Claims keep the requested, approved and reimbursed totals side by side.
+ALTER TABLE claims
+    ADD COLUMN IF NOT EXISTS requested_total BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS approved_total BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS reimbursed_total BIGINT NOT NULL DEFAULT 0;
+
+-- The old costs are in the major unit of COST_CURRENCY, which the migration
+-- reads from the ev_warranty.cost_currency setting (make db/migrations/up
+-- sets it) and takes as VND when it is not set. Existing rows are given that
+-- currency and their costs scaled to its minor units. An unknown currency
+-- fails the migration rather than storing wrongly scaled totals.
+DO $$
+DECLARE
+    cost_currency TEXT := COALESCE(NULLIF(current_setting('ev_warranty.cost_currency', true), ''), 'VND');
+    scale NUMERIC;
+BEGIN
+    scale := CASE cost_currency
+        WHEN 'VND' THEN 1
+        WHEN 'JPY' THEN 1
+        WHEN 'USD' THEN 100
+        WHEN 'EUR' THEN 100
+    END;
+    IF scale IS NULL THEN
+        RAISE EXCEPTION 'unsupported cost currency %, expected VND, USD, EUR or JPY', cost_currency;
+    END IF;
+
+    EXECUTE format('ALTER TABLE claim_items ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT %L',
+        cost_currency);
+    EXECUTE format('ALTER TABLE claims ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT %L',
+        cost_currency);
+
+    UPDATE claim_items
+    SET parts_cost = ROUND(COALESCE(cost, 0) * scale),
+        total_cost = ROUND(COALESCE(cost, 0) * scale);
+
+    UPDATE claims c
+    SET approved_total  = ROUND(COALESCE(c.total_cost, 0) * scale),
+        requested_total = COALESCE((
+            SELECT SUM(ci.total_cost)
+            FROM claim_items ci
+            WHERE ci.claim_id = c.id AND ci.deleted_at IS NULL
+        ), 0);
+END $$;
+
+ALTER TABLE claim_items DROP COLUMN IF EXISTS cost;
+ALTER TABLE claims DROP COLUMN IF EXISTS total_cost;
+
+COMMIT;
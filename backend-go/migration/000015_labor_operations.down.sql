@@ -0,0 +1,9 @@
+ALTER TABLE claim_items
+    DROP COLUMN IF EXISTS labor_operation_id,
+    DROP COLUMN IF EXISTS standard_minutes;
+
+DROP INDEX IF EXISTS idx_labor_operations_deleted_at;
+DROP INDEX IF EXISTS idx_labor_operations_part_category_id;
+DROP INDEX IF EXISTS uq_labor_operations_code;
+
+DROP TABLE IF EXISTS labor_operations CASCADE;
@@ -0,0 +1,27 @@
+BEGIN;
+
+-- Catalog of standard repair jobs and their flat-rate times. Codes are only
+-- unique among operations that are not deleted.
+CREATE TABLE IF NOT EXISTS labor_operations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(50) NOT NULL,
+    description TEXT NOT NULL,
+    flat_rate_minutes INTEGER NOT NULL CHECK (flat_rate_minutes > 0),
+    part_category_id UUID,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_labor_operations_code ON labor_operations(code) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_labor_operations_part_category_id ON labor_operations(part_category_id);
+CREATE INDEX IF NOT EXISTS idx_labor_operations_deleted_at ON labor_operations(deleted_at);
+
+-- The operation a claim item is charged for and its flat-rate time when the
+-- item was priced. labor_minutes differs from it once a reviewer adjusts it.
+ALTER TABLE claim_items
+    ADD COLUMN IF NOT EXISTS labor_operation_id UUID REFERENCES labor_operations(id),
+    ADD COLUMN IF NOT EXISTS standard_minutes INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
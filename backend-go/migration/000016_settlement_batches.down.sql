@@ -0,0 +1,12 @@
+ALTER TABLE claims DROP COLUMN IF EXISTS settlement_batch_id;
+
+DROP INDEX IF EXISTS idx_settlement_lines_batch_id;
+DROP INDEX IF EXISTS uq_settlement_lines_claim_item_id;
+
+DROP TABLE IF EXISTS settlement_lines CASCADE;
+
+DROP INDEX IF EXISTS idx_settlement_batches_deleted_at;
+DROP INDEX IF EXISTS idx_settlement_batches_status;
+DROP INDEX IF EXISTS idx_settlement_batches_office_id;
+
+DROP TABLE IF EXISTS settlement_batches CASCADE;
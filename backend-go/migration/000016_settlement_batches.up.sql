@@ -0,0 +1,44 @@
+BEGIN;
+
+-- Reimbursement statements owed to a service center for the claims it
+-- completed in [period_start, period_end). Amounts are in minor units.
+CREATE TABLE IF NOT EXISTS settlement_batches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    office_id UUID NOT NULL REFERENCES offices(id),
+    period_start TIMESTAMP NOT NULL,
+    period_end TIMESTAMP NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    currency VARCHAR(3) NOT NULL,
+    total_amount BIGINT NOT NULL DEFAULT 0,
+    dispute_reason TEXT,
+    created_by UUID NOT NULL REFERENCES users(id),
+    issued_at TIMESTAMP NULL,
+    paid_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP NULL,
+    CHECK (period_end > period_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlement_batches_office_id ON settlement_batches(office_id);
+CREATE INDEX IF NOT EXISTS idx_settlement_batches_status ON settlement_batches(status);
+CREATE INDEX IF NOT EXISTS idx_settlement_batches_deleted_at ON settlement_batches(deleted_at);
+
+-- A claim item is settled at most once, whatever the batch.
+CREATE TABLE IF NOT EXISTS settlement_lines (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    batch_id UUID NOT NULL REFERENCES settlement_batches(id) ON DELETE CASCADE,
+    claim_id UUID NOT NULL REFERENCES claims(id),
+    claim_item_id UUID NOT NULL REFERENCES claim_items(id),
+    description TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_settlement_lines_claim_item_id ON settlement_lines(claim_item_id);
+CREATE INDEX IF NOT EXISTS idx_settlement_lines_batch_id ON settlement_lines(batch_id);
+
+-- Set once the claim is part of an issued batch; the claim is locked from then on.
+ALTER TABLE claims ADD COLUMN IF NOT EXISTS settlement_batch_id UUID REFERENCES settlement_batches(id);
+
+COMMIT;
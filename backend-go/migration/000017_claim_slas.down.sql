@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_claim_slas_due;
+DROP INDEX IF EXISTS idx_claim_slas_claim_id;
+DROP INDEX IF EXISTS uq_claim_slas_open_claim_id;
+
+DROP TABLE IF EXISTS claim_slas CASCADE;
+
+DROP TABLE IF EXISTS business_calendars CASCADE;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Days each office works on, for SLAs counted in business days. Offices
+-- without a row work Monday to Friday in UTC.
+CREATE TABLE IF NOT EXISTS business_calendars (
+    office_id UUID PRIMARY KEY REFERENCES offices(id),
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    working_days JSONB NOT NULL DEFAULT '[1,2,3,4,5]',
+    holidays JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- The deadline a claim was held to in each status with an SLA policy. A claim
+-- has at most one open SLA, closed when it leaves the status.
+CREATE TABLE IF NOT EXISTS claim_slas (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    claim_id UUID NOT NULL REFERENCES claims(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    breached_at TIMESTAMP WITH TIME ZONE,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_claim_slas_open_claim_id ON claim_slas(claim_id) WHERE closed_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_claim_slas_claim_id ON claim_slas(claim_id);
+CREATE INDEX IF NOT EXISTS idx_claim_slas_due ON claim_slas(due_at) WHERE closed_at IS NULL AND breached_at IS NULL;
+
+COMMIT;
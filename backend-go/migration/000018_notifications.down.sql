@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_notification_emails_pending;
+
+DROP TABLE IF EXISTS notification_emails CASCADE;
+
+DROP TABLE IF EXISTS notification_preferences CASCADE;
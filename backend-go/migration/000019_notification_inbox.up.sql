@@ -0,0 +1,20 @@
+BEGIN;
+
+-- In-app inbox of each user, filled from the same claim events as the
+-- notification emails.
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    kind TEXT NOT NULL,
+    claim_id UUID NOT NULL REFERENCES claims(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_notifications_user_event UNIQUE (user_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created ON notifications(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Keys can't be turned back into URLs without the storage configuration, the
+-- column keeps them.
+ALTER TABLE claim_attachments RENAME COLUMN storage_key TO url;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Attachments keep the key of their file in the attachment storage, download
+-- URLs are signed per request.
+ALTER TABLE claim_attachments RENAME COLUMN url TO storage_key;
+
+-- Cloudinary delivery URLs become <resource type>/<delivery type>/<public ID>.<format>.
+UPDATE claim_attachments
+SET storage_key = regexp_replace(storage_key,
+    '^https?://res\.cloudinary\.com/[^/]+/([^/]+)/([^/]+)/(v[0-9]+/)?(.+)$', '\1/\2/\4')
+WHERE storage_key ~ '^https?://res\.cloudinary\.com/';
+
+-- Local and S3 storage URLs end with the <resource type>/<file name> key.
+UPDATE claim_attachments
+SET storage_key = substring(storage_key from '([^/?]+/[^/?]+)(\?.*)?$')
+WHERE storage_key ~ '^https?://' AND storage_key ~ '[^/?]+/[^/?]+(\?.*)?$';
+
+COMMIT;
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS uq_claim_attachments_claim_checksum;
+DROP INDEX IF EXISTS idx_claim_attachments_claim_item;
+
+ALTER TABLE claim_attachments
+    DROP COLUMN IF EXISTS uploaded_by,
+    DROP COLUMN IF EXISTS caption,
+    DROP COLUMN IF EXISTS checksum,
+    DROP COLUMN IF EXISTS size,
+    DROP COLUMN IF EXISTS mime_type,
+    DROP COLUMN IF EXISTS file_name,
+    DROP COLUMN IF EXISTS claim_item_id;
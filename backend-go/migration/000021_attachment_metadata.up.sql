@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Attachments uploaded before this migration keep empty file metadata and no
+-- uploader, only new uploads are checked for duplicates.
+ALTER TABLE claim_attachments
+    ADD COLUMN IF NOT EXISTS claim_item_id UUID REFERENCES claim_items(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS file_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS mime_type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS size BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS checksum TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS caption TEXT,
+    ADD COLUMN IF NOT EXISTS uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_claim_attachments_claim_item ON claim_attachments(claim_item_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_claim_attachments_claim_checksum ON claim_attachments(claim_id, checksum)
+    WHERE checksum <> '' AND deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_claim_attachments_processing_pending;
+
+ALTER TABLE claim_attachments
+    DROP COLUMN IF EXISTS duration_seconds,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS captured_at,
+    DROP COLUMN IF EXISTS processed_at,
+    DROP COLUMN IF EXISTS processing_attempts,
+    DROP COLUMN IF EXISTS processing_error,
+    DROP COLUMN IF EXISTS processing_status,
+    DROP COLUMN IF EXISTS thumbnail_key,
+    DROP COLUMN IF EXISTS public_key;
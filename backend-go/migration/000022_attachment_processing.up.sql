@@ -0,0 +1,20 @@
+BEGIN;
+
+-- Attachments uploaded before this migration are processed like new uploads,
+-- so the location is stripped from the images already stored.
+ALTER TABLE claim_attachments
+    ADD COLUMN IF NOT EXISTS public_key TEXT,
+    ADD COLUMN IF NOT EXISTS thumbnail_key TEXT,
+    ADD COLUMN IF NOT EXISTS processing_status TEXT NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN IF NOT EXISTS processing_error TEXT,
+    ADD COLUMN IF NOT EXISTS processing_attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS processed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS captured_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS duration_seconds DOUBLE PRECISION;
+
+CREATE INDEX IF NOT EXISTS idx_claim_attachments_processing_pending ON claim_attachments(created_at)
+    WHERE processing_status = 'PENDING' AND deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,2 @@
+ALTER TABLE claim_attachments
+    DROP COLUMN IF EXISTS processing_claimed_until;
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- An attachment being processed is hidden from other runs until
+-- processing_claimed_until, as its files are read and uploaded outside of the
+-- transaction that claimed it.
+ALTER TABLE claim_attachments
+    ADD COLUMN IF NOT EXISTS processing_claimed_until TIMESTAMPTZ;
+
+COMMIT;
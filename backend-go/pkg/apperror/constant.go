@@ -39,14 +39,34 @@ var (
 	ErrInvalidClaimAction       = New(http.StatusConflict, "CLAIM_INVALID_ACTION", "Invalid claim action")
 	ErrMissingInformationClaim  = New(http.StatusBadRequest, "CLAIM_MISSING_INFORMATION", "Claim does not have enough information to submit")
 	ErrTechnicianWorkloadExceed = New(http.StatusBadRequest, "CLAIM_TECH_WORKLOAD_EXCEED", "This technician has enough workload")
+	ErrVehicleCustomerMismatch  = New(http.StatusBadRequest, "CLAIM_VEHICLE_CUSTOMER_MISMATCH", "Vehicle does not belong to the customer")
+	ErrClaimItemNotCovered      = New(http.StatusUnprocessableEntity, "CLAIM_ITEM_NOT_COVERED", "Part category is not covered by the warranty policy")
+	ErrClaimNotEligible         = New(http.StatusUnprocessableEntity, "CLAIM_NOT_ELIGIBLE", "Vehicle is not eligible for warranty")
+	ErrClaimLocked              = New(http.StatusConflict, "CLAIM_LOCKED", "Claim is locked by an issued settlement batch")
+	ErrInvalidSettlementAction  = New(http.StatusConflict, "SETTLEMENT_INVALID_ACTION", "Invalid settlement batch action")
+	ErrNothingToSettle          = New(http.StatusUnprocessableEntity, "SETTLEMENT_NOTHING_TO_SETTLE", "No completed claims to settle")
+	ErrDuplicateAttachment      = New(http.StatusConflict, "CLAIM_DUPLICATE_ATTACHMENT", "The claim already has an attachment with this file")
+	ErrAttachmentTooLarge       = New(http.StatusRequestEntityTooLarge, "CLAIM_ATTACHMENT_TOO_LARGE", "The attachment is larger than allowed")
 
 	ErrFailedInitializeCloudinary = New(http.StatusInternalServerError, "CLOUDINARY_FAILED_INITIALIZE", "Failed to initialize Cloudinary")
-	ErrInvalidCloudinaryURL       = New(http.StatusBadRequest, "CLOUDINARY_INVALID_URL", "Invalid Cloudinary URL")
 	ErrFailedUploadCloudinary     = New(http.StatusServiceUnavailable, "CLOUDINARY_FAILED_UPLOAD", "Failed to upload to Cloudinary")
 	ErrFailedDeleteCloudinary     = New(http.StatusServiceUnavailable, "CLOUDINARY_FAILED_DELETE", "Failed to delete from Cloudinary")
-	ErrEmptyCloudinaryParameter   = New(http.StatusBadRequest, "CLOUDINARY_EMPTY_PARAMETER", "Empty Cloudinary parameter")
 
-	ErrExternalServiceUnavailable = New(http.StatusServiceUnavailable, "EXTERNAL_SERVICE_UNAVAILABLE", "External service is unavailable")
-	ErrExternalServiceError       = New(http.StatusBadGateway, "EXTERNAL_SERVICE_ERROR", "External service returned an error")
-	ErrInternal                   = New(http.StatusInternalServerError, "INTERNAL_ERROR", "Internal processing error")
+	ErrFailedInitializeStorage = New(http.StatusInternalServerError, "STORAGE_FAILED_INITIALIZE", "Failed to initialize attachment storage")
+	ErrInvalidStorageKey       = New(http.StatusInternalServerError, "STORAGE_INVALID_KEY", "Invalid attachment storage key")
+	ErrFailedUploadStorage     = New(http.StatusServiceUnavailable, "STORAGE_FAILED_UPLOAD", "Failed to upload to attachment storage")
+	ErrFailedDeleteStorage     = New(http.StatusServiceUnavailable, "STORAGE_FAILED_DELETE", "Failed to delete from attachment storage")
+	ErrFailedDownloadStorage   = New(http.StatusServiceUnavailable, "STORAGE_FAILED_DOWNLOAD", "Failed to download from attachment storage")
+	ErrInvalidFileSignature    = New(http.StatusForbidden, "STORAGE_INVALID_SIGNATURE", "Invalid or expired file signature")
+
+	ErrFailedGenerateWebhookSecret = New(http.StatusInternalServerError, "WEBHOOK_FAILED_GENERATE_SECRET", "Failed to generate webhook secret")
+	ErrWebhookSubscriptionInactive = New(http.StatusConflict, "WEBHOOK_SUBSCRIPTION_INACTIVE", "Webhook subscription is inactive")
+
+	ErrExternalServiceUnavailable  = New(http.StatusServiceUnavailable, "EXTERNAL_SERVICE_UNAVAILABLE", "External service is unavailable")
+	ErrExternalServiceError        = New(http.StatusBadGateway, "EXTERNAL_SERVICE_ERROR", "External service returned an error")
+	ErrExternalServiceUnauthorized = New(http.StatusForbidden, "EXTERNAL_SERVICE_UNAUTHORIZED", "Not authorized by external service")
+	ErrPartOutOfStock              = New(http.StatusConflict, "PART_OUT_OF_STOCK", "No part of this category is available at the office")
+	ErrVehicleNotFound             = New(http.StatusNotFound, "VEHICLE_NOT_FOUND", "Vehicle not found")
+	ErrPartNotFound                = New(http.StatusNotFound, "PART_NOT_FOUND", "Part not found in inventory")
+	ErrInternal                    = New(http.StatusInternalServerError, "INTERNAL_ERROR", "Internal processing error")
 )
@@ -0,0 +1,545 @@
+// Package media reads and rewrites the photos and videos attached to claims
+// without decoding more than needed: the EXIF metadata of JPEG, PNG and WebP
+// images, thumbnails of the images the standard library decodes, and the
+// duration of MP4, QuickTime, WebM and AVI videos.
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"math"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("media: unsupported format")
+	ErrMalformed         = errors.New("media: malformed file")
+)
+
+const (
+	tagOrientation       = 0x0112
+	tagDateTime          = 0x0132
+	tagExifIFD           = 0x8769
+	tagGPSIFD            = 0x8825
+	tagDateTimeOriginal  = 0x9003
+	tagOffsetTimeOrig    = 0x9011
+	tagGPSLatitudeRef    = 0x0001
+	tagGPSLatitude       = 0x0002
+	tagGPSLongitudeRef   = 0x0003
+	tagGPSLongitude      = 0x0004
+	exifDateTimeLayout   = "2006:01:02 15:04:05"
+	ifdEntrySize         = 12
+	jpegExifHeader       = "Exif\x00\x00"
+	jpegXMPHeader        = "http://ns.adobe.com/xap/1.0/\x00"
+	pngXMPKeyword        = "XML:com.adobe.xmp\x00"
+	webpVP8XFlagXMP      = 0x04
+	webpVP8XFlagsOffset  = 8
+	riffChunkHeaderSize  = 8
+	pngChunkOverheadSize = 12
+)
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// Exif is the metadata kept from a photo. CapturedAt is in UTC when the photo
+// does not record its time zone offset. Orientation is the EXIF orientation
+// from 1 to 8, 1 when the photo has none.
+type Exif struct {
+	CapturedAt  *time.Time
+	Latitude    *float64
+	Longitude   *float64
+	Orientation int
+}
+
+// ReadExif returns the EXIF metadata of a JPEG, PNG or WebP image, an empty
+// Exif when it has none.
+func ReadExif(data []byte, mimeType string) (*Exif, error) {
+	segment, err := findExif(data, mimeType)
+	if err != nil {
+		return nil, err
+	}
+
+	exif := &Exif{Orientation: 1}
+	if segment == nil {
+		return exif, nil
+	}
+	t, err := parseTIFF(segment.tiff)
+	if err != nil {
+		return nil, err
+	}
+
+	ifd0, err := t.readIFD(t.firstIFD)
+	if err != nil {
+		return nil, err
+	}
+	if orientation, ok := t.uint(ifd0[tagOrientation]); ok && orientation >= 1 && orientation <= 8 {
+		exif.Orientation = int(orientation)
+	}
+
+	dateTime, offset := t.ascii(ifd0[tagDateTime]), ""
+	if pointer, ok := t.uint(ifd0[tagExifIFD]); ok {
+		if exifIFD, err := t.readIFD(pointer); err == nil {
+			if original := t.ascii(exifIFD[tagDateTimeOriginal]); original != "" {
+				dateTime, offset = original, t.ascii(exifIFD[tagOffsetTimeOrig])
+			}
+		}
+	}
+	exif.CapturedAt = parseExifTime(dateTime, offset)
+
+	if pointer, ok := t.uint(ifd0[tagGPSIFD]); ok {
+		if gps, err := t.readIFD(pointer); err == nil {
+			exif.Latitude = t.coordinate(gps[tagGPSLatitude], t.ascii(gps[tagGPSLatitudeRef]), "S", 90)
+			exif.Longitude = t.coordinate(gps[tagGPSLongitude], t.ascii(gps[tagGPSLongitudeRef]), "W", 180)
+		}
+	}
+
+	return exif, nil
+}
+
+// StripLocation returns a copy of a JPEG, PNG or WebP image without its GPS
+// metadata: the entries of the EXIF GPS IFD are erased in place, keeping the
+// other tags such as the orientation, and XMP packets, which may repeat the
+// location, are dropped. Other formats are returned unchanged.
+func StripLocation(data []byte, mimeType string) ([]byte, error) {
+	var stripped []byte
+	var err error
+	switch mimeType {
+	case "image/jpeg":
+		stripped, err = stripJPEGXMP(data)
+	case "image/png":
+		stripped, err = stripPNGXMP(data)
+	case "image/webp":
+		stripped, err = stripWebPXMP(data)
+	default:
+		return data, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	segment, err := findExif(stripped, mimeType)
+	if err != nil || segment == nil {
+		return stripped, err
+	}
+	t, err := parseTIFF(segment.tiff)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.eraseGPS(); err != nil {
+		return nil, err
+	}
+	if segment.crc != nil {
+		segment.crc()
+	}
+	return stripped, nil
+}
+
+// exifSegment is the TIFF structure holding the EXIF metadata of an image,
+// sharing its bytes. crc, when set, recomputes the checksum of the chunk
+// holding it after it was modified.
+type exifSegment struct {
+	tiff []byte
+	crc  func()
+}
+
+func findExif(data []byte, mimeType string) (*exifSegment, error) {
+	switch mimeType {
+	case "image/jpeg":
+		return findJPEGExif(data)
+	case "image/png":
+		return findPNGExif(data)
+	case "image/webp":
+		return findWebPExif(data)
+	case "image/gif", "image/bmp":
+		return nil, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// jpegSegment is a marker segment of a JPEG file, start is the offset of its
+// 0xFF marker and payload excludes the marker and length.
+type jpegSegment struct {
+	marker  byte
+	start   int
+	end     int
+	payload []byte
+}
+
+// jpegSegments returns the marker segments before the image data.
+func jpegSegments(data []byte) ([]jpegSegment, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, ErrMalformed
+	}
+
+	var segments []jpegSegment
+	i := 2
+	for i+4 <= len(data) {
+		if data[i] != 0xFF {
+			return nil, ErrMalformed
+		}
+		marker := data[i+1]
+		if marker == 0xFF {
+			i++
+			continue
+		}
+		if marker == 0x01 || marker >= 0xD0 && marker <= 0xD7 {
+			i += 2
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+		end := i + 2 + int(binary.BigEndian.Uint16(data[i+2:]))
+		if end > len(data) || end < i+4 {
+			return nil, ErrMalformed
+		}
+		segments = append(segments, jpegSegment{marker: marker, start: i, end: end, payload: data[i+4 : end]})
+		i = end
+	}
+	return segments, nil
+}
+
+func findJPEGExif(data []byte) (*exifSegment, error) {
+	segments, err := jpegSegments(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, segment := range segments {
+		if segment.marker == 0xE1 && bytes.HasPrefix(segment.payload, []byte(jpegExifHeader)) {
+			return &exifSegment{tiff: segment.payload[len(jpegExifHeader):]}, nil
+		}
+	}
+	return nil, nil
+}
+
+func stripJPEGXMP(data []byte) ([]byte, error) {
+	segments, err := jpegSegments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	stripped := make([]byte, 0, len(data))
+	from := 0
+	for _, segment := range segments {
+		if segment.marker == 0xE1 && bytes.HasPrefix(segment.payload, []byte(jpegXMPHeader)) {
+			stripped = append(stripped, data[from:segment.start]...)
+			from = segment.end
+		}
+	}
+	return append(stripped, data[from:]...), nil
+}
+
+// pngChunk is a chunk of a PNG file from its length to its CRC.
+type pngChunk struct {
+	kind  string
+	start int
+	end   int
+	data  []byte
+}
+
+func pngChunks(data []byte) ([]pngChunk, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, ErrMalformed
+	}
+
+	var chunks []pngChunk
+	i := len(pngSignature)
+	for i+pngChunkOverheadSize <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		end := i + pngChunkOverheadSize + length
+		if length < 0 || end > len(data) || end < i {
+			return nil, ErrMalformed
+		}
+		chunk := pngChunk{kind: string(data[i+4 : i+8]), start: i, end: end, data: data[i+8 : end-4]}
+		chunks = append(chunks, chunk)
+		i = end
+		if chunk.kind == "IEND" {
+			break
+		}
+	}
+	return chunks, nil
+}
+
+func findPNGExif(data []byte) (*exifSegment, error) {
+	chunks, err := pngChunks(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, chunk := range chunks {
+		if chunk.kind == "eXIf" {
+			start, end := chunk.start, chunk.end
+			return &exifSegment{tiff: chunk.data, crc: func() {
+				binary.BigEndian.PutUint32(data[end-4:], crc32.ChecksumIEEE(data[start+4:end-4]))
+			}}, nil
+		}
+	}
+	return nil, nil
+}
+
+func stripPNGXMP(data []byte) ([]byte, error) {
+	chunks, err := pngChunks(data)
+	if err != nil {
+		return nil, err
+	}
+
+	stripped := make([]byte, 0, len(data))
+	from := 0
+	for _, chunk := range chunks {
+		if chunk.kind == "iTXt" && bytes.HasPrefix(chunk.data, []byte(pngXMPKeyword)) {
+			stripped = append(stripped, data[from:chunk.start]...)
+			from = chunk.end
+		}
+	}
+	return append(stripped, data[from:]...), nil
+}
+
+// riffChunk is a chunk of a RIFF file from its header to its padding.
+type riffChunk struct {
+	id    string
+	start int
+	end   int
+	data  []byte
+}
+
+// riffChunks returns the chunks of a RIFF container of the given form type,
+// such as WEBP or AVI.
+func riffChunks(data []byte, form string) ([]riffChunk, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != form {
+		return nil, ErrMalformed
+	}
+	return riffSubChunks(data, 12, len(data))
+}
+
+// riffSubChunks returns the chunks between from and to, and those before the
+// first malformed one with an error.
+func riffSubChunks(data []byte, from, to int) ([]riffChunk, error) {
+	var chunks []riffChunk
+	i := from
+	for i+riffChunkHeaderSize <= to {
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		dataEnd := i + riffChunkHeaderSize + size
+		if size < 0 || dataEnd > to || dataEnd < i {
+			return chunks, ErrMalformed
+		}
+		end := dataEnd + size%2
+		if end > to {
+			end = to
+		}
+		chunks = append(chunks, riffChunk{id: string(data[i : i+4]), start: i, end: end,
+			data: data[i+riffChunkHeaderSize : dataEnd]})
+		i = end
+	}
+	return chunks, nil
+}
+
+func findWebPExif(data []byte) (*exifSegment, error) {
+	chunks, err := riffChunks(data, "WEBP")
+	if err != nil {
+		return nil, err
+	}
+	for _, chunk := range chunks {
+		if chunk.id == "EXIF" {
+			return &exifSegment{tiff: bytes.TrimPrefix(chunk.data, []byte(jpegExifHeader))}, nil
+		}
+	}
+	return nil, nil
+}
+
+func stripWebPXMP(data []byte) ([]byte, error) {
+	chunks, err := riffChunks(data, "WEBP")
+	if err != nil {
+		return nil, err
+	}
+
+	stripped := make([]byte, 0, len(data))
+	stripped = append(stripped, data[:12]...)
+	for _, chunk := range chunks {
+		if chunk.id == "XMP " {
+			continue
+		}
+		start := len(stripped)
+		stripped = append(stripped, data[chunk.start:chunk.end]...)
+		if chunk.id == "VP8X" && len(chunk.data) > 0 {
+			stripped[start+webpVP8XFlagsOffset] &^= webpVP8XFlagXMP
+		}
+	}
+	binary.LittleEndian.PutUint32(stripped[4:], uint32(len(stripped)-8))
+	return stripped, nil
+}
+
+// tiff reads the IFDs of a TIFF structure, the format of EXIF metadata.
+type tiff struct {
+	data     []byte
+	order    binary.ByteOrder
+	firstIFD uint32
+}
+
+// ifdEntry is a tag of an IFD, offset is where its value is in the TIFF data.
+type ifdEntry struct {
+	position int
+	kind     uint16
+	count    uint32
+	offset   int
+}
+
+func parseTIFF(data []byte) (*tiff, error) {
+	if len(data) < 8 {
+		return nil, ErrMalformed
+	}
+	t := &tiff{data: data}
+	switch string(data[:4]) {
+	case "II*\x00":
+		t.order = binary.LittleEndian
+	case "MM\x00*":
+		t.order = binary.BigEndian
+	default:
+		return nil, ErrMalformed
+	}
+	t.firstIFD = t.order.Uint32(data[4:])
+	return t, nil
+}
+
+func typeSize(kind uint16) int {
+	switch kind {
+	case 1, 2, 6, 7:
+		return 1
+	case 3, 8:
+		return 2
+	case 4, 9, 11:
+		return 4
+	case 5, 10, 12:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// readIFD returns the entries of the IFD at offset by tag, skipping those
+// whose value lies outside the data.
+func (t *tiff) readIFD(offset uint32) (map[uint16]ifdEntry, error) {
+	start := int(offset)
+	if start < 8 || start+2 > len(t.data) {
+		return nil, ErrMalformed
+	}
+	count := int(t.order.Uint16(t.data[start:]))
+	if start+2+count*ifdEntrySize > len(t.data) {
+		return nil, ErrMalformed
+	}
+
+	entries := make(map[uint16]ifdEntry, count)
+	for i := 0; i < count; i++ {
+		position := start + 2 + i*ifdEntrySize
+		entry := ifdEntry{
+			position: position,
+			kind:     t.order.Uint16(t.data[position+2:]),
+			count:    t.order.Uint32(t.data[position+4:]),
+			offset:   position + 8,
+		}
+		size := int64(typeSize(entry.kind)) * int64(entry.count)
+		if size > 4 {
+			entry.offset = int(t.order.Uint32(t.data[position+8:]))
+		}
+		if size == 0 || int64(entry.offset)+size > int64(len(t.data)) {
+			continue
+		}
+		entries[t.order.Uint16(t.data[position:])] = entry
+	}
+	return entries, nil
+}
+
+func (e ifdEntry) size() int {
+	return typeSize(e.kind) * int(e.count)
+}
+
+// uint reads the first value of a SHORT or LONG entry.
+func (t *tiff) uint(entry ifdEntry) (uint32, bool) {
+	switch entry.kind {
+	case 3:
+		return uint32(t.order.Uint16(t.data[entry.offset:])), true
+	case 4:
+		return t.order.Uint32(t.data[entry.offset:]), true
+	default:
+		return 0, false
+	}
+}
+
+// ascii reads an ASCII entry without its terminating NULs.
+func (t *tiff) ascii(entry ifdEntry) string {
+	if entry.kind != 2 {
+		return ""
+	}
+	value := t.data[entry.offset : entry.offset+entry.size()]
+	return strings.TrimSpace(strings.TrimRight(string(value), "\x00"))
+}
+
+// coordinate reads degrees, minutes and seconds rationals as decimal degrees,
+// negative when ref is negativeRef.
+func (t *tiff) coordinate(entry ifdEntry, ref, negativeRef string, limit float64) *float64 {
+	if entry.kind != 5 || entry.count != 3 {
+		return nil
+	}
+
+	var value float64
+	for i, scale := range []float64{1, 60, 3600} {
+		numerator := t.order.Uint32(t.data[entry.offset+i*8:])
+		denominator := t.order.Uint32(t.data[entry.offset+i*8+4:])
+		if denominator == 0 {
+			return nil
+		}
+		value += float64(numerator) / float64(denominator) / scale
+	}
+	if ref == negativeRef {
+		value = -value
+	}
+	if math.Abs(value) > limit {
+		return nil
+	}
+	return &value
+}
+
+// eraseGPS zeroes the entries of the GPS IFD and their values and empties it,
+// so readers find an IFD without tags.
+func (t *tiff) eraseGPS() error {
+	ifd0, err := t.readIFD(t.firstIFD)
+	if err != nil {
+		return err
+	}
+	pointer, ok := t.uint(ifd0[tagGPSIFD])
+	if !ok {
+		return nil
+	}
+	gps, err := t.readIFD(pointer)
+	if err != nil {
+		return nil
+	}
+
+	for _, entry := range gps {
+		if entry.size() > 4 {
+			clear(t.data[entry.offset : entry.offset+entry.size()])
+		}
+	}
+	start := int(pointer)
+	count := int(t.order.Uint16(t.data[start:]))
+	clear(t.data[start : start+2+count*ifdEntrySize])
+	return nil
+}
+
+func parseExifTime(value, offset string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	location := time.UTC
+	if offset != "" {
+		if zone, err := time.Parse("-07:00", offset); err == nil {
+			location = zone.Location()
+		}
+	}
+	capturedAt, err := time.ParseInLocation(exifDateTimeLayout, value, location)
+	if err != nil || capturedAt.Year() < 1900 {
+		return nil
+	}
+	return &capturedAt
+}
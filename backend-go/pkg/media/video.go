@@ -0,0 +1,252 @@
+package media
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+)
+
+const (
+	ebmlIDHeader         = 0x1A45DFA3
+	ebmlIDSegment        = 0x18538067
+	ebmlIDInfo           = 0x1549A966
+	ebmlIDTimecodeScale  = 0x2AD7B1
+	ebmlIDDuration       = 0x4489
+	ebmlUnknownSize      = -1
+	defaultTimecodeScale = 1_000_000
+	// maxHeaderSize bounds the video headers read, which are small.
+	maxHeaderSize = 1 << 20
+)
+
+// VideoDuration reads the duration of an MP4, QuickTime, WebM or AVI video
+// from its headers, without reading the media data.
+func VideoDuration(r io.ReaderAt, size int64, mimeType string) (time.Duration, error) {
+	switch mimeType {
+	case "video/mp4", "video/quicktime":
+		return mp4Duration(r, size)
+	case "video/webm", "video/x-matroska":
+		return webmDuration(r, size)
+	case "video/avi", "video/x-msvideo":
+		return aviDuration(r, size)
+	default:
+		return 0, ErrUnsupportedFormat
+	}
+}
+
+// mp4Duration reads the movie header box, moov/mvhd, of an ISO base media
+// file.
+func mp4Duration(r io.ReaderAt, size int64) (time.Duration, error) {
+	moov, moovEnd, err := findBox(r, 0, size, "moov")
+	if err != nil {
+		return 0, err
+	}
+	mvhd, _, err := findBox(r, moov, moovEnd, "mvhd")
+	if err != nil {
+		return 0, err
+	}
+
+	header := make([]byte, 32)
+	if _, err = r.ReadAt(header, mvhd); err != nil {
+		return 0, ErrMalformed
+	}
+	var timescale uint32
+	var duration uint64
+	if header[0] == 1 {
+		timescale = binary.BigEndian.Uint32(header[20:])
+		duration = binary.BigEndian.Uint64(header[24:])
+	} else {
+		timescale = binary.BigEndian.Uint32(header[12:])
+		duration = uint64(binary.BigEndian.Uint32(header[16:]))
+	}
+	if timescale == 0 {
+		return 0, ErrMalformed
+	}
+	return scaleDuration(float64(duration) / float64(timescale) * float64(time.Second))
+}
+
+// findBox returns where the content of the first box of the given type
+// between from and to starts and ends.
+func findBox(r io.ReaderAt, from, to int64, boxType string) (int64, int64, error) {
+	header := make([]byte, 16)
+	for offset := from; offset+8 <= to; {
+		if _, err := r.ReadAt(header[:8], offset); err != nil {
+			return 0, 0, ErrMalformed
+		}
+		boxSize, headerSize := int64(binary.BigEndian.Uint32(header)), int64(8)
+		switch boxSize {
+		case 0:
+			boxSize = to - offset
+		case 1:
+			if _, err := r.ReadAt(header[8:], offset+8); err != nil {
+				return 0, 0, ErrMalformed
+			}
+			boxSize, headerSize = int64(binary.BigEndian.Uint64(header[8:])), 16
+		}
+		if boxSize < headerSize || offset+boxSize > to {
+			return 0, 0, ErrMalformed
+		}
+		if string(header[4:8]) == boxType {
+			return offset + headerSize, offset + boxSize, nil
+		}
+		offset += boxSize
+	}
+	return 0, 0, ErrMalformed
+}
+
+// webmDuration reads the Duration and TimecodeScale of the Segment Info
+// element of a Matroska file.
+func webmDuration(r io.ReaderAt, size int64) (time.Duration, error) {
+	reader := &ebmlReader{r: r, limit: min(size, maxHeaderSize)}
+	id, dataSize, err := reader.element()
+	if err != nil || id != ebmlIDHeader || dataSize == ebmlUnknownSize {
+		return 0, ErrMalformed
+	}
+	reader.offset += dataSize
+
+	id, _, err = reader.element()
+	if err != nil || id != ebmlIDSegment {
+		return 0, ErrMalformed
+	}
+	for {
+		id, dataSize, err = reader.element()
+		if err != nil || dataSize == ebmlUnknownSize {
+			return 0, ErrMalformed
+		}
+		if id == ebmlIDInfo {
+			break
+		}
+		reader.offset += dataSize
+	}
+
+	infoEnd := reader.offset + dataSize
+	timecodeScale, duration := uint64(defaultTimecodeScale), -1.0
+	for reader.offset < infoEnd {
+		id, dataSize, err = reader.element()
+		if err != nil || dataSize == ebmlUnknownSize || reader.offset+dataSize > infoEnd || dataSize > 8 {
+			return 0, ErrMalformed
+		}
+		value := make([]byte, dataSize)
+		if _, err = r.ReadAt(value, reader.offset); err != nil {
+			return 0, ErrMalformed
+		}
+		switch id {
+		case ebmlIDTimecodeScale:
+			timecodeScale = 0
+			for _, b := range value {
+				timecodeScale = timecodeScale<<8 | uint64(b)
+			}
+		case ebmlIDDuration:
+			switch dataSize {
+			case 4:
+				duration = float64(math.Float32frombits(binary.BigEndian.Uint32(value)))
+			case 8:
+				duration = math.Float64frombits(binary.BigEndian.Uint64(value))
+			}
+		}
+		reader.offset += dataSize
+	}
+	if duration < 0 {
+		return 0, ErrMalformed
+	}
+	return scaleDuration(duration * float64(timecodeScale))
+}
+
+// ebmlReader reads the element headers of an EBML document, the format of
+// Matroska and WebM files, up to limit.
+type ebmlReader struct {
+	r      io.ReaderAt
+	offset int64
+	limit  int64
+}
+
+// element reads the ID and data size of the element at the offset and moves
+// past its header. The size is ebmlUnknownSize when the element does not
+// record it.
+func (e *ebmlReader) element() (int64, int64, error) {
+	id, _, err := e.vint(true)
+	if err != nil {
+		return 0, 0, err
+	}
+	size, unknown, err := e.vint(false)
+	if err != nil {
+		return 0, 0, err
+	}
+	if unknown {
+		return id, ebmlUnknownSize, nil
+	}
+	return id, size, nil
+}
+
+// vint reads a variable size integer, keeping its length marker for IDs.
+func (e *ebmlReader) vint(keepMarker bool) (int64, bool, error) {
+	if e.offset >= e.limit {
+		return 0, false, ErrMalformed
+	}
+	first := make([]byte, 1)
+	if _, err := e.r.ReadAt(first, e.offset); err != nil {
+		return 0, false, ErrMalformed
+	}
+	length := 1
+	for length <= 8 && first[0]&(0x80>>(length-1)) == 0 {
+		length++
+	}
+	if length > 8 {
+		return 0, false, ErrMalformed
+	}
+
+	data := make([]byte, length)
+	if _, err := e.r.ReadAt(data, e.offset); err != nil {
+		return 0, false, ErrMalformed
+	}
+	e.offset += int64(length)
+
+	mask := byte(0xFF >> length)
+	if keepMarker {
+		mask = 0xFF
+	}
+	value, allOnes := int64(data[0]&mask), data[0]&byte(0xFF>>length) == byte(0xFF>>length)
+	for _, b := range data[1:] {
+		value = value<<8 | int64(b)
+		allOnes = allOnes && b == 0xFF
+	}
+	return value, allOnes && !keepMarker, nil
+}
+
+// aviDuration multiplies the frame duration and frame count of the main AVI
+// header, hdrl/avih.
+func aviDuration(r io.ReaderAt, size int64) (time.Duration, error) {
+	header := make([]byte, min(size, maxHeaderSize))
+	n, err := r.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return 0, ErrMalformed
+	}
+	header = header[:n]
+
+	chunks, err := riffChunks(header, "AVI ")
+	if err != nil && len(chunks) == 0 {
+		return 0, ErrMalformed
+	}
+	for _, chunk := range chunks {
+		if chunk.id != "LIST" || len(chunk.data) < 4 || string(chunk.data[:4]) != "hdrl" {
+			continue
+		}
+		start := chunk.start + riffChunkHeaderSize + 4
+		subChunks, _ := riffSubChunks(header, start, start+len(chunk.data)-4)
+		for _, sub := range subChunks {
+			if sub.id == "avih" && len(sub.data) >= 20 {
+				microSecPerFrame := binary.LittleEndian.Uint32(sub.data)
+				totalFrames := binary.LittleEndian.Uint32(sub.data[16:])
+				return scaleDuration(float64(microSecPerFrame) * float64(totalFrames) * float64(time.Microsecond))
+			}
+		}
+	}
+	return 0, ErrMalformed
+}
+
+func scaleDuration(nanoseconds float64) (time.Duration, error) {
+	if math.IsNaN(nanoseconds) || nanoseconds < 0 || nanoseconds > math.MaxInt64 {
+		return 0, ErrMalformed
+	}
+	return time.Duration(nanoseconds), nil
+}
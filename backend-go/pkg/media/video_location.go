@@ -0,0 +1,194 @@
+package media
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+)
+
+const (
+	// maxMovieBoxSize bounds the movie box read to strip the location, it
+	// only holds the metadata and sample tables.
+	maxMovieBoxSize = 64 << 20
+	// quickTimeLocationKeyPrefix prefixes the QuickTime metadata keys of the
+	// capture location, such as com.apple.quicktime.location.ISO6709.
+	quickTimeLocationKeyPrefix = "com.apple.quicktime.location."
+)
+
+// ReadWriterAt is a file read and modified in place, such as an *os.File.
+type ReadWriterAt interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// StripVideoLocation erases in place the capture location an MP4 or QuickTime
+// video records in its movie box: the ©xyz and loci user data and the
+// location entries of the QuickTime metadata, as phones write them. The
+// values are zeroed without resizing any box, so the offsets of the media
+// data stay valid. It reports whether a location was erased. Other formats
+// are left unchanged.
+func StripVideoLocation(f ReadWriterAt, size int64, mimeType string) (bool, error) {
+	if mimeType != "video/mp4" && mimeType != "video/quicktime" {
+		return false, nil
+	}
+
+	moov, moovEnd, err := findBox(f, 0, size, "moov")
+	if err != nil {
+		return false, err
+	}
+	if moovEnd-moov > maxMovieBoxSize {
+		return false, ErrMalformed
+	}
+
+	data := make([]byte, moovEnd-moov)
+	if _, err = f.ReadAt(data, moov); err != nil {
+		return false, ErrMalformed
+	}
+	erased, err := eraseLocationBoxes(data)
+	if err != nil || !erased {
+		return false, err
+	}
+	if _, err = f.WriteAt(data, moov); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// mp4Box is a box within a buffer, data is its content after the header.
+type mp4Box struct {
+	boxType string
+	data    []byte
+}
+
+// mp4Boxes splits data into the boxes it holds. Fewer than eight bytes left
+// at the end are padding, such as the terminator of QuickTime user data.
+func mp4Boxes(data []byte) ([]mp4Box, error) {
+	var boxes []mp4Box
+	for offset := 0; offset+8 <= len(data); {
+		boxSize, headerSize := uint64(binary.BigEndian.Uint32(data[offset:])), 8
+		switch boxSize {
+		case 0:
+			boxSize = uint64(len(data) - offset)
+		case 1:
+			if offset+16 > len(data) {
+				return nil, ErrMalformed
+			}
+			boxSize, headerSize = binary.BigEndian.Uint64(data[offset+8:]), 16
+		}
+		if boxSize < uint64(headerSize) || boxSize > uint64(len(data)-offset) {
+			return nil, ErrMalformed
+		}
+		end := offset + int(boxSize)
+		boxes = append(boxes, mp4Box{boxType: string(data[offset+4 : offset+8]), data: data[offset+headerSize : end]})
+		offset = end
+	}
+	return boxes, nil
+}
+
+// eraseLocationBoxes walks the containers metadata is found in.
+func eraseLocationBoxes(data []byte) (bool, error) {
+	boxes, err := mp4Boxes(data)
+	if err != nil {
+		return false, err
+	}
+
+	var erased bool
+	for _, box := range boxes {
+		var found bool
+		switch box.boxType {
+		case "moov", "trak", "udta":
+			found, err = eraseLocationBoxes(box.data)
+		case "meta":
+			found, err = eraseMetadataLocation(box.data)
+		case "\xa9xyz", "loci":
+			found = eraseValue(box.data)
+		}
+		if err != nil {
+			return false, err
+		}
+		erased = erased || found
+	}
+	return erased, nil
+}
+
+// eraseMetadataLocation erases the location entries of a metadata box. The
+// QuickTime metadata box holds its children directly, the ISO one after a
+// version and flags field, both start with a handler box.
+func eraseMetadataLocation(data []byte) (bool, error) {
+	if len(data) >= 12 && string(data[8:12]) == "hdlr" {
+		data = data[4:]
+	}
+	boxes, err := mp4Boxes(data)
+	if err != nil {
+		return false, err
+	}
+
+	var locationKeys map[uint32]bool
+	var items []mp4Box
+	for _, box := range boxes {
+		switch box.boxType {
+		case "keys":
+			locationKeys = quickTimeLocationKeys(box.data)
+		case "ilst":
+			if items, err = mp4Boxes(box.data); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	var erased bool
+	for _, item := range items {
+		// Items are typed by their key index when the metadata has keys,
+		// by their iTunes tag otherwise.
+		index := binary.BigEndian.Uint32([]byte(item.boxType))
+		if locationKeys[index] || item.boxType == "\xa9xyz" {
+			erased = eraseValue(item.data) || erased
+		}
+	}
+	return erased, nil
+}
+
+// quickTimeLocationKeys returns the 1-based indexes of the location keys of a
+// QuickTime metadata keys box.
+func quickTimeLocationKeys(data []byte) map[uint32]bool {
+	keys := make(map[uint32]bool)
+	if len(data) < 8 {
+		return keys
+	}
+	count := binary.BigEndian.Uint32(data[4:])
+	offset := 8
+	for index := uint32(1); index <= count && offset+8 <= len(data); index++ {
+		size := int(binary.BigEndian.Uint32(data[offset:]))
+		if size < 8 || offset+size > len(data) {
+			break
+		}
+		if strings.HasPrefix(string(data[offset+8:offset+size]), quickTimeLocationKeyPrefix) {
+			keys[index] = true
+		}
+		offset += size
+	}
+	return keys
+}
+
+// eraseValue zeroes a metadata value: the content of the data boxes it holds,
+// after their type and locale, or else everything after its first four bytes,
+// which are the length and language of a QuickTime user data text or the
+// version and flags of a 3GPP location box.
+func eraseValue(data []byte) bool {
+	if boxes, err := mp4Boxes(data); err == nil && len(boxes) > 0 && boxes[0].boxType == "data" {
+		var erased bool
+		for _, box := range boxes {
+			if box.boxType == "data" && len(box.data) > 8 {
+				clear(box.data[8:])
+				erased = true
+			}
+		}
+		return erased
+	}
+
+	if len(data) <= 4 {
+		return false
+	}
+	clear(data[4:])
+	return true
+}
@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -130,6 +132,64 @@ func (_c *ClaimAttachmentRepository_Create_Call) RunAndReturn(run func(applicati
 	return _c
 }
 
+// ExistsByChecksum provides a mock function with given fields: tx, claimID, checksum
+func (_m *ClaimAttachmentRepository) ExistsByChecksum(tx application.Tx, claimID uuid.UUID, checksum string) (bool, error) {
+	ret := _m.Called(tx, claimID, checksum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsByChecksum")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, string) (bool, error)); ok {
+		return rf(tx, claimID, checksum)
+	}
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, string) bool); ok {
+		r0 = rf(tx, claimID, checksum)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(application.Tx, uuid.UUID, string) error); ok {
+		r1 = rf(tx, claimID, checksum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimAttachmentRepository_ExistsByChecksum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsByChecksum'
+type ClaimAttachmentRepository_ExistsByChecksum_Call struct {
+	*mock.Call
+}
+
+// ExistsByChecksum is a helper method to define mock.On call
+//   - tx application.Tx
+//   - claimID uuid.UUID
+//   - checksum string
+func (_e *ClaimAttachmentRepository_Expecter) ExistsByChecksum(tx interface{}, claimID interface{}, checksum interface{}) *ClaimAttachmentRepository_ExistsByChecksum_Call {
+	return &ClaimAttachmentRepository_ExistsByChecksum_Call{Call: _e.mock.On("ExistsByChecksum", tx, claimID, checksum)}
+}
+
+func (_c *ClaimAttachmentRepository_ExistsByChecksum_Call) Run(run func(tx application.Tx, claimID uuid.UUID, checksum string)) *ClaimAttachmentRepository_ExistsByChecksum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_ExistsByChecksum_Call) Return(_a0 bool, _a1 error) *ClaimAttachmentRepository_ExistsByChecksum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_ExistsByChecksum_Call) RunAndReturn(run func(application.Tx, uuid.UUID, string) (bool, error)) *ClaimAttachmentRepository_ExistsByChecksum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByClaimID provides a mock function with given fields: ctx, claimID
 func (_m *ClaimAttachmentRepository) FindByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAttachment, error) {
 	ret := _m.Called(ctx, claimID)
@@ -189,6 +249,66 @@ func (_c *ClaimAttachmentRepository_FindByClaimID_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// FindByClaimItemID provides a mock function with given fields: ctx, claimID, claimItemID
+func (_m *ClaimAttachmentRepository) FindByClaimItemID(ctx context.Context, claimID uuid.UUID, claimItemID uuid.UUID) ([]*entity.ClaimAttachment, error) {
+	ret := _m.Called(ctx, claimID, claimItemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByClaimItemID")
+	}
+
+	var r0 []*entity.ClaimAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]*entity.ClaimAttachment, error)); ok {
+		return rf(ctx, claimID, claimItemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []*entity.ClaimAttachment); ok {
+		r0 = rf(ctx, claimID, claimItemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ClaimAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, claimID, claimItemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimAttachmentRepository_FindByClaimItemID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByClaimItemID'
+type ClaimAttachmentRepository_FindByClaimItemID_Call struct {
+	*mock.Call
+}
+
+// FindByClaimItemID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claimID uuid.UUID
+//   - claimItemID uuid.UUID
+func (_e *ClaimAttachmentRepository_Expecter) FindByClaimItemID(ctx interface{}, claimID interface{}, claimItemID interface{}) *ClaimAttachmentRepository_FindByClaimItemID_Call {
+	return &ClaimAttachmentRepository_FindByClaimItemID_Call{Call: _e.mock.On("FindByClaimItemID", ctx, claimID, claimItemID)}
+}
+
+func (_c *ClaimAttachmentRepository_FindByClaimItemID_Call) Run(run func(ctx context.Context, claimID uuid.UUID, claimItemID uuid.UUID)) *ClaimAttachmentRepository_FindByClaimItemID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_FindByClaimItemID_Call) Return(_a0 []*entity.ClaimAttachment, _a1 error) *ClaimAttachmentRepository_FindByClaimItemID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_FindByClaimItemID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]*entity.ClaimAttachment, error)) *ClaimAttachmentRepository_FindByClaimItemID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByID provides a mock function with given fields: ctx, id
 func (_m *ClaimAttachmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ClaimAttachment, error) {
 	ret := _m.Called(ctx, id)
@@ -308,6 +428,126 @@ func (_c *ClaimAttachmentRepository_FindByType_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// FindPendingProcessing provides a mock function with given fields: tx, now, limit
+func (_m *ClaimAttachmentRepository) FindPendingProcessing(tx application.Tx, now time.Time, limit int) ([]*entity.ClaimAttachment, error) {
+	ret := _m.Called(tx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPendingProcessing")
+	}
+
+	var r0 []*entity.ClaimAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(application.Tx, time.Time, int) ([]*entity.ClaimAttachment, error)); ok {
+		return rf(tx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(application.Tx, time.Time, int) []*entity.ClaimAttachment); ok {
+		r0 = rf(tx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ClaimAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(application.Tx, time.Time, int) error); ok {
+		r1 = rf(tx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimAttachmentRepository_FindPendingProcessing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPendingProcessing'
+type ClaimAttachmentRepository_FindPendingProcessing_Call struct {
+	*mock.Call
+}
+
+// FindPendingProcessing is a helper method to define mock.On call
+//   - tx application.Tx
+//   - now time.Time
+//   - limit int
+func (_e *ClaimAttachmentRepository_Expecter) FindPendingProcessing(tx interface{}, now interface{}, limit interface{}) *ClaimAttachmentRepository_FindPendingProcessing_Call {
+	return &ClaimAttachmentRepository_FindPendingProcessing_Call{Call: _e.mock.On("FindPendingProcessing", tx, now, limit)}
+}
+
+func (_c *ClaimAttachmentRepository_FindPendingProcessing_Call) Run(run func(tx application.Tx, now time.Time, limit int)) *ClaimAttachmentRepository_FindPendingProcessing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(application.Tx), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_FindPendingProcessing_Call) Return(_a0 []*entity.ClaimAttachment, _a1 error) *ClaimAttachmentRepository_FindPendingProcessing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_FindPendingProcessing_Call) RunAndReturn(run func(application.Tx, time.Time, int) ([]*entity.ClaimAttachment, error)) *ClaimAttachmentRepository_FindPendingProcessing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPublicUploads provides a mock function with given fields: ctx, afterID, limit
+func (_m *ClaimAttachmentRepository) FindPublicUploads(ctx context.Context, afterID uuid.UUID, limit int) ([]*entity.ClaimAttachment, error) {
+	ret := _m.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPublicUploads")
+	}
+
+	var r0 []*entity.ClaimAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]*entity.ClaimAttachment, error)); ok {
+		return rf(ctx, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []*entity.ClaimAttachment); ok {
+		r0 = rf(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ClaimAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimAttachmentRepository_FindPublicUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPublicUploads'
+type ClaimAttachmentRepository_FindPublicUploads_Call struct {
+	*mock.Call
+}
+
+// FindPublicUploads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - afterID uuid.UUID
+//   - limit int
+func (_e *ClaimAttachmentRepository_Expecter) FindPublicUploads(ctx interface{}, afterID interface{}, limit interface{}) *ClaimAttachmentRepository_FindPublicUploads_Call {
+	return &ClaimAttachmentRepository_FindPublicUploads_Call{Call: _e.mock.On("FindPublicUploads", ctx, afterID, limit)}
+}
+
+func (_c *ClaimAttachmentRepository_FindPublicUploads_Call) Run(run func(ctx context.Context, afterID uuid.UUID, limit int)) *ClaimAttachmentRepository_FindPublicUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_FindPublicUploads_Call) Return(_a0 []*entity.ClaimAttachment, _a1 error) *ClaimAttachmentRepository_FindPublicUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_FindPublicUploads_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]*entity.ClaimAttachment, error)) *ClaimAttachmentRepository_FindPublicUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HardDelete provides a mock function with given fields: tx, id
 func (_m *ClaimAttachmentRepository) HardDelete(tx application.Tx, id uuid.UUID) error {
 	ret := _m.Called(tx, id)
@@ -402,6 +642,100 @@ func (_c *ClaimAttachmentRepository_SoftDeleteByClaimID_Call) RunAndReturn(run f
 	return _c
 }
 
+// Update provides a mock function with given fields: tx, attachment
+func (_m *ClaimAttachmentRepository) Update(tx application.Tx, attachment *entity.ClaimAttachment) error {
+	ret := _m.Called(tx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(application.Tx, *entity.ClaimAttachment) error); ok {
+		r0 = rf(tx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimAttachmentRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ClaimAttachmentRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - tx application.Tx
+//   - attachment *entity.ClaimAttachment
+func (_e *ClaimAttachmentRepository_Expecter) Update(tx interface{}, attachment interface{}) *ClaimAttachmentRepository_Update_Call {
+	return &ClaimAttachmentRepository_Update_Call{Call: _e.mock.On("Update", tx, attachment)}
+}
+
+func (_c *ClaimAttachmentRepository_Update_Call) Run(run func(tx application.Tx, attachment *entity.ClaimAttachment)) *ClaimAttachmentRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(application.Tx), args[1].(*entity.ClaimAttachment))
+	})
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_Update_Call) Return(_a0 error) *ClaimAttachmentRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_Update_Call) RunAndReturn(run func(application.Tx, *entity.ClaimAttachment) error) *ClaimAttachmentRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStorageKeys provides a mock function with given fields: tx, attachment
+func (_m *ClaimAttachmentRepository) UpdateStorageKeys(tx application.Tx, attachment *entity.ClaimAttachment) error {
+	ret := _m.Called(tx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStorageKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(application.Tx, *entity.ClaimAttachment) error); ok {
+		r0 = rf(tx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimAttachmentRepository_UpdateStorageKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStorageKeys'
+type ClaimAttachmentRepository_UpdateStorageKeys_Call struct {
+	*mock.Call
+}
+
+// UpdateStorageKeys is a helper method to define mock.On call
+//   - tx application.Tx
+//   - attachment *entity.ClaimAttachment
+func (_e *ClaimAttachmentRepository_Expecter) UpdateStorageKeys(tx interface{}, attachment interface{}) *ClaimAttachmentRepository_UpdateStorageKeys_Call {
+	return &ClaimAttachmentRepository_UpdateStorageKeys_Call{Call: _e.mock.On("UpdateStorageKeys", tx, attachment)}
+}
+
+func (_c *ClaimAttachmentRepository_UpdateStorageKeys_Call) Run(run func(tx application.Tx, attachment *entity.ClaimAttachment)) *ClaimAttachmentRepository_UpdateStorageKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(application.Tx), args[1].(*entity.ClaimAttachment))
+	})
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_UpdateStorageKeys_Call) Return(_a0 error) *ClaimAttachmentRepository_UpdateStorageKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimAttachmentRepository_UpdateStorageKeys_Call) RunAndReturn(run func(application.Tx, *entity.ClaimAttachment) error) *ClaimAttachmentRepository_UpdateStorageKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClaimAttachmentRepository creates a new instance of ClaimAttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClaimAttachmentRepository(t interface {
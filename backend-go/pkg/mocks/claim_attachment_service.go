@@ -10,7 +10,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	multipart "mime/multipart"
+	service "ev-warranty-go/internal/application/service"
 
 	uuid "github.com/google/uuid"
 )
@@ -28,9 +28,9 @@ func (_m *ClaimAttachmentService) EXPECT() *ClaimAttachmentService_Expecter {
 	return &ClaimAttachmentService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: tx, technicianID, claimID, file
-func (_m *ClaimAttachmentService) Create(tx application.Tx, technicianID uuid.UUID, claimID uuid.UUID, file multipart.File) (*entity.ClaimAttachment, error) {
-	ret := _m.Called(tx, technicianID, claimID, file)
+// Create provides a mock function with given fields: tx, technicianID, claimID, cmd
+func (_m *ClaimAttachmentService) Create(tx application.Tx, technicianID uuid.UUID, claimID uuid.UUID, cmd *service.CreateClaimAttachmentCommand) (*entity.ClaimAttachment, error) {
+	ret := _m.Called(tx, technicianID, claimID, cmd)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -38,19 +38,19 @@ func (_m *ClaimAttachmentService) Create(tx application.Tx, technicianID uuid.UU
 
 	var r0 *entity.ClaimAttachment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, multipart.File) (*entity.ClaimAttachment, error)); ok {
-		return rf(tx, technicianID, claimID, file)
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, *service.CreateClaimAttachmentCommand) (*entity.ClaimAttachment, error)); ok {
+		return rf(tx, technicianID, claimID, cmd)
 	}
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, multipart.File) *entity.ClaimAttachment); ok {
-		r0 = rf(tx, technicianID, claimID, file)
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, *service.CreateClaimAttachmentCommand) *entity.ClaimAttachment); ok {
+		r0 = rf(tx, technicianID, claimID, cmd)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.ClaimAttachment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(application.Tx, uuid.UUID, uuid.UUID, multipart.File) error); ok {
-		r1 = rf(tx, technicianID, claimID, file)
+	if rf, ok := ret.Get(1).(func(application.Tx, uuid.UUID, uuid.UUID, *service.CreateClaimAttachmentCommand) error); ok {
+		r1 = rf(tx, technicianID, claimID, cmd)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -67,14 +67,14 @@ type ClaimAttachmentService_Create_Call struct {
 //   - tx application.Tx
 //   - technicianID uuid.UUID
 //   - claimID uuid.UUID
-//   - file multipart.File
-func (_e *ClaimAttachmentService_Expecter) Create(tx interface{}, technicianID interface{}, claimID interface{}, file interface{}) *ClaimAttachmentService_Create_Call {
-	return &ClaimAttachmentService_Create_Call{Call: _e.mock.On("Create", tx, technicianID, claimID, file)}
+//   - cmd *service.CreateClaimAttachmentCommand
+func (_e *ClaimAttachmentService_Expecter) Create(tx interface{}, technicianID interface{}, claimID interface{}, cmd interface{}) *ClaimAttachmentService_Create_Call {
+	return &ClaimAttachmentService_Create_Call{Call: _e.mock.On("Create", tx, technicianID, claimID, cmd)}
 }
 
-func (_c *ClaimAttachmentService_Create_Call) Run(run func(tx application.Tx, technicianID uuid.UUID, claimID uuid.UUID, file multipart.File)) *ClaimAttachmentService_Create_Call {
+func (_c *ClaimAttachmentService_Create_Call) Run(run func(tx application.Tx, technicianID uuid.UUID, claimID uuid.UUID, cmd *service.CreateClaimAttachmentCommand)) *ClaimAttachmentService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(multipart.File))
+		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*service.CreateClaimAttachmentCommand))
 	})
 	return _c
 }
@@ -84,14 +84,14 @@ func (_c *ClaimAttachmentService_Create_Call) Return(_a0 *entity.ClaimAttachment
 	return _c
 }
 
-func (_c *ClaimAttachmentService_Create_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID, multipart.File) (*entity.ClaimAttachment, error)) *ClaimAttachmentService_Create_Call {
+func (_c *ClaimAttachmentService_Create_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID, *service.CreateClaimAttachmentCommand) (*entity.ClaimAttachment, error)) *ClaimAttachmentService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByClaimID provides a mock function with given fields: ctx, claimID
-func (_m *ClaimAttachmentService) GetByClaimID(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAttachment, error) {
-	ret := _m.Called(ctx, claimID)
+// GetByClaimID provides a mock function with given fields: ctx, claimID, claimItemID
+func (_m *ClaimAttachmentService) GetByClaimID(ctx context.Context, claimID uuid.UUID, claimItemID *uuid.UUID) ([]*entity.ClaimAttachment, error) {
+	ret := _m.Called(ctx, claimID, claimItemID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByClaimID")
@@ -99,19 +99,19 @@ func (_m *ClaimAttachmentService) GetByClaimID(ctx context.Context, claimID uuid
 
 	var r0 []*entity.ClaimAttachment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ClaimAttachment, error)); ok {
-		return rf(ctx, claimID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *uuid.UUID) ([]*entity.ClaimAttachment, error)); ok {
+		return rf(ctx, claimID, claimItemID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ClaimAttachment); ok {
-		r0 = rf(ctx, claimID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *uuid.UUID) []*entity.ClaimAttachment); ok {
+		r0 = rf(ctx, claimID, claimItemID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.ClaimAttachment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, claimID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(ctx, claimID, claimItemID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +127,14 @@ type ClaimAttachmentService_GetByClaimID_Call struct {
 // GetByClaimID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - claimID uuid.UUID
-func (_e *ClaimAttachmentService_Expecter) GetByClaimID(ctx interface{}, claimID interface{}) *ClaimAttachmentService_GetByClaimID_Call {
-	return &ClaimAttachmentService_GetByClaimID_Call{Call: _e.mock.On("GetByClaimID", ctx, claimID)}
+//   - claimItemID *uuid.UUID
+func (_e *ClaimAttachmentService_Expecter) GetByClaimID(ctx interface{}, claimID interface{}, claimItemID interface{}) *ClaimAttachmentService_GetByClaimID_Call {
+	return &ClaimAttachmentService_GetByClaimID_Call{Call: _e.mock.On("GetByClaimID", ctx, claimID, claimItemID)}
 }
 
-func (_c *ClaimAttachmentService_GetByClaimID_Call) Run(run func(ctx context.Context, claimID uuid.UUID)) *ClaimAttachmentService_GetByClaimID_Call {
+func (_c *ClaimAttachmentService_GetByClaimID_Call) Run(run func(ctx context.Context, claimID uuid.UUID, claimItemID *uuid.UUID)) *ClaimAttachmentService_GetByClaimID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*uuid.UUID))
 	})
 	return _c
 }
@@ -143,14 +144,14 @@ func (_c *ClaimAttachmentService_GetByClaimID_Call) Return(_a0 []*entity.ClaimAt
 	return _c
 }
 
-func (_c *ClaimAttachmentService_GetByClaimID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.ClaimAttachment, error)) *ClaimAttachmentService_GetByClaimID_Call {
+func (_c *ClaimAttachmentService_GetByClaimID_Call) RunAndReturn(run func(context.Context, uuid.UUID, *uuid.UUID) ([]*entity.ClaimAttachment, error)) *ClaimAttachmentService_GetByClaimID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *ClaimAttachmentService) GetByID(ctx context.Context, id uuid.UUID) (*entity.ClaimAttachment, error) {
-	ret := _m.Called(ctx, id)
+// GetByID provides a mock function with given fields: ctx, claimID, attachmentID
+func (_m *ClaimAttachmentService) GetByID(ctx context.Context, claimID uuid.UUID, attachmentID uuid.UUID) (*entity.ClaimAttachment, error) {
+	ret := _m.Called(ctx, claimID, attachmentID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -158,19 +159,19 @@ func (_m *ClaimAttachmentService) GetByID(ctx context.Context, id uuid.UUID) (*e
 
 	var r0 *entity.ClaimAttachment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ClaimAttachment, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.ClaimAttachment, error)); ok {
+		return rf(ctx, claimID, attachmentID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ClaimAttachment); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.ClaimAttachment); ok {
+		r0 = rf(ctx, claimID, attachmentID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.ClaimAttachment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, claimID, attachmentID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -185,14 +186,15 @@ type ClaimAttachmentService_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *ClaimAttachmentService_Expecter) GetByID(ctx interface{}, id interface{}) *ClaimAttachmentService_GetByID_Call {
-	return &ClaimAttachmentService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - claimID uuid.UUID
+//   - attachmentID uuid.UUID
+func (_e *ClaimAttachmentService_Expecter) GetByID(ctx interface{}, claimID interface{}, attachmentID interface{}) *ClaimAttachmentService_GetByID_Call {
+	return &ClaimAttachmentService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, claimID, attachmentID)}
 }
 
-func (_c *ClaimAttachmentService_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ClaimAttachmentService_GetByID_Call {
+func (_c *ClaimAttachmentService_GetByID_Call) Run(run func(ctx context.Context, claimID uuid.UUID, attachmentID uuid.UUID)) *ClaimAttachmentService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -202,7 +204,7 @@ func (_c *ClaimAttachmentService_GetByID_Call) Return(_a0 *entity.ClaimAttachmen
 	return _c
 }
 
-func (_c *ClaimAttachmentService_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.ClaimAttachment, error)) *ClaimAttachmentService_GetByID_Call {
+func (_c *ClaimAttachmentService_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entity.ClaimAttachment, error)) *ClaimAttachmentService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
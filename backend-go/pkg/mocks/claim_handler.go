@@ -21,6 +21,72 @@ func (_m *ClaimHandler) EXPECT() *ClaimHandler_Expecter {
 	return &ClaimHandler_Expecter{mock: &_m.Mock}
 }
 
+// Actions provides a mock function with given fields: c
+func (_m *ClaimHandler) Actions(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ClaimHandler_Actions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Actions'
+type ClaimHandler_Actions_Call struct {
+	*mock.Call
+}
+
+// Actions is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ClaimHandler_Expecter) Actions(c interface{}) *ClaimHandler_Actions_Call {
+	return &ClaimHandler_Actions_Call{Call: _e.mock.On("Actions", c)}
+}
+
+func (_c *ClaimHandler_Actions_Call) Run(run func(c *gin.Context)) *ClaimHandler_Actions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimHandler_Actions_Call) Return() *ClaimHandler_Actions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ClaimHandler_Actions_Call) RunAndReturn(run func(*gin.Context)) *ClaimHandler_Actions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Audit provides a mock function with given fields: c
+func (_m *ClaimHandler) Audit(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ClaimHandler_Audit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Audit'
+type ClaimHandler_Audit_Call struct {
+	*mock.Call
+}
+
+// Audit is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ClaimHandler_Expecter) Audit(c interface{}) *ClaimHandler_Audit_Call {
+	return &ClaimHandler_Audit_Call{Call: _e.mock.On("Audit", c)}
+}
+
+func (_c *ClaimHandler_Audit_Call) Run(run func(c *gin.Context)) *ClaimHandler_Audit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimHandler_Audit_Call) Return() *ClaimHandler_Audit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ClaimHandler_Audit_Call) RunAndReturn(run func(*gin.Context)) *ClaimHandler_Audit_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Cancel provides a mock function with given fields: c
 func (_m *ClaimHandler) Cancel(c *gin.Context) {
 	_m.Called(c)
@@ -186,6 +252,39 @@ func (_c *ClaimHandler_DoneReview_Call) RunAndReturn(run func(*gin.Context)) *Cl
 	return _c
 }
 
+// Export provides a mock function with given fields: c
+func (_m *ClaimHandler) Export(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ClaimHandler_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type ClaimHandler_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ClaimHandler_Expecter) Export(c interface{}) *ClaimHandler_Export_Call {
+	return &ClaimHandler_Export_Call{Call: _e.mock.On("Export", c)}
+}
+
+func (_c *ClaimHandler_Export_Call) Run(run func(c *gin.Context)) *ClaimHandler_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimHandler_Export_Call) Return() *ClaimHandler_Export_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ClaimHandler_Export_Call) RunAndReturn(run func(*gin.Context)) *ClaimHandler_Export_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetAll provides a mock function with given fields: c
 func (_m *ClaimHandler) GetAll(c *gin.Context) {
 	_m.Called(c)
@@ -285,6 +384,39 @@ func (_c *ClaimHandler_History_Call) RunAndReturn(run func(*gin.Context)) *Claim
 	return _c
 }
 
+// RejectionReasons provides a mock function with given fields: c
+func (_m *ClaimHandler) RejectionReasons(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ClaimHandler_RejectionReasons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectionReasons'
+type ClaimHandler_RejectionReasons_Call struct {
+	*mock.Call
+}
+
+// RejectionReasons is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ClaimHandler_Expecter) RejectionReasons(c interface{}) *ClaimHandler_RejectionReasons_Call {
+	return &ClaimHandler_RejectionReasons_Call{Call: _e.mock.On("RejectionReasons", c)}
+}
+
+func (_c *ClaimHandler_RejectionReasons_Call) Run(run func(c *gin.Context)) *ClaimHandler_RejectionReasons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimHandler_RejectionReasons_Call) Return() *ClaimHandler_RejectionReasons_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ClaimHandler_RejectionReasons_Call) RunAndReturn(run func(*gin.Context)) *ClaimHandler_RejectionReasons_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Review provides a mock function with given fields: c
 func (_m *ClaimHandler) Review(c *gin.Context) {
 	_m.Called(c)
@@ -318,6 +450,39 @@ func (_c *ClaimHandler_Review_Call) RunAndReturn(run func(*gin.Context)) *ClaimH
 	return _c
 }
 
+// Stream provides a mock function with given fields: c
+func (_m *ClaimHandler) Stream(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ClaimHandler_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type ClaimHandler_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ClaimHandler_Expecter) Stream(c interface{}) *ClaimHandler_Stream_Call {
+	return &ClaimHandler_Stream_Call{Call: _e.mock.On("Stream", c)}
+}
+
+func (_c *ClaimHandler_Stream_Call) Run(run func(c *gin.Context)) *ClaimHandler_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimHandler_Stream_Call) Return() *ClaimHandler_Stream_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ClaimHandler_Stream_Call) RunAndReturn(run func(*gin.Context)) *ClaimHandler_Stream_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Submit provides a mock function with given fields: c
 func (_m *ClaimHandler) Submit(c *gin.Context) {
 	_m.Called(c)
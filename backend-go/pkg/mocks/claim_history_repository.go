@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "ev-warranty-go/internal/application/repository"
+
 	time "time"
 
 	uuid "github.com/google/uuid"
@@ -28,6 +30,65 @@ func (_m *ClaimHistoryRepository) EXPECT() *ClaimHistoryRepository_Expecter {
 	return &ClaimHistoryRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountRejectionReasons provides a mock function with given fields: ctx, filters
+func (_m *ClaimHistoryRepository) CountRejectionReasons(ctx context.Context, filters repository.RejectionReasonFilters) ([]*repository.ReasonCodeCount, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountRejectionReasons")
+	}
+
+	var r0 []*repository.ReasonCodeCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.RejectionReasonFilters) ([]*repository.ReasonCodeCount, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.RejectionReasonFilters) []*repository.ReasonCodeCount); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.ReasonCodeCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.RejectionReasonFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimHistoryRepository_CountRejectionReasons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRejectionReasons'
+type ClaimHistoryRepository_CountRejectionReasons_Call struct {
+	*mock.Call
+}
+
+// CountRejectionReasons is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters repository.RejectionReasonFilters
+func (_e *ClaimHistoryRepository_Expecter) CountRejectionReasons(ctx interface{}, filters interface{}) *ClaimHistoryRepository_CountRejectionReasons_Call {
+	return &ClaimHistoryRepository_CountRejectionReasons_Call{Call: _e.mock.On("CountRejectionReasons", ctx, filters)}
+}
+
+func (_c *ClaimHistoryRepository_CountRejectionReasons_Call) Run(run func(ctx context.Context, filters repository.RejectionReasonFilters)) *ClaimHistoryRepository_CountRejectionReasons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.RejectionReasonFilters))
+	})
+	return _c
+}
+
+func (_c *ClaimHistoryRepository_CountRejectionReasons_Call) Return(_a0 []*repository.ReasonCodeCount, _a1 error) *ClaimHistoryRepository_CountRejectionReasons_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimHistoryRepository_CountRejectionReasons_Call) RunAndReturn(run func(context.Context, repository.RejectionReasonFilters) ([]*repository.ReasonCodeCount, error)) *ClaimHistoryRepository_CountRejectionReasons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: tx, history
 func (_m *ClaimHistoryRepository) Create(tx application.Tx, history *entity.ClaimHistory) error {
 	ret := _m.Called(tx, history)
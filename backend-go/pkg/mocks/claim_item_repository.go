@@ -308,6 +308,64 @@ func (_c *ClaimItemRepository_FindByStatus_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// FindReplacementPartIDs provides a mock function with given fields: ctx
+func (_m *ClaimItemRepository) FindReplacementPartIDs(ctx context.Context) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindReplacementPartIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]uuid.UUID, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []uuid.UUID); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimItemRepository_FindReplacementPartIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReplacementPartIDs'
+type ClaimItemRepository_FindReplacementPartIDs_Call struct {
+	*mock.Call
+}
+
+// FindReplacementPartIDs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ClaimItemRepository_Expecter) FindReplacementPartIDs(ctx interface{}) *ClaimItemRepository_FindReplacementPartIDs_Call {
+	return &ClaimItemRepository_FindReplacementPartIDs_Call{Call: _e.mock.On("FindReplacementPartIDs", ctx)}
+}
+
+func (_c *ClaimItemRepository_FindReplacementPartIDs_Call) Run(run func(ctx context.Context)) *ClaimItemRepository_FindReplacementPartIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimItemRepository_FindReplacementPartIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *ClaimItemRepository_FindReplacementPartIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimItemRepository_FindReplacementPartIDs_Call) RunAndReturn(run func(context.Context) ([]uuid.UUID, error)) *ClaimItemRepository_FindReplacementPartIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HardDelete provides a mock function with given fields: tx, id
 func (_m *ClaimItemRepository) HardDelete(tx application.Tx, id uuid.UUID) error {
 	ret := _m.Called(tx, id)
@@ -403,22 +461,22 @@ func (_c *ClaimItemRepository_SoftDeleteByClaimID_Call) RunAndReturn(run func(ap
 }
 
 // SumCostByClaimID provides a mock function with given fields: tx, claimID
-func (_m *ClaimItemRepository) SumCostByClaimID(tx application.Tx, claimID uuid.UUID) (float64, error) {
+func (_m *ClaimItemRepository) SumCostByClaimID(tx application.Tx, claimID uuid.UUID) (int64, error) {
 	ret := _m.Called(tx, claimID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SumCostByClaimID")
 	}
 
-	var r0 float64
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID) (float64, error)); ok {
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID) (int64, error)); ok {
 		return rf(tx, claimID)
 	}
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID) float64); ok {
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID) int64); ok {
 		r0 = rf(tx, claimID)
 	} else {
-		r0 = ret.Get(0).(float64)
+		r0 = ret.Get(0).(int64)
 	}
 
 	if rf, ok := ret.Get(1).(func(application.Tx, uuid.UUID) error); ok {
@@ -449,12 +507,69 @@ func (_c *ClaimItemRepository_SumCostByClaimID_Call) Run(run func(tx application
 	return _c
 }
 
-func (_c *ClaimItemRepository_SumCostByClaimID_Call) Return(_a0 float64, _a1 error) *ClaimItemRepository_SumCostByClaimID_Call {
+func (_c *ClaimItemRepository_SumCostByClaimID_Call) Return(_a0 int64, _a1 error) *ClaimItemRepository_SumCostByClaimID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimItemRepository_SumCostByClaimID_Call) RunAndReturn(run func(application.Tx, uuid.UUID) (int64, error)) *ClaimItemRepository_SumCostByClaimID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumRequestedCostByClaimID provides a mock function with given fields: tx, claimID
+func (_m *ClaimItemRepository) SumRequestedCostByClaimID(tx application.Tx, claimID uuid.UUID) (int64, error) {
+	ret := _m.Called(tx, claimID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumRequestedCostByClaimID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID) (int64, error)); ok {
+		return rf(tx, claimID)
+	}
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID) int64); ok {
+		r0 = rf(tx, claimID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(application.Tx, uuid.UUID) error); ok {
+		r1 = rf(tx, claimID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimItemRepository_SumRequestedCostByClaimID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumRequestedCostByClaimID'
+type ClaimItemRepository_SumRequestedCostByClaimID_Call struct {
+	*mock.Call
+}
+
+// SumRequestedCostByClaimID is a helper method to define mock.On call
+//   - tx application.Tx
+//   - claimID uuid.UUID
+func (_e *ClaimItemRepository_Expecter) SumRequestedCostByClaimID(tx interface{}, claimID interface{}) *ClaimItemRepository_SumRequestedCostByClaimID_Call {
+	return &ClaimItemRepository_SumRequestedCostByClaimID_Call{Call: _e.mock.On("SumRequestedCostByClaimID", tx, claimID)}
+}
+
+func (_c *ClaimItemRepository_SumRequestedCostByClaimID_Call) Run(run func(tx application.Tx, claimID uuid.UUID)) *ClaimItemRepository_SumRequestedCostByClaimID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(application.Tx), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimItemRepository_SumRequestedCostByClaimID_Call) Return(_a0 int64, _a1 error) *ClaimItemRepository_SumRequestedCostByClaimID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ClaimItemRepository_SumCostByClaimID_Call) RunAndReturn(run func(application.Tx, uuid.UUID) (float64, error)) *ClaimItemRepository_SumCostByClaimID_Call {
+func (_c *ClaimItemRepository_SumRequestedCostByClaimID_Call) RunAndReturn(run func(application.Tx, uuid.UUID) (int64, error)) *ClaimItemRepository_SumRequestedCostByClaimID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -28,17 +28,17 @@ func (_m *ClaimItemService) EXPECT() *ClaimItemService_Expecter {
 	return &ClaimItemService_Expecter{mock: &_m.Mock}
 }
 
-// Approve provides a mock function with given fields: tx, claimID, itemID
-func (_m *ClaimItemService) Approve(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID) error {
-	ret := _m.Called(tx, claimID, itemID)
+// Approve provides a mock function with given fields: tx, claimID, itemID, changedBy, cmd
+func (_m *ClaimItemService) Approve(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID, changedBy uuid.UUID, cmd *service.ApproveClaimItemCommand) error {
+	ret := _m.Called(tx, claimID, itemID, changedBy, cmd)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Approve")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(tx, claimID, itemID)
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, uuid.UUID, *service.ApproveClaimItemCommand) error); ok {
+		r0 = rf(tx, claimID, itemID, changedBy, cmd)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -55,13 +55,15 @@ type ClaimItemService_Approve_Call struct {
 //   - tx application.Tx
 //   - claimID uuid.UUID
 //   - itemID uuid.UUID
-func (_e *ClaimItemService_Expecter) Approve(tx interface{}, claimID interface{}, itemID interface{}) *ClaimItemService_Approve_Call {
-	return &ClaimItemService_Approve_Call{Call: _e.mock.On("Approve", tx, claimID, itemID)}
+//   - changedBy uuid.UUID
+//   - cmd *service.ApproveClaimItemCommand
+func (_e *ClaimItemService_Expecter) Approve(tx interface{}, claimID interface{}, itemID interface{}, changedBy interface{}, cmd interface{}) *ClaimItemService_Approve_Call {
+	return &ClaimItemService_Approve_Call{Call: _e.mock.On("Approve", tx, claimID, itemID, changedBy, cmd)}
 }
 
-func (_c *ClaimItemService_Approve_Call) Run(run func(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID)) *ClaimItemService_Approve_Call {
+func (_c *ClaimItemService_Approve_Call) Run(run func(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID, changedBy uuid.UUID, cmd *service.ApproveClaimItemCommand)) *ClaimItemService_Approve_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(*service.ApproveClaimItemCommand))
 	})
 	return _c
 }
@@ -71,7 +73,7 @@ func (_c *ClaimItemService_Approve_Call) Return(_a0 error) *ClaimItemService_App
 	return _c
 }
 
-func (_c *ClaimItemService_Approve_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID) error) *ClaimItemService_Approve_Call {
+func (_c *ClaimItemService_Approve_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID, uuid.UUID, *service.ApproveClaimItemCommand) error) *ClaimItemService_Approve_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -196,9 +198,9 @@ func (_c *ClaimItemService_GetByClaimID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *ClaimItemService) GetByID(ctx context.Context, id uuid.UUID) (*entity.ClaimItem, error) {
-	ret := _m.Called(ctx, id)
+// GetByID provides a mock function with given fields: ctx, claimID, itemID
+func (_m *ClaimItemService) GetByID(ctx context.Context, claimID uuid.UUID, itemID uuid.UUID) (*entity.ClaimItem, error) {
+	ret := _m.Called(ctx, claimID, itemID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -206,19 +208,19 @@ func (_m *ClaimItemService) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 
 	var r0 *entity.ClaimItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.ClaimItem, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.ClaimItem, error)); ok {
+		return rf(ctx, claimID, itemID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.ClaimItem); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.ClaimItem); ok {
+		r0 = rf(ctx, claimID, itemID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.ClaimItem)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, claimID, itemID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -233,14 +235,15 @@ type ClaimItemService_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *ClaimItemService_Expecter) GetByID(ctx interface{}, id interface{}) *ClaimItemService_GetByID_Call {
-	return &ClaimItemService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - claimID uuid.UUID
+//   - itemID uuid.UUID
+func (_e *ClaimItemService_Expecter) GetByID(ctx interface{}, claimID interface{}, itemID interface{}) *ClaimItemService_GetByID_Call {
+	return &ClaimItemService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, claimID, itemID)}
 }
 
-func (_c *ClaimItemService_GetByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ClaimItemService_GetByID_Call {
+func (_c *ClaimItemService_GetByID_Call) Run(run func(ctx context.Context, claimID uuid.UUID, itemID uuid.UUID)) *ClaimItemService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -250,7 +253,7 @@ func (_c *ClaimItemService_GetByID_Call) Return(_a0 *entity.ClaimItem, _a1 error
 	return _c
 }
 
-func (_c *ClaimItemService_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.ClaimItem, error)) *ClaimItemService_GetByID_Call {
+func (_c *ClaimItemService_GetByID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entity.ClaimItem, error)) *ClaimItemService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -304,17 +307,17 @@ func (_c *ClaimItemService_HardDelete_Call) RunAndReturn(run func(application.Tx
 	return _c
 }
 
-// Reject provides a mock function with given fields: tx, claimID, itemID, authToken
-func (_m *ClaimItemService) Reject(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID, authToken string) error {
-	ret := _m.Called(tx, claimID, itemID, authToken)
+// Reject provides a mock function with given fields: tx, claimID, itemID, changedBy, reason, authToken
+func (_m *ClaimItemService) Reject(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID, changedBy uuid.UUID, reason *service.ReasonCommand, authToken string) error {
+	ret := _m.Called(tx, claimID, itemID, changedBy, reason, authToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Reject")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, string) error); ok {
-		r0 = rf(tx, claimID, itemID, authToken)
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, uuid.UUID, *service.ReasonCommand, string) error); ok {
+		r0 = rf(tx, claimID, itemID, changedBy, reason, authToken)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -331,14 +334,16 @@ type ClaimItemService_Reject_Call struct {
 //   - tx application.Tx
 //   - claimID uuid.UUID
 //   - itemID uuid.UUID
+//   - changedBy uuid.UUID
+//   - reason *service.ReasonCommand
 //   - authToken string
-func (_e *ClaimItemService_Expecter) Reject(tx interface{}, claimID interface{}, itemID interface{}, authToken interface{}) *ClaimItemService_Reject_Call {
-	return &ClaimItemService_Reject_Call{Call: _e.mock.On("Reject", tx, claimID, itemID, authToken)}
+func (_e *ClaimItemService_Expecter) Reject(tx interface{}, claimID interface{}, itemID interface{}, changedBy interface{}, reason interface{}, authToken interface{}) *ClaimItemService_Reject_Call {
+	return &ClaimItemService_Reject_Call{Call: _e.mock.On("Reject", tx, claimID, itemID, changedBy, reason, authToken)}
 }
 
-func (_c *ClaimItemService_Reject_Call) Run(run func(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID, authToken string)) *ClaimItemService_Reject_Call {
+func (_c *ClaimItemService_Reject_Call) Run(run func(tx application.Tx, claimID uuid.UUID, itemID uuid.UUID, changedBy uuid.UUID, reason *service.ReasonCommand, authToken string)) *ClaimItemService_Reject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(*service.ReasonCommand), args[5].(string))
 	})
 	return _c
 }
@@ -348,7 +353,7 @@ func (_c *ClaimItemService_Reject_Call) Return(_a0 error) *ClaimItemService_Reje
 	return _c
 }
 
-func (_c *ClaimItemService_Reject_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID, string) error) *ClaimItemService_Reject_Call {
+func (_c *ClaimItemService_Reject_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID, uuid.UUID, *service.ReasonCommand, string) error) *ClaimItemService_Reject_Call {
 	_c.Call.Return(run)
 	return _c
 }
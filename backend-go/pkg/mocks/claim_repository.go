@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "ev-warranty-go/internal/application/repository"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -130,34 +132,89 @@ func (_c *ClaimRepository_Create_Call) RunAndReturn(run func(application.Tx, *en
 	return _c
 }
 
-// FindAll provides a mock function with given fields: ctx
-func (_m *ClaimRepository) FindAll(ctx context.Context) ([]*entity.Claim, error) {
-	ret := _m.Called(ctx)
+// Export provides a mock function with given fields: ctx, filters, fn
+func (_m *ClaimRepository) Export(ctx context.Context, filters repository.ClaimFilters, fn func(*repository.ClaimExportRow) error) error {
+	ret := _m.Called(ctx, filters, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ClaimFilters, func(*repository.ClaimExportRow) error) error); ok {
+		r0 = rf(ctx, filters, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimRepository_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type ClaimRepository_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters repository.ClaimFilters
+//   - fn func(*repository.ClaimExportRow) error
+func (_e *ClaimRepository_Expecter) Export(ctx interface{}, filters interface{}, fn interface{}) *ClaimRepository_Export_Call {
+	return &ClaimRepository_Export_Call{Call: _e.mock.On("Export", ctx, filters, fn)}
+}
+
+func (_c *ClaimRepository_Export_Call) Run(run func(ctx context.Context, filters repository.ClaimFilters, fn func(*repository.ClaimExportRow) error)) *ClaimRepository_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ClaimFilters), args[2].(func(*repository.ClaimExportRow) error))
+	})
+	return _c
+}
+
+func (_c *ClaimRepository_Export_Call) Return(_a0 error) *ClaimRepository_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimRepository_Export_Call) RunAndReturn(run func(context.Context, repository.ClaimFilters, func(*repository.ClaimExportRow) error) error) *ClaimRepository_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: ctx, filters, pagination
+func (_m *ClaimRepository) FindAll(ctx context.Context, filters repository.ClaimFilters, pagination repository.Pagination) ([]*entity.Claim, int64, error) {
+	ret := _m.Called(ctx, filters, pagination)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
 	}
 
 	var r0 []*entity.Claim
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Claim, error)); ok {
-		return rf(ctx)
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ClaimFilters, repository.Pagination) ([]*entity.Claim, int64, error)); ok {
+		return rf(ctx, filters, pagination)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Claim); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ClaimFilters, repository.Pagination) []*entity.Claim); ok {
+		r0 = rf(ctx, filters, pagination)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.Claim)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ClaimFilters, repository.Pagination) int64); ok {
+		r1 = rf(ctx, filters, pagination)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, repository.ClaimFilters, repository.Pagination) error); ok {
+		r2 = rf(ctx, filters, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // ClaimRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
@@ -167,23 +224,25 @@ type ClaimRepository_FindAll_Call struct {
 
 // FindAll is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *ClaimRepository_Expecter) FindAll(ctx interface{}) *ClaimRepository_FindAll_Call {
-	return &ClaimRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+//   - filters repository.ClaimFilters
+//   - pagination repository.Pagination
+func (_e *ClaimRepository_Expecter) FindAll(ctx interface{}, filters interface{}, pagination interface{}) *ClaimRepository_FindAll_Call {
+	return &ClaimRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, filters, pagination)}
 }
 
-func (_c *ClaimRepository_FindAll_Call) Run(run func(ctx context.Context)) *ClaimRepository_FindAll_Call {
+func (_c *ClaimRepository_FindAll_Call) Run(run func(ctx context.Context, filters repository.ClaimFilters, pagination repository.Pagination)) *ClaimRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(repository.ClaimFilters), args[2].(repository.Pagination))
 	})
 	return _c
 }
 
-func (_c *ClaimRepository_FindAll_Call) Return(_a0 []*entity.Claim, _a1 error) *ClaimRepository_FindAll_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *ClaimRepository_FindAll_Call) Return(_a0 []*entity.Claim, _a1 int64, _a2 error) *ClaimRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *ClaimRepository_FindAll_Call) RunAndReturn(run func(context.Context) ([]*entity.Claim, error)) *ClaimRepository_FindAll_Call {
+func (_c *ClaimRepository_FindAll_Call) RunAndReturn(run func(context.Context, repository.ClaimFilters, repository.Pagination) ([]*entity.Claim, int64, error)) *ClaimRepository_FindAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -306,6 +365,66 @@ func (_c *ClaimRepository_FindByID_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindByIDInOffice provides a mock function with given fields: ctx, id, officeID
+func (_m *ClaimRepository) FindByIDInOffice(ctx context.Context, id uuid.UUID, officeID uuid.UUID) (*entity.Claim, error) {
+	ret := _m.Called(ctx, id, officeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDInOffice")
+	}
+
+	var r0 *entity.Claim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.Claim, error)); ok {
+		return rf(ctx, id, officeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.Claim); ok {
+		r0 = rf(ctx, id, officeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Claim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, id, officeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepository_FindByIDInOffice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDInOffice'
+type ClaimRepository_FindByIDInOffice_Call struct {
+	*mock.Call
+}
+
+// FindByIDInOffice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - officeID uuid.UUID
+func (_e *ClaimRepository_Expecter) FindByIDInOffice(ctx interface{}, id interface{}, officeID interface{}) *ClaimRepository_FindByIDInOffice_Call {
+	return &ClaimRepository_FindByIDInOffice_Call{Call: _e.mock.On("FindByIDInOffice", ctx, id, officeID)}
+}
+
+func (_c *ClaimRepository_FindByIDInOffice_Call) Run(run func(ctx context.Context, id uuid.UUID, officeID uuid.UUID)) *ClaimRepository_FindByIDInOffice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimRepository_FindByIDInOffice_Call) Return(_a0 *entity.Claim, _a1 error) *ClaimRepository_FindByIDInOffice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepository_FindByIDInOffice_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entity.Claim, error)) *ClaimRepository_FindByIDInOffice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByVehicleID provides a mock function with given fields: ctx, vehicleID
 func (_m *ClaimRepository) FindByVehicleID(ctx context.Context, vehicleID uuid.UUID) ([]*entity.Claim, error) {
 	ret := _m.Called(ctx, vehicleID)
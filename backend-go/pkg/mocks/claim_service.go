@@ -3,13 +3,17 @@
 package mocks
 
 import (
-	context "context"
 	application "ev-warranty-go/internal/application"
+	claimstream "ev-warranty-go/internal/application/claimstream"
+
+	context "context"
 
 	entity "ev-warranty-go/internal/domain/entity"
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "ev-warranty-go/internal/application/repository"
+
 	service "ev-warranty-go/internal/application/service"
 
 	uuid "github.com/google/uuid"
@@ -28,6 +32,55 @@ func (_m *ClaimService) EXPECT() *ClaimService_Expecter {
 	return &ClaimService_Expecter{mock: &_m.Mock}
 }
 
+// Cancel provides a mock function with given fields: tx, id, changedBy, reason
+func (_m *ClaimService) Cancel(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *service.ReasonCommand) error {
+	ret := _m.Called(tx, id, changedBy, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, *service.ReasonCommand) error); ok {
+		r0 = rf(tx, id, changedBy, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimService_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type ClaimService_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - tx application.Tx
+//   - id uuid.UUID
+//   - changedBy uuid.UUID
+//   - reason *service.ReasonCommand
+func (_e *ClaimService_Expecter) Cancel(tx interface{}, id interface{}, changedBy interface{}, reason interface{}) *ClaimService_Cancel_Call {
+	return &ClaimService_Cancel_Call{Call: _e.mock.On("Cancel", tx, id, changedBy, reason)}
+}
+
+func (_c *ClaimService_Cancel_Call) Run(run func(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *service.ReasonCommand)) *ClaimService_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*service.ReasonCommand))
+	})
+	return _c
+}
+
+func (_c *ClaimService_Cancel_Call) Return(_a0 error) *ClaimService_Cancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimService_Cancel_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID, *service.ReasonCommand) error) *ClaimService_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Complete provides a mock function with given fields: tx, id, changedBy
 func (_m *ClaimService) Complete(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
 	ret := _m.Called(tx, id, changedBy)
@@ -76,9 +129,9 @@ func (_c *ClaimService_Complete_Call) RunAndReturn(run func(application.Tx, uuid
 	return _c
 }
 
-// Create provides a mock function with given fields: tx, cmd
-func (_m *ClaimService) Create(tx application.Tx, cmd *service.CreateClaimCommand) (*entity.Claim, error) {
-	ret := _m.Called(tx, cmd)
+// Create provides a mock function with given fields: tx, cmd, authToken
+func (_m *ClaimService) Create(tx application.Tx, cmd *service.CreateClaimCommand, authToken string) (*entity.Claim, error) {
+	ret := _m.Called(tx, cmd, authToken)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -86,19 +139,19 @@ func (_m *ClaimService) Create(tx application.Tx, cmd *service.CreateClaimComman
 
 	var r0 *entity.Claim
 	var r1 error
-	if rf, ok := ret.Get(0).(func(application.Tx, *service.CreateClaimCommand) (*entity.Claim, error)); ok {
-		return rf(tx, cmd)
+	if rf, ok := ret.Get(0).(func(application.Tx, *service.CreateClaimCommand, string) (*entity.Claim, error)); ok {
+		return rf(tx, cmd, authToken)
 	}
-	if rf, ok := ret.Get(0).(func(application.Tx, *service.CreateClaimCommand) *entity.Claim); ok {
-		r0 = rf(tx, cmd)
+	if rf, ok := ret.Get(0).(func(application.Tx, *service.CreateClaimCommand, string) *entity.Claim); ok {
+		r0 = rf(tx, cmd, authToken)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Claim)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(application.Tx, *service.CreateClaimCommand) error); ok {
-		r1 = rf(tx, cmd)
+	if rf, ok := ret.Get(1).(func(application.Tx, *service.CreateClaimCommand, string) error); ok {
+		r1 = rf(tx, cmd, authToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,13 +167,14 @@ type ClaimService_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - tx application.Tx
 //   - cmd *service.CreateClaimCommand
-func (_e *ClaimService_Expecter) Create(tx interface{}, cmd interface{}) *ClaimService_Create_Call {
-	return &ClaimService_Create_Call{Call: _e.mock.On("Create", tx, cmd)}
+//   - authToken string
+func (_e *ClaimService_Expecter) Create(tx interface{}, cmd interface{}, authToken interface{}) *ClaimService_Create_Call {
+	return &ClaimService_Create_Call{Call: _e.mock.On("Create", tx, cmd, authToken)}
 }
 
-func (_c *ClaimService_Create_Call) Run(run func(tx application.Tx, cmd *service.CreateClaimCommand)) *ClaimService_Create_Call {
+func (_c *ClaimService_Create_Call) Run(run func(tx application.Tx, cmd *service.CreateClaimCommand, authToken string)) *ClaimService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(application.Tx), args[1].(*service.CreateClaimCommand))
+		run(args[0].(application.Tx), args[1].(*service.CreateClaimCommand), args[2].(string))
 	})
 	return _c
 }
@@ -130,27 +184,39 @@ func (_c *ClaimService_Create_Call) Return(_a0 *entity.Claim, _a1 error) *ClaimS
 	return _c
 }
 
-func (_c *ClaimService_Create_Call) RunAndReturn(run func(application.Tx, *service.CreateClaimCommand) (*entity.Claim, error)) *ClaimService_Create_Call {
+func (_c *ClaimService_Create_Call) RunAndReturn(run func(application.Tx, *service.CreateClaimCommand, string) (*entity.Claim, error)) *ClaimService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DoneReview provides a mock function with given fields: tx, id, changedBy
-func (_m *ClaimService) DoneReview(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
-	ret := _m.Called(tx, id, changedBy)
+// DoneReview provides a mock function with given fields: tx, id, changedBy, reason
+func (_m *ClaimService) DoneReview(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *service.ReasonCommand) (*service.ClaimReviewStats, error) {
+	ret := _m.Called(tx, id, changedBy, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DoneReview")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(tx, id, changedBy)
+	var r0 *service.ClaimReviewStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, *service.ReasonCommand) (*service.ClaimReviewStats, error)); ok {
+		return rf(tx, id, changedBy, reason)
+	}
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID, *service.ReasonCommand) *service.ClaimReviewStats); ok {
+		r0 = rf(tx, id, changedBy, reason)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.ClaimReviewStats)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(application.Tx, uuid.UUID, uuid.UUID, *service.ReasonCommand) error); ok {
+		r1 = rf(tx, id, changedBy, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // ClaimService_DoneReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoneReview'
@@ -162,50 +228,51 @@ type ClaimService_DoneReview_Call struct {
 //   - tx application.Tx
 //   - id uuid.UUID
 //   - changedBy uuid.UUID
-func (_e *ClaimService_Expecter) DoneReview(tx interface{}, id interface{}, changedBy interface{}) *ClaimService_DoneReview_Call {
-	return &ClaimService_DoneReview_Call{Call: _e.mock.On("DoneReview", tx, id, changedBy)}
+//   - reason *service.ReasonCommand
+func (_e *ClaimService_Expecter) DoneReview(tx interface{}, id interface{}, changedBy interface{}, reason interface{}) *ClaimService_DoneReview_Call {
+	return &ClaimService_DoneReview_Call{Call: _e.mock.On("DoneReview", tx, id, changedBy, reason)}
 }
 
-func (_c *ClaimService_DoneReview_Call) Run(run func(tx application.Tx, id uuid.UUID, changedBy uuid.UUID)) *ClaimService_DoneReview_Call {
+func (_c *ClaimService_DoneReview_Call) Run(run func(tx application.Tx, id uuid.UUID, changedBy uuid.UUID, reason *service.ReasonCommand)) *ClaimService_DoneReview_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*service.ReasonCommand))
 	})
 	return _c
 }
 
-func (_c *ClaimService_DoneReview_Call) Return(_a0 error) *ClaimService_DoneReview_Call {
-	_c.Call.Return(_a0)
+func (_c *ClaimService_DoneReview_Call) Return(_a0 *service.ClaimReviewStats, _a1 error) *ClaimService_DoneReview_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ClaimService_DoneReview_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID) error) *ClaimService_DoneReview_Call {
+func (_c *ClaimService_DoneReview_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID, *service.ReasonCommand) (*service.ClaimReviewStats, error)) *ClaimService_DoneReview_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function with given fields: ctx
-func (_m *ClaimService) GetAll(ctx context.Context) ([]*entity.Claim, error) {
-	ret := _m.Called(ctx)
+// Export provides a mock function with given fields: ctx, filters, format
+func (_m *ClaimService) Export(ctx context.Context, filters repository.ClaimFilters, format string) (*service.ClaimExport, error) {
+	ret := _m.Called(ctx, filters, format)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAll")
+		panic("no return value specified for Export")
 	}
 
-	var r0 []*entity.Claim
+	var r0 *service.ClaimExport
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Claim, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ClaimFilters, string) (*service.ClaimExport, error)); ok {
+		return rf(ctx, filters, format)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Claim); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ClaimFilters, string) *service.ClaimExport); ok {
+		r0 = rf(ctx, filters, format)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entity.Claim)
+			r0 = ret.Get(0).(*service.ClaimExport)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ClaimFilters, string) error); ok {
+		r1 = rf(ctx, filters, format)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -213,6 +280,73 @@ func (_m *ClaimService) GetAll(ctx context.Context) ([]*entity.Claim, error) {
 	return r0, r1
 }
 
+// ClaimService_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type ClaimService_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters repository.ClaimFilters
+//   - format string
+func (_e *ClaimService_Expecter) Export(ctx interface{}, filters interface{}, format interface{}) *ClaimService_Export_Call {
+	return &ClaimService_Export_Call{Call: _e.mock.On("Export", ctx, filters, format)}
+}
+
+func (_c *ClaimService_Export_Call) Run(run func(ctx context.Context, filters repository.ClaimFilters, format string)) *ClaimService_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ClaimFilters), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ClaimService_Export_Call) Return(_a0 *service.ClaimExport, _a1 error) *ClaimService_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimService_Export_Call) RunAndReturn(run func(context.Context, repository.ClaimFilters, string) (*service.ClaimExport, error)) *ClaimService_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, filters, pagination
+func (_m *ClaimService) GetAll(ctx context.Context, filters repository.ClaimFilters, pagination repository.Pagination) ([]*entity.Claim, int64, error) {
+	ret := _m.Called(ctx, filters, pagination)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*entity.Claim
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ClaimFilters, repository.Pagination) ([]*entity.Claim, int64, error)); ok {
+		return rf(ctx, filters, pagination)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.ClaimFilters, repository.Pagination) []*entity.Claim); ok {
+		r0 = rf(ctx, filters, pagination)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Claim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.ClaimFilters, repository.Pagination) int64); ok {
+		r1 = rf(ctx, filters, pagination)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.ClaimFilters, repository.Pagination) error); ok {
+		r2 = rf(ctx, filters, pagination)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ClaimService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
 type ClaimService_GetAll_Call struct {
 	*mock.Call
@@ -220,23 +354,143 @@ type ClaimService_GetAll_Call struct {
 
 // GetAll is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *ClaimService_Expecter) GetAll(ctx interface{}) *ClaimService_GetAll_Call {
-	return &ClaimService_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+//   - filters repository.ClaimFilters
+//   - pagination repository.Pagination
+func (_e *ClaimService_Expecter) GetAll(ctx interface{}, filters interface{}, pagination interface{}) *ClaimService_GetAll_Call {
+	return &ClaimService_GetAll_Call{Call: _e.mock.On("GetAll", ctx, filters, pagination)}
+}
+
+func (_c *ClaimService_GetAll_Call) Run(run func(ctx context.Context, filters repository.ClaimFilters, pagination repository.Pagination)) *ClaimService_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.ClaimFilters), args[2].(repository.Pagination))
+	})
+	return _c
+}
+
+func (_c *ClaimService_GetAll_Call) Return(_a0 []*entity.Claim, _a1 int64, _a2 error) *ClaimService_GetAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ClaimService_GetAll_Call) RunAndReturn(run func(context.Context, repository.ClaimFilters, repository.Pagination) ([]*entity.Claim, int64, error)) *ClaimService_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditLog provides a mock function with given fields: ctx, claimID
+func (_m *ClaimService) GetAuditLog(ctx context.Context, claimID uuid.UUID) ([]*entity.ClaimAuditLog, error) {
+	ret := _m.Called(ctx, claimID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditLog")
+	}
+
+	var r0 []*entity.ClaimAuditLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ClaimAuditLog, error)); ok {
+		return rf(ctx, claimID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ClaimAuditLog); ok {
+		r0 = rf(ctx, claimID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ClaimAuditLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, claimID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimService_GetAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditLog'
+type ClaimService_GetAuditLog_Call struct {
+	*mock.Call
+}
+
+// GetAuditLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claimID uuid.UUID
+func (_e *ClaimService_Expecter) GetAuditLog(ctx interface{}, claimID interface{}) *ClaimService_GetAuditLog_Call {
+	return &ClaimService_GetAuditLog_Call{Call: _e.mock.On("GetAuditLog", ctx, claimID)}
 }
 
-func (_c *ClaimService_GetAll_Call) Run(run func(ctx context.Context)) *ClaimService_GetAll_Call {
+func (_c *ClaimService_GetAuditLog_Call) Run(run func(ctx context.Context, claimID uuid.UUID)) *ClaimService_GetAuditLog_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *ClaimService_GetAll_Call) Return(_a0 []*entity.Claim, _a1 error) *ClaimService_GetAll_Call {
+func (_c *ClaimService_GetAuditLog_Call) Return(_a0 []*entity.ClaimAuditLog, _a1 error) *ClaimService_GetAuditLog_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ClaimService_GetAll_Call) RunAndReturn(run func(context.Context) ([]*entity.Claim, error)) *ClaimService_GetAll_Call {
+func (_c *ClaimService_GetAuditLog_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.ClaimAuditLog, error)) *ClaimService_GetAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailableActions provides a mock function with given fields: ctx, id
+func (_m *ClaimService) GetAvailableActions(ctx context.Context, id uuid.UUID) ([]string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableActions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimService_GetAvailableActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailableActions'
+type ClaimService_GetAvailableActions_Call struct {
+	*mock.Call
+}
+
+// GetAvailableActions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ClaimService_Expecter) GetAvailableActions(ctx interface{}, id interface{}) *ClaimService_GetAvailableActions_Call {
+	return &ClaimService_GetAvailableActions_Call{Call: _e.mock.On("GetAvailableActions", ctx, id)}
+}
+
+func (_c *ClaimService_GetAvailableActions_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ClaimService_GetAvailableActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimService_GetAvailableActions_Call) Return(_a0 []string, _a1 error) *ClaimService_GetAvailableActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimService_GetAvailableActions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]string, error)) *ClaimService_GetAvailableActions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -359,6 +613,65 @@ func (_c *ClaimService_GetHistory_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// GetRejectionReasons provides a mock function with given fields: ctx, filters
+func (_m *ClaimService) GetRejectionReasons(ctx context.Context, filters repository.RejectionReasonFilters) ([]*repository.ReasonCodeCount, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRejectionReasons")
+	}
+
+	var r0 []*repository.ReasonCodeCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.RejectionReasonFilters) ([]*repository.ReasonCodeCount, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.RejectionReasonFilters) []*repository.ReasonCodeCount); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.ReasonCodeCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.RejectionReasonFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimService_GetRejectionReasons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRejectionReasons'
+type ClaimService_GetRejectionReasons_Call struct {
+	*mock.Call
+}
+
+// GetRejectionReasons is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters repository.RejectionReasonFilters
+func (_e *ClaimService_Expecter) GetRejectionReasons(ctx interface{}, filters interface{}) *ClaimService_GetRejectionReasons_Call {
+	return &ClaimService_GetRejectionReasons_Call{Call: _e.mock.On("GetRejectionReasons", ctx, filters)}
+}
+
+func (_c *ClaimService_GetRejectionReasons_Call) Run(run func(ctx context.Context, filters repository.RejectionReasonFilters)) *ClaimService_GetRejectionReasons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.RejectionReasonFilters))
+	})
+	return _c
+}
+
+func (_c *ClaimService_GetRejectionReasons_Call) Return(_a0 []*repository.ReasonCodeCount, _a1 error) *ClaimService_GetRejectionReasons_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimService_GetRejectionReasons_Call) RunAndReturn(run func(context.Context, repository.RejectionReasonFilters) ([]*repository.ReasonCodeCount, error)) *ClaimService_GetRejectionReasons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HardDelete provides a mock function with given fields: tx, id
 func (_m *ClaimService) HardDelete(tx application.Tx, id uuid.UUID) error {
 	ret := _m.Called(tx, id)
@@ -406,6 +719,54 @@ func (_c *ClaimService_HardDelete_Call) RunAndReturn(run func(application.Tx, uu
 	return _c
 }
 
+// Review provides a mock function with given fields: tx, id, changedBy
+func (_m *ClaimService) Review(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
+	ret := _m.Called(tx, id, changedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(tx, id, changedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimService_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type ClaimService_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - tx application.Tx
+//   - id uuid.UUID
+//   - changedBy uuid.UUID
+func (_e *ClaimService_Expecter) Review(tx interface{}, id interface{}, changedBy interface{}) *ClaimService_Review_Call {
+	return &ClaimService_Review_Call{Call: _e.mock.On("Review", tx, id, changedBy)}
+}
+
+func (_c *ClaimService_Review_Call) Run(run func(tx application.Tx, id uuid.UUID, changedBy uuid.UUID)) *ClaimService_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimService_Review_Call) Return(_a0 error) *ClaimService_Review_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimService_Review_Call) RunAndReturn(run func(application.Tx, uuid.UUID, uuid.UUID) error) *ClaimService_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SoftDelete provides a mock function with given fields: tx, id
 func (_m *ClaimService) SoftDelete(tx application.Tx, id uuid.UUID) error {
 	ret := _m.Called(tx, id)
@@ -453,6 +814,65 @@ func (_c *ClaimService_SoftDelete_Call) RunAndReturn(run func(application.Tx, uu
 	return _c
 }
 
+// Stream provides a mock function with given fields: ctx, lastEventID
+func (_m *ClaimService) Stream(ctx context.Context, lastEventID uint64) (*claimstream.Subscription, error) {
+	ret := _m.Called(ctx, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 *claimstream.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (*claimstream.Subscription, error)); ok {
+		return rf(ctx, lastEventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) *claimstream.Subscription); ok {
+		r0 = rf(ctx, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*claimstream.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, lastEventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimService_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type ClaimService_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lastEventID uint64
+func (_e *ClaimService_Expecter) Stream(ctx interface{}, lastEventID interface{}) *ClaimService_Stream_Call {
+	return &ClaimService_Stream_Call{Call: _e.mock.On("Stream", ctx, lastEventID)}
+}
+
+func (_c *ClaimService_Stream_Call) Run(run func(ctx context.Context, lastEventID uint64)) *ClaimService_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *ClaimService_Stream_Call) Return(_a0 *claimstream.Subscription, _a1 error) *ClaimService_Stream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimService_Stream_Call) RunAndReturn(run func(context.Context, uint64) (*claimstream.Subscription, error)) *ClaimService_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Submit provides a mock function with given fields: tx, id, changedBy
 func (_m *ClaimService) Submit(tx application.Tx, id uuid.UUID, changedBy uuid.UUID) error {
 	ret := _m.Called(tx, id, changedBy)
@@ -549,55 +969,6 @@ func (_c *ClaimService_Update_Call) RunAndReturn(run func(application.Tx, uuid.U
 	return _c
 }
 
-// UpdateStatus provides a mock function with given fields: tx, id, status, changedBy
-func (_m *ClaimService) UpdateStatus(tx application.Tx, id uuid.UUID, status string, changedBy uuid.UUID) error {
-	ret := _m.Called(tx, id, status, changedBy)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateStatus")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(application.Tx, uuid.UUID, string, uuid.UUID) error); ok {
-		r0 = rf(tx, id, status, changedBy)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ClaimService_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
-type ClaimService_UpdateStatus_Call struct {
-	*mock.Call
-}
-
-// UpdateStatus is a helper method to define mock.On call
-//   - tx application.Tx
-//   - id uuid.UUID
-//   - status string
-//   - changedBy uuid.UUID
-func (_e *ClaimService_Expecter) UpdateStatus(tx interface{}, id interface{}, status interface{}, changedBy interface{}) *ClaimService_UpdateStatus_Call {
-	return &ClaimService_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", tx, id, status, changedBy)}
-}
-
-func (_c *ClaimService_UpdateStatus_Call) Run(run func(tx application.Tx, id uuid.UUID, status string, changedBy uuid.UUID)) *ClaimService_UpdateStatus_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(application.Tx), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID))
-	})
-	return _c
-}
-
-func (_c *ClaimService_UpdateStatus_Call) Return(_a0 error) *ClaimService_UpdateStatus_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *ClaimService_UpdateStatus_Call) RunAndReturn(run func(application.Tx, uuid.UUID, string, uuid.UUID) error) *ClaimService_UpdateStatus_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // NewClaimService creates a new instance of ClaimService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClaimService(t interface {
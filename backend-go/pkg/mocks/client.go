@@ -24,6 +24,306 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// GetCoverage provides a mock function with given fields: ctx, policyID, categoryID, authToken
+func (_m *Client) GetCoverage(ctx context.Context, policyID uuid.UUID, categoryID uuid.UUID, authToken string) (*dotnet.CoverageResponse, error) {
+	ret := _m.Called(ctx, policyID, categoryID, authToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCoverage")
+	}
+
+	var r0 *dotnet.CoverageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (*dotnet.CoverageResponse, error)); ok {
+		return rf(ctx, policyID, categoryID, authToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) *dotnet.CoverageResponse); ok {
+		r0 = rf(ctx, policyID, categoryID, authToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dotnet.CoverageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, policyID, categoryID, authToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_GetCoverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCoverage'
+type Client_GetCoverage_Call struct {
+	*mock.Call
+}
+
+// GetCoverage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyID uuid.UUID
+//   - categoryID uuid.UUID
+//   - authToken string
+func (_e *Client_Expecter) GetCoverage(ctx interface{}, policyID interface{}, categoryID interface{}, authToken interface{}) *Client_GetCoverage_Call {
+	return &Client_GetCoverage_Call{Call: _e.mock.On("GetCoverage", ctx, policyID, categoryID, authToken)}
+}
+
+func (_c *Client_GetCoverage_Call) Run(run func(ctx context.Context, policyID uuid.UUID, categoryID uuid.UUID, authToken string)) *Client_GetCoverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Client_GetCoverage_Call) Return(_a0 *dotnet.CoverageResponse, _a1 error) *Client_GetCoverage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_GetCoverage_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) (*dotnet.CoverageResponse, error)) *Client_GetCoverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVehicle provides a mock function with given fields: ctx, vehicleID, authToken
+func (_m *Client) GetVehicle(ctx context.Context, vehicleID uuid.UUID, authToken string) (*dotnet.VehicleResponse, error) {
+	ret := _m.Called(ctx, vehicleID, authToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVehicle")
+	}
+
+	var r0 *dotnet.VehicleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dotnet.VehicleResponse, error)); ok {
+		return rf(ctx, vehicleID, authToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dotnet.VehicleResponse); ok {
+		r0 = rf(ctx, vehicleID, authToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dotnet.VehicleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, vehicleID, authToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_GetVehicle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVehicle'
+type Client_GetVehicle_Call struct {
+	*mock.Call
+}
+
+// GetVehicle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vehicleID uuid.UUID
+//   - authToken string
+func (_e *Client_Expecter) GetVehicle(ctx interface{}, vehicleID interface{}, authToken interface{}) *Client_GetVehicle_Call {
+	return &Client_GetVehicle_Call{Call: _e.mock.On("GetVehicle", ctx, vehicleID, authToken)}
+}
+
+func (_c *Client_GetVehicle_Call) Run(run func(ctx context.Context, vehicleID uuid.UUID, authToken string)) *Client_GetVehicle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Client_GetVehicle_Call) Return(_a0 *dotnet.VehicleResponse, _a1 error) *Client_GetVehicle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_GetVehicle_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dotnet.VehicleResponse, error)) *Client_GetVehicle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVehicleModel provides a mock function with given fields: ctx, modelID, authToken
+func (_m *Client) GetVehicleModel(ctx context.Context, modelID uuid.UUID, authToken string) (*dotnet.VehicleModelResponse, error) {
+	ret := _m.Called(ctx, modelID, authToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVehicleModel")
+	}
+
+	var r0 *dotnet.VehicleModelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dotnet.VehicleModelResponse, error)); ok {
+		return rf(ctx, modelID, authToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dotnet.VehicleModelResponse); ok {
+		r0 = rf(ctx, modelID, authToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dotnet.VehicleModelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, modelID, authToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_GetVehicleModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVehicleModel'
+type Client_GetVehicleModel_Call struct {
+	*mock.Call
+}
+
+// GetVehicleModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID uuid.UUID
+//   - authToken string
+func (_e *Client_Expecter) GetVehicleModel(ctx interface{}, modelID interface{}, authToken interface{}) *Client_GetVehicleModel_Call {
+	return &Client_GetVehicleModel_Call{Call: _e.mock.On("GetVehicleModel", ctx, modelID, authToken)}
+}
+
+func (_c *Client_GetVehicleModel_Call) Run(run func(ctx context.Context, modelID uuid.UUID, authToken string)) *Client_GetVehicleModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Client_GetVehicleModel_Call) Return(_a0 *dotnet.VehicleModelResponse, _a1 error) *Client_GetVehicleModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_GetVehicleModel_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dotnet.VehicleModelResponse, error)) *Client_GetVehicleModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWarrantyPolicy provides a mock function with given fields: ctx, policyID, authToken
+func (_m *Client) GetWarrantyPolicy(ctx context.Context, policyID uuid.UUID, authToken string) (*dotnet.WarrantyPolicyResponse, error) {
+	ret := _m.Called(ctx, policyID, authToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWarrantyPolicy")
+	}
+
+	var r0 *dotnet.WarrantyPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dotnet.WarrantyPolicyResponse, error)); ok {
+		return rf(ctx, policyID, authToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dotnet.WarrantyPolicyResponse); ok {
+		r0 = rf(ctx, policyID, authToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dotnet.WarrantyPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, policyID, authToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_GetWarrantyPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWarrantyPolicy'
+type Client_GetWarrantyPolicy_Call struct {
+	*mock.Call
+}
+
+// GetWarrantyPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyID uuid.UUID
+//   - authToken string
+func (_e *Client_Expecter) GetWarrantyPolicy(ctx interface{}, policyID interface{}, authToken interface{}) *Client_GetWarrantyPolicy_Call {
+	return &Client_GetWarrantyPolicy_Call{Call: _e.mock.On("GetWarrantyPolicy", ctx, policyID, authToken)}
+}
+
+func (_c *Client_GetWarrantyPolicy_Call) Run(run func(ctx context.Context, policyID uuid.UUID, authToken string)) *Client_GetWarrantyPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Client_GetWarrantyPolicy_Call) Return(_a0 *dotnet.WarrantyPolicyResponse, _a1 error) *Client_GetWarrantyPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_GetWarrantyPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dotnet.WarrantyPolicyResponse, error)) *Client_GetWarrantyPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReservedParts provides a mock function with given fields: ctx, authToken
+func (_m *Client) ListReservedParts(ctx context.Context, authToken string) ([]dotnet.PartResponse, error) {
+	ret := _m.Called(ctx, authToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReservedParts")
+	}
+
+	var r0 []dotnet.PartResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]dotnet.PartResponse, error)); ok {
+		return rf(ctx, authToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []dotnet.PartResponse); ok {
+		r0 = rf(ctx, authToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dotnet.PartResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, authToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_ListReservedParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReservedParts'
+type Client_ListReservedParts_Call struct {
+	*mock.Call
+}
+
+// ListReservedParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authToken string
+func (_e *Client_Expecter) ListReservedParts(ctx interface{}, authToken interface{}) *Client_ListReservedParts_Call {
+	return &Client_ListReservedParts_Call{Call: _e.mock.On("ListReservedParts", ctx, authToken)}
+}
+
+func (_c *Client_ListReservedParts_Call) Run(run func(ctx context.Context, authToken string)) *Client_ListReservedParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Client_ListReservedParts_Call) Return(_a0 []dotnet.PartResponse, _a1 error) *Client_ListReservedParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_ListReservedParts_Call) RunAndReturn(run func(context.Context, string) ([]dotnet.PartResponse, error)) *Client_ListReservedParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReservePart provides a mock function with given fields: ctx, officeLocationID, categoryID, authToken
 func (_m *Client) ReservePart(ctx context.Context, officeLocationID uuid.UUID, categoryID uuid.UUID, authToken string) (*dotnet.PartResponse, error) {
 	ret := _m.Called(ctx, officeLocationID, categoryID, authToken)
@@ -160,6 +160,72 @@ func (_c *Tx_GetTx_Call) RunAndReturn(run func() interface{}) *Tx_GetTx_Call {
 	return _c
 }
 
+// OnCommit provides a mock function with given fields: fn
+func (_m *Tx) OnCommit(fn func()) {
+	_m.Called(fn)
+}
+
+// Tx_OnCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnCommit'
+type Tx_OnCommit_Call struct {
+	*mock.Call
+}
+
+// OnCommit is a helper method to define mock.On call
+//   - fn func()
+func (_e *Tx_Expecter) OnCommit(fn interface{}) *Tx_OnCommit_Call {
+	return &Tx_OnCommit_Call{Call: _e.mock.On("OnCommit", fn)}
+}
+
+func (_c *Tx_OnCommit_Call) Run(run func(fn func())) *Tx_OnCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func()))
+	})
+	return _c
+}
+
+func (_c *Tx_OnCommit_Call) Return() *Tx_OnCommit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Tx_OnCommit_Call) RunAndReturn(run func(func())) *Tx_OnCommit_Call {
+	_c.Run(run)
+	return _c
+}
+
+// OnRollback provides a mock function with given fields: fn
+func (_m *Tx) OnRollback(fn func()) {
+	_m.Called(fn)
+}
+
+// Tx_OnRollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnRollback'
+type Tx_OnRollback_Call struct {
+	*mock.Call
+}
+
+// OnRollback is a helper method to define mock.On call
+//   - fn func()
+func (_e *Tx_Expecter) OnRollback(fn interface{}) *Tx_OnRollback_Call {
+	return &Tx_OnRollback_Call{Call: _e.mock.On("OnRollback", fn)}
+}
+
+func (_c *Tx_OnRollback_Call) Run(run func(fn func())) *Tx_OnRollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func()))
+	})
+	return _c
+}
+
+func (_c *Tx_OnRollback_Call) Return() *Tx_OnRollback_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Tx_OnRollback_Call) RunAndReturn(run func(func())) *Tx_OnRollback_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Rollback provides a mock function with no fields
 func (_m *Tx) Rollback() error {
 	ret := _m.Called()
@@ -71,6 +71,65 @@ func (_c *UserRepository_Create_Call) RunAndReturn(run func(context.Context, *en
 	return _c
 }
 
+// FindActiveByRole provides a mock function with given fields: ctx, role
+func (_m *UserRepository) FindActiveByRole(ctx context.Context, role string) ([]*entity.User, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveByRole")
+	}
+
+	var r0 []*entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.User, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.User); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindActiveByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActiveByRole'
+type UserRepository_FindActiveByRole_Call struct {
+	*mock.Call
+}
+
+// FindActiveByRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *UserRepository_Expecter) FindActiveByRole(ctx interface{}, role interface{}) *UserRepository_FindActiveByRole_Call {
+	return &UserRepository_FindActiveByRole_Call{Call: _e.mock.On("FindActiveByRole", ctx, role)}
+}
+
+func (_c *UserRepository_FindActiveByRole_Call) Run(run func(ctx context.Context, role string)) *UserRepository_FindActiveByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindActiveByRole_Call) Return(_a0 []*entity.User, _a1 error) *UserRepository_FindActiveByRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindActiveByRole_Call) RunAndReturn(run func(context.Context, string) ([]*entity.User, error)) *UserRepository_FindActiveByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindAll provides a mock function with given fields: ctx
 func (_m *UserRepository) FindAll(ctx context.Context) ([]*entity.User, error) {
 	ret := _m.Called(ctx)
@@ -247,6 +306,65 @@ func (_c *UserRepository_FindByID_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// FindByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []*entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type UserRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *UserRepository_Expecter) FindByIDs(ctx interface{}, ids interface{}) *UserRepository_FindByIDs_Call {
+	return &UserRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ctx, ids)}
+}
+
+func (_c *UserRepository_FindByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *UserRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindByIDs_Call) Return(_a0 []*entity.User, _a1 error) *UserRepository_FindByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindByIDs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*entity.User, error)) *UserRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByOAuth provides a mock function with given fields: ctx, provider, oauthID
 func (_m *UserRepository) FindByOAuth(ctx context.Context, provider string, oauthID string) (*entity.User, error) {
 	ret := _m.Called(ctx, provider, oauthID)
@@ -307,6 +425,65 @@ func (_c *UserRepository_FindByOAuth_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// FindByOfficeID provides a mock function with given fields: ctx, officeID
+func (_m *UserRepository) FindByOfficeID(ctx context.Context, officeID uuid.UUID) ([]*entity.User, error) {
+	ret := _m.Called(ctx, officeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByOfficeID")
+	}
+
+	var r0 []*entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.User, error)); ok {
+		return rf(ctx, officeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.User); ok {
+		r0 = rf(ctx, officeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, officeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_FindByOfficeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByOfficeID'
+type UserRepository_FindByOfficeID_Call struct {
+	*mock.Call
+}
+
+// FindByOfficeID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - officeID uuid.UUID
+func (_e *UserRepository_Expecter) FindByOfficeID(ctx interface{}, officeID interface{}) *UserRepository_FindByOfficeID_Call {
+	return &UserRepository_FindByOfficeID_Call{Call: _e.mock.On("FindByOfficeID", ctx, officeID)}
+}
+
+func (_c *UserRepository_FindByOfficeID_Call) Run(run func(ctx context.Context, officeID uuid.UUID)) *UserRepository_FindByOfficeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserRepository_FindByOfficeID_Call) Return(_a0 []*entity.User, _a1 error) *UserRepository_FindByOfficeID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_FindByOfficeID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.User, error)) *UserRepository_FindByOfficeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SoftDelete provides a mock function with given fields: ctx, id
 func (_m *UserRepository) SoftDelete(ctx context.Context, id uuid.UUID) error {
 	ret := _m.Called(ctx, id)
@@ -0,0 +1,499 @@
+package pdf
+
+import (
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"sort"
+	"sync"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// fontData is DejaVu Sans Mono, a monospaced font covering Latin, Greek and
+// Cyrillic. See fonts/LICENSE.
+//
+//go:embed fonts/DejaVuSansMono.ttf
+var fontData []byte
+
+const fontName = "DejaVuSansMono"
+
+var errMalformedFont = errors.New("pdf: malformed font")
+
+// subsetTables are the TrueType tables a PDF reader needs to draw the glyphs
+// of an embedded font, the glyphs are looked up by ID so cmap is left out.
+var subsetTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// Flags of the components of a composite glyph.
+const (
+	componentArgsAreWords   = 0x0001
+	componentArgsAreXY      = 0x0002
+	componentHasScale       = 0x0008
+	componentMoreComponents = 0x0020
+	componentHasXYScale     = 0x0040
+	componentHasTwoByTwo    = 0x0080
+	componentUseMyMetrics   = 0x0200
+)
+
+// font is a parsed TrueType font. Metrics are in PDF glyph space, thousandths
+// of the font size, unless noted.
+type font struct {
+	tables  map[string][]byte
+	glyphs  map[rune]uint16
+	offsets []uint32
+	advance int
+	ascent  int
+	descent int
+	bbox    [4]int
+	// markGap is the space between a lowercase letter and the marks above
+	// it, in font units.
+	markGap int
+}
+
+var loadFont = sync.OnceValues(func() (*font, error) {
+	return parseFont(fontData)
+})
+
+func parseFont(data []byte) (*font, error) {
+	tables, err := fontTables(data)
+	if err != nil {
+		return nil, err
+	}
+	f := &font{tables: tables}
+
+	head, hhea, maxp := f.tables["head"], f.tables["hhea"], f.tables["maxp"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 32 || len(f.tables["hmtx"]) < 2 {
+		return nil, errMalformedFont
+	}
+	unitsPerEm := int(binary.BigEndian.Uint16(head[18:]))
+	if unitsPerEm == 0 {
+		return nil, errMalformedFont
+	}
+	scale := func(b []byte) int {
+		return int(int16(binary.BigEndian.Uint16(b))) * 1000 / unitsPerEm
+	}
+	f.bbox = [4]int{scale(head[36:]), scale(head[38:]), scale(head[40:]), scale(head[42:])}
+	f.ascent, f.descent = scale(hhea[4:]), scale(hhea[6:])
+	// Every glyph of a monospaced font has the advance of the first.
+	f.advance = int(binary.BigEndian.Uint16(f.tables["hmtx"])) * 1000 / unitsPerEm
+
+	numGlyphs := int(binary.BigEndian.Uint16(maxp[4:]))
+	longOffsets := binary.BigEndian.Uint16(head[50:]) == 1
+	if f.offsets, err = glyphOffsets(f.tables["loca"], numGlyphs, longOffsets, len(f.tables["glyf"])); err != nil {
+		return nil, err
+	}
+	if f.glyphs, err = unicodeGlyphs(f.tables["cmap"]); err != nil {
+		return nil, err
+	}
+	letter, letterOK := f.bounds(f.glyphs['o'])
+	acute, acuteOK := f.bounds(f.glyphs['\u0301'])
+	if letterOK && acuteOK {
+		f.markGap = int(acute[1] - letter[3])
+	}
+	return f, nil
+}
+
+// fontTables reads the table directory of a TrueType font file.
+func fontTables(data []byte) (map[string][]byte, error) {
+	if len(data) < 12 {
+		return nil, errMalformedFont
+	}
+	tables := make(map[string][]byte)
+	for i := range int(binary.BigEndian.Uint16(data[4:])) {
+		entry := 12 + 16*i
+		if entry+16 > len(data) {
+			return nil, errMalformedFont
+		}
+		offset := binary.BigEndian.Uint32(data[entry+8:])
+		length := binary.BigEndian.Uint32(data[entry+12:])
+		if uint64(offset)+uint64(length) > uint64(len(data)) {
+			return nil, errMalformedFont
+		}
+		tables[string(data[entry:entry+4])] = data[offset : offset+length]
+	}
+	return tables, nil
+}
+
+// glyphOffsets reads the loca table: the offset of every glyph in the glyf
+// table, followed by the end of the last one.
+func glyphOffsets(loca []byte, numGlyphs int, long bool, glyfSize int) ([]uint32, error) {
+	offsets := make([]uint32, numGlyphs+1)
+	for i := range offsets {
+		switch {
+		case long && 4*i+4 <= len(loca):
+			offsets[i] = binary.BigEndian.Uint32(loca[4*i:])
+		case !long && 2*i+2 <= len(loca):
+			offsets[i] = 2 * uint32(binary.BigEndian.Uint16(loca[2*i:]))
+		default:
+			return nil, errMalformedFont
+		}
+		if offsets[i] > uint32(glyfSize) || i > 0 && offsets[i] < offsets[i-1] {
+			return nil, errMalformedFont
+		}
+	}
+	return offsets, nil
+}
+
+// unicodeGlyphs reads the glyphs of the Basic Multilingual Plane from the
+// Windows Unicode subtable of a cmap table, which is in format 4.
+func unicodeGlyphs(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, errMalformedFont
+	}
+	var sub []byte
+	for i := range int(binary.BigEndian.Uint16(cmap[2:])) {
+		record := 4 + 8*i
+		if record+8 > len(cmap) {
+			return nil, errMalformedFont
+		}
+		offset := int(binary.BigEndian.Uint32(cmap[record+4:]))
+		if binary.BigEndian.Uint16(cmap[record:]) == 3 && binary.BigEndian.Uint16(cmap[record+2:]) == 1 &&
+			offset+14 <= len(cmap) && binary.BigEndian.Uint16(cmap[offset:]) == 4 {
+			sub = cmap[offset:]
+			break
+		}
+	}
+	if sub == nil {
+		return nil, errMalformedFont
+	}
+
+	segments := int(binary.BigEndian.Uint16(sub[6:])) / 2
+	ends, starts := 14, 16+2*segments
+	deltas, rangeOffsets := starts+2*segments, starts+4*segments
+	if rangeOffsets+2*segments > len(sub) {
+		return nil, errMalformedFont
+	}
+	glyphs := make(map[rune]uint16)
+	for s := range segments {
+		end := int(binary.BigEndian.Uint16(sub[ends+2*s:]))
+		start := int(binary.BigEndian.Uint16(sub[starts+2*s:]))
+		delta := binary.BigEndian.Uint16(sub[deltas+2*s:])
+		rangeOffset := int(binary.BigEndian.Uint16(sub[rangeOffsets+2*s:]))
+		for c := start; c <= end && c != 0xFFFF; c++ {
+			glyph := uint16(c) + delta
+			if rangeOffset != 0 {
+				// The offset is relative to where it is stored.
+				at := rangeOffsets + 2*s + rangeOffset + 2*(c-start)
+				if at+2 > len(sub) {
+					return nil, errMalformedFont
+				}
+				if glyph = binary.BigEndian.Uint16(sub[at:]); glyph != 0 {
+					glyph += delta
+				}
+			}
+			if glyph != 0 {
+				glyphs[rune(c)] = glyph
+			}
+		}
+	}
+	return glyphs, nil
+}
+
+// glyphData returns the outline of glyph g, empty for a glyph without one.
+func (f *font) glyphData(g uint16) []byte {
+	if int(g)+1 >= len(f.offsets) {
+		return nil
+	}
+	return f.tables["glyf"][f.offsets[g]:f.offsets[g+1]]
+}
+
+// bounds returns the xMin, yMin, xMax and yMax of glyph g in font units.
+func (f *font) bounds(g uint16) ([4]int16, bool) {
+	data := f.glyphData(g)
+	if len(data) < 10 {
+		return [4]int16{}, false
+	}
+	var box [4]int16
+	for i := range box {
+		box[i] = int16(binary.BigEndian.Uint16(data[2+2*i:]))
+	}
+	return box, true
+}
+
+// compose builds a composite glyph drawing r as the longest start of its
+// decomposition the font has a glyph for, with the marks left stacked above
+// it. It returns false when the font can not draw r that way.
+func (f *font) compose(r rune) ([]byte, bool) {
+	decomposed := []rune(norm.NFD.String(string(r)))
+	for n := len(decomposed) - 1; n >= 1; n-- {
+		start := []rune(norm.NFC.String(string(decomposed[:n])))
+		if len(start) != 1 {
+			continue
+		}
+		if base, ok := f.glyphs[start[0]]; ok {
+			return f.stackMarks(base, decomposed[n:])
+		}
+	}
+	return nil, false
+}
+
+// stackMarks builds a composite glyph of base with marks placed above it in
+// turn, each keeping the gap the font leaves above a lowercase letter.
+func (f *font) stackMarks(base uint16, marks []rune) ([]byte, bool) {
+	box, ok := f.bounds(base)
+	if !ok {
+		return nil, false
+	}
+	top := int(box[3])
+
+	data := make([]byte, 10)
+	binary.BigEndian.PutUint16(data, 0xFFFF)
+	component := func(g uint16, flags uint16, dy int) {
+		data = binary.BigEndian.AppendUint16(data, flags|componentArgsAreWords|componentArgsAreXY)
+		data = binary.BigEndian.AppendUint16(data, g)
+		data = binary.BigEndian.AppendUint16(data, 0)
+		data = binary.BigEndian.AppendUint16(data, uint16(int16(dy)))
+	}
+	component(base, componentUseMyMetrics|componentMoreComponents, 0)
+	for i, mark := range marks {
+		g, ok := f.glyphs[mark]
+		if !ok {
+			return nil, false
+		}
+		markBox, ok := f.bounds(g)
+		if !ok || markBox[1] < 0 {
+			return nil, false
+		}
+		dy := max(0, top+f.markGap-int(markBox[1]))
+		var flags uint16
+		if i < len(marks)-1 {
+			flags = componentMoreComponents
+		}
+		component(g, flags, dy)
+		box[0], box[2] = min(box[0], markBox[0]), max(box[2], markBox[2])
+		top = int(markBox[3]) + dy
+	}
+	box[3] = int16(top)
+	for i, value := range box {
+		binary.BigEndian.PutUint16(data[2+2*i:], uint16(value))
+	}
+	return data, true
+}
+
+// glyphSet collects the glyphs a document uses, with the text each stands
+// for. Letters the font has no glyph for but can draw as a letter with marks
+// above it, such as most Vietnamese letters with a hook above, get a
+// composite glyph numbered after those of the font.
+type glyphSet struct {
+	font  *font
+	runes map[uint16]rune
+	added map[rune]uint16
+	extra [][]byte
+}
+
+func newGlyphSet(f *font) *glyphSet {
+	return &glyphSet{font: f, runes: make(map[uint16]rune), added: make(map[rune]uint16)}
+}
+
+// glyph returns the glyph drawing r, or false when the font can not draw it.
+func (s *glyphSet) glyph(r rune) (uint16, bool) {
+	g, ok := s.font.glyphs[r]
+	if !ok {
+		if g, ok = s.added[r]; !ok {
+			data, composed := s.font.compose(r)
+			if !composed {
+				return 0, false
+			}
+			g = uint16(len(s.font.offsets) - 1 + len(s.extra))
+			s.extra = append(s.extra, data)
+			s.added[r] = g
+		}
+	}
+	if _, seen := s.runes[g]; !seen {
+		s.runes[g] = r
+	}
+	return g, true
+}
+
+// sorted returns the glyphs used in ascending order.
+func (s *glyphSet) sorted() []uint16 {
+	glyphs := make([]uint16, 0, len(s.runes))
+	for g := range s.runes {
+		glyphs = append(glyphs, g)
+	}
+	sort.Slice(glyphs, func(i, j int) bool { return glyphs[i] < glyphs[j] })
+	return glyphs
+}
+
+// subset returns a TrueType font holding the glyphs used, the components of
+// composite ones and the glyphs added, with the other glyphs of the font left
+// empty so that glyph IDs do not change.
+func (s *glyphSet) subset() []byte {
+	f := s.font
+	numGlyphs := len(f.offsets) - 1
+	keep := map[uint16]bool{0: true}
+	var add func(g uint16)
+	add = func(g uint16) {
+		if keep[g] || int(g) >= numGlyphs {
+			return
+		}
+		keep[g] = true
+		for _, component := range compositeComponents(f.glyphData(g)) {
+			add(component)
+		}
+	}
+	for g := range s.runes {
+		add(g)
+	}
+	for _, data := range s.extra {
+		for _, component := range compositeComponents(data) {
+			add(component)
+		}
+	}
+
+	var glyf []byte
+	loca := make([]byte, 0, 4*(numGlyphs+len(s.extra)+1))
+	appendGlyph := func(data []byte) {
+		loca = binary.BigEndian.AppendUint32(loca, uint32(len(glyf)))
+		glyf = append(glyf, data...)
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	for g := range numGlyphs {
+		if keep[uint16(g)] {
+			appendGlyph(f.glyphData(uint16(g)))
+		} else {
+			appendGlyph(nil)
+		}
+	}
+	for _, data := range s.extra {
+		appendGlyph(data)
+	}
+	loca = binary.BigEndian.AppendUint32(loca, uint32(len(glyf)))
+
+	// The subset uses long loca offsets, and its checksum is not adjusted.
+	head := append([]byte(nil), f.tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)
+	binary.BigEndian.PutUint16(head[50:], 1)
+	tables := map[string][]byte{"glyf": glyf, "head": head, "loca": loca}
+
+	if len(s.extra) > 0 {
+		// The glyphs added take the advance of the last metrics of the
+		// font, only their left side bearing is recorded.
+		hmtx := append([]byte(nil), f.tables["hmtx"]...)
+		for _, data := range s.extra {
+			hmtx = append(hmtx, data[2:4]...)
+		}
+		// They nest the composite glyphs of the font one level deeper, the
+		// limits readers allocate for are raised to hold them.
+		maxp := append([]byte(nil), f.tables["maxp"]...)
+		binary.BigEndian.PutUint16(maxp[4:], uint16(numGlyphs+len(s.extra)))
+		raise := func(offset int, value int) {
+			binary.BigEndian.PutUint16(maxp[offset:], max(binary.BigEndian.Uint16(maxp[offset:]), uint16(value)))
+		}
+		for _, data := range s.extra {
+			points, contours := f.outlineSize(data)
+			raise(10, points)
+			raise(12, contours)
+			raise(28, len(compositeComponents(data)))
+		}
+		binary.BigEndian.PutUint16(maxp[30:], binary.BigEndian.Uint16(maxp[30:])+1)
+		tables["hmtx"], tables["maxp"] = hmtx, maxp
+	}
+
+	for _, tag := range subsetTables {
+		if _, ok := tables[tag]; !ok && f.tables[tag] != nil {
+			tables[tag] = f.tables[tag]
+		}
+	}
+	return writeFont(tables)
+}
+
+// outlineSize returns the number of points and contours of a glyph, counting
+// those of its components for a composite glyph.
+func (f *font) outlineSize(glyph []byte) (int, int) {
+	if len(glyph) < 10 {
+		return 0, 0
+	}
+	contours := int(int16(binary.BigEndian.Uint16(glyph)))
+	if contours >= 0 {
+		if contours == 0 || len(glyph) < 10+2*contours {
+			return 0, contours
+		}
+		return int(binary.BigEndian.Uint16(glyph[8+2*contours:])) + 1, contours
+	}
+	var points int
+	contours = 0
+	for _, component := range compositeComponents(glyph) {
+		p, c := f.outlineSize(f.glyphData(component))
+		points, contours = points+p, contours+c
+	}
+	return points, contours
+}
+
+// compositeComponents returns the glyphs a composite glyph is made of, none
+// for a simple glyph.
+func compositeComponents(glyph []byte) []uint16 {
+	if len(glyph) < 10 || int16(binary.BigEndian.Uint16(glyph)) >= 0 {
+		return nil
+	}
+	var components []uint16
+	for offset := 10; offset+4 <= len(glyph); {
+		flags := binary.BigEndian.Uint16(glyph[offset:])
+		components = append(components, binary.BigEndian.Uint16(glyph[offset+2:]))
+		offset += 4
+		if flags&componentArgsAreWords != 0 {
+			offset += 4
+		} else {
+			offset += 2
+		}
+		switch {
+		case flags&componentHasScale != 0:
+			offset += 2
+		case flags&componentHasXYScale != 0:
+			offset += 4
+		case flags&componentHasTwoByTwo != 0:
+			offset += 8
+		}
+		if flags&componentMoreComponents == 0 {
+			break
+		}
+	}
+	return components
+}
+
+// writeFont writes a TrueType font file holding tables.
+func writeFont(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= len(tags) {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	out := make([]byte, 12+16*len(tags))
+	binary.BigEndian.PutUint32(out, 0x00010000)
+	binary.BigEndian.PutUint16(out[4:], uint16(len(tags)))
+	binary.BigEndian.PutUint16(out[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(out[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(out[10:], uint16(16*len(tags)-searchRange))
+	for i, tag := range tags {
+		table := tables[tag]
+		entry := out[12+16*i:]
+		copy(entry, tag)
+		binary.BigEndian.PutUint32(entry[4:], tableChecksum(table))
+		binary.BigEndian.PutUint32(entry[8:], uint32(len(out)))
+		binary.BigEndian.PutUint32(entry[12:], uint32(len(table)))
+		out = append(out, table...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	return out
+}
+
+func tableChecksum(table []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(table); i += 4 {
+		var word [4]byte
+		copy(word[:], table[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
@@ -0,0 +1,190 @@
+// Package pdf writes plain text documents as PDF, enough for printable
+// statements without depending on a layout library. Text is set in an
+// embedded monospaced font so columns padded with spaces line up, and only
+// the glyphs a document uses are embedded. Characters the font can not draw,
+// even as a letter with its marks, and control characters are replaced with
+// '?'.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// A4 in points.
+	pageWidth  = 595
+	pageHeight = 842
+
+	margin       = 50
+	fontSize     = 9
+	lineHeight   = 12
+	linesPerPage = (pageHeight - 2*margin) / lineHeight
+)
+
+type Document struct {
+	pages [][]string
+}
+
+func New() *Document {
+	return &Document{}
+}
+
+// Line adds a line of text, starting a new page when the current one is
+// full.
+func (d *Document) Line(text string) {
+	if len(d.pages) == 0 || len(d.pages[len(d.pages)-1]) == linesPerPage {
+		d.pages = append(d.pages, nil)
+	}
+	last := len(d.pages) - 1
+	d.pages[last] = append(d.pages[last], text)
+}
+
+// Linef adds a formatted line of text.
+func (d *Document) Linef(format string, args ...any) {
+	d.Line(fmt.Sprintf(format, args...))
+}
+
+// WriteTo writes the document as a PDF file. A document without lines has
+// a single blank page.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	f, err := loadFont()
+	if err != nil {
+		return 0, err
+	}
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = [][]string{nil}
+	}
+
+	// Text is written as glyph IDs, the ToUnicode map lets readers copy and
+	// search it.
+	glyphs := newGlyphSet(f)
+	glyphPages := make([][][]uint16, len(pages))
+	for i, lines := range pages {
+		glyphPages[i] = make([][]uint16, len(lines))
+		for j, line := range lines {
+			for _, r := range norm.NFC.String(line) {
+				g, ok := glyphs.glyph(r)
+				if !ok || r < ' ' || r == 0x7F {
+					g, _ = glyphs.glyph('?')
+				}
+				glyphPages[i][j] = append(glyphPages[i][j], g)
+			}
+		}
+	}
+	fontFile := glyphs.subset()
+	baseFont := subsetTag(glyphs) + "+" + fontName
+
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	stream := func(dict string, content []byte) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n<< /Length %d%s >>\nstream\n", len(offsets), len(content), dict)
+		buf.Write(content)
+		buf.WriteString("\nendstream\nendobj\n")
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// Objects 1 and 2 are the catalog and the page tree, 3 to 7 the font,
+	// then every page is followed by its content stream.
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 8+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H "+
+		"/DescendantFonts [4 0 R] /ToUnicode 7 0 R >>", baseFont))
+	object(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+		"/FontDescriptor 5 0 R /DW %d /CIDToGIDMap /Identity >>", baseFont, f.advance))
+	// Flags mark a fixed pitch font of the standard Latin character set.
+	object(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 33 /FontBBox [%d %d %d %d] "+
+		"/ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 6 0 R >>",
+		baseFont, f.bbox[0], f.bbox[1], f.bbox[2], f.bbox[3], f.ascent, f.descent, f.ascent))
+	stream(fmt.Sprintf(" /Length1 %d", len(fontFile)), fontFile)
+	stream("", toUnicode(glyphs))
+
+	for i, lines := range glyphPages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, 9+2*i))
+		stream("", []byte(pageContent(lines)))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+func pageContent(lines [][]uint16) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, lineHeight, margin, pageHeight-margin)
+	for _, line := range lines {
+		b.WriteByte('<')
+		for _, g := range line {
+			fmt.Fprintf(&b, "%04X", g)
+		}
+		b.WriteString("> Tj T*\n")
+	}
+	b.WriteString("ET")
+	return b.String()
+}
+
+// toUnicode returns the CMap mapping the glyphs used back to their text.
+func toUnicode(glyphs *glyphSet) []byte {
+	sorted := glyphs.sorted()
+
+	var b bytes.Buffer
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// A bfchar section holds at most 100 mappings.
+	for start := 0; start < len(sorted); start += 100 {
+		end := min(start+100, len(sorted))
+		fmt.Fprintf(&b, "%d beginbfchar\n", end-start)
+		for _, g := range sorted[start:end] {
+			fmt.Fprintf(&b, "<%04X> <", g)
+			for _, unit := range utf16.Encode([]rune{glyphs.runes[g]}) {
+				fmt.Fprintf(&b, "%04X", unit)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend")
+	return b.Bytes()
+}
+
+// subsetTag names the subset of the font embedded, readers tell the subsets
+// of a font apart by their six uppercase letters tag.
+func subsetTag(glyphs *glyphSet) string {
+	h := fnv.New32a()
+	for _, g := range glyphs.sorted() {
+		_, _ = h.Write([]byte{byte(g >> 8), byte(g)})
+	}
+	sum := h.Sum32()
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + byte(sum%26)
+		sum /= 26
+	}
+	return string(tag)
+}
@@ -0,0 +1,226 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	objectPattern = regexp.MustCompile(`(?s)(\d+) 0 obj\n(.*?)\nendobj\n`)
+	streamPattern = regexp.MustCompile(`(?s)^<< /Length (\d+).*?>>\nstream\n`)
+	xrefPattern   = regexp.MustCompile(`(?s)xref\n0 (\d+)\n0000000000 65535 f \n(.*)trailer\n.*startxref\n(\d+)\n`)
+	bfcharPattern = regexp.MustCompile(`<([0-9A-F]{4})> <([0-9A-F]+)>`)
+	textPattern   = regexp.MustCompile(`<([0-9A-F]*)> Tj T\*`)
+)
+
+var _ = Describe("Document", func() {
+	write := func(doc *Document) []byte {
+		var out bytes.Buffer
+		_, err := doc.WriteTo(&out)
+		Expect(err).NotTo(HaveOccurred())
+		return out.Bytes()
+	}
+
+	// objects returns the body of every object by number, the content of
+	// streams only.
+	objects := func(data []byte) map[int][]byte {
+		bodies := make(map[int][]byte)
+		for _, match := range objectPattern.FindAllSubmatchIndex(data, -1) {
+			number, _ := strconv.Atoi(string(data[match[2]:match[3]]))
+			body := data[match[4]:]
+			if header := streamPattern.FindSubmatch(body); header != nil {
+				length, _ := strconv.Atoi(string(header[1]))
+				bodies[number] = body[len(header[0]) : len(header[0])+length]
+				continue
+			}
+			bodies[number] = data[match[4]:match[5]]
+		}
+		return bodies
+	}
+
+	// text decodes the lines of a page through the ToUnicode map.
+	text := func(data []byte, page int) []string {
+		bodies := objects(data)
+		runes := make(map[string]string)
+		for _, match := range bfcharPattern.FindAllSubmatch(bodies[7], -1) {
+			var units []uint16
+			for i := 0; i < len(match[2]); i += 4 {
+				unit, _ := strconv.ParseUint(string(match[2][i:i+4]), 16, 16)
+				units = append(units, uint16(unit))
+			}
+			runes[string(match[1])] = string(utf16.Decode(units))
+		}
+
+		var lines []string
+		for _, match := range textPattern.FindAllSubmatch(bodies[9+2*page], -1) {
+			var line strings.Builder
+			for i := 0; i < len(match[1]); i += 4 {
+				line.WriteString(runes[string(match[1][i:i+4])])
+			}
+			lines = append(lines, line.String())
+		}
+		return lines
+	}
+
+	Context("when a statement is written", func() {
+		var data []byte
+
+		BeforeEach(func() {
+			doc := New()
+			doc.Line("WARRANTY REIMBURSEMENT STATEMENT")
+			doc.Linef("Office:   %s", "Trung tâm bảo hành Quận 7, TP. Hồ Chí Minh")
+			// The same text decomposed, as some keyboards type it.
+			doc.Linef("Item:     %s", "Thay pin (ốc vít) đèn")
+			doc.Linef("%-10s %8s", "Total", "1.500.000 ₫")
+			doc.Line("Unsupported: 漢\tend")
+			data = write(doc)
+		})
+
+		It("should match the golden file", func() {
+			golden := filepath.Join("testdata", "statement.pdf.golden")
+			if *update {
+				Expect(os.WriteFile(golden, data, 0o644)).To(Succeed())
+			}
+			expected, err := os.ReadFile(golden)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(expected))
+		})
+
+		It("should keep the text of every line", func() {
+			Expect(text(data, 0)).To(Equal([]string{
+				"WARRANTY REIMBURSEMENT STATEMENT",
+				"Office:   Trung tâm bảo hành Quận 7, TP. Hồ Chí Minh",
+				"Item:     Thay pin (ốc vít) đèn",
+				"Total      1.500.000 ₫",
+				"Unsupported: ??end",
+			}))
+		})
+
+		It("should point the cross reference table at every object", func() {
+			xref := xrefPattern.FindSubmatch(data)
+			Expect(xref).NotTo(BeNil())
+			start, _ := strconv.Atoi(string(xref[3]))
+			Expect(bytes.HasPrefix(data[start:], []byte("xref\n"))).To(BeTrue())
+
+			entries := strings.Split(strings.TrimSuffix(string(xref[2]), "\n"), "\n")
+			Expect(entries).To(HaveLen(len(objects(data))))
+			for i, entry := range entries {
+				offset, err := strconv.Atoi(entry[:10])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bytes.HasPrefix(data[offset:], []byte(strconv.Itoa(i+1)+" 0 obj\n"))).To(BeTrue())
+			}
+		})
+
+		It("should embed the glyphs it uses and their components", func() {
+			original, err := loadFont()
+			Expect(err).NotTo(HaveOccurred())
+			tables, err := fontTables(objects(data)[6])
+			Expect(err).NotTo(HaveOccurred())
+			numGlyphs := int(binary.BigEndian.Uint16(tables["maxp"][4:]))
+			offsets, err := glyphOffsets(tables["loca"], numGlyphs, true, len(tables["glyf"]))
+			Expect(err).NotTo(HaveOccurred())
+			subset := &font{tables: tables, offsets: offsets}
+			// ả, ồ and ố are added to the glyphs of the font.
+			Expect(numGlyphs).To(Equal(len(original.offsets) + 2))
+
+			for _, r := range "Hồ Chí Minh₫?" {
+				g := original.glyphs[r]
+				Expect(bytes.TrimRight(subset.glyphData(g), "\x00")).
+					To(Equal(bytes.TrimRight(original.glyphData(g), "\x00")), string(r))
+				for _, component := range compositeComponents(original.glyphData(g)) {
+					Expect(subset.glyphData(component)).NotTo(BeEmpty(), string(r))
+				}
+			}
+			added := subset.glyphData(uint16(len(original.offsets) - 1))
+			Expect(compositeComponents(added)).To(Equal([]uint16{original.glyphs['a'], original.glyphs['\u0309']}))
+			Expect(subset.glyphData(original.glyphs['a'])).NotTo(BeEmpty())
+			Expect(subset.glyphData(original.glyphs['Z'])).To(BeEmpty())
+			Expect(len(tables["hmtx"])).To(Equal(len(original.tables["hmtx"]) + 3*2))
+		})
+	})
+
+	Context("when the document has no lines", func() {
+		It("should write a single blank page", func() {
+			data := write(New())
+
+			Expect(string(data)).To(ContainSubstring("/Count 1 >>"))
+			Expect(text(data, 0)).To(BeEmpty())
+		})
+	})
+
+	Context("when lines fill a page", func() {
+		It("should start a new one", func() {
+			doc := New()
+			for i := range linesPerPage + 1 {
+				doc.Linef("Line %d", i)
+			}
+			data := write(doc)
+
+			Expect(string(data)).To(ContainSubstring("/Count 2 >>"))
+			Expect(text(data, 0)).To(HaveLen(linesPerPage))
+			Expect(text(data, 1)).To(Equal([]string{"Line " + strconv.Itoa(linesPerPage)}))
+		})
+	})
+})
+
+var _ = Describe("glyphSet", func() {
+	var (
+		f      *font
+		glyphs *glyphSet
+	)
+
+	BeforeEach(func() {
+		var err error
+		f, err = loadFont()
+		Expect(err).NotTo(HaveOccurred())
+		glyphs = newGlyphSet(f)
+	})
+
+	It("should draw every Vietnamese letter", func() {
+		for _, r := range "ăâđêôơưàảãáạằẳẵắặầẩẫấậèẻẽéẹềểễếệìỉĩíịòỏõóọồổỗốộờởỡớợùủũúụừửữứựỳỷỹýỵ" +
+			"ĂÂĐÊÔƠƯÀẢÃÁẠẰẲẴẮẶẦẨẪẤẬÈẺẼÉẸỀỂỄẾỆÌỈĨÍỊÒỎÕÓỌỒỔỖỐỘỜỞỠỚỢÙỦŨÚỤỪỬỮỨỰỲỶỸÝỴ" {
+			_, ok := glyphs.glyph(r)
+			Expect(ok).To(BeTrue(), string(r))
+		}
+	})
+
+	Context("when the font has no glyph for a letter", func() {
+		It("should add one stacking its marks above the closest letter", func() {
+			Expect(f.glyphs).NotTo(HaveKey('ấ'))
+
+			g, ok := glyphs.glyph('ấ')
+
+			Expect(ok).To(BeTrue())
+			Expect(int(g)).To(Equal(len(f.offsets) - 1))
+			Expect(compositeComponents(glyphs.extra[0])).To(Equal([]uint16{f.glyphs['â'], f.glyphs['\u0301']}))
+			again, _ := glyphs.glyph('ấ')
+			Expect(again).To(Equal(g))
+
+			circumflex, _ := f.bounds(f.glyphs['â'])
+			acute, _ := f.bounds(f.glyphs['\u0301'])
+			dy := int16(binary.BigEndian.Uint16(glyphs.extra[0][24:]))
+			Expect(acute[1] + dy).To(BeNumerically(">", circumflex[3]))
+		})
+	})
+
+	Context("when the font can not draw a character", func() {
+		It("should report it", func() {
+			_, ok := glyphs.glyph('漢')
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	It("should use a monospaced font", func() {
+		Expect(f.advance).To(Equal(602))
+	})
+})
@@ -0,0 +1,18 @@
+package xlsx_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+// update rewrites the golden files with the current output:
+// go test ./pkg/xlsx -update
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func TestXLSX(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "XLSX Suite")
+}
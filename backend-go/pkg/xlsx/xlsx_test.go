@@ -0,0 +1,72 @@
+package xlsx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"ev-warranty-go/pkg/xlsx"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Writer", func() {
+	// parts lists the files of a workbook with their content.
+	parts := func(data []byte) string {
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		Expect(err).NotTo(HaveOccurred())
+
+		var out strings.Builder
+		for _, file := range archive.File {
+			rc, err := file.Open()
+			Expect(err).NotTo(HaveOccurred())
+			content, err := io.ReadAll(rc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rc.Close()).To(Succeed())
+			fmt.Fprintf(&out, "== %s ==\n%s\n", file.Name, content)
+		}
+		return out.String()
+	}
+
+	Context("when rows are written", func() {
+		It("should match the golden file", func() {
+			var out bytes.Buffer
+			w, err := xlsx.NewWriter(&out, "Claims & <Items>")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(w.WriteRow("claim_id", "description", "kilometers", "cost", "ratio", "created_at")).To(Succeed())
+			Expect(w.WriteRow("8bfea98e-d865-46f5-88df-579bcad92c9e", "Pin <quá nhiệt> & \"hỏng\"\nthay mới",
+				12000, int64(1500000), 0.25, time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC))).To(Succeed())
+			Expect(w.WriteRow(nil, "control \x01 character", nil, true)).To(Succeed())
+			wide := make([]any, 28)
+			wide[27] = "AB"
+			Expect(w.WriteRow(wide...)).To(Succeed())
+			Expect(w.Close()).To(Succeed())
+
+			actual := parts(out.Bytes())
+			golden := filepath.Join("testdata", "workbook.golden")
+			if *update {
+				Expect(os.WriteFile(golden, []byte(actual), 0o644)).To(Succeed())
+			}
+			expected, err := os.ReadFile(golden)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(string(expected)))
+		})
+	})
+
+	Context("when no row is written", func() {
+		It("should write a workbook with an empty sheet", func() {
+			var out bytes.Buffer
+			w, err := xlsx.NewWriter(&out, "Empty")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(w.Close()).To(Succeed())
+
+			Expect(parts(out.Bytes())).To(ContainSubstring("<sheetData></sheetData>"))
+		})
+	})
+})
@@ -1,7 +1,7 @@
 import React from 'react'
 import { Card, Timeline, Tag, Typography, Empty } from 'antd'
 import { ClockCircleOutlined, HistoryOutlined, UserOutlined } from '@ant-design/icons'
-import { CLAIM_STATUS_LABELS } from '@constants/common-constants'
+import { CLAIM_STATUS_LABELS, REASON_CODE_LABELS } from '@constants/common-constants'
 import type { ClaimHistory as ClaimHistoryType, User } from '@/types/index'
 
 const { Title, Text } = Typography
@@ -64,7 +64,19 @@ const ClaimHistory: React.FC<ClaimHistoryProps> = ({ history, users, loading })
                   <Tag style={{ fontSize: '13px' }}>
                     {CLAIM_STATUS_LABELS[record.status] || record.status}
                   </Tag>
+                  {record.claim_item_id && <Tag color="blue">Item</Tag>}
                 </div>
+                {(record.reason_code || record.note) && (
+                  <div style={{ marginBottom: '8px' }}>
+                    {record.reason_code && (
+                      <Text strong style={{ fontSize: '12px' }}>
+                        {REASON_CODE_LABELS[record.reason_code] || record.reason_code}
+                        {record.note ? ': ' : ''}
+                      </Text>
+                    )}
+                    {record.note && <Text style={{ fontSize: '12px' }}>{record.note}</Text>}
+                  </div>
+                )}
                 <div style={{ display: 'flex', alignItems: 'center', gap: '4px' }}>
                   <UserOutlined style={{ fontSize: '12px', color: '#999' }} />
                   <Text type="secondary" style={{ fontSize: '12px' }}>
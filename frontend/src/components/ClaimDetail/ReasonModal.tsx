@@ -0,0 +1,80 @@
+import React, { useEffect } from 'react'
+import { Modal, Form, Input, Select } from 'antd'
+import { REASON_CODES, REASON_CODE_LABELS } from '@constants/common-constants'
+import type { ReasonRequest } from '@/types/index'
+
+const { Option } = Select
+const { TextArea } = Input
+
+interface ReasonModalProps {
+  visible: boolean
+  title: string
+  okText: string
+  loading?: boolean
+  onCancel: () => void
+  onSubmit: (data: ReasonRequest) => void
+}
+
+const ReasonModal: React.FC<ReasonModalProps> = ({
+  visible,
+  title,
+  okText,
+  loading,
+  onCancel,
+  onSubmit,
+}) => {
+  const [form] = Form.useForm<ReasonRequest>()
+
+  // Reset form when modal opens
+  useEffect(() => {
+    if (visible) {
+      form.resetFields()
+    }
+  }, [visible, form])
+
+  const handleOk = async () => {
+    const values = await form.validateFields()
+    onSubmit({ reason_code: values.reason_code, note: values.note.trim() })
+  }
+
+  return (
+    <Modal
+      title={title}
+      open={visible}
+      okText={okText}
+      okType="danger"
+      confirmLoading={loading}
+      onOk={handleOk}
+      onCancel={onCancel}
+      destroyOnClose
+    >
+      <Form form={form} layout="vertical">
+        <Form.Item
+          name="reason_code"
+          label="Reason"
+          rules={[{ required: true, message: 'Please select a reason' }]}
+        >
+          <Select placeholder="Select a reason">
+            {Object.values(REASON_CODES).map((code) => (
+              <Option key={code} value={code}>
+                {REASON_CODE_LABELS[code]}
+              </Option>
+            ))}
+          </Select>
+        </Form.Item>
+        <Form.Item
+          name="note"
+          label="Note"
+          rules={[
+            { required: true, whitespace: true, message: 'Please enter a note' },
+            { max: 1000, message: 'Note must not exceed 1000 characters' },
+          ]}
+        >
+          <TextArea rows={4} placeholder="Explain the decision" />
+        </Form.Item>
+      </Form>
+    </Modal>
+  )
+}
+
+export default ReasonModal
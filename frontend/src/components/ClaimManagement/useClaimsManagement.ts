@@ -4,7 +4,7 @@ import { customersApi } from '@/services/customersApi'
 import useDelay from '@/hooks/useDelay'
 import useHandleApiError from '@/hooks/useHandleApiError'
 import type { ErrorResponse } from '@/utils/errorHandler'
-import type { Claim, PaginationParams, Customer } from '@/types'
+import type { Claim, PaginationParams, Customer, ReasonRequest } from '@/types'
 import { allowRoles } from '@/utils/navigationHelpers'
 import { USER_ROLES } from '@/constants/common-constants'
 
@@ -31,7 +31,7 @@ interface UseClaimsManagementReturn {
   fetchClaims: (params?: PaginationParams) => Promise<void>
   handleReset: () => Promise<void>
   handleSubmit: (claimId: string) => Promise<void>
-  handleCancel: (claimId: string) => Promise<void>
+  handleCancel: (claimId: string, data: ReasonRequest) => Promise<void>
   handleDoneReview: (claimId: string) => Promise<void>
   handleReview: (claimId: string) => Promise<void>
   allowCreate: boolean
@@ -63,7 +63,7 @@ const useClaimsManagement = (): UseClaimsManagementReturn => {
       try {
         setLoading(true)
         const response = await claimsApi.getAll(params || pagination)
-        const claimsData = Array.isArray(response.data) ? response.data : []
+        const claimsData = Array.isArray(response.data?.claims) ? response.data.claims : []
 
         const customersResponse = await customersApi.getAll()
 
@@ -128,10 +128,10 @@ const useClaimsManagement = (): UseClaimsManagementReturn => {
     }
   }
 
-  const handleCancel = async (claimId: string) => {
+  const handleCancel = async (claimId: string, data: ReasonRequest) => {
     try {
       setLoading(true)
-      await claimsApi.cancel(claimId)
+      await claimsApi.cancel(claimId, data)
       await fetchClaims()
     } catch (error) {
       handleError(error as ErrorResponse)
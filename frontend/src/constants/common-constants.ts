@@ -151,6 +151,30 @@ export const CLAIM_ITEM_STATUS_LABELS: Record<ClaimItemStatus, string> = {
   [CLAIM_ITEM_STATUSES.COMPLETED]: 'Completed',
 }
 
+export const REASON_CODES = {
+  NOT_COVERED: 'NOT_COVERED',
+  WARRANTY_EXPIRED: 'WARRANTY_EXPIRED',
+  INSUFFICIENT_EVIDENCE: 'INSUFFICIENT_EVIDENCE',
+  MISUSE_OR_ACCIDENT: 'MISUSE_OR_ACCIDENT',
+  NO_FAULT_FOUND: 'NO_FAULT_FOUND',
+  DUPLICATE_CLAIM: 'DUPLICATE_CLAIM',
+  CUSTOMER_REQUEST: 'CUSTOMER_REQUEST',
+  OTHER: 'OTHER',
+} as const
+
+export type ReasonCode = (typeof REASON_CODES)[keyof typeof REASON_CODES]
+
+export const REASON_CODE_LABELS: Record<ReasonCode, string> = {
+  [REASON_CODES.NOT_COVERED]: 'Not Covered',
+  [REASON_CODES.WARRANTY_EXPIRED]: 'Warranty Expired',
+  [REASON_CODES.INSUFFICIENT_EVIDENCE]: 'Insufficient Evidence',
+  [REASON_CODES.MISUSE_OR_ACCIDENT]: 'Misuse or Accident',
+  [REASON_CODES.NO_FAULT_FOUND]: 'No Fault Found',
+  [REASON_CODES.DUPLICATE_CLAIM]: 'Duplicate Claim',
+  [REASON_CODES.CUSTOMER_REQUEST]: 'Customer Request',
+  [REASON_CODES.OTHER]: 'Other',
+}
+
 export const OFFICE_TYPES = {
   EVM: 'EVM',
   SC: 'SC',
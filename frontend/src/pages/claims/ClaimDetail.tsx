@@ -21,13 +21,27 @@ import ClaimAttachments from '@/components/ClaimDetail/ClaimAttachments'
 import WarrantyPolicyCard from '@/components/ClaimDetail/WarrantyPolicyCard'
 import ClaimHistory from '@/components/ClaimDetail/ClaimHistory'
 import PolicyCoverageModal from '@/components/ClaimDetail/PolicyCoverageModal'
+import ReasonModal from '@/components/ClaimDetail/ReasonModal'
 import useClaimData from '@/hooks/useClaimData'
 import useClaimPermissions from '@/hooks/useClaimPermissions'
 import { getClaimsBasePath } from '@/utils/navigationHelpers'
 import { claimsApi, claimItemsApi } from '@/services/claimsApi'
+import { CLAIM_ITEM_STATUSES } from '@constants/common-constants'
+import type { ReasonRequest } from '@/types/index'
 
 const { Title } = Typography
 
+type ReasonTarget =
+  | { kind: 'cancel' }
+  | { kind: 'reject-item'; itemId: string }
+  | { kind: 'done-review' }
+
+const REASON_MODAL_TITLES: Record<ReasonTarget['kind'], string> = {
+  cancel: 'Cancel Claim',
+  'reject-item': 'Reject Claim Item',
+  'done-review': 'Reject Claim',
+}
+
 const ClaimDetail: React.FC = () => {
   const { id } = useParams<{ id: string }>()
   const navigate = useNavigate()
@@ -43,6 +57,7 @@ const ClaimDetail: React.FC = () => {
   const [cancelLoading, setCancelLoading] = useState(false)
   const [startReviewLoading, setStartReviewLoading] = useState(false)
   const [doneReviewLoading, setDoneReviewLoading] = useState(false)
+  const [reasonTarget, setReasonTarget] = useState<ReasonTarget | null>(null)
 
   // Custom hooks
   const {
@@ -167,29 +182,25 @@ const ClaimDetail: React.FC = () => {
   }
 
   const handleCancelClaim = () => {
+    setReasonTarget({ kind: 'cancel' })
+  }
+
+  const cancelClaim = async (data: ReasonRequest) => {
     if (!id) return
 
-    Modal.confirm({
-      title: 'Cancel Claim',
-      content: 'Are you sure you want to cancel this claim?',
-      okText: 'Cancel Claim',
-      cancelText: 'Keep Claim',
-      okType: 'danger',
-      onOk: async () => {
-        try {
-          setCancelLoading(true)
-          await claimsApi.cancel(id)
-          message.success('Claim cancelled successfully')
-          refetchClaim() // Refresh claim to update status
-          refetchHistory() // Refresh history to show new entry
-        } catch (error) {
-          console.error('Error cancelling claim:', error)
-          message.error('Failed to cancel claim')
-        } finally {
-          setCancelLoading(false)
-        }
-      },
-    })
+    try {
+      setCancelLoading(true)
+      await claimsApi.cancel(id, data)
+      message.success('Claim cancelled successfully')
+      setReasonTarget(null)
+      refetchClaim() // Refresh claim to update status
+      refetchHistory() // Refresh history to show new entry
+    } catch (error) {
+      console.error('Error cancelling claim:', error)
+      message.error('Failed to cancel claim')
+    } finally {
+      setCancelLoading(false)
+    }
   }
 
   const handleStartReview = async () => {
@@ -224,12 +235,17 @@ const ClaimDetail: React.FC = () => {
     }
   }
 
-  const handleRejectClaimItem = async (itemId: string) => {
+  const handleRejectClaimItem = (itemId: string) => {
+    setReasonTarget({ kind: 'reject-item', itemId })
+  }
+
+  const rejectClaimItem = async (itemId: string, data: ReasonRequest) => {
     if (!id) return
 
     try {
-      await claimItemsApi.reject(id, itemId)
+      await claimItemsApi.reject(id, itemId, data)
       message.success('Claim item rejected successfully')
+      setReasonTarget(null)
       refetchClaimItems() // Refresh claim items
       refetchClaim() // Refresh claim to update status if needed
       refetchHistory() // Refresh history in case status changed
@@ -239,13 +255,26 @@ const ClaimDetail: React.FC = () => {
     }
   }
 
-  const handleDoneReviewClaim = async () => {
+  const handleDoneReviewClaim = () => {
+    // A claim with every item rejected ends up rejected, which needs a reason
+    const allRejected =
+      claimItems.length > 0 &&
+      claimItems.every((item) => item.status === CLAIM_ITEM_STATUSES.REJECTED)
+    if (allRejected) {
+      setReasonTarget({ kind: 'done-review' })
+      return
+    }
+    doneReviewClaim()
+  }
+
+  const doneReviewClaim = async (data?: ReasonRequest) => {
     if (!id) return
 
     try {
       setDoneReviewLoading(true)
-      await claimsApi.doneReview(id)
+      await claimsApi.doneReview(id, data)
       message.success('Claim done review successfully')
+      setReasonTarget(null)
       refetchClaim() // Refresh claim to update status
       refetchHistory() // Refresh history to show new entry
     } catch (error) {
@@ -256,6 +285,22 @@ const ClaimDetail: React.FC = () => {
     }
   }
 
+  const handleReasonSubmit = (data: ReasonRequest) => {
+    if (!reasonTarget) return
+
+    switch (reasonTarget.kind) {
+      case 'cancel':
+        cancelClaim(data)
+        break
+      case 'reject-item':
+        rejectClaimItem(reasonTarget.itemId, data)
+        break
+      case 'done-review':
+        doneReviewClaim(data)
+        break
+    }
+  }
+
   // Policy coverage modal handlers
   const handleViewPolicyCoverage = (categoryId: string, categoryName: string) => {
     setSelectedCategoryId(categoryId)
@@ -433,6 +478,18 @@ const ClaimDetail: React.FC = () => {
           />
         )}
 
+        {/* Reason Modal */}
+        {reasonTarget && (
+          <ReasonModal
+            visible={!!reasonTarget}
+            title={REASON_MODAL_TITLES[reasonTarget.kind]}
+            okText={REASON_MODAL_TITLES[reasonTarget.kind]}
+            loading={cancelLoading || doneReviewLoading}
+            onCancel={() => setReasonTarget(null)}
+            onSubmit={handleReasonSubmit}
+          />
+        )}
+
         {/* Policy Coverage Modal */}
         {policyCoverageModalVisible && selectedCategoryId && warrantyPolicy && (
           <PolicyCoverageModal
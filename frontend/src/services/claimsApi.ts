@@ -11,14 +11,17 @@ import type {
   CreateClaimItemRequest,
   ClaimItemListResponse,
   ClaimAttachmentListResponse,
+  ClaimListResponse,
   PaginationParams,
+  ReasonRequest,
+  NoteRequest,
 } from '@/types'
 
 export const claimsApi = {
-  getAll: (params?: PaginationParams): Promise<ApiSuccessResponse<Claim[]>> => {
+  getAll: (params?: PaginationParams): Promise<ApiSuccessResponse<ClaimListResponse>> => {
     const searchParams = new URLSearchParams()
     if (params?.page) searchParams.append('page', params.page.toString())
-    if (params?.limit) searchParams.append('limit', params.limit.toString())
+    if (params?.limit) searchParams.append('page_size', params.limit.toString())
     if (params?.status) searchParams.append('status', params.status)
 
     const query = searchParams.toString()
@@ -47,12 +50,12 @@ export const claimsApi = {
     return api.post(API_ENDPOINTS.CLAIM_ACTIONS.SUBMIT(id))
   },
 
-  cancel: (id: string): Promise<void> => {
-    return api.post(API_ENDPOINTS.CLAIM_ACTIONS.CANCEL(id))
+  cancel: (id: string, data: ReasonRequest): Promise<void> => {
+    return api.post(API_ENDPOINTS.CLAIM_ACTIONS.CANCEL(id), data)
   },
 
-  doneReview: (id: string): Promise<void> => {
-    return api.post(API_ENDPOINTS.CLAIM_ACTIONS.DONE_REVIEW(id))
+  doneReview: (id: string, data?: ReasonRequest): Promise<void> => {
+    return api.post(API_ENDPOINTS.CLAIM_ACTIONS.DONE_REVIEW(id), data)
   },
 
   review: (id: string): Promise<void> => {
@@ -84,12 +87,12 @@ export const claimItemsApi = {
     return api.delete(`${API_ENDPOINTS.CLAIM_ACTIONS.ITEMS(claimId)}/${itemId}`)
   },
 
-  approve: (claimId: string, itemId: string): Promise<void> => {
-    return api.post(API_ENDPOINTS.CLAIM_ITEM_ACTIONS.APPROVE(claimId, itemId))
+  approve: (claimId: string, itemId: string, data?: NoteRequest): Promise<void> => {
+    return api.post(API_ENDPOINTS.CLAIM_ITEM_ACTIONS.APPROVE(claimId, itemId), data)
   },
 
-  reject: (claimId: string, itemId: string): Promise<void> => {
-    return api.post(API_ENDPOINTS.CLAIM_ITEM_ACTIONS.REJECT(claimId, itemId))
+  reject: (claimId: string, itemId: string, data: ReasonRequest): Promise<void> => {
+    return api.post(API_ENDPOINTS.CLAIM_ITEM_ACTIONS.REJECT(claimId, itemId), data)
   },
 }
 
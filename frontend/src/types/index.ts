@@ -5,6 +5,7 @@ import type {
   ClaimItemStatus,
   ClaimItemType,
   AttachmentType,
+  ReasonCode,
 } from '../constants/common-constants'
 
 export interface ApiSuccessResponse<T = unknown> {
@@ -220,6 +221,18 @@ export interface ClaimHistory {
   status: ClaimStatus
   changed_by: string
   changed_at: string
+  claim_item_id?: string
+  reason_code?: ReasonCode
+  note?: string
+}
+
+export interface ReasonRequest {
+  reason_code: ReasonCode
+  note: string
+}
+
+export interface NoteRequest {
+  note?: string
 }
 
 export interface SortInfo {